@@ -19,6 +19,7 @@ package cmd
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"runtime/debug"
@@ -32,15 +33,19 @@ import (
 	"github.com/radius-project/radius/pkg/cli/bicep"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	app_delete "github.com/radius-project/radius/pkg/cli/cmd/app/delete"
+	app_export "github.com/radius-project/radius/pkg/cli/cmd/app/export"
 	app_graph "github.com/radius-project/radius/pkg/cli/cmd/app/graph"
 	app_list "github.com/radius-project/radius/pkg/cli/cmd/app/list"
 	app_show "github.com/radius-project/radius/pkg/cli/cmd/app/show"
 	app_status "github.com/radius-project/radius/pkg/cli/cmd/app/status"
+	audit "github.com/radius-project/radius/pkg/cli/cmd/audit"
 	bicep_generate_kubernetes_manifest "github.com/radius-project/radius/pkg/cli/cmd/bicep/generatekubernetesmanifest"
 	bicep_publish "github.com/radius-project/radius/pkg/cli/cmd/bicep/publish"
 	bicep_publishextension "github.com/radius-project/radius/pkg/cli/cmd/bicep/publishextension"
 	credential "github.com/radius-project/radius/pkg/cli/cmd/credential"
 	cmd_deploy "github.com/radius-project/radius/pkg/cli/cmd/deploy"
+	cmd_diff "github.com/radius-project/radius/pkg/cli/cmd/diff"
+	cmd_doctor "github.com/radius-project/radius/pkg/cli/cmd/doctor"
 	env_create "github.com/radius-project/radius/pkg/cli/cmd/env/create"
 	env_create_preview "github.com/radius-project/radius/pkg/cli/cmd/env/create/preview"
 	env_delete "github.com/radius-project/radius/pkg/cli/cmd/env/delete"
@@ -57,6 +62,8 @@ import (
 	group "github.com/radius-project/radius/pkg/cli/cmd/group"
 	"github.com/radius-project/radius/pkg/cli/cmd/install"
 	install_kubernetes "github.com/radius-project/radius/pkg/cli/cmd/install/kubernetes"
+	lock "github.com/radius-project/radius/pkg/cli/cmd/lock"
+	cmd_query "github.com/radius-project/radius/pkg/cli/cmd/query"
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
@@ -67,7 +74,10 @@ import (
 	recipe_pack_show "github.com/radius-project/radius/pkg/cli/cmd/recipepack/show"
 	resource_create "github.com/radius-project/radius/pkg/cli/cmd/resource/create"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
+	resource_exec "github.com/radius-project/radius/pkg/cli/cmd/resource/exec"
+	resource_invoke "github.com/radius-project/radius/pkg/cli/cmd/resource/invoke"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
+	resource_portforward "github.com/radius-project/radius/pkg/cli/cmd/resource/portforward"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
 	resourceprovider_create "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/create"
 	resourceprovider_delete "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/delete"
@@ -196,7 +206,11 @@ func Execute() error {
 	ctx, span := tr.Start(ctx, spanName)
 	defer span.End()
 	err = RootCmd.ExecuteContext(ctx)
-	if clierrors.IsFriendlyError(err) {
+	var exitCodeError *clierrors.ExitCodeError
+	if errors.As(err, &exitCodeError) {
+		// The command has already displayed its output.
+		return err
+	} else if clierrors.IsFriendlyError(err) {
 		fmt.Println(err.Error())
 		fmt.Println("") // Output an extra blank line for readability
 		return err
@@ -251,7 +265,8 @@ func initSubCommands() {
 	framework := &framework.Impl{
 		Bicep: &bicep.Impl{
 			FileSystem: filesystem.OSFileSystem{},
-			Output:     &output.OutputWriter{Writer: RootCmd.OutOrStdout()},
+			// Build messages are written to stderr so that they don't mix with structured output on stdout.
+			Output: &output.OutputWriter{Writer: RootCmd.ErrOrStderr()},
 		},
 		ConnectionFactory: connections.DefaultFactory,
 		ConfigHolder:      ConfigHolder,
@@ -279,6 +294,12 @@ func initSubCommands() {
 	runCmd, _ := run.NewCommand(framework)
 	RootCmd.AddCommand(runCmd)
 
+	diffCmd, _ := cmd_diff.NewCommand(framework)
+	RootCmd.AddCommand(diffCmd)
+
+	doctorCmd, _ := cmd_doctor.NewCommand(framework)
+	RootCmd.AddCommand(doctorCmd)
+
 	resourceShowCmd, _ := resource_show.NewCommand(framework)
 	resourceCmd.AddCommand(resourceShowCmd)
 
@@ -291,6 +312,15 @@ func initSubCommands() {
 	resourceDeleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(resourceDeleteCmd)
 
+	resourceExecCmd, _ := resource_exec.NewCommand(framework)
+	resourceCmd.AddCommand(resourceExecCmd)
+
+	resourcePortForwardCmd, _ := resource_portforward.NewCommand(framework)
+	resourceCmd.AddCommand(resourcePortForwardCmd)
+
+	resourceInvokeCmd, _ := resource_invoke.NewCommand(framework)
+	resourceCmd.AddCommand(resourceInvokeCmd)
+
 	resourceProviderShowCmd, _ := resourceprovider_show.NewCommand(framework)
 	resourceProviderCmd.AddCommand(resourceProviderShowCmd)
 
@@ -342,6 +372,15 @@ func initSubCommands() {
 	groupCmd := group.NewCommand(framework)
 	RootCmd.AddCommand(groupCmd)
 
+	lockCmd := lock.NewCommand(framework)
+	RootCmd.AddCommand(lockCmd)
+
+	auditCmd := audit.NewCommand(framework)
+	RootCmd.AddCommand(auditCmd)
+
+	queryCmd, _ := cmd_query.NewCommand(framework)
+	RootCmd.AddCommand(queryCmd)
+
 	initCmd, _ := radinit.NewCommand(framework)
 	RootCmd.AddCommand(initCmd)
 
@@ -412,6 +451,9 @@ func initSubCommands() {
 	appGraphCmd, _ := app_graph.NewCommand(framework)
 	applicationCmd.AddCommand(appGraphCmd)
 
+	appExportCmd, _ := app_export.NewCommand(framework)
+	applicationCmd.AddCommand(appExportCmd)
+
 	envSwitchCmd, _ := env_switch.NewCommand(framework)
 	previewEnvSwitchCmd, _ := env_switch_preview.NewCommand(framework)
 	wirePreviewSubcommand(envSwitchCmd, previewEnvSwitchCmd)
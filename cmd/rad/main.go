@@ -20,11 +20,12 @@ import (
 	"os"
 
 	"github.com/radius-project/radius/cmd/rad/cmd"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 )
 
 func main() {
 	err := cmd.Execute()
 	if err != nil {
-		os.Exit(1) //nolint:forbidigo // this is OK inside the main function.
+		os.Exit(clierrors.GetExitCode(err)) //nolint:forbidigo // this is OK inside the main function.
 	}
 }
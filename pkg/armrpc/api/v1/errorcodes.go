@@ -61,4 +61,10 @@ const (
 
 	// Used for failed invalid spec api validation.
 	CodeHTTPRequestPayloadAPISpecValidationFailed = "HttpRequestPayloadAPISpecValidationFailed"
+
+	// Used when a request is blocked by a management lock.
+	CodeScopeLocked = "ScopeLocked"
+
+	// Used when the caller is not authorized to perform the requested action.
+	CodeAuthorizationFailed = "AuthorizationFailed"
 )
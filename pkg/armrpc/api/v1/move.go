@@ -0,0 +1,36 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+const (
+	// ValidateMoveResourcesActionName is the name of the action that validates whether resources can be moved to
+	// another resource group.
+	ValidateMoveResourcesActionName = "validateMoveResources"
+
+	// MoveResourcesActionName is the name of the action that moves resources to another resource group.
+	MoveResourcesActionName = "moveResources"
+)
+
+// ResourcesMoveInfo represents the request body of the validateMoveResources and moveResources actions.
+// https://github.com/Azure/azure-resource-manager-rpc/blob/master/v1.0/resource-api-reference.md#move-resource
+type ResourcesMoveInfo struct {
+	// Resources is the list of IDs of the resources to move. The resources must be in the same resource group.
+	Resources []string `json:"resources"`
+
+	// TargetResourceGroup is the ID of the resource group to move the resources to.
+	TargetResourceGroup string `json:"targetResourceGroup"`
+}
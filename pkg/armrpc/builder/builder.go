@@ -65,6 +65,14 @@ func defaultHandlerOptions(
 
 	statusType := namespace + "/operationstatuses"
 	resultType := namespace + "/operationresults"
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses", rootScopePath, namespace),
+		ResourceType:      statusType,
+		Method:            v1.OperationList,
+		ControllerFactory: defaultoperation.NewListOperationStatuses,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}", rootScopePath, namespace),
@@ -81,6 +89,25 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationResult,
 	})
 
+	// UCP validates and then commits moves of resources between resource groups with each resource provider.
+	// https://github.com/Azure/azure-resource-manager-rpc/blob/master/v1.0/resource-api-reference.md#move-resource
+	for _, action := range []struct {
+		name    string
+		factory server.ControllerFactoryFunc
+	}{
+		{name: v1.ValidateMoveResourcesActionName, factory: defaultoperation.NewValidateMoveResources},
+		{name: v1.MoveResourcesActionName, factory: defaultoperation.NewMoveResources},
+	} {
+		name := strings.ToLower(action.name)
+		handlers = append(handlers, server.HandlerOptions{
+			ParentRouter:      rootRouter,
+			Path:              fmt.Sprintf("%s%s/providers/%s/%s", rootScopePath, ResourceGroupPath, namespace, name),
+			ResourceType:      namespace + "/" + name,
+			Method:            v1.OperationPost,
+			ControllerFactory: action.factory,
+		})
+	}
+
 	return handlers
 }
 
@@ -157,6 +157,10 @@ var defaultHandlerTests = []rpctest.HandlerTestSpec{
 	},
 	// default operations
 	{
+		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationList},
+		Path:          "/providers/applications.compute/locations/global/operationstatuses",
+		Method:        http.MethodGet,
+	}, {
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationStatuses", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationstatuses/00000000-0000-0000-0000-000000000000",
 		Method:        http.MethodGet,
@@ -164,6 +168,14 @@ var defaultHandlerTests = []rpctest.HandlerTestSpec{
 		OperationType: v1.OperationType{Type: "Applications.Compute/operationResults", Method: v1.OperationGet},
 		Path:          "/providers/applications.compute/locations/global/operationresults/00000000-0000-0000-0000-000000000000",
 		Method:        http.MethodGet,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/validateMoveResources", Method: v1.OperationPost},
+		Path:          "/resourcegroups/testrg/providers/applications.compute/validatemoveresources",
+		Method:        http.MethodPost,
+	}, {
+		OperationType: v1.OperationType{Type: "Applications.Compute/moveResources", Method: v1.OperationPost},
+		Path:          "/resourcegroups/testrg/providers/applications.compute/moveresources",
+		Method:        http.MethodPost,
 	},
 }
 
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ResourceIDQueryParameter is the query parameter of the resource whose operation statuses are listed.
+const ResourceIDQueryParameter = "resourceId"
+
+var _ ctrl.Controller = (*ListOperationStatuses)(nil)
+
+// ListOperationStatuses is the controller implementation to list the async operation statuses of a resource.
+type ListOperationStatuses struct {
+	ctrl.BaseController
+}
+
+// NewListOperationStatuses creates a new ListOperationStatuses.
+func NewListOperationStatuses(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ListOperationStatuses{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the statuses of the asynchronous operations of the resource given by the resourceId query parameter,
+// starting with the latest operation. Resources keep no reference to their operations, so this is how clients find
+// out why the last operation on a resource failed.
+func (e *ListOperationStatuses) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	resourceID := req.URL.Query().Get(ResourceIDQueryParameter)
+	if _, err := resources.ParseResource(resourceID); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The %s query parameter must be a valid resource ID.", ResourceIDQueryParameter)), nil
+	}
+
+	query := database.Query{
+		RootScope:    serviceCtx.ResourceID.RootScope(),
+		ResourceType: serviceCtx.ResourceID.Type(),
+	}
+
+	statuses := []v1.AsyncOperationStatus{}
+	token := ""
+	for {
+		result, err := e.DatabaseClient().Query(ctx, query, database.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			status := &manager.Status{}
+			if err := item.As(status); err != nil {
+				return nil, err
+			}
+
+			// Resource IDs are compared case-insensitively.
+			if strings.EqualFold(status.LinkedResourceID, resourceID) {
+				statuses = append(statuses, status.AsyncOperationStatus)
+			}
+		}
+
+		token = result.PaginationToken
+		if token == "" {
+			break
+		}
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return statuses[i].StartTime.After(statuses[j].StartTime)
+	})
+
+	items := make([]any, len(statuses))
+	for i, status := range statuses {
+		items[i] = status
+	}
+
+	return rest.NewOKResponse(&v1.PaginatedList{Value: items}), nil
+}
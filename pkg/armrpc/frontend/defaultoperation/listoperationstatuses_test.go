@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOperationStatusesRun(t *testing.T) {
+	ctx := context.Background()
+	databaseClient := inmemory.NewClient()
+
+	const (
+		containerID      = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend"
+		operationsPath   = "/planes/radius/local/providers/applications.core/locations/global/operationstatuses"
+		listStatusesPath = "/planes/radius/local/providers/Applications.Core/locations/global/operationStatuses"
+	)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, status := range []manager.Status{
+		{
+			AsyncOperationStatus: v1.AsyncOperationStatus{Name: "first", Status: v1.ProvisioningStateSucceeded, StartTime: start},
+			LinkedResourceID:     containerID,
+		},
+		{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				Name:      "second",
+				Status:    v1.ProvisioningStateFailed,
+				StartTime: start.Add(time.Hour),
+				Error:     &v1.ErrorDetails{Code: v1.CodeInternal, Message: "failed to render the container"},
+			},
+			LinkedResourceID: containerID,
+		},
+		{
+			AsyncOperationStatus: v1.AsyncOperationStatus{Name: "other", Status: v1.ProvisioningStateSucceeded, StartTime: start.Add(2 * time.Hour)},
+			LinkedResourceID:     "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/backend",
+		},
+	} {
+		status.ID = operationsPath + "/" + status.Name
+		err := databaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: status.ID}, Data: &status})
+		require.NoError(t, err, "status %d", i)
+	}
+
+	t.Run("statuses of the resource", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestWithContent(ctx, http.MethodGet, listStatusesPath+"?api-version=2023-10-01-preview&resourceId="+containerID, nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewListOperationStatuses(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		err = resp.Apply(ctx, w, req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		actual := struct {
+			Value []v1.AsyncOperationStatus `json:"value"`
+		}{}
+		err = json.Unmarshal(w.Body.Bytes(), &actual)
+		require.NoError(t, err)
+
+		require.Len(t, actual.Value, 2)
+		require.Equal(t, "second", actual.Value[0].Name)
+		require.Equal(t, &v1.ErrorDetails{Code: v1.CodeInternal, Message: "failed to render the container"}, actual.Value[0].Error)
+		require.Equal(t, "first", actual.Value[1].Name)
+	})
+
+	t.Run("invalid resource ID", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req, err := rpctest.NewHTTPRequestWithContent(ctx, http.MethodGet, listStatusesPath+"?api-version=2023-10-01-preview", nil)
+		require.NoError(t, err)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewListOperationStatuses(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, w, req)
+		require.NoError(t, err)
+		err = resp.Apply(ctx, w, req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
+}
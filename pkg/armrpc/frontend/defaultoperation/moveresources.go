@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ ctrl.Controller = (*ValidateMoveResources)(nil)
+
+// ValidateMoveResources is the controller implementation of the validateMoveResources action of a resource provider.
+//
+// UCP calls this action on the resource provider of the resources before it moves them to another resource group. The
+// resource provider rejects the move if the resources do not exist or are still being provisioned. Rejections use
+// Conflict rather than NotFound, because UCP treats NotFound as a resource provider that does not support moves.
+type ValidateMoveResources struct {
+	ctrl.BaseController
+}
+
+// NewValidateMoveResources creates a new ValidateMoveResources.
+func NewValidateMoveResources(opts ctrl.Options) (ctrl.Controller, error) {
+	return &ValidateMoveResources{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run validates that the resources of the request can be moved. It returns NoContent if they can be moved, and Conflict
+// if a resource does not exist or is still being provisioned.
+func (e *ValidateMoveResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	info, response, err := ReadMoveRequest(req)
+	if response != nil || err != nil {
+		return response, err
+	}
+
+	for _, id := range info.Resources {
+		obj, err := e.DatabaseClient().Get(ctx, id)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			return rest.NewConflictResponse(fmt.Sprintf("The resource %q was not found.", id)), nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		resource := v1.BaseResource{}
+		if err := obj.As(&resource); err != nil {
+			return nil, err
+		}
+
+		if !resource.ProvisioningState().IsTerminal() {
+			return rest.NewConflictResponse(fmt.Sprintf("The resource %q is being provisioned and cannot be moved.", id)), nil
+		}
+	}
+
+	return rest.NewNoContentResponse(), nil
+}
+
+var _ ctrl.Controller = (*MoveResources)(nil)
+
+// MoveResources is the controller implementation of the moveResources action of a resource provider.
+//
+// UCP calls this action on the resource provider of the resources after it has saved the resources with their new IDs,
+// and before it deletes them from the source resource group, so that the resource provider can move any state that it
+// keeps outside of its resources. If the move is rolled back, UCP calls this action again with the source and target
+// resource groups swapped. Resource providers that keep no such state only need to acknowledge the move.
+type MoveResources struct {
+	ctrl.BaseController
+}
+
+// NewMoveResources creates a new MoveResources.
+func NewMoveResources(opts ctrl.Options) (ctrl.Controller, error) {
+	return &MoveResources{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run acknowledges the move of the resources of the request.
+func (e *MoveResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	_, response, err := ReadMoveRequest(req)
+	if response != nil || err != nil {
+		return response, err
+	}
+
+	return rest.NewNoContentResponse(), nil
+}
+
+// ReadMoveRequest reads the body of a validateMoveResources or moveResources request. The request path is the action
+// of the resource provider namespace in the source resource group, for example:
+//
+//	/planes/radius/local/resourcegroups/rg1/providers/Applications.Core/moveResources
+//
+// A BadRequest response is returned if the body is invalid, or if a resource is not in the resource group or namespace.
+func ReadMoveRequest(req *http.Request) (*v1.ResourcesMoveInfo, rest.Response, error) {
+	path := strings.TrimPrefix(req.URL.Path, v1.ParsePathBase(req.URL.Path))
+	index := strings.LastIndex(strings.ToLower(path), resources.SegmentSeparator+resources.ProvidersSegment+resources.SegmentSeparator)
+	if index < 0 {
+		return nil, rest.NewBadRequestResponse(fmt.Sprintf("The path %q is not a valid move request.", path)), nil
+	}
+
+	resourceGroup, err := resources.ParseScope(path[:index])
+	if err != nil {
+		return nil, rest.NewBadRequestResponse(fmt.Sprintf("The path %q is not a valid move request.", path)), nil
+	}
+	namespace, _, _ := strings.Cut(path[index+len(resources.ProvidersSegment)+2:], resources.SegmentSeparator)
+
+	content, err := ctrl.ReadJSONBody(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info := &v1.ResourcesMoveInfo{}
+	if err := json.Unmarshal(content, info); err != nil {
+		return nil, rest.NewBadRequestResponse(fmt.Sprintf("The request body is invalid: %s.", err.Error())), nil
+	}
+
+	if len(info.Resources) == 0 {
+		return nil, rest.NewBadRequestResponse("At least one resource must be specified."), nil
+	}
+
+	for _, resource := range info.Resources {
+		id, err := resources.ParseResource(resource)
+		if err != nil {
+			return nil, rest.NewBadRequestResponse(fmt.Sprintf("The resource %q is not a valid resource id.", resource)), nil
+		}
+
+		if !strings.EqualFold(id.RootScope(), resourceGroup.String()) || !strings.EqualFold(id.ProviderNamespace(), namespace) {
+			return nil, rest.NewBadRequestResponse(fmt.Sprintf("The resource %q is not a %s resource in the resource group %q.", resource, namespace, resourceGroup.String())), nil
+		}
+	}
+
+	return info, nil, nil
+}
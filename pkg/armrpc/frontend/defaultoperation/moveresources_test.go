@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	moveTestResourceGroup = "/planes/radius/local/resourceGroups/source"
+	moveTestContainerID   = moveTestResourceGroup + "/providers/Applications.Core/containers/frontend"
+	moveTestGatewayID     = moveTestResourceGroup + "/providers/Applications.Core/gateways/public"
+)
+
+func TestValidateMoveResourcesRun(t *testing.T) {
+	ctx := context.Background()
+	databaseClient := inmemory.NewClient()
+
+	for id, state := range map[string]v1.ProvisioningState{
+		moveTestContainerID: v1.ProvisioningStateSucceeded,
+		moveTestGatewayID:   v1.ProvisioningStateUpdating,
+	} {
+		resource := v1.BaseResource{}
+		resource.ID = id
+		resource.SetProvisioningState(state)
+		err := databaseClient.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: resource})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name           string
+		path           string
+		resources      []string
+		expectedStatus int
+	}{
+		{
+			name:           "valid",
+			path:           "/apis/api.ucp.dev/v1alpha3" + moveTestResourceGroup + "/providers/applications.core/validatemoveresources",
+			resources:      []string{moveTestContainerID},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "resource being provisioned",
+			path:           moveTestResourceGroup + "/providers/applications.core/validatemoveresources",
+			resources:      []string{moveTestContainerID, moveTestGatewayID},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "resource not found",
+			path:           moveTestResourceGroup + "/providers/applications.core/validatemoveresources",
+			resources:      []string{moveTestResourceGroup + "/providers/Applications.Core/containers/backend"},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "resource in another namespace",
+			path:           moveTestResourceGroup + "/providers/applications.core/validatemoveresources",
+			resources:      []string{moveTestResourceGroup + "/providers/Applications.Datastores/redisCaches/cache"},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "resource in another resource group",
+			path:           moveTestResourceGroup + "/providers/applications.core/validatemoveresources",
+			resources:      []string{"/planes/radius/local/resourceGroups/other/providers/Applications.Core/containers/frontend"},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "no resources",
+			path:           moveTestResourceGroup + "/providers/applications.core/validatemoveresources",
+			resources:      []string{},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := newMoveRequest(t, tt.path, tt.resources)
+			ctx := rpctest.NewARMRequestContext(req)
+
+			ctl, err := NewValidateMoveResources(ctrl.Options{DatabaseClient: databaseClient})
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.expectedStatus, w.Result().StatusCode)
+		})
+	}
+}
+
+func TestMoveResourcesRun(t *testing.T) {
+	w := httptest.NewRecorder()
+	req := newMoveRequest(t, moveTestResourceGroup+"/providers/applications.core/moveresources", []string{moveTestContainerID})
+	ctx := rpctest.NewARMRequestContext(req)
+
+	ctl, err := NewMoveResources(ctrl.Options{DatabaseClient: inmemory.NewClient()})
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+}
+
+func newMoveRequest(t *testing.T, path string, resources []string) *http.Request {
+	body, err := json.Marshal(v1.ResourcesMoveInfo{
+		Resources:           resources,
+		TargetResourceGroup: "/planes/radius/local/resourceGroups/target",
+	})
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, path+"?api-version=2023-10-01-preview", bytes.NewBuffer(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
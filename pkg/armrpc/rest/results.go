@@ -601,6 +601,19 @@ func NewConflictResponse(message string) Response {
 	}
 }
 
+// NewScopeLockedResponse creates a ConflictResponse for a request that is blocked by a management lock.
+func NewScopeLockedResponse(target string, message string) Response {
+	return &ConflictResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeScopeLocked,
+				Message: message,
+				Target:  target,
+			},
+		},
+	}
+}
+
 // Apply renders 409 Conflict HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
 func (r *ConflictResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -727,6 +740,44 @@ func (r *ClientAuthenticationFailed) Apply(ctx context.Context, w http.ResponseW
 	return nil
 }
 
+// ForbiddenResponse represents an HTTP 403 with an ARM error payload.
+type ForbiddenResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewAuthorizationFailedResponse creates a ForbiddenResponse with CodeAuthorizationFailed code for a request
+// that the caller is not authorized to perform.
+func NewAuthorizationFailedResponse(target string, message string) Response {
+	return &ForbiddenResponse{
+		Body: v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeAuthorizationFailed,
+				Message: message,
+				Target:  target,
+			},
+		},
+	}
+}
+
+// Apply writes a response with status code 403 Forbidden and a JSON body to the response writer.
+func (r *ForbiddenResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusForbidden), logging.LogHTTPStatusCode, http.StatusForbidden)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusForbidden)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+	return nil
+}
+
 // AsyncOperationResultResponse
 type AsyncOperationResultResponse struct {
 	Headers map[string]string
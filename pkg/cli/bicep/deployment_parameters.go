@@ -70,6 +70,24 @@ func (pp ParameterParser) Parse(inputs ...string) (clients.DeploymentParameters,
 	return output, nil
 }
 
+// FilePaths returns the paths of the files referenced by the given parameters, in the order they are referenced.
+func (pp ParameterParser) FilePaths(inputs ...string) []string {
+	filePaths := []string{}
+	for _, input := range inputs {
+		if strings.HasPrefix(input, "@") {
+			filePaths = append(filePaths, strings.TrimPrefix(input, "@"))
+			continue
+		}
+
+		parts := strings.SplitN(input, "=", 2)
+		if len(parts) == 2 && strings.HasPrefix(parts[1], "@") {
+			filePaths = append(filePaths, strings.TrimPrefix(parts[1], "@"))
+		}
+	}
+
+	return filePaths
+}
+
 func (pp ParameterParser) parseSingle(input string, output clients.DeploymentParameters) error {
 	// Parameters come in one of three forms:
 	//
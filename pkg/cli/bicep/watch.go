@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+)
+
+// DefaultWatchInterval is the interval at which the Watcher checks the watched files for changes.
+const DefaultWatchInterval = 500 * time.Millisecond
+
+// moduleReferenceRegex matches the file references of module declarations and import statements in a Bicep file.
+var moduleReferenceRegex = regexp.MustCompile(`(?m)^\s*(?:module\s+\S+|import\b.*\bfrom)\s+'([^']+)'`)
+
+// Watcher checks the source files of a template for changes. Files are compared by content, so saving a file without
+// changing it is not reported as a change.
+type Watcher struct {
+	// FileSystem is the file system used to read the watched files.
+	FileSystem filesystem.FileSystem
+
+	// Interval is the interval at which the watched files are checked. DefaultWatchInterval is used if it is not set.
+	Interval time.Duration
+}
+
+// Watch starts watching the template at filePath, the local modules it references and the given additional files.
+// The list of changed files is sent on the returned channel each time any of the files changes. The list of modules is
+// refreshed on every check, so modules added to the template are watched as well. The channel is closed when the
+// context is cancelled.
+func (w *Watcher) Watch(ctx context.Context, filePath string, additionalFilePaths []string) <-chan []string {
+	interval := w.Interval
+	if interval == 0 {
+		interval = DefaultWatchInterval
+	}
+
+	changes := make(chan []string)
+	previous := w.snapshot(filePath, additionalFilePaths)
+
+	go func() {
+		defer close(changes)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			current := w.snapshot(filePath, additionalFilePaths)
+			changed := compareSnapshots(previous, current)
+			previous = current
+			if len(changed) == 0 {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case changes <- changed:
+			}
+		}
+	}()
+
+	return changes
+}
+
+// snapshot reads the content of the watched files. Files that can't be read are recorded with empty content so that
+// creating or deleting them is reported as a change.
+func (w *Watcher) snapshot(filePath string, additionalFilePaths []string) map[string]string {
+	files := append(SourceFiles(w.FileSystem, filePath), additionalFilePaths...)
+
+	snapshot := map[string]string{}
+	for _, file := range files {
+		b, err := w.FileSystem.ReadFile(file)
+		if err != nil {
+			snapshot[file] = ""
+			continue
+		}
+
+		snapshot[file] = string(b)
+	}
+
+	return snapshot
+}
+
+// compareSnapshots returns the sorted list of files that were added, removed or changed between two snapshots.
+func compareSnapshots(previous map[string]string, current map[string]string) []string {
+	changed := []string{}
+	for file, content := range current {
+		if previousContent, ok := previous[file]; !ok || previousContent != content {
+			changed = append(changed, file)
+		}
+	}
+
+	for file := range previous {
+		if _, ok := current[file]; !ok {
+			changed = append(changed, file)
+		}
+	}
+
+	sort.Strings(changed)
+	return changed
+}
+
+// SourceFiles returns the template at filePath and the local Bicep files it references through module declarations
+// and import statements, recursively. References to registries and template specs are ignored. Referenced files that
+// don't exist are included so that they can be watched for creation.
+func SourceFiles(fs filesystem.FileSystem, filePath string) []string {
+	files := []string{}
+	visited := map[string]bool{}
+
+	var visit func(file string)
+	visit = func(file string) {
+		file = filepath.Clean(file)
+		if visited[file] {
+			return
+		}
+
+		visited[file] = true
+		files = append(files, file)
+
+		if !strings.EqualFold(filepath.Ext(file), ".bicep") {
+			return
+		}
+
+		b, err := fs.ReadFile(file)
+		if err != nil {
+			return
+		}
+
+		for _, match := range moduleReferenceRegex.FindAllStringSubmatch(string(b), -1) {
+			reference := match[1]
+
+			// Registry and template spec references use a scheme, such as 'br:' or 'ts:'.
+			if strings.Contains(reference, ":") {
+				continue
+			}
+
+			visit(filepath.Join(filepath.Dir(file), filepath.FromSlash(reference)))
+		}
+	}
+
+	visit(filePath)
+	return files
+}
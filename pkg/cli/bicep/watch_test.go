@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bicep
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SourceFiles(t *testing.T) {
+	fs := filesystem.NewMemMapFileSystem()
+	require.NoError(t, fs.WriteFile("app.bicep", []byte(`
+extension radius
+
+import { tags } from 'shared/types.bicep'
+
+module db 'modules/db.bicep' = {
+  name: 'db'
+}
+
+module registry 'br:myregistry.azurecr.io/bicep/modules/storage:v1' = {
+  name: 'storage'
+}
+
+module missing './modules/missing.bicep' = {
+  name: 'missing'
+}
+`), 0644))
+	require.NoError(t, fs.WriteFile(filepath.Join("modules", "db.bicep"), []byte(`
+module secrets '../shared/secrets.bicep' = {
+  name: 'secrets'
+}
+
+// The app template references this module too, but it is only listed once.
+module db './db.bicep' = {
+  name: 'db'
+}
+`), 0644))
+	require.NoError(t, fs.WriteFile(filepath.Join("shared", "secrets.bicep"), []byte(`param name string`), 0644))
+
+	files := SourceFiles(fs, "app.bicep")
+	require.Equal(t, []string{
+		"app.bicep",
+		filepath.Join("shared", "types.bicep"),
+		filepath.Join("modules", "db.bicep"),
+		filepath.Join("shared", "secrets.bicep"),
+		filepath.Join("modules", "missing.bicep"),
+	}, files)
+}
+
+func Test_SourceFiles_JSON(t *testing.T) {
+	fs := filesystem.NewMemMapFileSystem()
+	require.NoError(t, fs.WriteFile("app.json", []byte(`{}`), 0644))
+
+	require.Equal(t, []string{"app.json"}, SourceFiles(fs, "app.json"))
+}
+
+func Test_compareSnapshots(t *testing.T) {
+	previous := map[string]string{"app.bicep": "a", "modules/db.bicep": "b", "modules/old.bicep": "c"}
+	current := map[string]string{"app.bicep": "a", "modules/db.bicep": "changed", "modules/new.bicep": "d"}
+
+	require.Equal(t, []string{"modules/db.bicep", "modules/new.bicep", "modules/old.bicep"}, compareSnapshots(previous, current))
+	require.Empty(t, compareSnapshots(previous, previous))
+}
+
+func Test_Watcher(t *testing.T) {
+	directory := t.TempDir()
+	templateFilePath := filepath.Join(directory, "app.bicep")
+	moduleFilePath := filepath.Join(directory, "db.bicep")
+	parameterFilePath := filepath.Join(directory, "params.json")
+
+	require.NoError(t, os.WriteFile(templateFilePath, []byte(`param name string`), 0644))
+	require.NoError(t, os.WriteFile(parameterFilePath, []byte(`{"parameters": {}}`), 0644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watcher := Watcher{FileSystem: filesystem.NewOSFS(), Interval: 10 * time.Millisecond}
+	changes := watcher.Watch(ctx, templateFilePath, []string{parameterFilePath})
+
+	receive := func() []string {
+		select {
+		case changed := <-changes:
+			return changed
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "timed out waiting for changes")
+			return nil
+		}
+	}
+
+	// Files that are not referenced by the template are not watched, until a module declaration references them.
+	require.NoError(t, os.WriteFile(moduleFilePath, []byte(`param name string`), 0644))
+	require.NoError(t, os.WriteFile(templateFilePath, []byte(`module db 'db.bicep' = { name: 'db' }`), 0644))
+	require.Equal(t, []string{templateFilePath, moduleFilePath}, receive())
+
+	// Changes to modules and parameter files are reported.
+	require.NoError(t, os.WriteFile(moduleFilePath, []byte(`param name string = 'db'`), 0644))
+	require.Equal(t, []string{moduleFilePath}, receive())
+
+	require.NoError(t, os.WriteFile(parameterFilePath, []byte(`{"parameters": {"name": {"value": "db"}}}`), 0644))
+	require.Equal(t, []string{parameterFilePath}, receive())
+
+	// The channel is closed when the context is cancelled.
+	cancel()
+	for range changes {
+	}
+}
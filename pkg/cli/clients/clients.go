@@ -21,6 +21,7 @@ import (
 	"io"
 	"os"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	radiuscore "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
@@ -82,6 +83,10 @@ const (
 type ResourceProgress struct {
 	Resource ucpresources.ID
 	Status   ResourceStatus
+
+	// Error describes why the resource failed. It is only set when the status is StatusFailed and the deployment
+	// reported an error for the resource.
+	Error *v1.ErrorDetails
 }
 
 type DeploymentOutput struct {
@@ -106,12 +111,23 @@ type DiagnosticsClient interface {
 	Expose(ctx context.Context, options ExposeOptions) (failed chan error, stop chan struct{}, signals chan os.Signal, err error)
 	Logs(ctx context.Context, options LogsOptions) ([]LogStream, error)
 	GetPublicEndpoint(ctx context.Context, options EndpointOptions) (*string, error)
+	GetJobStatus(ctx context.Context, options JobStatusOptions) (*JobStatus, error)
+
+	// GetReplicaStatus returns the replica readiness of the Kubernetes Deployments created for a resource.
+	GetReplicaStatus(ctx context.Context, options ReplicaStatusOptions) ([]ReplicaStatus, error)
+
+	// Exec runs a command in a container of a running replica of a resource, and blocks until the command exits.
+	Exec(ctx context.Context, options ExecOptions) error
+
+	// PortForward forwards local ports to a running replica of a resource, and blocks until the context is cancelled.
+	PortForward(ctx context.Context, options PortForwardOptions) error
 }
 
 type ApplicationStatus struct {
 	Name          string
 	ResourceCount int
 	Gateways      []GatewayStatus
+	Jobs          []JobStatus
 }
 
 type GatewayStatus struct {
@@ -119,10 +135,33 @@ type GatewayStatus struct {
 	Endpoint string
 }
 
+// JobStatus represents the status of the last run of a container that runs as a job or cronJob workload.
+type JobStatus struct {
+	Name    string
+	Kind    string
+	Status  string
+	LastRun string
+}
+
+const (
+	JobStatusPending   = "Pending"
+	JobStatusRunning   = "Running"
+	JobStatusSucceeded = "Succeeded"
+	JobStatusFailed    = "Failed"
+	JobStatusScheduled = "Scheduled"
+	JobStatusSuspended = "Suspended"
+	JobStatusFinished  = "Finished"
+	JobStatusUnknown   = "Unknown"
+)
+
 type EndpointOptions struct {
 	ResourceID ucpresources.ID
 }
 
+type JobStatusOptions struct {
+	ResourceID ucpresources.ID
+}
+
 type ExposeOptions struct {
 	Application string
 	Resource    string
@@ -139,6 +178,63 @@ type LogsOptions struct {
 	Replica     string
 }
 
+// ReplicaStatusOptions specifies the resource whose Kubernetes Deployments are inspected.
+type ReplicaStatusOptions struct {
+	ResourceID ucpresources.ID
+
+	// Properties are the properties of the resource. The Deployments are found in its output resources.
+	Properties map[string]any
+}
+
+// ReplicaStatus represents the replica readiness of a Kubernetes Deployment created for a resource.
+type ReplicaStatus struct {
+	Name          string
+	ReadyReplicas int32
+	Replicas      int32
+
+	// Message describes why the rollout of the Deployment failed, if it failed.
+	Message string
+}
+
+// ExecOptions specifies the options for running a command in a container of a resource.
+type ExecOptions struct {
+	Application string
+	Resource    string
+	Replica     string
+
+	// Container is the container to run the command in. The primary container of the resource is used if it is empty.
+	Container string
+	Command   []string
+
+	// Stdin is passed to the command if it is set.
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+
+	// TTY allocates a terminal for the command. Stdin must be a terminal when TTY is set.
+	TTY bool
+}
+
+// PortForwardOptions specifies the options for forwarding local ports to a resource.
+type PortForwardOptions struct {
+	Application string
+	Resource    string
+	Replica     string
+
+	// Container is the container whose ports are forwarded when Ports is empty. The primary container of the resource
+	// is used if it is empty.
+	Container string
+
+	// Ports are the ports to forward, in the format '<local port>:<remote port>' or '<port>'.
+	Ports []string
+
+	// Addresses are the local addresses to listen on.
+	Addresses []string
+
+	Out    io.Writer
+	ErrOut io.Writer
+}
+
 type LogStream struct {
 	Name   string
 	Stream io.ReadCloser
@@ -175,6 +271,10 @@ type ApplicationsManagementClient interface {
 	// CreateOrUpdateResource creates or updates a resource using its type name (or id).
 	CreateOrUpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, resource *generated.GenericResource) (generated.GenericResource, error)
 
+	// InvokeResourceAction invokes a custom action of a resource by its type and name (or id), and returns the
+	// response of the action.
+	InvokeResourceAction(ctx context.Context, resourceType string, resourceNameOrID string, actionName string, body map[string]any) (map[string]any, error)
+
 	// DeleteResource deletes a resource by its type and name (or id).
 	DeleteResource(ctx context.Context, resourceType string, resourceNameOrID string) (bool, error)
 
@@ -250,6 +350,28 @@ type ApplicationsManagementClient interface {
 	// ListResourcesOfTypeInResourceGroupFiltered lists resources of a specific type in a resource group, optionally filtered by environment and/or application.
 	ListResourcesOfTypeInResourceGroupFiltered(ctx context.Context, planeName string, resourceGroupName string, resourceType string, environmentID string, applicationID string) ([]generated.GenericResource, error)
 
+	// ListLocks lists all management locks in a resource group.
+	ListLocks(ctx context.Context, planeName string, resourceGroupName string) ([]ucp_v20231001preview.LockResource, error)
+
+	// GetLock retrieves a management lock by its name.
+	GetLock(ctx context.Context, planeName string, resourceGroupName string, lockName string) (ucp_v20231001preview.LockResource, error)
+
+	// CreateOrUpdateLock creates or updates a management lock by its name.
+	CreateOrUpdateLock(ctx context.Context, planeName string, resourceGroupName string, lockName string, resource *ucp_v20231001preview.LockResource) error
+
+	// DeleteLock deletes a management lock by its name.
+	DeleteLock(ctx context.Context, planeName string, resourceGroupName string, lockName string) (bool, error)
+
+	// ListAuditRecords lists the audit records of mutating operations in a plane, newest first.
+	ListAuditRecords(ctx context.Context, planeName string, options *ucp_v20231001preview.AuditRecordsClientListOptions) ([]ucp_v20231001preview.AuditRecordResource, error)
+
+	// GetLatestOperationStatus gets the status of the latest asynchronous operation on a resource, or nil if the
+	// resource has no asynchronous operations.
+	GetLatestOperationStatus(ctx context.Context, resourceID string) (*v1.AsyncOperationStatus, error)
+
+	// QueryResources returns a page of the resources in a plane that match the query.
+	QueryResources(ctx context.Context, planeName string, query ucp_v20231001preview.ResourceQueryRequest) (ucp_v20231001preview.ResourceQueryResponse, error)
+
 	// ListResourceProviders lists all resource providers in the configured scope.
 	ListResourceProviders(ctx context.Context, planeName string) ([]ucp_v20231001preview.ResourceProviderResource, error)
 
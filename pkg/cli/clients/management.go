@@ -28,6 +28,7 @@ import (
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/azure/clientv2"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
@@ -46,10 +47,14 @@ type UCPApplicationsManagementClient struct {
 	environmentResourceClientFactory func(scope string) (environmentResourceClient, error)
 	recipePackResourceClientFactory  func(scope string) (recipePackResourceClient, error)
 	resourceGroupClientFactory       func() (resourceGroupClient, error)
+	lockClientFactory                func() (lockClient, error)
+	auditRecordClientFactory         func() (auditRecordClient, error)
+	resourceQueryClientFactory       func() (resourceQueryClient, error)
 	resourceProviderClientFactory    func() (resourceProviderClient, error)
 	resourceTypeClientFactory        func() (resourceTypeClient, error)
 	apiVersionClientFactory          func() (apiVersionClient, error)
 	locationClientFactory            func() (locationClient, error)
+	operationStatusClientFactory     func() (operationStatusClient, error)
 	capture                          func(ctx context.Context, capture **http.Response) context.Context
 }
 
@@ -177,6 +182,32 @@ func (amc *UCPApplicationsManagementClient) CreateOrUpdateResource(ctx context.C
 	return response.GenericResource, nil
 }
 
+// InvokeResourceAction invokes a custom action of a resource by its type and name (or id), and returns the response
+// of the action.
+func (amc *UCPApplicationsManagementClient) InvokeResourceAction(ctx context.Context, resourceType string, resourceNameOrID string, actionName string, body map[string]any) (map[string]any, error) {
+	apiVersions, err := amc.getApiVersionsForResourceType(ctx, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, name, err := amc.extractScopeAndName(resourceNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := amc.getGenericClient(scope, resourceType, apiVersions)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.InvokeAction(ctx, name, actionName, &generated.GenericResourcesClientInvokeActionOptions{Body: body})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Value, nil
+}
+
 // DeleteResource deletes a resource by its type and name (or id).
 func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context, resourceType string, resourceNameOrID string) (bool, error) {
 	apiVersions, err := amc.getApiVersionsForResourceType(ctx, resourceType)
@@ -356,22 +387,50 @@ func (amc *UCPApplicationsManagementClient) CreateApplicationIfNotFound(ctx cont
 	return nil
 }
 
-// DeleteApplication deletes an application and all of its resources by its name (or id).
+// DeleteApplication deletes an application and all of its resources by its name (or id). Nothing is deleted if a
+// management lock applies to the application, its environment or any of its resources.
 func (amc *UCPApplicationsManagementClient) DeleteApplication(ctx context.Context, applicationNameOrID string) (bool, error) {
 	scope, name, err := amc.extractScopeAndName(applicationNameOrID)
 	if err != nil {
 		return false, err
 	}
 
+	applicationID, err := amc.fullyQualifyID(applicationNameOrID, "Applications.Core/applications")
+	if err != nil {
+		return false, err
+	}
+
+	ids := []string{applicationID}
+	application, err := amc.GetApplication(ctx, applicationNameOrID)
+	if err != nil && !clientv2.Is404Error(err) {
+		return false, err
+	} else if err == nil && application.Properties != nil && application.Properties.Environment != nil {
+		ids = append(ids, *application.Properties.Environment)
+	}
+
 	// This *also* handles the case where the resource group doesn't exist.
-	resources, err := amc.ListResourcesInApplication(ctx, applicationNameOrID)
+	applicationResources, err := amc.ListResourcesInApplication(ctx, applicationNameOrID)
 	if err != nil && !clientv2.Is404Error(err) {
 		return false, err
 	}
 
+	for _, resource := range applicationResources {
+		ids = append(ids, *resource.ID)
+	}
+
+	err = amc.validateDeleteLocks(ctx, ids)
+	if err != nil {
+		return false, err
+	}
+
+	return amc.deleteApplication(ctx, scope, name, applicationResources)
+}
+
+// deleteApplication deletes the resources of an application, and then the application.
+func (amc *UCPApplicationsManagementClient) deleteApplication(ctx context.Context, scope string, name string, applicationResources []generated.GenericResource) (bool, error) {
 	// Delete resources in parallel
 	g, groupCtx := errgroup.WithContext(ctx)
-	for _, resource := range resources {
+	for _, resource := range applicationResources {
 		resource := resource
 		g.Go(func() error {
 			_, err := amc.DeleteResource(groupCtx, *resource.Type, *resource.ID)
@@ -383,7 +442,7 @@ func (amc *UCPApplicationsManagementClient) DeleteApplication(ctx context.Contex
 	}
 
 	// Wait for dependent resources to be deleted.
-	err = g.Wait()
+	err := g.Wait()
 	if err != nil {
 		return false, err
 	}
@@ -630,19 +689,51 @@ func (amc *UCPApplicationsManagementClient) CreateOrUpdateEnvironment(ctx contex
 }
 
 // DeleteEnvironment deletes an environment and all of its resources by its name (in the configured scope) or resource ID.
+// Nothing is deleted if a management lock applies to the environment, any of its applications or their resources.
 func (amc *UCPApplicationsManagementClient) DeleteEnvironment(ctx context.Context, environmentNameOrID string) (bool, error) {
 	scope, name, err := amc.extractScopeAndName(environmentNameOrID)
 	if err != nil {
 		return false, err
 	}
 
+	environmentID, err := amc.fullyQualifyID(environmentNameOrID, "Applications.Core/environments")
+	if err != nil {
+		return false, err
+	}
+
 	applications, err := amc.ListApplicationsInEnvironment(ctx, name)
 	if err != nil {
 		return false, err
 	}
 
+	// Validate the locks of everything that is deleted before deleting anything.
+	ids := []string{environmentID}
+	applicationResources := map[string][]generated.GenericResource{}
 	for _, application := range applications {
-		_, err := amc.DeleteApplication(ctx, *application.ID)
+		resources, err := amc.ListResourcesInApplication(ctx, *application.ID)
+		if err != nil && !clientv2.Is404Error(err) {
+			return false, err
+		}
+
+		applicationResources[*application.ID] = resources
+		ids = append(ids, *application.ID)
+		for _, resource := range resources {
+			ids = append(ids, *resource.ID)
+		}
+	}
+
+	err = amc.validateDeleteLocks(ctx, ids)
+	if err != nil {
+		return false, err
+	}
+
+	for _, application := range applications {
+		applicationScope, applicationName, err := amc.extractScopeAndName(*application.ID)
+		if err != nil {
+			return false, err
+		}
+
+		_, err = amc.deleteApplication(ctx, applicationScope, applicationName, applicationResources[*application.ID])
 		if err != nil {
 			return false, err
 		}
@@ -782,6 +873,178 @@ func (amc *UCPApplicationsManagementClient) DeleteResourceGroup(ctx context.Cont
 	return response.StatusCode != 204, nil
 }
 
+// ListLocks lists all management locks in a resource group.
+func (amc *UCPApplicationsManagementClient) ListLocks(ctx context.Context, planeName string, resourceGroupName string) ([]ucpv20231001.LockResource, error) {
+	client, err := amc.createLockClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []ucpv20231001.LockResource{}
+	pager := client.NewListPager(planeName, resourceGroupName, &ucpv20231001.LocksClientListOptions{})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lock := range page.Value {
+			results = append(results, *lock)
+		}
+	}
+
+	return results, nil
+}
+
+// validateDeleteLocks returns an error if a management lock applies to any of the resources. A lock applies to the
+// resource it specifies and its child resources, or to every resource in the resource group if it does not specify a
+// resource.
+func (amc *UCPApplicationsManagementClient) validateDeleteLocks(ctx context.Context, ids []string) error {
+	locks := map[string][]ucpv20231001.LockResource{}
+	for _, id := range ids {
+		parsed, err := resources.ParseResource(id)
+		if err != nil {
+			return err
+		}
+
+		planeName := parsed.FindScope(resources_radius.PlaneTypeRadius)
+		resourceGroupName := parsed.FindScope(resources_radius.ScopeResourceGroups)
+		if planeName == "" || resourceGroupName == "" {
+			continue
+		}
+
+		key := strings.ToLower(parsed.RootScope())
+		resourceGroupLocks, ok := locks[key]
+		if !ok {
+			resourceGroupLocks, err = amc.ListLocks(ctx, planeName, resourceGroupName)
+			if err != nil && !clientv2.Is404Error(err) {
+				return err
+			}
+			locks[key] = resourceGroupLocks
+		}
+
+		for _, lock := range resourceGroupLocks {
+			lockedID := ""
+			if lock.Properties != nil && lock.Properties.ResourceID != nil {
+				lockedID = strings.ToLower(*lock.Properties.ResourceID)
+			}
+
+			target := strings.ToLower(parsed.String())
+			if lockedID == "" || target == lockedID || strings.HasPrefix(target, lockedID+resources.SegmentSeparator) {
+				return fmt.Errorf("cannot delete %q because it is protected by the lock %q, delete the lock first", parsed.String(), *lock.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetLock retrieves a management lock by its name.
+func (amc *UCPApplicationsManagementClient) GetLock(ctx context.Context, planeName string, resourceGroupName string, lockName string) (ucpv20231001.LockResource, error) {
+	client, err := amc.createLockClient()
+	if err != nil {
+		return ucpv20231001.LockResource{}, err
+	}
+
+	response, err := client.Get(ctx, planeName, resourceGroupName, lockName, &ucpv20231001.LocksClientGetOptions{})
+	if err != nil {
+		return ucpv20231001.LockResource{}, err
+	}
+
+	return response.LockResource, nil
+}
+
+// CreateOrUpdateLock creates or updates a management lock by its name.
+func (amc *UCPApplicationsManagementClient) CreateOrUpdateLock(ctx context.Context, planeName string, resourceGroupName string, lockName string, resource *ucpv20231001.LockResource) error {
+	client, err := amc.createLockClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.CreateOrUpdate(ctx, planeName, resourceGroupName, lockName, *resource, &ucpv20231001.LocksClientCreateOrUpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteLock deletes a management lock by its name.
+func (amc *UCPApplicationsManagementClient) DeleteLock(ctx context.Context, planeName string, resourceGroupName string, lockName string) (bool, error) {
+	client, err := amc.createLockClient()
+	if err != nil {
+		return false, err
+	}
+
+	var response *http.Response
+	ctx = amc.captureResponse(ctx, &response)
+
+	_, err = client.Delete(ctx, planeName, resourceGroupName, lockName, &ucpv20231001.LocksClientDeleteOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return response.StatusCode != 204, nil
+}
+
+// ListAuditRecords lists the audit records of mutating operations in a plane, newest first.
+func (amc *UCPApplicationsManagementClient) ListAuditRecords(ctx context.Context, planeName string, options *ucpv20231001.AuditRecordsClientListOptions) ([]ucpv20231001.AuditRecordResource, error) {
+	client, err := amc.createAuditRecordClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []ucpv20231001.AuditRecordResource{}
+	pager := client.NewListPager(planeName, options)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, record := range page.Value {
+			results = append(results, *record)
+		}
+	}
+
+	return results, nil
+}
+
+// GetLatestOperationStatus gets the status of the latest asynchronous operation on a resource, or nil if the
+// resource has no asynchronous operations.
+func (amc *UCPApplicationsManagementClient) GetLatestOperationStatus(ctx context.Context, resourceID string) (*v1.AsyncOperationStatus, error) {
+	client, err := amc.createOperationStatusClient()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := client.List(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	return &statuses[0], nil
+}
+
+// QueryResources returns a page of the resources in a plane that match the query.
+func (amc *UCPApplicationsManagementClient) QueryResources(ctx context.Context, planeName string, query ucpv20231001.ResourceQueryRequest) (ucpv20231001.ResourceQueryResponse, error) {
+	client, err := amc.createResourceQueryClient()
+	if err != nil {
+		return ucpv20231001.ResourceQueryResponse{}, err
+	}
+
+	response, err := client.Query(ctx, planeName, query, &ucpv20231001.ResourceQueryClientQueryOptions{})
+	if err != nil {
+		return ucpv20231001.ResourceQueryResponse{}, err
+	}
+
+	return response.ResourceQueryResponse, nil
+}
+
 // ListResourcesInResourceGroup lists all resources in a specific resource group.
 func (amc *UCPApplicationsManagementClient) ListResourcesInResourceGroup(ctx context.Context, planeName string, resourceGroupName string) ([]generated.GenericResource, error) {
 	// First check if the resource group exists
@@ -1250,6 +1513,30 @@ func (amc *UCPApplicationsManagementClient) createResourceGroupClient() (resourc
 	return amc.resourceGroupClientFactory()
 }
 
+func (amc *UCPApplicationsManagementClient) createLockClient() (lockClient, error) {
+	if amc.lockClientFactory == nil {
+		return ucpv20231001.NewLocksClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.lockClientFactory()
+}
+
+func (amc *UCPApplicationsManagementClient) createAuditRecordClient() (auditRecordClient, error) {
+	if amc.auditRecordClientFactory == nil {
+		return ucpv20231001.NewAuditRecordsClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.auditRecordClientFactory()
+}
+
+func (amc *UCPApplicationsManagementClient) createResourceQueryClient() (resourceQueryClient, error) {
+	if amc.resourceQueryClientFactory == nil {
+		return ucpv20231001.NewResourceQueryClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+	}
+
+	return amc.resourceQueryClientFactory()
+}
+
 func (amc *UCPApplicationsManagementClient) createResourceProviderClient() (resourceProviderClient, error) {
 	if amc.resourceProviderClientFactory == nil {
 		return ucpv20231001.NewResourceProvidersClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
@@ -1282,6 +1569,14 @@ func (amc *UCPApplicationsManagementClient) createLocationClient() (locationClie
 	return amc.locationClientFactory()
 }
 
+func (amc *UCPApplicationsManagementClient) createOperationStatusClient() (operationStatusClient, error) {
+	if amc.operationStatusClientFactory == nil {
+		return newOperationStatusesClient(amc.ClientOptions)
+	}
+
+	return amc.operationStatusClientFactory()
+}
+
 func (amc *UCPApplicationsManagementClient) extractScopeAndName(nameOrID string) (string, string, error) {
 	if strings.HasPrefix(nameOrID, resources.SegmentSeparator) {
 		// Treat this as a resource id.
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerpv20231001 "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
@@ -35,13 +36,14 @@ import (
 // Because these interfaces are non-exported, they MUST be defined in their own file
 // and we MUST use -source on mockgen to generate mocks for them.
 
-//go:generate mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,recipePackResourceClient
+//go:generate mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,lockClient,auditRecordClient,resourceQueryClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,recipePackResourceClient,operationStatusClient
 
 // genericResourceClient is an interface for mocking the generated SDK client for any resource.
 type genericResourceClient interface {
 	BeginCreateOrUpdate(ctx context.Context, resourceName string, genericResourceParameters generated.GenericResource, options *generated.GenericResourcesClientBeginCreateOrUpdateOptions) (*runtime.Poller[generated.GenericResourcesClientCreateOrUpdateResponse], error)
 	BeginDelete(ctx context.Context, resourceName string, options *generated.GenericResourcesClientBeginDeleteOptions) (*runtime.Poller[generated.GenericResourcesClientDeleteResponse], error)
 	Get(ctx context.Context, resourceName string, options *generated.GenericResourcesClientGetOptions) (generated.GenericResourcesClientGetResponse, error)
+	InvokeAction(ctx context.Context, resourceName string, actionName string, options *generated.GenericResourcesClientInvokeActionOptions) (generated.GenericResourcesClientInvokeActionResponse, error)
 	NewListByRootScopePager(options *generated.GenericResourcesClientListByRootScopeOptions) *runtime.Pager[generated.GenericResourcesClientListByRootScopeResponse]
 }
 
@@ -73,6 +75,24 @@ type resourceGroupClient interface {
 	NewListPager(planeName string, options *ucpv20231001.ResourceGroupsClientListOptions) *runtime.Pager[ucpv20231001.ResourceGroupsClientListResponse]
 }
 
+// lockClient is an interface for mocking the generated SDK client for management locks.
+type lockClient interface {
+	CreateOrUpdate(ctx context.Context, planeName string, resourceGroupName string, lockName string, resource ucpv20231001.LockResource, options *ucpv20231001.LocksClientCreateOrUpdateOptions) (ucpv20231001.LocksClientCreateOrUpdateResponse, error)
+	Delete(ctx context.Context, planeName string, resourceGroupName string, lockName string, options *ucpv20231001.LocksClientDeleteOptions) (ucpv20231001.LocksClientDeleteResponse, error)
+	Get(ctx context.Context, planeName string, resourceGroupName string, lockName string, options *ucpv20231001.LocksClientGetOptions) (ucpv20231001.LocksClientGetResponse, error)
+	NewListPager(planeName string, resourceGroupName string, options *ucpv20231001.LocksClientListOptions) *runtime.Pager[ucpv20231001.LocksClientListResponse]
+}
+
+// auditRecordClient is an interface for mocking the generated SDK client for audit records.
+type auditRecordClient interface {
+	NewListPager(planeName string, options *ucpv20231001.AuditRecordsClientListOptions) *runtime.Pager[ucpv20231001.AuditRecordsClientListResponse]
+}
+
+// resourceQueryClient is an interface for mocking the generated SDK client for resource queries.
+type resourceQueryClient interface {
+	Query(ctx context.Context, planeName string, body ucpv20231001.ResourceQueryRequest, options *ucpv20231001.ResourceQueryClientQueryOptions) (ucpv20231001.ResourceQueryClientQueryResponse, error)
+}
+
 // resourceProviderClient is an interface for mocking the generated SDK client for resource providers.
 type resourceProviderClient interface {
 	BeginCreateOrUpdate(ctx context.Context, planeName string, resourceProviderName string, resource ucpv20231001.ResourceProviderResource, options *ucpv20231001.ResourceProvidersClientBeginCreateOrUpdateOptions) (*runtime.Poller[ucpv20231001.ResourceProvidersClientCreateOrUpdateResponse], error)
@@ -106,3 +126,8 @@ type recipePackResourceClient interface {
 	Get(ctx context.Context, recipePackName string, options *corerpv20250801.RecipePacksClientGetOptions) (corerpv20250801.RecipePacksClientGetResponse, error)
 	NewListByScopePager(options *corerpv20250801.RecipePacksClientListByScopeOptions) *runtime.Pager[corerpv20250801.RecipePacksClientListByScopeResponse]
 }
+
+// operationStatusClient is an interface for mocking the client for the operation statuses of resources.
+type operationStatusClient interface {
+	List(ctx context.Context, resourceID string) ([]v1.AsyncOperationStatus, error)
+}
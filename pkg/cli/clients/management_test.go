@@ -128,6 +128,15 @@ var (
 			},
 		},
 	}
+
+	emptyLockPages = []ucp.LocksClientListResponse{
+		{
+			LockResourceListResult: ucp.LockResourceListResult{
+				Value:    []*ucp.LockResource{},
+				NextLink: to.Ptr("0"),
+			},
+		},
+	}
 )
 
 // Test helper functions to reduce repetition
@@ -620,6 +629,36 @@ func Test_Resource(t *testing.T) {
 		require.Equal(t, expectedResource, resource)
 	})
 
+	t.Run("InvokeResourceAction", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mock := NewMockgenericResourceClient(ctrl)
+		resourceProviderMock := NewMockresourceProviderClient(ctrl)
+		client := createResourceAndResourceProviderClient(mock, resourceProviderMock)
+		expectedResourceSummary := ucp.ResourceProviderSummary{
+			Name: to.Ptr("Applications.Test"),
+			ResourceTypes: map[string]*ucp.ResourceProviderSummaryResourceType{
+				"testResource": {
+					APIVersions: map[string]*ucp.ResourceTypeSummaryResultAPIVersion{
+						version: {},
+					},
+				},
+			},
+		}
+		resourceProviderMock.EXPECT().
+			GetProviderSummary(gomock.Any(), "local", "Applications.Test", gomock.Any()).
+			Return(ucp.ResourceProvidersClientGetProviderSummaryResponse{ResourceProviderSummary: expectedResourceSummary}, nil)
+
+		body := map[string]any{"keyName": "primary"}
+		expected := map[string]any{"key": "new-key"}
+		mock.EXPECT().
+			InvokeAction(gomock.Any(), testResourceName, "rotateKey", &generated.GenericResourcesClientInvokeActionOptions{Body: body}).
+			Return(generated.GenericResourcesClientInvokeActionResponse{Value: expected}, nil)
+
+		response, err := client.InvokeResourceAction(context.Background(), testResourceType, testResourceID, "rotateKey", body)
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
 	t.Run("CreateOrUpdateResource", func(t *testing.T) {
 		mock := NewMockgenericResourceClient(gomock.NewController(t))
 		client := createClient(mock)
@@ -741,6 +780,17 @@ func Test_Application(t *testing.T) {
 		Location: to.Ptr(v1.LocationGlobal),
 	}
 
+	testEnvironmentID := testScope + "/providers/Applications.Core/environments/test-environment"
+	applicationInEnvironment := corerp.ApplicationResource{
+		ID:       &testResourceID,
+		Name:     &testResourceName,
+		Type:     &testResourceType,
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &corerp.ApplicationProperties{
+			Environment: &testEnvironmentID,
+		},
+	}
+
 	listPages := []corerp.ApplicationsClientListByScopeResponse{
 		{
 			ApplicationResourceListResult: corerp.ApplicationResourceListResult{
@@ -892,6 +942,10 @@ func Test_Application(t *testing.T) {
 		mockResourceProviderClient := NewMockresourceProviderClient(ctrl)
 		genericResourceMock := NewMockgenericResourceClient(ctrl)
 		client := createClient(mock)
+		lockMock := NewMocklockClient(ctrl)
+		client.lockClientFactory = func() (lockClient, error) {
+			return lockMock, nil
+		}
 		client.genericResourceClientFactory = func(scope string, resourceType string) (genericResourceClient, error) {
 			return genericResourceMock, nil
 		}
@@ -957,6 +1011,14 @@ func Test_Application(t *testing.T) {
 			NewListByRootScopePager(gomock.Any()).
 			Return(pager(resourceListPages)).AnyTimes()
 
+		mock.EXPECT().
+			Get(gomock.Any(), testResourceName, gomock.Any()).
+			Return(corerp.ApplicationsClientGetResponse{ApplicationResource: applicationInEnvironment}, nil)
+
+		lockMock.EXPECT().
+			NewListPager("local", "my-default-rg", gomock.Any()).
+			Return(pager(emptyLockPages))
+
 		genericResourceMock.EXPECT().
 			BeginDelete(gomock.Any(), "test1", gomock.Any()).
 			Return(poller(&generated.GenericResourcesClientDeleteResponse{}), nil)
@@ -981,6 +1043,10 @@ func Test_Application(t *testing.T) {
 		mockResourceProviderClient := NewMockresourceProviderClient(ctrl)
 		genericResourceMock := NewMockgenericResourceClient(ctrl)
 		client := createClient(mock)
+		lockMock := NewMocklockClient(ctrl)
+		client.lockClientFactory = func() (lockClient, error) {
+			return lockMock, nil
+		}
 		client.genericResourceClientFactory = func(scope string, resourceType string) (genericResourceClient, error) {
 			return genericResourceMock, nil
 		}
@@ -1040,6 +1106,14 @@ func Test_Application(t *testing.T) {
 				return runtime.NewPager(handler)
 			}).AnyTimes()
 
+		mock.EXPECT().
+			Get(gomock.Any(), testResourceName, gomock.Any()).
+			Return(corerp.ApplicationsClientGetResponse{}, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+
+		lockMock.EXPECT().
+			NewListPager("local", "my-default-rg", gomock.Any()).
+			Return(errorPager[ucp.LocksClientListResponse](&azcore.ResponseError{StatusCode: http.StatusNotFound}))
+
 		// Even though ListResourcesInApplication fails with 404, Delete should still be called
 		mock.EXPECT().
 			Delete(gomock.Any(), testResourceName, gomock.Any()).
@@ -1060,6 +1134,10 @@ func Test_Application(t *testing.T) {
 		mock := NewMockapplicationResourceClient(ctrl)
 		mockResourceProviderClient := NewMockresourceProviderClient(ctrl)
 		client := createClient(mock)
+		lockMock := NewMocklockClient(ctrl)
+		client.lockClientFactory = func() (lockClient, error) {
+			return lockMock, nil
+		}
 		client.resourceProviderClientFactory = func() (resourceProviderClient, error) {
 			return mockResourceProviderClient, nil
 		}
@@ -1084,6 +1162,10 @@ func Test_Application(t *testing.T) {
 				return runtime.NewPager(handler)
 			})
 
+		mock.EXPECT().
+			Get(gomock.Any(), testResourceName, gomock.Any()).
+			Return(corerp.ApplicationsClientGetResponse{ApplicationResource: applicationInEnvironment}, nil)
+
 		// Delete should NOT be called when ListResourcesInApplication fails with non-404 error
 		// No expectation set for mock.Delete()
 
@@ -1093,6 +1175,89 @@ func Test_Application(t *testing.T) {
 		// Verify the error is propagated correctly
 		require.Contains(t, err.Error(), "failed to list resource provider summaries")
 	})
+
+	t.Run("DeleteApplication_WithLockedEnvironment", func(t *testing.T) {
+		// Test case where a lock applies to the environment of the application. Nothing should be deleted.
+		ctrl := gomock.NewController(t)
+		mock := NewMockapplicationResourceClient(ctrl)
+		mockResourceProviderClient := NewMockresourceProviderClient(ctrl)
+		genericResourceMock := NewMockgenericResourceClient(ctrl)
+		lockMock := NewMocklockClient(ctrl)
+		client := createClient(mock)
+		client.genericResourceClientFactory = func(scope string, resourceType string) (genericResourceClient, error) {
+			return genericResourceMock, nil
+		}
+		client.resourceProviderClientFactory = func() (resourceProviderClient, error) {
+			return mockResourceProviderClient, nil
+		}
+		client.lockClientFactory = func() (lockClient, error) {
+			return lockMock, nil
+		}
+
+		resourceListPages := []generated.GenericResourcesClientListByRootScopeResponse{
+			{
+				GenericResourcesList: generated.GenericResourcesList{
+					Value: []*generated.GenericResource{
+						{
+							ID:       to.Ptr(testScope + "/providers/Applications.Test1/resourceType1/test1"),
+							Name:     to.Ptr("test1"),
+							Type:     to.Ptr("Applications.Test1/resourceType1"),
+							Location: to.Ptr(v1.LocationGlobal),
+							Properties: map[string]any{
+								"application": testResourceID,
+							},
+						},
+					},
+					NextLink: to.Ptr("0"),
+				},
+			},
+		}
+
+		lockPages := []ucp.LocksClientListResponse{
+			{
+				LockResourceListResult: ucp.LockResourceListResult{
+					Value: []*ucp.LockResource{
+						{
+							ID: to.Ptr(testScope + "/providers/System.Resources/locks/production"),
+							Properties: &ucp.LockProperties{
+								Level:      to.Ptr(ucp.LockLevelCanNotDelete),
+								ResourceID: &testEnvironmentID,
+							},
+						},
+					},
+					NextLink: to.Ptr("0"),
+				},
+			},
+		}
+
+		mockResourceProviderClient.EXPECT().
+			NewListProviderSummariesPager("local", gomock.Any()).
+			Return(pager(resourceProviderSummaryPages))
+
+		mockResourceProviderClient.EXPECT().
+			GetProviderSummary(gomock.Any(), "local", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, plane string, providerName string, opts *ucp.ResourceProvidersClientGetProviderSummaryOptions) (ucp.ResourceProvidersClientGetProviderSummaryResponse, error) {
+				return ucp.ResourceProvidersClientGetProviderSummaryResponse{ResourceProviderSummary: *findProviderSummary(providerName)}, nil
+			}).AnyTimes()
+
+		genericResourceMock.EXPECT().
+			NewListByRootScopePager(gomock.Any()).
+			Return(pager(resourceListPages)).AnyTimes()
+
+		mock.EXPECT().
+			Get(gomock.Any(), testResourceName, gomock.Any()).
+			Return(corerp.ApplicationsClientGetResponse{ApplicationResource: applicationInEnvironment}, nil)
+
+		lockMock.EXPECT().
+			NewListPager("local", "my-default-rg", gomock.Any()).
+			Return(pager(lockPages))
+
+		// No resource or the application should be deleted, so no expectations are set for BeginDelete or Delete.
+
+		deleted, err := client.DeleteApplication(context.Background(), testResourceID)
+		require.ErrorContains(t, err, "is protected by the lock")
+		require.False(t, deleted)
+	})
 }
 
 func Test_Environment(t *testing.T) {
@@ -1251,6 +1416,10 @@ func Test_Environment(t *testing.T) {
 		genericResourceMock := NewMockgenericResourceClient(ctrl)
 		resourceProviderMock := NewMockresourceProviderClient(ctrl)
 		client := createClient(mock)
+		lockMock := NewMocklockClient(ctrl)
+		client.lockClientFactory = func() (lockClient, error) {
+			return lockMock, nil
+		}
 		client.applicationResourceClientFactory = func(scope string) (applicationResourceClient, error) {
 			return applicationResourceMock, nil
 		}
@@ -1342,6 +1511,10 @@ func Test_Environment(t *testing.T) {
 			NewListByScopePager(gomock.Any()).
 			Return(pager(applicationListPages))
 
+		lockMock.EXPECT().
+			NewListPager("local", "my-default-rg", gomock.Any()).
+			Return(pager(emptyLockPages))
+
 		applicationResourceMock.EXPECT().
 			Delete(gomock.Any(), "test-application", gomock.Any()).
 			DoAndReturn(func(ctx context.Context, s string, acdo *corerp.ApplicationsClientDeleteOptions) (corerp.ApplicationsClientDeleteResponse, error) {
@@ -1360,6 +1533,94 @@ func Test_Environment(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, deleted)
 	})
+
+	t.Run("DeleteEnvironment_WithLockedApplication", func(t *testing.T) {
+		// Test case where a lock applies to an application in the environment. Nothing should be deleted.
+		ctrl := gomock.NewController(t)
+		mock := NewMockenvironmentResourceClient(ctrl)
+		applicationResourceMock := NewMockapplicationResourceClient(ctrl)
+		genericResourceMock := NewMockgenericResourceClient(ctrl)
+		resourceProviderMock := NewMockresourceProviderClient(ctrl)
+		lockMock := NewMocklockClient(ctrl)
+		client := createClient(mock)
+		client.applicationResourceClientFactory = func(scope string) (applicationResourceClient, error) {
+			return applicationResourceMock, nil
+		}
+		client.resourceProviderClientFactory = func() (resourceProviderClient, error) {
+			return resourceProviderMock, nil
+		}
+		client.genericResourceClientFactory = func(scope string, resourceType string) (genericResourceClient, error) {
+			return genericResourceMock, nil
+		}
+		client.lockClientFactory = func() (lockClient, error) {
+			return lockMock, nil
+		}
+
+		applicationID := testScope + "/providers/Applications.Core/applications/test-application"
+		applicationListPages := []corerp.ApplicationsClientListByScopeResponse{
+			{
+				ApplicationResourceListResult: corerp.ApplicationResourceListResult{
+					Value: []*corerp.ApplicationResource{
+						{
+							ID:       &applicationID,
+							Name:     to.Ptr("test-application"),
+							Type:     to.Ptr("Applications.Core/applications"),
+							Location: to.Ptr(v1.LocationGlobal),
+							Properties: &corerp.ApplicationProperties{
+								Environment: &testResourceID,
+							},
+						},
+					},
+					NextLink: to.Ptr("0"),
+				},
+			},
+		}
+
+		lockPages := []ucp.LocksClientListResponse{
+			{
+				LockResourceListResult: ucp.LockResourceListResult{
+					Value: []*ucp.LockResource{
+						{
+							ID: to.Ptr(testScope + "/providers/System.Resources/locks/production"),
+							Properties: &ucp.LockProperties{
+								Level:      to.Ptr(ucp.LockLevelReadOnly),
+								ResourceID: &applicationID,
+							},
+						},
+					},
+					NextLink: to.Ptr("0"),
+				},
+			},
+		}
+
+		applicationResourceMock.EXPECT().
+			NewListByScopePager(gomock.Any()).
+			Return(pager(applicationListPages))
+
+		resourceProviderMock.EXPECT().
+			NewListProviderSummariesPager("local", gomock.Any()).
+			Return(pager(resourceProviderSummaryPages))
+
+		resourceProviderMock.EXPECT().
+			GetProviderSummary(gomock.Any(), "local", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, plane string, providerName string, opts *ucp.ResourceProvidersClientGetProviderSummaryOptions) (ucp.ResourceProvidersClientGetProviderSummaryResponse, error) {
+				return ucp.ResourceProvidersClientGetProviderSummaryResponse{ResourceProviderSummary: *findProviderSummary(providerName)}, nil
+			}).AnyTimes()
+
+		genericResourceMock.EXPECT().
+			NewListByRootScopePager(gomock.Any()).
+			Return(pager(createResourceList())).AnyTimes()
+
+		lockMock.EXPECT().
+			NewListPager("local", "my-default-rg", gomock.Any()).
+			Return(pager(lockPages))
+
+		// Neither the application nor the environment should be deleted, so no expectations are set for Delete.
+
+		deleted, err := client.DeleteEnvironment(context.Background(), testResourceID)
+		require.ErrorContains(t, err, "is protected by the lock")
+		require.False(t, deleted)
+	})
 }
 
 func Test_ResourceGroup(t *testing.T) {
@@ -1519,6 +1780,215 @@ func Test_ResourceGroup(t *testing.T) {
 	})
 }
 
+func Test_Lock(t *testing.T) {
+	t.Parallel()
+	createClient := func(wrapped lockClient) *UCPApplicationsManagementClient {
+		return &UCPApplicationsManagementClient{
+			RootScope: testScope,
+			lockClientFactory: func() (lockClient, error) {
+				return wrapped, nil
+			},
+			capture: testCapture,
+		}
+	}
+
+	testResourceGroupName := "test-resource-group"
+	testLockName := "test-lock"
+
+	expectedResource := ucp.LockResource{
+		ID:   to.Ptr("/planes/radius/local/resourcegroups/" + testResourceGroupName + "/providers/System.Resources/locks/" + testLockName),
+		Name: &testLockName,
+		Type: to.Ptr("System.Resources/locks"),
+		Properties: &ucp.LockProperties{
+			Level: to.Ptr(ucp.LockLevelCanNotDelete),
+		},
+	}
+
+	t.Run("ListLocks", func(t *testing.T) {
+		mock := NewMocklockClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		lockPages := []ucp.LocksClientListResponse{
+			{
+				LockResourceListResult: ucp.LockResourceListResult{
+					Value:    []*ucp.LockResource{&expectedResource},
+					NextLink: to.Ptr("0"),
+				},
+			},
+		}
+
+		mock.EXPECT().
+			NewListPager("local", testResourceGroupName, gomock.Any()).
+			Return(pager(lockPages))
+
+		locks, err := client.ListLocks(context.Background(), "local", testResourceGroupName)
+		require.NoError(t, err)
+		require.Equal(t, []ucp.LockResource{expectedResource}, locks)
+	})
+
+	t.Run("GetLock", func(t *testing.T) {
+		mock := NewMocklockClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			Get(gomock.Any(), "local", testResourceGroupName, testLockName, gomock.Any()).
+			Return(ucp.LocksClientGetResponse{LockResource: expectedResource}, nil)
+
+		lock, err := client.GetLock(context.Background(), "local", testResourceGroupName, testLockName)
+		require.NoError(t, err)
+		require.Equal(t, expectedResource, lock)
+	})
+
+	t.Run("CreateOrUpdateLock", func(t *testing.T) {
+		mock := NewMocklockClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			CreateOrUpdate(gomock.Any(), "local", testResourceGroupName, testLockName, expectedResource, gomock.Any()).
+			Return(ucp.LocksClientCreateOrUpdateResponse{}, nil)
+
+		err := client.CreateOrUpdateLock(context.Background(), "local", testResourceGroupName, testLockName, &expectedResource)
+		require.NoError(t, err)
+	})
+
+	t.Run("DeleteLock", func(t *testing.T) {
+		mock := NewMocklockClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		mock.EXPECT().
+			Delete(gomock.Any(), "local", testResourceGroupName, testLockName, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, s1, s2, s3 string, options *ucp.LocksClientDeleteOptions) (ucp.LocksClientDeleteResponse, error) {
+				setCapture(ctx, &http.Response{StatusCode: 200})
+				return ucp.LocksClientDeleteResponse{}, nil
+			})
+
+		deleted, err := client.DeleteLock(context.Background(), "local", testResourceGroupName, testLockName)
+		require.NoError(t, err)
+		require.True(t, deleted)
+	})
+}
+
+func Test_AuditRecords(t *testing.T) {
+	t.Parallel()
+
+	expectedResource := ucp.AuditRecordResource{
+		ID:   to.Ptr("/planes/radius/local/providers/System.Audit/records/a1b2c3"),
+		Name: to.Ptr("a1b2c3"),
+		Type: to.Ptr("System.Audit/records"),
+		Properties: &ucp.AuditRecordProperties{
+			PrincipalName: to.Ptr("alice"),
+			Method:        to.Ptr("PUT"),
+			ResourceID:    to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app"),
+			Result:        to.Ptr(ucp.AuditResultSucceeded),
+		},
+	}
+
+	mock := NewMockauditRecordClient(gomock.NewController(t))
+	client := &UCPApplicationsManagementClient{
+		RootScope: testScope,
+		auditRecordClientFactory: func() (auditRecordClient, error) {
+			return mock, nil
+		},
+		capture: testCapture,
+	}
+
+	recordPages := []ucp.AuditRecordsClientListResponse{
+		{
+			AuditRecordResourceListResult: ucp.AuditRecordResourceListResult{
+				Value:    []*ucp.AuditRecordResource{&expectedResource},
+				NextLink: to.Ptr("0"),
+			},
+		},
+	}
+
+	options := &ucp.AuditRecordsClientListOptions{Scope: to.Ptr("/planes/radius/local/resourceGroups/test-group")}
+	mock.EXPECT().
+		NewListPager("local", options).
+		Return(pager(recordPages))
+
+	records, err := client.ListAuditRecords(context.Background(), "local", options)
+	require.NoError(t, err)
+	require.Equal(t, []ucp.AuditRecordResource{expectedResource}, records)
+}
+
+func Test_GetLatestOperationStatus(t *testing.T) {
+	t.Parallel()
+
+	resourceID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend"
+
+	t.Run("Success", func(t *testing.T) {
+		mock := NewMockoperationStatusClient(gomock.NewController(t))
+		client := &UCPApplicationsManagementClient{
+			RootScope: testScope,
+			operationStatusClientFactory: func() (operationStatusClient, error) {
+				return mock, nil
+			},
+			capture: testCapture,
+		}
+
+		statuses := []v1.AsyncOperationStatus{
+			{ID: "latest", Status: v1.ProvisioningStateFailed, Error: &v1.ErrorDetails{Code: v1.CodeInternal, Message: "failed"}},
+			{ID: "previous", Status: v1.ProvisioningStateSucceeded},
+		}
+		mock.EXPECT().
+			List(gomock.Any(), resourceID).
+			Return(statuses, nil)
+
+		status, err := client.GetLatestOperationStatus(context.Background(), resourceID)
+		require.NoError(t, err)
+		require.Equal(t, &statuses[0], status)
+	})
+
+	t.Run("No operations", func(t *testing.T) {
+		mock := NewMockoperationStatusClient(gomock.NewController(t))
+		client := &UCPApplicationsManagementClient{
+			RootScope: testScope,
+			operationStatusClientFactory: func() (operationStatusClient, error) {
+				return mock, nil
+			},
+			capture: testCapture,
+		}
+
+		mock.EXPECT().
+			List(gomock.Any(), resourceID).
+			Return([]v1.AsyncOperationStatus{}, nil)
+
+		status, err := client.GetLatestOperationStatus(context.Background(), resourceID)
+		require.NoError(t, err)
+		require.Nil(t, status)
+	})
+}
+
+func Test_QueryResources(t *testing.T) {
+	t.Parallel()
+
+	mock := NewMockresourceQueryClient(gomock.NewController(t))
+	client := &UCPApplicationsManagementClient{
+		RootScope: testScope,
+		resourceQueryClientFactory: func() (resourceQueryClient, error) {
+			return mock, nil
+		},
+		capture: testCapture,
+	}
+
+	query := ucp.ResourceQueryRequest{
+		Filter: to.Ptr("type == 'Applications.Datastores/redisCaches'"),
+		Select: to.SliceOfPtrs("id"),
+	}
+	expected := ucp.ResourceQueryResponse{
+		Count: to.Ptr(int64(1)),
+		Data:  []map[string]any{{"id": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/cache"}},
+	}
+
+	mock.EXPECT().
+		Query(gomock.Any(), "local", query, gomock.Any()).
+		Return(ucp.ResourceQueryClientQueryResponse{ResourceQueryResponse: expected}, nil)
+
+	result, err := client.QueryResources(context.Background(), "local", query)
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
 func Test_DeleteResourceGroup(t *testing.T) {
 	t.Parallel()
 
@@ -2315,6 +2785,20 @@ func pager[S ~[]E, E any](pages S) *runtime.Pager[E] {
 	return runtime.NewPager(handler)
 }
 
+func errorPager[E any](err error) *runtime.Pager[E] {
+	handler := runtime.PagingHandler[E]{
+		More: func(page E) bool {
+			return false
+		},
+		Fetcher: func(ctx context.Context, page *E) (E, error) {
+			var zero E
+			return zero, err
+		},
+	}
+
+	return runtime.NewPager(handler)
+}
+
 func poller[T any](response *T) *runtime.Poller[T] {
 
 	p, err := runtime.NewPoller(nil, runtime.Pipeline{}, &runtime.NewPollerOptions[T]{
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	generated "github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	v20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
@@ -235,6 +236,44 @@ func (c *MockApplicationsManagementClientCreateOrUpdateLocationCall) DoAndReturn
 	return c
 }
 
+// CreateOrUpdateLock mocks base method.
+func (m *MockApplicationsManagementClient) CreateOrUpdateLock(arg0 context.Context, arg1, arg2, arg3 string, arg4 *v20231001preview0.LockResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateLock", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrUpdateLock indicates an expected call of CreateOrUpdateLock.
+func (mr *MockApplicationsManagementClientMockRecorder) CreateOrUpdateLock(arg0, arg1, arg2, arg3, arg4 any) *MockApplicationsManagementClientCreateOrUpdateLockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateLock", reflect.TypeOf((*MockApplicationsManagementClient)(nil).CreateOrUpdateLock), arg0, arg1, arg2, arg3, arg4)
+	return &MockApplicationsManagementClientCreateOrUpdateLockCall{Call: call}
+}
+
+// MockApplicationsManagementClientCreateOrUpdateLockCall wrap *gomock.Call
+type MockApplicationsManagementClientCreateOrUpdateLockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientCreateOrUpdateLockCall) Return(arg0 error) *MockApplicationsManagementClientCreateOrUpdateLockCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientCreateOrUpdateLockCall) Do(f func(context.Context, string, string, string, *v20231001preview0.LockResource) error) *MockApplicationsManagementClientCreateOrUpdateLockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientCreateOrUpdateLockCall) DoAndReturn(f func(context.Context, string, string, string, *v20231001preview0.LockResource) error) *MockApplicationsManagementClientCreateOrUpdateLockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateOrUpdateResource mocks base method.
 func (m *MockApplicationsManagementClient) CreateOrUpdateResource(arg0 context.Context, arg1, arg2 string, arg3 *generated.GenericResource) (generated.GenericResource, error) {
 	m.ctrl.T.Helper()
@@ -468,6 +507,45 @@ func (c *MockApplicationsManagementClientDeleteEnvironmentCall) DoAndReturn(f fu
 	return c
 }
 
+// DeleteLock mocks base method.
+func (m *MockApplicationsManagementClient) DeleteLock(arg0 context.Context, arg1, arg2, arg3 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLock", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLock indicates an expected call of DeleteLock.
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteLock(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientDeleteLockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLock", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteLock), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientDeleteLockCall{Call: call}
+}
+
+// MockApplicationsManagementClientDeleteLockCall wrap *gomock.Call
+type MockApplicationsManagementClientDeleteLockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientDeleteLockCall) Return(arg0 bool, arg1 error) *MockApplicationsManagementClientDeleteLockCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientDeleteLockCall) Do(f func(context.Context, string, string, string) (bool, error)) *MockApplicationsManagementClientDeleteLockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientDeleteLockCall) DoAndReturn(f func(context.Context, string, string, string) (bool, error)) *MockApplicationsManagementClientDeleteLockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteRecipePack mocks base method.
 func (m *MockApplicationsManagementClient) DeleteRecipePack(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -780,6 +858,84 @@ func (c *MockApplicationsManagementClientGetEnvironmentCall) DoAndReturn(f func(
 	return c
 }
 
+// GetLatestOperationStatus mocks base method.
+func (m *MockApplicationsManagementClient) GetLatestOperationStatus(arg0 context.Context, arg1 string) (*v1.AsyncOperationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestOperationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*v1.AsyncOperationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestOperationStatus indicates an expected call of GetLatestOperationStatus.
+func (mr *MockApplicationsManagementClientMockRecorder) GetLatestOperationStatus(arg0, arg1 any) *MockApplicationsManagementClientGetLatestOperationStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestOperationStatus", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetLatestOperationStatus), arg0, arg1)
+	return &MockApplicationsManagementClientGetLatestOperationStatusCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetLatestOperationStatusCall wrap *gomock.Call
+type MockApplicationsManagementClientGetLatestOperationStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetLatestOperationStatusCall) Return(arg0 *v1.AsyncOperationStatus, arg1 error) *MockApplicationsManagementClientGetLatestOperationStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetLatestOperationStatusCall) Do(f func(context.Context, string) (*v1.AsyncOperationStatus, error)) *MockApplicationsManagementClientGetLatestOperationStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetLatestOperationStatusCall) DoAndReturn(f func(context.Context, string) (*v1.AsyncOperationStatus, error)) *MockApplicationsManagementClientGetLatestOperationStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetLock mocks base method.
+func (m *MockApplicationsManagementClient) GetLock(arg0 context.Context, arg1, arg2, arg3 string) (v20231001preview0.LockResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLock", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(v20231001preview0.LockResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLock indicates an expected call of GetLock.
+func (mr *MockApplicationsManagementClientMockRecorder) GetLock(arg0, arg1, arg2, arg3 any) *MockApplicationsManagementClientGetLockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLock", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetLock), arg0, arg1, arg2, arg3)
+	return &MockApplicationsManagementClientGetLockCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetLockCall wrap *gomock.Call
+type MockApplicationsManagementClientGetLockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetLockCall) Return(arg0 v20231001preview0.LockResource, arg1 error) *MockApplicationsManagementClientGetLockCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetLockCall) Do(f func(context.Context, string, string, string) (v20231001preview0.LockResource, error)) *MockApplicationsManagementClientGetLockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetLockCall) DoAndReturn(f func(context.Context, string, string, string) (v20231001preview0.LockResource, error)) *MockApplicationsManagementClientGetLockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRecipeMetadata mocks base method.
 func (m *MockApplicationsManagementClient) GetRecipeMetadata(arg0 context.Context, arg1 string, arg2 v20231001preview.RecipeGetMetadata) (v20231001preview.RecipeGetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1014,6 +1170,45 @@ func (c *MockApplicationsManagementClientGetResourceProviderSummaryCall) DoAndRe
 	return c
 }
 
+// InvokeResourceAction mocks base method.
+func (m *MockApplicationsManagementClient) InvokeResourceAction(arg0 context.Context, arg1, arg2, arg3 string, arg4 map[string]any) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvokeResourceAction", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvokeResourceAction indicates an expected call of InvokeResourceAction.
+func (mr *MockApplicationsManagementClientMockRecorder) InvokeResourceAction(arg0, arg1, arg2, arg3, arg4 any) *MockApplicationsManagementClientInvokeResourceActionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeResourceAction", reflect.TypeOf((*MockApplicationsManagementClient)(nil).InvokeResourceAction), arg0, arg1, arg2, arg3, arg4)
+	return &MockApplicationsManagementClientInvokeResourceActionCall{Call: call}
+}
+
+// MockApplicationsManagementClientInvokeResourceActionCall wrap *gomock.Call
+type MockApplicationsManagementClientInvokeResourceActionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientInvokeResourceActionCall) Return(arg0 map[string]any, arg1 error) *MockApplicationsManagementClientInvokeResourceActionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientInvokeResourceActionCall) Do(f func(context.Context, string, string, string, map[string]any) (map[string]any, error)) *MockApplicationsManagementClientInvokeResourceActionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientInvokeResourceActionCall) DoAndReturn(f func(context.Context, string, string, string, map[string]any) (map[string]any, error)) *MockApplicationsManagementClientInvokeResourceActionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListAllResourceTypesNames mocks base method.
 func (m *MockApplicationsManagementClient) ListAllResourceTypesNames(arg0 context.Context, arg1 string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1092,6 +1287,45 @@ func (c *MockApplicationsManagementClientListApplicationsCall) DoAndReturn(f fun
 	return c
 }
 
+// ListAuditRecords mocks base method.
+func (m *MockApplicationsManagementClient) ListAuditRecords(arg0 context.Context, arg1 string, arg2 *v20231001preview0.AuditRecordsClientListOptions) ([]v20231001preview0.AuditRecordResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditRecords", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]v20231001preview0.AuditRecordResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditRecords indicates an expected call of ListAuditRecords.
+func (mr *MockApplicationsManagementClientMockRecorder) ListAuditRecords(arg0, arg1, arg2 any) *MockApplicationsManagementClientListAuditRecordsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditRecords", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListAuditRecords), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientListAuditRecordsCall{Call: call}
+}
+
+// MockApplicationsManagementClientListAuditRecordsCall wrap *gomock.Call
+type MockApplicationsManagementClientListAuditRecordsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListAuditRecordsCall) Return(arg0 []v20231001preview0.AuditRecordResource, arg1 error) *MockApplicationsManagementClientListAuditRecordsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListAuditRecordsCall) Do(f func(context.Context, string, *v20231001preview0.AuditRecordsClientListOptions) ([]v20231001preview0.AuditRecordResource, error)) *MockApplicationsManagementClientListAuditRecordsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListAuditRecordsCall) DoAndReturn(f func(context.Context, string, *v20231001preview0.AuditRecordsClientListOptions) ([]v20231001preview0.AuditRecordResource, error)) *MockApplicationsManagementClientListAuditRecordsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListEnvironments mocks base method.
 func (m *MockApplicationsManagementClient) ListEnvironments(arg0 context.Context) ([]v20231001preview.EnvironmentResource, error) {
 	m.ctrl.T.Helper()
@@ -1170,6 +1404,45 @@ func (c *MockApplicationsManagementClientListEnvironmentsAllCall) DoAndReturn(f
 	return c
 }
 
+// ListLocks mocks base method.
+func (m *MockApplicationsManagementClient) ListLocks(arg0 context.Context, arg1, arg2 string) ([]v20231001preview0.LockResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLocks", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]v20231001preview0.LockResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLocks indicates an expected call of ListLocks.
+func (mr *MockApplicationsManagementClientMockRecorder) ListLocks(arg0, arg1, arg2 any) *MockApplicationsManagementClientListLocksCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLocks", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListLocks), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientListLocksCall{Call: call}
+}
+
+// MockApplicationsManagementClientListLocksCall wrap *gomock.Call
+type MockApplicationsManagementClientListLocksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListLocksCall) Return(arg0 []v20231001preview0.LockResource, arg1 error) *MockApplicationsManagementClientListLocksCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListLocksCall) Do(f func(context.Context, string, string) ([]v20231001preview0.LockResource, error)) *MockApplicationsManagementClientListLocksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListLocksCall) DoAndReturn(f func(context.Context, string, string) ([]v20231001preview0.LockResource, error)) *MockApplicationsManagementClientListLocksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListRecipePacks mocks base method.
 func (m *MockApplicationsManagementClient) ListRecipePacks(arg0 context.Context) ([]v20250801preview.RecipePackResource, error) {
 	m.ctrl.T.Helper()
@@ -1715,3 +1988,42 @@ func (c *MockApplicationsManagementClientListResourcesOfTypeInResourceGroupFilte
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// QueryResources mocks base method.
+func (m *MockApplicationsManagementClient) QueryResources(arg0 context.Context, arg1 string, arg2 v20231001preview0.ResourceQueryRequest) (v20231001preview0.ResourceQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryResources", arg0, arg1, arg2)
+	ret0, _ := ret[0].(v20231001preview0.ResourceQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryResources indicates an expected call of QueryResources.
+func (mr *MockApplicationsManagementClientMockRecorder) QueryResources(arg0, arg1, arg2 any) *MockApplicationsManagementClientQueryResourcesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryResources", reflect.TypeOf((*MockApplicationsManagementClient)(nil).QueryResources), arg0, arg1, arg2)
+	return &MockApplicationsManagementClientQueryResourcesCall{Call: call}
+}
+
+// MockApplicationsManagementClientQueryResourcesCall wrap *gomock.Call
+type MockApplicationsManagementClientQueryResourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientQueryResourcesCall) Return(arg0 v20231001preview0.ResourceQueryResponse, arg1 error) *MockApplicationsManagementClientQueryResourcesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientQueryResourcesCall) Do(f func(context.Context, string, v20231001preview0.ResourceQueryRequest) (v20231001preview0.ResourceQueryResponse, error)) *MockApplicationsManagementClientQueryResourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientQueryResourcesCall) DoAndReturn(f func(context.Context, string, v20231001preview0.ResourceQueryRequest) (v20231001preview0.ResourceQueryResponse, error)) *MockApplicationsManagementClientQueryResourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -40,6 +40,44 @@ func (m *MockDiagnosticsClient) EXPECT() *MockDiagnosticsClientMockRecorder {
 	return m.recorder
 }
 
+// Exec mocks base method.
+func (m *MockDiagnosticsClient) Exec(arg0 context.Context, arg1 ExecOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exec", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Exec indicates an expected call of Exec.
+func (mr *MockDiagnosticsClientMockRecorder) Exec(arg0, arg1 any) *MockDiagnosticsClientExecCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exec", reflect.TypeOf((*MockDiagnosticsClient)(nil).Exec), arg0, arg1)
+	return &MockDiagnosticsClientExecCall{Call: call}
+}
+
+// MockDiagnosticsClientExecCall wrap *gomock.Call
+type MockDiagnosticsClientExecCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDiagnosticsClientExecCall) Return(arg0 error) *MockDiagnosticsClientExecCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDiagnosticsClientExecCall) Do(f func(context.Context, ExecOptions) error) *MockDiagnosticsClientExecCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDiagnosticsClientExecCall) DoAndReturn(f func(context.Context, ExecOptions) error) *MockDiagnosticsClientExecCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Expose mocks base method.
 func (m *MockDiagnosticsClient) Expose(arg0 context.Context, arg1 ExposeOptions) (chan error, chan struct{}, chan os.Signal, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +119,45 @@ func (c *MockDiagnosticsClientExposeCall) DoAndReturn(f func(context.Context, Ex
 	return c
 }
 
+// GetJobStatus mocks base method.
+func (m *MockDiagnosticsClient) GetJobStatus(arg0 context.Context, arg1 JobStatusOptions) (*JobStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobStatus", arg0, arg1)
+	ret0, _ := ret[0].(*JobStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobStatus indicates an expected call of GetJobStatus.
+func (mr *MockDiagnosticsClientMockRecorder) GetJobStatus(arg0, arg1 any) *MockDiagnosticsClientGetJobStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobStatus", reflect.TypeOf((*MockDiagnosticsClient)(nil).GetJobStatus), arg0, arg1)
+	return &MockDiagnosticsClientGetJobStatusCall{Call: call}
+}
+
+// MockDiagnosticsClientGetJobStatusCall wrap *gomock.Call
+type MockDiagnosticsClientGetJobStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDiagnosticsClientGetJobStatusCall) Return(arg0 *JobStatus, arg1 error) *MockDiagnosticsClientGetJobStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDiagnosticsClientGetJobStatusCall) Do(f func(context.Context, JobStatusOptions) (*JobStatus, error)) *MockDiagnosticsClientGetJobStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDiagnosticsClientGetJobStatusCall) DoAndReturn(f func(context.Context, JobStatusOptions) (*JobStatus, error)) *MockDiagnosticsClientGetJobStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetPublicEndpoint mocks base method.
 func (m *MockDiagnosticsClient) GetPublicEndpoint(arg0 context.Context, arg1 EndpointOptions) (*string, error) {
 	m.ctrl.T.Helper()
@@ -120,6 +197,45 @@ func (c *MockDiagnosticsClientGetPublicEndpointCall) DoAndReturn(f func(context.
 	return c
 }
 
+// GetReplicaStatus mocks base method.
+func (m *MockDiagnosticsClient) GetReplicaStatus(arg0 context.Context, arg1 ReplicaStatusOptions) ([]ReplicaStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicaStatus", arg0, arg1)
+	ret0, _ := ret[0].([]ReplicaStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicaStatus indicates an expected call of GetReplicaStatus.
+func (mr *MockDiagnosticsClientMockRecorder) GetReplicaStatus(arg0, arg1 any) *MockDiagnosticsClientGetReplicaStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicaStatus", reflect.TypeOf((*MockDiagnosticsClient)(nil).GetReplicaStatus), arg0, arg1)
+	return &MockDiagnosticsClientGetReplicaStatusCall{Call: call}
+}
+
+// MockDiagnosticsClientGetReplicaStatusCall wrap *gomock.Call
+type MockDiagnosticsClientGetReplicaStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDiagnosticsClientGetReplicaStatusCall) Return(arg0 []ReplicaStatus, arg1 error) *MockDiagnosticsClientGetReplicaStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDiagnosticsClientGetReplicaStatusCall) Do(f func(context.Context, ReplicaStatusOptions) ([]ReplicaStatus, error)) *MockDiagnosticsClientGetReplicaStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDiagnosticsClientGetReplicaStatusCall) DoAndReturn(f func(context.Context, ReplicaStatusOptions) ([]ReplicaStatus, error)) *MockDiagnosticsClientGetReplicaStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Logs mocks base method.
 func (m *MockDiagnosticsClient) Logs(arg0 context.Context, arg1 LogsOptions) ([]LogStream, error) {
 	m.ctrl.T.Helper()
@@ -158,3 +274,41 @@ func (c *MockDiagnosticsClientLogsCall) DoAndReturn(f func(context.Context, Logs
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// PortForward mocks base method.
+func (m *MockDiagnosticsClient) PortForward(arg0 context.Context, arg1 PortForwardOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PortForward", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PortForward indicates an expected call of PortForward.
+func (mr *MockDiagnosticsClientMockRecorder) PortForward(arg0, arg1 any) *MockDiagnosticsClientPortForwardCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PortForward", reflect.TypeOf((*MockDiagnosticsClient)(nil).PortForward), arg0, arg1)
+	return &MockDiagnosticsClientPortForwardCall{Call: call}
+}
+
+// MockDiagnosticsClientPortForwardCall wrap *gomock.Call
+type MockDiagnosticsClientPortForwardCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDiagnosticsClientPortForwardCall) Return(arg0 error) *MockDiagnosticsClientPortForwardCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDiagnosticsClientPortForwardCall) Do(f func(context.Context, PortForwardOptions) error) *MockDiagnosticsClientPortForwardCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDiagnosticsClientPortForwardCall) DoAndReturn(f func(context.Context, PortForwardOptions) error) *MockDiagnosticsClientPortForwardCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
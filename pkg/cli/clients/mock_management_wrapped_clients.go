@@ -3,7 +3,7 @@
 //
 // Generated by this command:
 //
-//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,recipePackResourceClient
+//	mockgen -typed -source=./management_mocks.go -destination=./mock_management_wrapped_clients.go -package=clients -self_package github.com/radius-project/radius/pkg/cli/clients github.com/radius-project/radius/pkg/cli/clients genericResourceClient,applicationResourceClient,environmentResourceClient,resourceGroupClient,lockClient,auditRecordClient,resourceQueryClient,resourceProviderClient,resourceTypeClient,apiVersonClient,locationClient,recipePackResourceClient,operationStatusClient
 //
 
 // Package clients is a generated GoMock package.
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	runtime "github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	generated "github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	v20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
@@ -161,6 +162,45 @@ func (c *MockgenericResourceClientGetCall) DoAndReturn(f func(context.Context, s
 	return c
 }
 
+// InvokeAction mocks base method.
+func (m *MockgenericResourceClient) InvokeAction(ctx context.Context, resourceName, actionName string, options *generated.GenericResourcesClientInvokeActionOptions) (generated.GenericResourcesClientInvokeActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvokeAction", ctx, resourceName, actionName, options)
+	ret0, _ := ret[0].(generated.GenericResourcesClientInvokeActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvokeAction indicates an expected call of InvokeAction.
+func (mr *MockgenericResourceClientMockRecorder) InvokeAction(ctx, resourceName, actionName, options any) *MockgenericResourceClientInvokeActionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeAction", reflect.TypeOf((*MockgenericResourceClient)(nil).InvokeAction), ctx, resourceName, actionName, options)
+	return &MockgenericResourceClientInvokeActionCall{Call: call}
+}
+
+// MockgenericResourceClientInvokeActionCall wrap *gomock.Call
+type MockgenericResourceClientInvokeActionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockgenericResourceClientInvokeActionCall) Return(arg0 generated.GenericResourcesClientInvokeActionResponse, arg1 error) *MockgenericResourceClientInvokeActionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockgenericResourceClientInvokeActionCall) Do(f func(context.Context, string, string, *generated.GenericResourcesClientInvokeActionOptions) (generated.GenericResourcesClientInvokeActionResponse, error)) *MockgenericResourceClientInvokeActionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockgenericResourceClientInvokeActionCall) DoAndReturn(f func(context.Context, string, string, *generated.GenericResourcesClientInvokeActionOptions) (generated.GenericResourcesClientInvokeActionResponse, error)) *MockgenericResourceClientInvokeActionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // NewListByRootScopePager mocks base method.
 func (m *MockgenericResourceClient) NewListByRootScopePager(options *generated.GenericResourcesClientListByRootScopeOptions) *runtime.Pager[generated.GenericResourcesClientListByRootScopeResponse] {
 	m.ctrl.T.Helper()
@@ -811,6 +851,307 @@ func (c *MockresourceGroupClientNewListPagerCall) DoAndReturn(f func(string, *v2
 	return c
 }
 
+// MocklockClient is a mock of lockClient interface.
+type MocklockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MocklockClientMockRecorder
+}
+
+// MocklockClientMockRecorder is the mock recorder for MocklockClient.
+type MocklockClientMockRecorder struct {
+	mock *MocklockClient
+}
+
+// NewMocklockClient creates a new mock instance.
+func NewMocklockClient(ctrl *gomock.Controller) *MocklockClient {
+	mock := &MocklockClient{ctrl: ctrl}
+	mock.recorder = &MocklockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklockClient) EXPECT() *MocklockClientMockRecorder {
+	return m.recorder
+}
+
+// CreateOrUpdate mocks base method.
+func (m *MocklockClient) CreateOrUpdate(ctx context.Context, planeName, resourceGroupName, lockName string, resource v20231001preview0.LockResource, options *v20231001preview0.LocksClientCreateOrUpdateOptions) (v20231001preview0.LocksClientCreateOrUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, planeName, resourceGroupName, lockName, resource, options)
+	ret0, _ := ret[0].(v20231001preview0.LocksClientCreateOrUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MocklockClientMockRecorder) CreateOrUpdate(ctx, planeName, resourceGroupName, lockName, resource, options any) *MocklockClientCreateOrUpdateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MocklockClient)(nil).CreateOrUpdate), ctx, planeName, resourceGroupName, lockName, resource, options)
+	return &MocklockClientCreateOrUpdateCall{Call: call}
+}
+
+// MocklockClientCreateOrUpdateCall wrap *gomock.Call
+type MocklockClientCreateOrUpdateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklockClientCreateOrUpdateCall) Return(arg0 v20231001preview0.LocksClientCreateOrUpdateResponse, arg1 error) *MocklockClientCreateOrUpdateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklockClientCreateOrUpdateCall) Do(f func(context.Context, string, string, string, v20231001preview0.LockResource, *v20231001preview0.LocksClientCreateOrUpdateOptions) (v20231001preview0.LocksClientCreateOrUpdateResponse, error)) *MocklockClientCreateOrUpdateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklockClientCreateOrUpdateCall) DoAndReturn(f func(context.Context, string, string, string, v20231001preview0.LockResource, *v20231001preview0.LocksClientCreateOrUpdateOptions) (v20231001preview0.LocksClientCreateOrUpdateResponse, error)) *MocklockClientCreateOrUpdateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Delete mocks base method.
+func (m *MocklockClient) Delete(ctx context.Context, planeName, resourceGroupName, lockName string, options *v20231001preview0.LocksClientDeleteOptions) (v20231001preview0.LocksClientDeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, planeName, resourceGroupName, lockName, options)
+	ret0, _ := ret[0].(v20231001preview0.LocksClientDeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MocklockClientMockRecorder) Delete(ctx, planeName, resourceGroupName, lockName, options any) *MocklockClientDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MocklockClient)(nil).Delete), ctx, planeName, resourceGroupName, lockName, options)
+	return &MocklockClientDeleteCall{Call: call}
+}
+
+// MocklockClientDeleteCall wrap *gomock.Call
+type MocklockClientDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklockClientDeleteCall) Return(arg0 v20231001preview0.LocksClientDeleteResponse, arg1 error) *MocklockClientDeleteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklockClientDeleteCall) Do(f func(context.Context, string, string, string, *v20231001preview0.LocksClientDeleteOptions) (v20231001preview0.LocksClientDeleteResponse, error)) *MocklockClientDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklockClientDeleteCall) DoAndReturn(f func(context.Context, string, string, string, *v20231001preview0.LocksClientDeleteOptions) (v20231001preview0.LocksClientDeleteResponse, error)) *MocklockClientDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Get mocks base method.
+func (m *MocklockClient) Get(ctx context.Context, planeName, resourceGroupName, lockName string, options *v20231001preview0.LocksClientGetOptions) (v20231001preview0.LocksClientGetResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, planeName, resourceGroupName, lockName, options)
+	ret0, _ := ret[0].(v20231001preview0.LocksClientGetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MocklockClientMockRecorder) Get(ctx, planeName, resourceGroupName, lockName, options any) *MocklockClientGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MocklockClient)(nil).Get), ctx, planeName, resourceGroupName, lockName, options)
+	return &MocklockClientGetCall{Call: call}
+}
+
+// MocklockClientGetCall wrap *gomock.Call
+type MocklockClientGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklockClientGetCall) Return(arg0 v20231001preview0.LocksClientGetResponse, arg1 error) *MocklockClientGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklockClientGetCall) Do(f func(context.Context, string, string, string, *v20231001preview0.LocksClientGetOptions) (v20231001preview0.LocksClientGetResponse, error)) *MocklockClientGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklockClientGetCall) DoAndReturn(f func(context.Context, string, string, string, *v20231001preview0.LocksClientGetOptions) (v20231001preview0.LocksClientGetResponse, error)) *MocklockClientGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NewListPager mocks base method.
+func (m *MocklockClient) NewListPager(planeName, resourceGroupName string, options *v20231001preview0.LocksClientListOptions) *runtime.Pager[v20231001preview0.LocksClientListResponse] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPager", planeName, resourceGroupName, options)
+	ret0, _ := ret[0].(*runtime.Pager[v20231001preview0.LocksClientListResponse])
+	return ret0
+}
+
+// NewListPager indicates an expected call of NewListPager.
+func (mr *MocklockClientMockRecorder) NewListPager(planeName, resourceGroupName, options any) *MocklockClientNewListPagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPager", reflect.TypeOf((*MocklockClient)(nil).NewListPager), planeName, resourceGroupName, options)
+	return &MocklockClientNewListPagerCall{Call: call}
+}
+
+// MocklockClientNewListPagerCall wrap *gomock.Call
+type MocklockClientNewListPagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklockClientNewListPagerCall) Return(arg0 *runtime.Pager[v20231001preview0.LocksClientListResponse]) *MocklockClientNewListPagerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklockClientNewListPagerCall) Do(f func(string, string, *v20231001preview0.LocksClientListOptions) *runtime.Pager[v20231001preview0.LocksClientListResponse]) *MocklockClientNewListPagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklockClientNewListPagerCall) DoAndReturn(f func(string, string, *v20231001preview0.LocksClientListOptions) *runtime.Pager[v20231001preview0.LocksClientListResponse]) *MocklockClientNewListPagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockauditRecordClient is a mock of auditRecordClient interface.
+type MockauditRecordClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditRecordClientMockRecorder
+}
+
+// MockauditRecordClientMockRecorder is the mock recorder for MockauditRecordClient.
+type MockauditRecordClientMockRecorder struct {
+	mock *MockauditRecordClient
+}
+
+// NewMockauditRecordClient creates a new mock instance.
+func NewMockauditRecordClient(ctrl *gomock.Controller) *MockauditRecordClient {
+	mock := &MockauditRecordClient{ctrl: ctrl}
+	mock.recorder = &MockauditRecordClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditRecordClient) EXPECT() *MockauditRecordClientMockRecorder {
+	return m.recorder
+}
+
+// NewListPager mocks base method.
+func (m *MockauditRecordClient) NewListPager(planeName string, options *v20231001preview0.AuditRecordsClientListOptions) *runtime.Pager[v20231001preview0.AuditRecordsClientListResponse] {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewListPager", planeName, options)
+	ret0, _ := ret[0].(*runtime.Pager[v20231001preview0.AuditRecordsClientListResponse])
+	return ret0
+}
+
+// NewListPager indicates an expected call of NewListPager.
+func (mr *MockauditRecordClientMockRecorder) NewListPager(planeName, options any) *MockauditRecordClientNewListPagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewListPager", reflect.TypeOf((*MockauditRecordClient)(nil).NewListPager), planeName, options)
+	return &MockauditRecordClientNewListPagerCall{Call: call}
+}
+
+// MockauditRecordClientNewListPagerCall wrap *gomock.Call
+type MockauditRecordClientNewListPagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauditRecordClientNewListPagerCall) Return(arg0 *runtime.Pager[v20231001preview0.AuditRecordsClientListResponse]) *MockauditRecordClientNewListPagerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauditRecordClientNewListPagerCall) Do(f func(string, *v20231001preview0.AuditRecordsClientListOptions) *runtime.Pager[v20231001preview0.AuditRecordsClientListResponse]) *MockauditRecordClientNewListPagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauditRecordClientNewListPagerCall) DoAndReturn(f func(string, *v20231001preview0.AuditRecordsClientListOptions) *runtime.Pager[v20231001preview0.AuditRecordsClientListResponse]) *MockauditRecordClientNewListPagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockresourceQueryClient is a mock of resourceQueryClient interface.
+type MockresourceQueryClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockresourceQueryClientMockRecorder
+}
+
+// MockresourceQueryClientMockRecorder is the mock recorder for MockresourceQueryClient.
+type MockresourceQueryClientMockRecorder struct {
+	mock *MockresourceQueryClient
+}
+
+// NewMockresourceQueryClient creates a new mock instance.
+func NewMockresourceQueryClient(ctrl *gomock.Controller) *MockresourceQueryClient {
+	mock := &MockresourceQueryClient{ctrl: ctrl}
+	mock.recorder = &MockresourceQueryClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockresourceQueryClient) EXPECT() *MockresourceQueryClientMockRecorder {
+	return m.recorder
+}
+
+// Query mocks base method.
+func (m *MockresourceQueryClient) Query(ctx context.Context, planeName string, body v20231001preview0.ResourceQueryRequest, options *v20231001preview0.ResourceQueryClientQueryOptions) (v20231001preview0.ResourceQueryClientQueryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", ctx, planeName, body, options)
+	ret0, _ := ret[0].(v20231001preview0.ResourceQueryClientQueryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockresourceQueryClientMockRecorder) Query(ctx, planeName, body, options any) *MockresourceQueryClientQueryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockresourceQueryClient)(nil).Query), ctx, planeName, body, options)
+	return &MockresourceQueryClientQueryCall{Call: call}
+}
+
+// MockresourceQueryClientQueryCall wrap *gomock.Call
+type MockresourceQueryClientQueryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockresourceQueryClientQueryCall) Return(arg0 v20231001preview0.ResourceQueryClientQueryResponse, arg1 error) *MockresourceQueryClientQueryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockresourceQueryClientQueryCall) Do(f func(context.Context, string, v20231001preview0.ResourceQueryRequest, *v20231001preview0.ResourceQueryClientQueryOptions) (v20231001preview0.ResourceQueryClientQueryResponse, error)) *MockresourceQueryClientQueryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockresourceQueryClientQueryCall) DoAndReturn(f func(context.Context, string, v20231001preview0.ResourceQueryRequest, *v20231001preview0.ResourceQueryClientQueryOptions) (v20231001preview0.ResourceQueryClientQueryResponse, error)) *MockresourceQueryClientQueryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockresourceProviderClient is a mock of resourceProviderClient interface.
 type MockresourceProviderClient struct {
 	ctrl     *gomock.Controller
@@ -1468,3 +1809,65 @@ func (c *MockrecipePackResourceClientNewListByScopePagerCall) DoAndReturn(f func
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockoperationStatusClient is a mock of operationStatusClient interface.
+type MockoperationStatusClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockoperationStatusClientMockRecorder
+}
+
+// MockoperationStatusClientMockRecorder is the mock recorder for MockoperationStatusClient.
+type MockoperationStatusClientMockRecorder struct {
+	mock *MockoperationStatusClient
+}
+
+// NewMockoperationStatusClient creates a new mock instance.
+func NewMockoperationStatusClient(ctrl *gomock.Controller) *MockoperationStatusClient {
+	mock := &MockoperationStatusClient{ctrl: ctrl}
+	mock.recorder = &MockoperationStatusClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoperationStatusClient) EXPECT() *MockoperationStatusClientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockoperationStatusClient) List(ctx context.Context, resourceID string) ([]v1.AsyncOperationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, resourceID)
+	ret0, _ := ret[0].([]v1.AsyncOperationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockoperationStatusClientMockRecorder) List(ctx, resourceID any) *MockoperationStatusClientListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockoperationStatusClient)(nil).List), ctx, resourceID)
+	return &MockoperationStatusClientListCall{Call: call}
+}
+
+// MockoperationStatusClientListCall wrap *gomock.Call
+type MockoperationStatusClientListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockoperationStatusClientListCall) Return(arg0 []v1.AsyncOperationStatus, arg1 error) *MockoperationStatusClientListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockoperationStatusClientListCall) Do(f func(context.Context, string) ([]v1.AsyncOperationStatus, error)) *MockoperationStatusClientListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockoperationStatusClientListCall) DoAndReturn(f func(context.Context, string) ([]v1.AsyncOperationStatus, error)) *MockoperationStatusClientListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
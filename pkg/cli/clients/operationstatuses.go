@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// operationStatusesAPIVersion is the API version used to list operation statuses. Every resource provider
+	// implements the operation statuses regardless of the API version.
+	operationStatusesAPIVersion = "2023-10-01-preview"
+
+	// operationStatusesResourceIDParameter is the query parameter of the resource whose operation statuses are listed.
+	operationStatusesResourceIDParameter = "resourceId"
+)
+
+// operationStatusesClient lists the statuses of the asynchronous operations of resources. Operation statuses are
+// implemented by every resource provider, so they are not part of the generated clients.
+type operationStatusesClient struct {
+	internal *arm.Client
+}
+
+// newOperationStatusesClient creates a new operationStatusesClient.
+func newOperationStatusesClient(options *arm.ClientOptions) (*operationStatusesClient, error) {
+	client, err := arm.NewClient("clients.operationStatusesClient", "v0.0.1", &aztoken.AnonymousCredential{}, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationStatusesClient{internal: client}, nil
+}
+
+// List lists the statuses of the asynchronous operations of a resource, starting with the latest operation.
+func (c *operationStatusesClient) List(ctx context.Context, resourceID string) ([]v1.AsyncOperationStatus, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	urlPath := fmt.Sprintf("%s/providers/%s/locations/%s/operationStatuses", id.PlaneScope(), id.ProviderNamespace(), v1.LocationGlobal)
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(c.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.Raw().URL.Query()
+	query.Set("api-version", operationStatusesAPIVersion)
+	query.Set(operationStatusesResourceIDParameter, resourceID)
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	resp, err := c.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	result := struct {
+		Value []v1.AsyncOperationStatus `json:"value"`
+	}{}
+	if err := runtime.UnmarshalAsJSON(resp, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Value, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"net/http"
 	"net/url"
+	"reflect"
 	"regexp"
 )
 
@@ -32,6 +33,10 @@ type GenericResourcesServer struct {
 	// HTTP status codes to indicate success: http.StatusOK
 	Get func(ctx context.Context, resourceName string, options *generated.GenericResourcesClientGetOptions) (resp azfake.Responder[generated.GenericResourcesClientGetResponse], errResp azfake.ErrorResponder)
 
+	// InvokeAction is the fake for method GenericResourcesClient.InvokeAction
+	// HTTP status codes to indicate success: http.StatusOK
+	InvokeAction func(ctx context.Context, resourceName string, actionName string, options *generated.GenericResourcesClientInvokeActionOptions) (resp azfake.Responder[generated.GenericResourcesClientInvokeActionResponse], errResp azfake.ErrorResponder)
+
 	// NewListByRootScopePager is the fake for method GenericResourcesClient.NewListByRootScopePager
 	// HTTP status codes to indicate success: http.StatusOK
 	NewListByRootScopePager func(options *generated.GenericResourcesClientListByRootScopeOptions) (resp azfake.PagerResponder[generated.GenericResourcesClientListByRootScopeResponse])
@@ -91,6 +96,8 @@ func (g *GenericResourcesServerTransport) dispatchToMethodFake(req *http.Request
 				res.resp, res.err = g.dispatchBeginDelete(req)
 			case "GenericResourcesClient.Get":
 				res.resp, res.err = g.dispatchGet(req)
+			case "GenericResourcesClient.InvokeAction":
+				res.resp, res.err = g.dispatchInvokeAction(req)
 			case "GenericResourcesClient.NewListByRootScopePager":
 				res.resp, res.err = g.dispatchNewListByRootScopePager(req)
 			case "GenericResourcesClient.ListSecrets":
@@ -227,6 +234,49 @@ func (g *GenericResourcesServerTransport) dispatchGet(req *http.Request) (*http.
 	return resp, nil
 }
 
+func (g *GenericResourcesServerTransport) dispatchInvokeAction(req *http.Request) (*http.Response, error) {
+	if g.srv.InvokeAction == nil {
+		return nil, &nonRetriableError{errors.New("fake for method InvokeAction not implemented")}
+	}
+	const regexStr = `/(?P<rootScope>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/(?P<resourceType>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/(?P<resourceName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/(?P<actionName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)`
+	regex := regexp.MustCompile(regexStr)
+	matches := regex.FindStringSubmatch(req.URL.EscapedPath())
+	if len(matches) < 5 {
+		return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
+	}
+	body, err := server.UnmarshalRequestAsJSON[map[string]any](req)
+	if err != nil {
+		return nil, err
+	}
+	resourceNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("resourceName")])
+	if err != nil {
+		return nil, err
+	}
+	actionNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("actionName")])
+	if err != nil {
+		return nil, err
+	}
+	var options *generated.GenericResourcesClientInvokeActionOptions
+	if !reflect.ValueOf(body).IsZero() {
+		options = &generated.GenericResourcesClientInvokeActionOptions{
+			Body: body,
+		}
+	}
+	respr, errRespr := g.srv.InvokeAction(req.Context(), resourceNameParam, actionNameParam, options)
+	if respErr := server.GetError(errRespr, req); respErr != nil {
+		return nil, respErr
+	}
+	respContent := server.GetResponseContent(respr)
+	if !contains([]int{http.StatusOK}, respContent.HTTPStatus) {
+		return nil, &nonRetriableError{fmt.Errorf("unexpected status code %d. acceptable values are http.StatusOK", respContent.HTTPStatus)}
+	}
+	resp, err := server.MarshalResponseAsJSON(respContent, server.GetResponse(respr).Value, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (g *GenericResourcesServerTransport) dispatchNewListByRootScopePager(req *http.Request) (*http.Response, error) {
 	if g.srv.NewListByRootScopePager == nil {
 		return nil, &nonRetriableError{errors.New("fake for method NewListByRootScopePager not implemented")}
@@ -243,6 +243,75 @@ func (client *GenericResourcesClient) getHandleResponse(resp *http.Response) (Ge
 	return result, nil
 }
 
+// InvokeAction - Invokes a custom action of a resource
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - resourceName - The name of the generic resource
+//   - actionName - The name of the custom action
+//   - options - GenericResourcesClientInvokeActionOptions contains the optional parameters for the GenericResourcesClient.InvokeAction
+//     method.
+func (client *GenericResourcesClient) InvokeAction(ctx context.Context, resourceName string, actionName string, options *GenericResourcesClientInvokeActionOptions) (GenericResourcesClientInvokeActionResponse, error) {
+	var err error
+	const operationName = "GenericResourcesClient.InvokeAction"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.invokeActionCreateRequest(ctx, resourceName, actionName, options)
+	if err != nil {
+		return GenericResourcesClientInvokeActionResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return GenericResourcesClientInvokeActionResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return GenericResourcesClientInvokeActionResponse{}, err
+	}
+	resp, err := client.invokeActionHandleResponse(httpResp)
+	return resp, err
+}
+
+// invokeActionCreateRequest creates the InvokeAction request.
+func (client *GenericResourcesClient) invokeActionCreateRequest(ctx context.Context, resourceName string, actionName string, options *GenericResourcesClientInvokeActionOptions) (*policy.Request, error) {
+	urlPath := "/{rootScope}/providers/{resourceType}/{resourceName}/{actionName}"
+	urlPath = strings.ReplaceAll(urlPath, "{rootScope}", client.rootScope)
+	urlPath = strings.ReplaceAll(urlPath, "{resourceType}", client.resourceType)
+	if resourceName == "" {
+		return nil, errors.New("parameter resourceName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceName}", url.PathEscape(resourceName))
+	if actionName == "" {
+		return nil, errors.New("parameter actionName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{actionName}", url.PathEscape(actionName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if options != nil && options.Body != nil {
+		if err := runtime.MarshalAsJSON(req, options.Body); err != nil {
+			return nil, err
+		}
+		return req, nil
+	}
+	return req, nil
+}
+
+// invokeActionHandleResponse handles the InvokeAction response.
+func (client *GenericResourcesClient) invokeActionHandleResponse(resp *http.Response) (GenericResourcesClientInvokeActionResponse, error) {
+	result := GenericResourcesClientInvokeActionResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.Value); err != nil {
+		return GenericResourcesClientInvokeActionResponse{}, err
+	}
+	return result, nil
+}
+
 // NewListByRootScopePager - Lists information about all resources of the given resource type in the given root scope
 //
 // Generated from API version 2023-10-01-preview
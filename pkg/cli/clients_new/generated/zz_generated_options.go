@@ -22,6 +22,13 @@ type GenericResourcesClientGetOptions struct {
 	// placeholder for future optional parameters
 }
 
+// GenericResourcesClientInvokeActionOptions contains the optional parameters for the GenericResourcesClient.InvokeAction
+// method.
+type GenericResourcesClientInvokeActionOptions struct {
+	// The request body of the action
+	Body map[string]any
+}
+
 // GenericResourcesClientListByRootScopeOptions contains the optional parameters for the GenericResourcesClient.NewListByRootScopePager
 // method.
 type GenericResourcesClientListByRootScopeOptions struct {
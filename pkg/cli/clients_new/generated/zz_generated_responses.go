@@ -21,6 +21,11 @@ type GenericResourcesClientGetResponse struct {
 	GenericResource
 }
 
+// GenericResourcesClientInvokeActionResponse contains the response from method GenericResourcesClient.InvokeAction.
+type GenericResourcesClientInvokeActionResponse struct {
+	Value map[string]any
+}
+
 // GenericResourcesClientListByRootScopeResponse contains the response from method GenericResourcesClient.NewListByRootScopePager.
 type GenericResourcesClientListByRootScopeResponse struct {
 	// Object that includes an array of GenericResources and a possible link for next set
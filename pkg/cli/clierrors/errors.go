@@ -16,7 +16,10 @@ limitations under the License.
 
 package clierrors
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 // IsFriendlyError returns true if the error should be handled gracefully by the CLI.
 func IsFriendlyError(err error) bool {
@@ -33,3 +36,23 @@ func Message(message string, args ...any) *ErrorMessage {
 func MessageWithCause(cause error, message string, args ...any) *ErrorMessage {
 	return &ErrorMessage{Cause: cause, Message: fmt.Sprintf(message, args...)}
 }
+
+// ExitCode returns a new ExitCodeError with the given exit code.
+func ExitCode(code int) *ExitCodeError {
+	return &ExitCodeError{ExitCode: code}
+}
+
+// GetExitCode returns the exit code of the CLI for the given error: 0 if there is no error, the exit code of an
+// ExitCodeError, or 1 for any other error.
+func GetExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitCodeError *ExitCodeError
+	if errors.As(err, &exitCodeError) {
+		return exitCodeError.ExitCode
+	}
+
+	return 1
+}
@@ -16,7 +16,10 @@ limitations under the License.
 
 package clierrors
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
 
 // FriendlyError defines an interface for errors that should be gracefully handled by the CLI and
 // display a friendly error message to the user.
@@ -56,3 +59,22 @@ func (*ErrorMessage) IsFriendlyError() bool {
 func (e *ErrorMessage) Unwrap() error {
 	return e.Cause
 }
+
+var _ FriendlyError = &ExitCodeError{}
+
+// ExitCodeError is an error that exits the CLI with a specific exit code without displaying a message. It is used by
+// commands that report their result through the exit code, after displaying their output.
+type ExitCodeError struct {
+	// ExitCode is the exit code of the CLI.
+	ExitCode int
+}
+
+// Error returns the error message for the error.
+func (e *ExitCodeError) Error() string {
+	return fmt.Sprintf("exit code %d", e.ExitCode)
+}
+
+// IsFriendlyError returns true for ExitCodeError. These errors are always handled gracefully by the CLI.
+func (*ExitCodeError) IsFriendlyError() bool {
+	return true
+}
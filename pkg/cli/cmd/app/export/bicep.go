@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clients"
+)
+
+const (
+	// indentation is the indentation of nested Bicep values.
+	indentation = "  "
+)
+
+var bicepIdentifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// writeBicep writes the exported application as a Bicep template.
+func writeBicep(application *exportedApplication) string {
+	output := &strings.Builder{}
+	output.WriteString("extension radius\n")
+
+	if application.EnvironmentID != "" {
+		output.WriteString("\n@description('The ID of the Radius environment to deploy the application to.')\n")
+		output.WriteString(fmt.Sprintf("param %s string\n", environmentParameter))
+	}
+
+	for _, resource := range application.Resources {
+		output.WriteString("\n")
+		output.WriteString(fmt.Sprintf("resource %s %s = {\n", resource.SymbolicName, quoteBicep(resource.Type+"@"+resource.APIVersion)))
+		output.WriteString(fmt.Sprintf("%sname: %s\n", indentation, quoteBicep(resource.Name)))
+
+		if len(resource.Tags) > 0 {
+			tags := map[string]any{}
+			for key, value := range resource.Tags {
+				tags[key] = value
+			}
+			output.WriteString(fmt.Sprintf("%stags: %s\n", indentation, formatBicepValue(tags, indentation)))
+		}
+
+		output.WriteString(fmt.Sprintf("%sproperties: %s\n", indentation, formatBicepValue(resource.Properties, indentation)))
+		output.WriteString("}\n")
+	}
+
+	return output.String()
+}
+
+// writeParameters writes the parameters file of the exported application, in the format accepted by
+// 'rad deploy --parameters @file'.
+func writeParameters(application *exportedApplication) ([]byte, error) {
+	parameters := clients.DeploymentParameters{}
+	if application.EnvironmentID != "" {
+		parameters[environmentParameter] = bicep.NewParameter(application.EnvironmentID)
+	}
+
+	file := map[string]any{
+		"$schema":        "https://schema.management.azure.com/schemas/2019-04-01/deploymentParameters.json#",
+		"contentVersion": "1.0.0.0",
+		"parameters":     parameters,
+	}
+
+	b, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, '\n'), nil
+}
+
+// formatBicepValue formats a value as a Bicep literal. Nested objects and arrays are written on multiple lines,
+// indented relative to the given indentation.
+func formatBicepValue(value any, indent string) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bicepExpression:
+		return string(v)
+	case string:
+		return quoteBicep(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case int32:
+		return strconv.FormatInt(int64(v), 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return formatBicepNumber(v)
+	case json.Number:
+		f, _ := v.Float64()
+		return formatBicepNumber(f)
+	case map[string]any:
+		if len(v) == 0 {
+			return "{}"
+		}
+
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		output := &strings.Builder{}
+		output.WriteString("{\n")
+		for _, key := range keys {
+			name := key
+			if !bicepIdentifier.MatchString(key) {
+				name = quoteBicep(key)
+			}
+			output.WriteString(fmt.Sprintf("%s%s%s: %s\n", indent, indentation, name, formatBicepValue(v[key], indent+indentation)))
+		}
+		output.WriteString(indent + "}")
+		return output.String()
+	case []any:
+		if len(v) == 0 {
+			return "[]"
+		}
+
+		output := &strings.Builder{}
+		output.WriteString("[\n")
+		for _, item := range v {
+			output.WriteString(fmt.Sprintf("%s%s%s\n", indent, indentation, formatBicepValue(item, indent+indentation)))
+		}
+		output.WriteString(indent + "]")
+		return output.String()
+	default:
+		// Other values are written as their JSON representation.
+		b, err := json.Marshal(v)
+		if err != nil {
+			return quoteBicep(fmt.Sprint(v))
+		}
+		return fmt.Sprintf("json(%s)", quoteBicep(string(b)))
+	}
+}
+
+// formatBicepNumber formats a number as a Bicep literal. Bicep only supports integer literals, so other numbers are
+// written with the json() function.
+func formatBicepNumber(value float64) string {
+	if value == math.Trunc(value) && math.Abs(value) < 1<<53 {
+		return strconv.FormatInt(int64(value), 10)
+	}
+
+	return fmt.Sprintf("json(%s)", quoteBicep(strconv.FormatFloat(value, 'f', -1, 64)))
+}
+
+// quoteBicep quotes a string as a Bicep string literal.
+func quoteBicep(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`'`, `\'`,
+		"${", `\${`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+	)
+
+	return "'" + replacer.Replace(value) + "'"
+}
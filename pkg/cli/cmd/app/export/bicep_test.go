@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_formatBicepValue(t *testing.T) {
+	testcases := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{name: "null", value: nil, expected: "null"},
+		{name: "string", value: "hello", expected: "'hello'"},
+		{name: "escaped string", value: "it's\n${x}\\", expected: `'it\'s\n\${x}\\'`},
+		{name: "bool", value: true, expected: "true"},
+		{name: "integer", value: float64(-42), expected: "-42"},
+		{name: "int", value: 8080, expected: "8080"},
+		{name: "decimal", value: 0.5, expected: "json('0.5')"},
+		{name: "json number", value: json.Number("3"), expected: "3"},
+		{name: "expression", value: bicepExpression("app.id"), expected: "app.id"},
+		{name: "empty object", value: map[string]any{}, expected: "{}"},
+		{name: "empty array", value: []any{}, expected: "[]"},
+		{
+			name:     "object",
+			value:    map[string]any{"b": "x", "a": float64(1), "not-identifier": true},
+			expected: "{\n  a: 1\n  b: 'x'\n  'not-identifier': true\n}",
+		},
+		{
+			name:     "nested array",
+			value:    []any{map[string]any{"a": []any{"x"}}},
+			expected: "[\n  {\n    a: [\n      'x'\n    ]\n  }\n]",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, formatBicepValue(tc.value, ""))
+		})
+	}
+}
+
+func Test_writeBicep_NoEnvironment(t *testing.T) {
+	application := &exportedApplication{
+		Resources: []exportedResource{
+			{SymbolicName: "app", Type: applicationsResourceType, APIVersion: defaultAPIVersion, Name: "app", Properties: map[string]any{}},
+		},
+	}
+
+	expected := `extension radius
+
+resource app 'Applications.Core/applications@2023-10-01-preview' = {
+  name: 'app'
+  properties: {}
+}
+`
+	require.Equal(t, expected, writeBicep(application))
+
+	parameters, err := writeParameters(application)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentParameters.json#", "contentVersion": "1.0.0.0", "parameters": {}}`, string(parameters))
+}
@@ -0,0 +1,420 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// applicationsResourceType is the resource type of Radius applications.
+	applicationsResourceType = "Applications.Core/applications"
+
+	// defaultAPIVersion is the API version used for resource types that don't declare one.
+	defaultAPIVersion = "2023-10-01-preview"
+
+	// environmentParameter is the name of the template parameter for the environment ID.
+	environmentParameter = "environment"
+)
+
+var (
+	// computedProperties are the properties computed by Radius for every resource type.
+	computedProperties = []string{"provisioningState", "status"}
+
+	// bicepKeywords are the identifiers that can't be used as symbolic names.
+	bicepKeywords = map[string]bool{
+		"assert": true, "existing": true, "extension": true, "false": true, "for": true, "func": true, "if": true,
+		"import": true, "in": true, "metadata": true, "module": true, "null": true, "output": true, "param": true,
+		"resource": true, "targetScope": true, "true": true, "type": true, "using": true, "var": true,
+	}
+
+	symbolicNameSeparator = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+)
+
+// bicepExpression is a Bicep expression that is written to the template as-is, such as a reference to the ID of
+// another resource.
+type bicepExpression string
+
+// exportedApplication is an application and its resources, ready to be written as a Bicep template.
+type exportedApplication struct {
+	// Resources are the application followed by its resources, sorted by type and name.
+	Resources []exportedResource
+
+	// EnvironmentID is the ID of the environment of the application.
+	EnvironmentID string
+
+	// Warnings describe the parts of the application that could not be exported exactly.
+	Warnings []string
+}
+
+// exportedResource is a resource of the application, with its read-only properties removed and references to other
+// resources replaced with symbolic references.
+type exportedResource struct {
+	SymbolicName string
+	ID           string
+	Type         string
+	APIVersion   string
+	Name         string
+	Tags         map[string]string
+	Properties   map[string]any
+}
+
+// resourceTypeInfo is the API version and schema used to export the resources of a resource type.
+type resourceTypeInfo struct {
+	APIVersion string
+	Schema     map[string]any
+}
+
+// computeExport fetches the application and its resources and prepares them to be written as a Bicep template.
+func computeExport(ctx context.Context, client clients.ApplicationsManagementClient, applicationID string) (*exportedApplication, error) {
+	application, err := client.GetResource(ctx, applicationsResourceType, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	listed, err := client.ListResourcesInApplication(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationResources := []generated.GenericResource{}
+	for _, resource := range listed {
+		if !strings.EqualFold(stringValue(resource.ID), stringValue(application.ID)) {
+			applicationResources = append(applicationResources, resource)
+		}
+	}
+
+	sort.Slice(applicationResources, func(i, j int) bool {
+		left, right := strings.ToLower(stringValue(applicationResources[i].Type)), strings.ToLower(stringValue(applicationResources[j].Type))
+		if left != right {
+			return left < right
+		}
+		return strings.ToLower(stringValue(applicationResources[i].Name)) < strings.ToLower(stringValue(applicationResources[j].Name))
+	})
+
+	result := &exportedApplication{Warnings: []string{}}
+	if environmentID, ok := application.Properties["environment"].(string); ok {
+		result.EnvironmentID = environmentID
+	}
+
+	symbolicNames := map[string]bool{environmentParameter: true}
+	typeInfos := map[string]resourceTypeInfo{}
+	for _, resource := range append([]generated.GenericResource{application}, applicationResources...) {
+		resourceType := stringValue(resource.Type)
+		if resourceType == "" {
+			resourceType = applicationsResourceType
+		}
+
+		info, ok := typeInfos[strings.ToLower(resourceType)]
+		if !ok {
+			info, err = getResourceTypeInfo(ctx, client, resourceType)
+			if err != nil {
+				return nil, err
+			}
+			typeInfos[strings.ToLower(resourceType)] = info
+		}
+
+		name := stringValue(resource.Name)
+		if name == "" {
+			name = resourceName(stringValue(resource.ID))
+		}
+
+		exported := exportedResource{
+			SymbolicName: uniqueSymbolicName(name, symbolicNames),
+			ID:           stringValue(resource.ID),
+			Type:         resourceType,
+			APIVersion:   info.APIVersion,
+			Name:         name,
+			Properties:   removeReadOnlyProperties(resource.Properties, info.Schema),
+		}
+
+		for key, value := range resource.Tags {
+			if exported.Tags == nil {
+				exported.Tags = map[string]string{}
+			}
+			exported.Tags[key] = stringValue(value)
+		}
+
+		result.Resources = append(result.Resources, exported)
+	}
+
+	result.Warnings = append(result.Warnings, replaceReferences(result)...)
+	return result, nil
+}
+
+// getResourceTypeInfo returns the API version and schema of a resource type. The built-in resource types don't
+// register schemas, so their schemas are read from their OpenAPI specifications. Resource providers that are not
+// registered are exported with the default API version.
+func getResourceTypeInfo(ctx context.Context, client clients.ApplicationsManagementClient, resourceType string) (resourceTypeInfo, error) {
+	info, err := getRegisteredResourceTypeInfo(ctx, client, resourceType)
+	if err != nil || info.Schema != nil {
+		return info, err
+	}
+
+	info.Schema, err = getBuiltInSchema(resourceType, info.APIVersion)
+	if err != nil {
+		return resourceTypeInfo{}, err
+	}
+
+	return info, nil
+}
+
+// getRegisteredResourceTypeInfo returns the API version and schema of a resource type registered with UCP.
+func getRegisteredResourceTypeInfo(ctx context.Context, client clients.ApplicationsManagementClient, resourceType string) (resourceTypeInfo, error) {
+	info := resourceTypeInfo{APIVersion: defaultAPIVersion}
+
+	namespace, typeName, ok := strings.Cut(resourceType, "/")
+	if !ok {
+		return info, nil
+	}
+
+	summary, err := client.GetResourceProviderSummary(ctx, "local", namespace)
+	if clients.Is404Error(err) {
+		return info, nil
+	} else if err != nil {
+		return resourceTypeInfo{}, err
+	}
+
+	var summaryType *v20231001preview.ResourceProviderSummaryResourceType
+	for name, candidate := range summary.ResourceTypes {
+		if strings.EqualFold(name, typeName) {
+			summaryType = candidate
+			break
+		}
+	}
+	if summaryType == nil {
+		return info, nil
+	}
+
+	apiVersions := make([]string, 0, len(summaryType.APIVersions))
+	for apiVersion := range summaryType.APIVersions {
+		apiVersions = append(apiVersions, apiVersion)
+	}
+	sort.Strings(apiVersions)
+
+	if summaryType.DefaultAPIVersion != nil && *summaryType.DefaultAPIVersion != "" {
+		info.APIVersion = *summaryType.DefaultAPIVersion
+	} else if len(apiVersions) > 0 {
+		info.APIVersion = apiVersions[len(apiVersions)-1]
+	}
+
+	if apiVersion, ok := summaryType.APIVersions[info.APIVersion]; ok && apiVersion != nil {
+		info.Schema = apiVersion.Schema
+	}
+
+	return info, nil
+}
+
+// removeReadOnlyProperties returns a copy of the properties of a resource without the properties that are computed
+// by Radius or marked as read-only by the schema of the resource type.
+func removeReadOnlyProperties(properties map[string]any, schema map[string]any) map[string]any {
+	result, _ := removeReadOnly(properties, schema).(map[string]any)
+	if result == nil {
+		result = map[string]any{}
+	}
+
+	for _, property := range computedProperties {
+		delete(result, property)
+	}
+
+	return result
+}
+
+// removeReadOnly copies a value, removing the nested properties marked as read-only by the schema.
+func removeReadOnly(value any, schema map[string]any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		properties, _ := schema["properties"].(map[string]any)
+		additionalProperties, _ := schema["additionalProperties"].(map[string]any)
+
+		result := map[string]any{}
+		for key, item := range v {
+			propertySchema, ok := properties[key].(map[string]any)
+			if !ok {
+				propertySchema = additionalProperties
+			}
+
+			if readOnly, _ := propertySchema["readOnly"].(bool); readOnly {
+				continue
+			}
+
+			result[key] = removeReadOnly(item, propertySchema)
+		}
+		return result
+	case []any:
+		items, _ := schema["items"].(map[string]any)
+
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = removeReadOnly(item, items)
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// replaceReferences replaces the IDs of the environment and of the exported resources with references to the
+// environment parameter and to the symbolic names of the resources. References that would make resources depend on
+// each other in a cycle are kept as IDs, and a warning is returned for each of them.
+func replaceReferences(application *exportedApplication) []string {
+	symbolicNames := map[string]string{}
+	for _, resource := range application.Resources {
+		symbolicNames[strings.ToLower(resource.ID)] = resource.SymbolicName
+	}
+
+	// dependencies tracks the symbolic references added so far, to detect cycles.
+	dependencies := map[string]map[string]bool{}
+	warnings := []string{}
+
+	var replace func(resource string, value any) any
+	replace = func(resource string, value any) any {
+		switch v := value.(type) {
+		case string:
+			if application.EnvironmentID != "" && strings.EqualFold(v, application.EnvironmentID) {
+				return bicepExpression(environmentParameter)
+			}
+
+			target, ok := symbolicNames[strings.ToLower(v)]
+			if !ok || target == resource {
+				return v
+			}
+
+			if dependsOn(dependencies, target, resource) {
+				warnings = append(warnings, fmt.Sprintf("Resource %q references %q, which references it back. The reference is exported as a resource ID.", resource, target))
+				return v
+			}
+
+			if dependencies[resource] == nil {
+				dependencies[resource] = map[string]bool{}
+			}
+			dependencies[resource][target] = true
+			return bicepExpression(target + ".id")
+		case map[string]any:
+			for key, item := range v {
+				v[key] = replace(resource, item)
+			}
+			return v
+		case []any:
+			for i, item := range v {
+				v[i] = replace(resource, item)
+			}
+			return v
+		default:
+			return value
+		}
+	}
+
+	for _, resource := range application.Resources {
+		replace(resource.SymbolicName, resource.Properties)
+	}
+
+	return warnings
+}
+
+// dependsOn returns true if the source resource depends on the target resource, directly or indirectly.
+func dependsOn(dependencies map[string]map[string]bool, source string, target string) bool {
+	visited := map[string]bool{}
+	pending := []string{source}
+	for len(pending) > 0 {
+		current := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if current == target {
+			return true
+		}
+
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		for dependency := range dependencies[current] {
+			pending = append(pending, dependency)
+		}
+	}
+
+	return false
+}
+
+// uniqueSymbolicName converts a resource name to a valid Bicep symbolic name in camel case, such as 'myApp' for
+// 'my-app', that is not used by another resource.
+func uniqueSymbolicName(name string, used map[string]bool) string {
+	words := symbolicNameSeparator.Split(name, -1)
+
+	symbolicName := ""
+	for _, word := range words {
+		if word == "" {
+			continue
+		}
+
+		if symbolicName == "" {
+			symbolicName = strings.ToLower(word[:1]) + word[1:]
+		} else {
+			symbolicName += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	if symbolicName == "" {
+		symbolicName = "exportedResource"
+	}
+
+	if unicode.IsDigit(rune(symbolicName[0])) || bicepKeywords[symbolicName] {
+		symbolicName = "r" + strings.ToUpper(symbolicName[:1]) + symbolicName[1:]
+	}
+
+	candidate := symbolicName
+	for i := 2; used[strings.ToLower(candidate)]; i++ {
+		candidate = fmt.Sprintf("%s%d", symbolicName, i)
+	}
+	used[strings.ToLower(candidate)] = true
+
+	return candidate
+}
+
+// isBuiltInResourceType returns true if the resource type is part of the Radius Bicep extension.
+func isBuiltInResourceType(resourceType string) bool {
+	namespace, _, _ := strings.Cut(strings.ToLower(resourceType), "/")
+	return strings.HasPrefix(namespace, "applications.")
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+// resourceName returns the name of the resource from its ID, for resources returned without a name.
+func resourceName(id string) string {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.Name()
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testScope         = "/planes/radius/local/resourceGroups/test-group"
+	testEnvironmentID = testScope + "/providers/Applications.Core/environments/test-env"
+	testApplicationID = testScope + "/providers/Applications.Core/applications/test-app"
+	testFrontendID    = testScope + "/providers/Applications.Core/containers/frontend"
+	testBackendID     = testScope + "/providers/Applications.Core/containers/backend"
+	testGatewayID     = testScope + "/providers/Applications.Core/gateways/gateway"
+)
+
+// configureTestApplication configures the client to return an application with two containers and a gateway.
+func configureTestApplication(client *clients.MockApplicationsManagementClient) {
+	client.EXPECT().
+		GetResource(gomock.Any(), applicationsResourceType, "test-app").
+		Return(generated.GenericResource{
+			ID:   to.Ptr(testApplicationID),
+			Name: to.Ptr("test-app"),
+			Type: to.Ptr(applicationsResourceType),
+			Properties: map[string]any{
+				"environment":       testEnvironmentID,
+				"provisioningState": "Succeeded",
+				"status":            map[string]any{"compute": map[string]any{"kind": "kubernetes"}},
+			},
+		}, nil).
+		Times(1)
+	client.EXPECT().
+		ListResourcesInApplication(gomock.Any(), "test-app").
+		Return([]generated.GenericResource{
+			{
+				ID:   to.Ptr(testGatewayID),
+				Name: to.Ptr("gateway"),
+				Type: to.Ptr("Applications.Core/gateways"),
+				Properties: map[string]any{
+					"application":       testApplicationID,
+					"provisioningState": "Succeeded",
+					"url":               "http://gateway.example.com",
+					"routes":            []any{map[string]any{"path": "/", "destination": "http://frontend:80"}},
+				},
+			},
+			{
+				ID:   to.Ptr(testFrontendID),
+				Name: to.Ptr("frontend"),
+				Type: to.Ptr("Applications.Core/containers"),
+				Tags: map[string]*string{"team": to.Ptr("web")},
+				Properties: map[string]any{
+					"application": testApplicationID,
+					"container": map[string]any{
+						"image": "nginx:1.25",
+						"env":   map[string]any{"MESSAGE": "it's ${working}"},
+						"ports": map[string]any{"web": map[string]any{"containerPort": float64(80)}},
+					},
+					"connections": map[string]any{
+						"backend": map[string]any{"source": testBackendID},
+					},
+					"status": map[string]any{"outputResources": []any{}},
+				},
+			},
+			{
+				ID:   to.Ptr(testBackendID),
+				Name: to.Ptr("backend"),
+				Type: to.Ptr("Applications.Core/containers"),
+				Properties: map[string]any{
+					"application": testApplicationID,
+					"container":   map[string]any{"image": "redis:7"},
+				},
+			},
+		}, nil).
+		Times(1)
+	client.EXPECT().
+		GetResourceProviderSummary(gomock.Any(), "local", "Applications.Core").
+		Return(v20231001preview.ResourceProviderSummary{
+			Name: to.Ptr("Applications.Core"),
+			ResourceTypes: map[string]*v20231001preview.ResourceProviderSummaryResourceType{
+				"applications": {APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{"2023-10-01-preview": {}}},
+				"containers":   {APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{"2023-10-01-preview": {}}},
+				"gateways":     {APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{"2023-10-01-preview": {}}},
+			},
+		}, nil).
+		Times(3)
+}
+
+func Test_computeExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockApplicationsManagementClient(ctrl)
+	configureTestApplication(client)
+
+	application, err := computeExport(context.Background(), client, "test-app")
+	require.NoError(t, err)
+
+	require.Equal(t, testEnvironmentID, application.EnvironmentID)
+	require.Empty(t, application.Warnings)
+
+	symbolicNames := []string{}
+	for _, resource := range application.Resources {
+		symbolicNames = append(symbolicNames, resource.SymbolicName)
+	}
+	require.Equal(t, []string{"testApp", "backend", "frontend", "gateway"}, symbolicNames)
+
+	require.Equal(t, map[string]any{"environment": bicepExpression("environment")}, application.Resources[0].Properties)
+	require.Equal(t, map[string]any{
+		"application": bicepExpression("testApp.id"),
+		"routes":      []any{map[string]any{"path": "/", "destination": "http://frontend:80"}},
+	}, application.Resources[3].Properties)
+}
+
+func Test_getResourceTypeInfo(t *testing.T) {
+	schema := map[string]any{"type": "object"}
+
+	t.Run("default API version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Test.Resources").
+			Return(v20231001preview.ResourceProviderSummary{
+				ResourceTypes: map[string]*v20231001preview.ResourceProviderSummaryResourceType{
+					"testResources": {
+						DefaultAPIVersion: to.Ptr("2024-01-01"),
+						APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{
+							"2024-01-01": {Schema: schema},
+							"2025-01-01": {},
+						},
+					},
+				},
+			}, nil).
+			Times(1)
+
+		info, err := getResourceTypeInfo(context.Background(), client, "Test.Resources/testResources")
+		require.NoError(t, err)
+		require.Equal(t, resourceTypeInfo{APIVersion: "2024-01-01", Schema: schema}, info)
+	})
+
+	t.Run("latest API version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Test.Resources").
+			Return(v20231001preview.ResourceProviderSummary{
+				ResourceTypes: map[string]*v20231001preview.ResourceProviderSummaryResourceType{
+					"testResources": {
+						APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{
+							"2024-01-01": {},
+							"2025-01-01": {Schema: schema},
+						},
+					},
+				},
+			}, nil).
+			Times(1)
+
+		info, err := getResourceTypeInfo(context.Background(), client, "Test.Resources/TESTRESOURCES")
+		require.NoError(t, err)
+		require.Equal(t, resourceTypeInfo{APIVersion: "2025-01-01", Schema: schema}, info)
+	})
+
+	t.Run("resource provider not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Test.Resources").
+			Return(v20231001preview.ResourceProviderSummary{}, radcli.Create404Error()).
+			Times(1)
+
+		info, err := getResourceTypeInfo(context.Background(), client, "Test.Resources/testResources")
+		require.NoError(t, err)
+		require.Equal(t, resourceTypeInfo{APIVersion: defaultAPIVersion}, info)
+	})
+
+	t.Run("built-in resource type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Applications.Dapr").
+			Return(v20231001preview.ResourceProviderSummary{}, radcli.Create404Error()).
+			Times(1)
+
+		info, err := getResourceTypeInfo(context.Background(), client, "Applications.Dapr/stateStores")
+		require.NoError(t, err)
+		require.Equal(t, defaultAPIVersion, info.APIVersion)
+
+		actual := removeReadOnlyProperties(map[string]any{"componentName": "statestore", "type": "state.redis"}, info.Schema)
+		require.Equal(t, map[string]any{"type": "state.redis"}, actual)
+	})
+}
+
+func Test_removeReadOnlyProperties(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"host":     map[string]any{"type": "string", "readOnly": true},
+			"database": map[string]any{"type": "string"},
+			"credentials": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"username": map[string]any{"type": "string"},
+					"password": map[string]any{"type": "string", "readOnly": true},
+				},
+			},
+			"replicas": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"endpoint": map[string]any{"type": "string", "readOnly": true},
+					},
+				},
+			},
+			"labels": map[string]any{
+				"type": "object",
+				"additionalProperties": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"generated": map[string]any{"type": "string", "readOnly": true},
+					},
+				},
+			},
+		},
+	}
+
+	properties := map[string]any{
+		"host":              "db.example.com",
+		"database":          "orders",
+		"credentials":       map[string]any{"username": "admin", "password": "secret"},
+		"replicas":          []any{map[string]any{"region": "east", "endpoint": "east.example.com"}},
+		"labels":            map[string]any{"tier": map[string]any{"value": "data", "generated": "true"}},
+		"provisioningState": "Succeeded",
+		"status":            map[string]any{},
+	}
+
+	actual := removeReadOnlyProperties(properties, schema)
+	expected := map[string]any{
+		"database":    "orders",
+		"credentials": map[string]any{"username": "admin"},
+		"replicas":    []any{map[string]any{"region": "east"}},
+		"labels":      map[string]any{"tier": map[string]any{"value": "data"}},
+	}
+	require.Equal(t, expected, actual)
+
+	// The properties of the resource are not modified.
+	require.Equal(t, "db.example.com", properties["host"])
+
+	t.Run("built-in resource type", func(t *testing.T) {
+		properties := map[string]any{
+			"url": "http://gateway.example.com",
+			"tls": map[string]any{"hostname": "example.com", "certificateStatus": map[string]any{"state": "Ready"}},
+		}
+
+		schema, err := getBuiltInSchema("Applications.Core/Gateways", "2023-10-01-preview")
+		require.NoError(t, err)
+
+		actual := removeReadOnlyProperties(properties, schema)
+		require.Equal(t, map[string]any{"tls": map[string]any{"hostname": "example.com"}}, actual)
+	})
+}
+
+func Test_replaceReferences_Cycle(t *testing.T) {
+	application := &exportedApplication{
+		Resources: []exportedResource{
+			{SymbolicName: "frontend", ID: testFrontendID, Properties: map[string]any{"connections": map[string]any{"backend": map[string]any{"source": testBackendID}}}},
+			{SymbolicName: "backend", ID: testBackendID, Properties: map[string]any{"connections": map[string]any{"frontend": map[string]any{"source": testFrontendID}}}},
+		},
+	}
+
+	warnings := replaceReferences(application)
+	require.Equal(t, []string{"Resource \"backend\" references \"frontend\", which references it back. The reference is exported as a resource ID."}, warnings)
+	require.Equal(t, bicepExpression("backend.id"), application.Resources[0].Properties["connections"].(map[string]any)["backend"].(map[string]any)["source"])
+	require.Equal(t, testFrontendID, application.Resources[1].Properties["connections"].(map[string]any)["frontend"].(map[string]any)["source"])
+}
+
+func Test_uniqueSymbolicName(t *testing.T) {
+	used := map[string]bool{environmentParameter: true}
+
+	require.Equal(t, "myApp", uniqueSymbolicName("my-app", used))
+	require.Equal(t, "myApp2", uniqueSymbolicName("my_app", used))
+	require.Equal(t, "environment2", uniqueSymbolicName("environment", used))
+	require.Equal(t, "r1stService", uniqueSymbolicName("1st-service", used))
+	require.Equal(t, "rResource", uniqueSymbolicName("resource", used))
+	require.Equal(t, "exportedResource", uniqueSymbolicName("---", used))
+	require.Equal(t, "redisCache", uniqueSymbolicName("Redis.Cache", used))
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// templateFileName is the name of the exported Bicep template.
+	templateFileName = "app.bicep"
+
+	// parametersFileName is the name of the exported parameters file.
+	parametersFileName = "app.parameters.json"
+)
+
+// NewCommand creates an instance of the `rad app export` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a Radius Application to Bicep",
+		Long: `Export a Radius Application to Bicep. Exports the user's default application (if configured) by default.
+
+The export command writes the application and its resources to a Bicep template named 'app.bicep', and the environment
+of the application to a parameters file named 'app.parameters.json'. The template can be deployed with:
+
+	rad deploy app.bicep --parameters @app.parameters.json
+
+Properties computed by Radius, such as the status of the resources, and properties marked as read-only by the schema of
+their resource type are not exported. References between the resources of the application are written as symbolic
+references, unless the resources reference each other.
+
+Values that Radius does not return, such as the values of secrets, are not exported and must be added to the template.
+`,
+		Args: cobra.MaximumNArgs(1),
+		Example: `
+# Export the current application to the current directory
+rad app export
+
+# Export the specified application to a directory
+rad app export my-app --destination-dir ./my-app
+
+# Export the specified application and overwrite existing files
+rad app export my-app --overwrite
+`,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	cmd.Flags().StringP("destination-dir", "d", ".", "The directory where the exported files are written.")
+	_ = cmd.MarkFlagDirname("destination-dir")
+	cmd.Flags().Bool("overwrite", false, "Overwrite the exported files if they already exist.")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad app export` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	FileSystem        filesystem.FileSystem
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	DestinationDir  string
+	Overwrite       bool
+}
+
+// NewRunner creates an instance of the runner for the `rad app export` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad app export` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplicationArgs(cmd, args, *workspace)
+	if err != nil {
+		return err
+	}
+
+	r.DestinationDir, err = cmd.Flags().GetString("destination-dir")
+	if err != nil {
+		return err
+	}
+
+	r.Overwrite, err = cmd.Flags().GetBool("overwrite")
+	if err != nil {
+		return err
+	}
+
+	if r.FileSystem == nil {
+		r.FileSystem = filesystem.NewOSFS()
+	}
+
+	if !r.Overwrite {
+		for _, fileName := range []string{templateFileName, parametersFileName} {
+			filePath := filepath.Join(r.DestinationDir, fileName)
+			if r.FileSystem.Exists(filePath) {
+				return clierrors.Message("The file %q already exists. Specify '--overwrite' to overwrite it.", filePath)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Run runs the `rad app export` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	application, err := computeExport(ctx, client, r.ApplicationName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The application %q was not found or has been deleted.", r.ApplicationName)
+	} else if err != nil {
+		return err
+	}
+
+	parameters, err := writeParameters(application)
+	if err != nil {
+		return err
+	}
+
+	err = r.FileSystem.MkdirAll(r.DestinationDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	templateFilePath := filepath.Join(r.DestinationDir, templateFileName)
+	err = r.FileSystem.WriteFile(templateFilePath, []byte(writeBicep(application)), 0644)
+	if err != nil {
+		return err
+	}
+
+	parametersFilePath := filepath.Join(r.DestinationDir, parametersFileName)
+	err = r.FileSystem.WriteFile(parametersFilePath, parameters, 0644)
+	if err != nil {
+		return err
+	}
+
+	reported := map[string]bool{}
+	for _, resource := range application.Resources {
+		if !isBuiltInResourceType(resource.Type) && !reported[strings.ToLower(resource.Type)] {
+			reported[strings.ToLower(resource.Type)] = true
+			r.Output.LogInfo("Resource type %q is not part of the Radius Bicep extension. Add the Bicep extension of its resource provider to %s.", resource.Type, templateFilePath)
+		}
+	}
+
+	for _, warning := range application.Warnings {
+		r.Output.LogInfo("%s", warning)
+	}
+
+	r.Output.LogInfo("Exported application %q with %d resources to %s and %s.", r.ApplicationName, len(application.Resources)-1, templateFilePath, parametersFilePath)
+
+	return nil
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	existingDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(existingDir, templateFileName), []byte{}, 0644)
+	require.NoError(t, err)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Export command with application",
+			Input:         []string{"test-app"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "test-app", runner.ApplicationName)
+				require.Equal(t, ".", runner.DestinationDir)
+				require.False(t, runner.Overwrite)
+			},
+		},
+		{
+			Name:          "Export command with destination directory",
+			Input:         []string{"-a", "test-app", "--destination-dir", t.TempDir()},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Export command with existing files",
+			Input:         []string{"test-app", "-d", existingDir},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Export command with existing files and overwrite",
+			Input:         []string{"test-app", "-d", existingDir, "--overwrite"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				require.True(t, r.(*Runner).Overwrite)
+			},
+		},
+		{
+			Name:          "Export command without application",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Export command with too many positional args",
+			Input:         []string{"test-app", "other-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		configureTestApplication(client)
+
+		fs := filesystem.NewMemMapFileSystem()
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			FileSystem:        fs,
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{Scope: testScope},
+			ApplicationName:   "test-app",
+			DestinationDir:    "out",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		template, err := fs.ReadFile(filepath.Join("out", templateFileName))
+		require.NoError(t, err)
+		require.Equal(t, expectedTemplate, string(template))
+
+		parameters, err := fs.ReadFile(filepath.Join("out", parametersFileName))
+		require.NoError(t, err)
+		require.JSONEq(t, expectedParameters, string(parameters))
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Exported application %q with %d resources to %s and %s.",
+				Params: []any{"test-app", 3, filepath.Join("out", templateFileName), filepath.Join("out", parametersFileName)},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Application not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResource(gomock.Any(), applicationsResourceType, "test-app").
+			Return(generated.GenericResource{}, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			FileSystem:        filesystem.NewMemMapFileSystem(),
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{Scope: testScope},
+			ApplicationName:   "test-app",
+			DestinationDir:    ".",
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The application %q was not found or has been deleted.", "test-app"), err)
+	})
+}
+
+func Test_Run_ExtensionResourceType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockApplicationsManagementClient(ctrl)
+
+	client.EXPECT().
+		GetResource(gomock.Any(), applicationsResourceType, "test-app").
+		Return(generated.GenericResource{
+			ID:         to.Ptr(testApplicationID),
+			Name:       to.Ptr("test-app"),
+			Type:       to.Ptr(applicationsResourceType),
+			Properties: map[string]any{"environment": testEnvironmentID},
+		}, nil).
+		Times(1)
+	client.EXPECT().
+		ListResourcesInApplication(gomock.Any(), "test-app").
+		Return([]generated.GenericResource{
+			{
+				ID:         to.Ptr(testScope + "/providers/Radius.Data/postgreSqlDatabases/db"),
+				Name:       to.Ptr("db"),
+				Type:       to.Ptr("Radius.Data/postgreSqlDatabases"),
+				Properties: map[string]any{"application": testApplicationID},
+			},
+		}, nil).
+		Times(1)
+	client.EXPECT().
+		GetResourceProviderSummary(gomock.Any(), "local", "Applications.Core").
+		Return(v20231001preview.ResourceProviderSummary{}, radcli.Create404Error()).
+		Times(1)
+	client.EXPECT().
+		GetResourceProviderSummary(gomock.Any(), "local", "Radius.Data").
+		Return(v20231001preview.ResourceProviderSummary{}, radcli.Create404Error()).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+		FileSystem:        filesystem.NewMemMapFileSystem(),
+		Output:            outputSink,
+		Workspace:         &workspaces.Workspace{Scope: testScope},
+		ApplicationName:   "test-app",
+		DestinationDir:    ".",
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, output.LogOutput{
+		Format: "Resource type %q is not part of the Radius Bicep extension. Add the Bicep extension of its resource provider to %s.",
+		Params: []any{"Radius.Data/postgreSqlDatabases", templateFileName},
+	}, outputSink.Writes[0])
+}
+
+const expectedTemplate = `extension radius
+
+@description('The ID of the Radius environment to deploy the application to.')
+param environment string
+
+resource testApp 'Applications.Core/applications@2023-10-01-preview' = {
+  name: 'test-app'
+  properties: {
+    environment: environment
+  }
+}
+
+resource backend 'Applications.Core/containers@2023-10-01-preview' = {
+  name: 'backend'
+  properties: {
+    application: testApp.id
+    container: {
+      image: 'redis:7'
+    }
+  }
+}
+
+resource frontend 'Applications.Core/containers@2023-10-01-preview' = {
+  name: 'frontend'
+  tags: {
+    team: 'web'
+  }
+  properties: {
+    application: testApp.id
+    connections: {
+      backend: {
+        source: backend.id
+      }
+    }
+    container: {
+      env: {
+        MESSAGE: 'it\'s \${working}'
+      }
+      image: 'nginx:1.25'
+      ports: {
+        web: {
+          containerPort: 80
+        }
+      }
+    }
+  }
+}
+
+resource gateway 'Applications.Core/gateways@2023-10-01-preview' = {
+  name: 'gateway'
+  properties: {
+    application: testApp.id
+    routes: [
+      {
+        destination: 'http://frontend:80'
+        path: '/'
+      }
+    ]
+  }
+}
+`
+
+const expectedParameters = `{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentParameters.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "environment": {
+      "value": "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+    }
+  }
+}`
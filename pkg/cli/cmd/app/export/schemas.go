@@ -0,0 +1,302 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/radius-project/radius/swagger"
+)
+
+var (
+	// builtInSchemas are the schemas of the properties of the built-in resource types, which don't register schemas.
+	// They are read from the OpenAPI specifications of the built-in resource providers. The keys are lowercase
+	// resource types and API versions separated by '@'.
+	builtInSchemas     map[string]map[string]any
+	builtInSchemasErr  error
+	builtInSchemasOnce sync.Once
+
+	// resourcePathPattern matches the path of a resource in an OpenAPI specification and captures its resource type.
+	resourcePathPattern = regexp.MustCompile(`/providers/([^/{}]+/[^/{}]+)/\{[^/{}]+\}$`)
+)
+
+// getBuiltInSchema returns the schema of the properties of a built-in resource type, or nil if the resource type
+// or the API version is not built-in.
+func getBuiltInSchema(resourceType string, apiVersion string) (map[string]any, error) {
+	builtInSchemasOnce.Do(func() {
+		builtInSchemas, builtInSchemasErr = loadBuiltInSchemas(swagger.SpecFiles)
+	})
+	if builtInSchemasErr != nil {
+		return nil, builtInSchemasErr
+	}
+
+	return builtInSchemas[builtInSchemaKey(resourceType, apiVersion)], nil
+}
+
+func builtInSchemaKey(resourceType string, apiVersion string) string {
+	return strings.ToLower(resourceType + "@" + apiVersion)
+}
+
+// loadBuiltInSchemas reads the schemas of the properties of the resource types declared by the OpenAPI
+// specifications. The schema of a resource type is the schema of the body of its PUT operation.
+func loadBuiltInSchemas(specFiles fs.FS) (map[string]map[string]any, error) {
+	loader := &openAPILoader{files: specFiles, documents: map[string]map[string]any{}}
+	schemas := map[string]map[string]any{}
+
+	err := fs.WalkDir(specFiles, ".", func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || path.Base(filePath) != "openapi.json" {
+			return nil
+		}
+
+		document, err := loader.load(filePath)
+		if err != nil {
+			return err
+		}
+
+		info, _ := document["info"].(map[string]any)
+		apiVersion, _ := info["version"].(string)
+		paths, _ := document["paths"].(map[string]any)
+		for resourcePath, item := range paths {
+			match := resourcePathPattern.FindStringSubmatch(resourcePath)
+			if match == nil {
+				continue
+			}
+
+			operations, _ := item.(map[string]any)
+			put, _ := operations["put"].(map[string]any)
+			parameters, _ := put["parameters"].([]any)
+			for _, parameter := range parameters {
+				parameter, _ := parameter.(map[string]any)
+				if parameter["in"] != "body" {
+					continue
+				}
+
+				body, _ := parameter["schema"].(map[string]any)
+				schema, err := loader.flatten(filePath, body, map[string]bool{})
+				if err != nil {
+					return err
+				}
+
+				resourceProperties, _ := schema["properties"].(map[string]any)
+				properties, _ := resourceProperties["properties"].(map[string]any)
+				if properties != nil {
+					schemas[builtInSchemaKey(match[1], apiVersion)] = properties
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schemas, nil
+}
+
+// openAPILoader reads OpenAPI specifications and resolves the references between them.
+type openAPILoader struct {
+	files     fs.FS
+	documents map[string]map[string]any
+}
+
+func (l *openAPILoader) load(filePath string) (map[string]any, error) {
+	if document, ok := l.documents[filePath]; ok {
+		return document, nil
+	}
+
+	data, err := fs.ReadFile(l.files, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	document := map[string]any{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI specification %s: %w", filePath, err)
+	}
+
+	l.documents[filePath] = document
+	return document, nil
+}
+
+// flatten returns a copy of a schema with its references resolved, and with the schemas it is composed of with
+// allOf and the schemas of its polymorphic subtypes merged into it. The copy only keeps the keywords used to find
+// read-only properties: properties, additionalProperties, items and readOnly.
+func (l *openAPILoader) flatten(filePath string, schema map[string]any, visiting map[string]bool) (map[string]any, error) {
+	result := map[string]any{}
+	if ref, ok := schema["$ref"].(string); ok {
+		referenced, err := l.flattenReference(filePath, ref, visiting)
+		if err != nil {
+			return nil, err
+		}
+		result = referenced
+	}
+
+	// Properties that reference a definition can be marked as read-only next to the reference.
+	if readOnly, _ := schema["readOnly"].(bool); readOnly {
+		result["readOnly"] = true
+	}
+
+	allOf, _ := schema["allOf"].([]any)
+	for _, item := range allOf {
+		item, _ := item.(map[string]any)
+		flattened, err := l.flatten(filePath, item, visiting)
+		if err != nil {
+			return nil, err
+		}
+		mergeSchema(result, flattened)
+	}
+
+	if properties, ok := schema["properties"].(map[string]any); ok {
+		flattenedProperties := map[string]any{}
+		for name, property := range properties {
+			property, _ := property.(map[string]any)
+			flattened, err := l.flatten(filePath, property, visiting)
+			if err != nil {
+				return nil, err
+			}
+			flattenedProperties[name] = flattened
+		}
+		mergeSchema(result, map[string]any{"properties": flattenedProperties})
+	}
+
+	for _, keyword := range []string{"additionalProperties", "items"} {
+		nested, ok := schema[keyword].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		flattened, err := l.flatten(filePath, nested, visiting)
+		if err != nil {
+			return nil, err
+		}
+		mergeSchema(result, map[string]any{keyword: flattened})
+	}
+
+	return result, nil
+}
+
+// flattenReference flattens the definition a reference points to. Recursive references are flattened to an empty
+// schema.
+func (l *openAPILoader) flattenReference(filePath string, ref string, visiting map[string]bool) (map[string]any, error) {
+	file, fragment, _ := strings.Cut(ref, "#")
+	if file != "" {
+		filePath = path.Join(path.Dir(filePath), file)
+	}
+
+	name, ok := strings.CutPrefix(fragment, "/definitions/")
+	if !ok {
+		return nil, fmt.Errorf("unsupported reference %q in OpenAPI specification %s", ref, filePath)
+	}
+
+	key := filePath + "#" + name
+	if visiting[key] {
+		return map[string]any{}, nil
+	}
+	visiting[key] = true
+	defer delete(visiting, key)
+
+	document, err := l.load(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions, _ := document["definitions"].(map[string]any)
+	definition, ok := definitions[name].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("definition %q not found in OpenAPI specification %s", name, filePath)
+	}
+
+	result, err := l.flatten(filePath, definition, visiting)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := definition["discriminator"]; !ok {
+		return result, nil
+	}
+
+	// The properties of polymorphic types are declared by their subtypes, which reference the base type with allOf.
+	for _, subtype := range definitions {
+		subtype, _ := subtype.(map[string]any)
+		if !referencesDefinition(subtype, "#"+fragment) {
+			continue
+		}
+
+		flattened, err := l.flatten(filePath, subtype, visiting)
+		if err != nil {
+			return nil, err
+		}
+		mergeSchema(result, flattened)
+	}
+
+	return result, nil
+}
+
+// referencesDefinition returns true if a schema is composed of the definition with the given local reference.
+func referencesDefinition(schema map[string]any, ref string) bool {
+	allOf, _ := schema["allOf"].([]any)
+	for _, item := range allOf {
+		if item, ok := item.(map[string]any); ok && item["$ref"] == ref {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergeSchema merges a flattened schema into another. A property is read-only if it is read-only in either schema.
+func mergeSchema(destination map[string]any, source map[string]any) {
+	for keyword, value := range source {
+		switch keyword {
+		case "readOnly":
+			destination[keyword] = true
+		case "properties":
+			properties, ok := destination[keyword].(map[string]any)
+			if !ok {
+				properties = map[string]any{}
+				destination[keyword] = properties
+			}
+
+			for name, property := range value.(map[string]any) {
+				existing, ok := properties[name].(map[string]any)
+				if !ok {
+					properties[name] = property
+					continue
+				}
+				mergeSchema(existing, property.(map[string]any))
+			}
+		default:
+			existing, ok := destination[keyword].(map[string]any)
+			if !ok {
+				destination[keyword] = value
+				continue
+			}
+			mergeSchema(existing, value.(map[string]any))
+		}
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_loadBuiltInSchemas(t *testing.T) {
+	files := fstest.MapFS{
+		"specification/common/types.json": &fstest.MapFile{Data: []byte(`{
+			"definitions": {
+				"TrackedResource": {
+					"properties": {
+						"id": {"type": "string", "readOnly": true}
+					}
+				}
+			}
+		}`)},
+		"specification/test/Test.Resource/2024-01-01/openapi.json": &fstest.MapFile{Data: []byte(`{
+			"info": {"version": "2024-01-01"},
+			"paths": {
+				"/{rootScope}/providers/Test.Resource/widgets": {
+					"get": {}
+				},
+				"/{rootScope}/providers/Test.Resource/widgets/{widgetName}": {
+					"put": {
+						"parameters": [
+							{"name": "widgetName", "in": "path"},
+							{"name": "resource", "in": "body", "schema": {"$ref": "#/definitions/WidgetResource"}}
+						]
+					}
+				}
+			},
+			"definitions": {
+				"WidgetResource": {
+					"allOf": [{"$ref": "../../../common/types.json#/definitions/TrackedResource"}],
+					"properties": {
+						"properties": {"$ref": "#/definitions/WidgetProperties"}
+					}
+				},
+				"WidgetProperties": {
+					"allOf": [{"$ref": "#/definitions/BaseProperties"}],
+					"properties": {
+						"size": {"type": "integer"},
+						"parts": {"type": "array", "items": {"$ref": "#/definitions/Part"}},
+						"children": {"type": "object", "additionalProperties": {"$ref": "#/definitions/WidgetProperties"}}
+					}
+				},
+				"BaseProperties": {
+					"properties": {
+						"url": {"type": "string", "readOnly": true}
+					}
+				},
+				"Part": {
+					"discriminator": "kind",
+					"properties": {
+						"kind": {"type": "string"}
+					}
+				},
+				"BoltPart": {
+					"allOf": [{"$ref": "#/definitions/Part"}],
+					"properties": {
+						"serial": {"type": "string", "readOnly": true}
+					}
+				}
+			}
+		}`)},
+	}
+
+	schemas, err := loadBuiltInSchemas(files)
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+
+	schema := schemas["test.resource/widgets@2024-01-01"]
+	require.NotNil(t, schema)
+
+	properties := map[string]any{
+		"url":      "http://widget.example.com",
+		"size":     float64(3),
+		"parts":    []any{map[string]any{"kind": "bolt", "serial": "1234"}},
+		"children": map[string]any{"child": map[string]any{"size": float64(1), "url": "http://child.example.com"}},
+	}
+	expected := map[string]any{
+		"size":     float64(3),
+		"parts":    []any{map[string]any{"kind": "bolt"}},
+		"children": map[string]any{"child": map[string]any{"size": float64(1), "url": "http://child.example.com"}},
+	}
+	require.Equal(t, expected, removeReadOnlyProperties(properties, schema))
+}
+
+func Test_loadBuiltInSchemas_InvalidReference(t *testing.T) {
+	files := fstest.MapFS{
+		"specification/test/Test.Resource/2024-01-01/openapi.json": &fstest.MapFile{Data: []byte(`{
+			"info": {"version": "2024-01-01"},
+			"paths": {
+				"/{rootScope}/providers/Test.Resource/widgets/{widgetName}": {
+					"put": {
+						"parameters": [
+							{"name": "resource", "in": "body", "schema": {"$ref": "#/definitions/Missing"}}
+						]
+					}
+				}
+			},
+			"definitions": {}
+		}`)},
+	}
+
+	_, err := loadBuiltInSchemas(files)
+	require.ErrorContains(t, err, `definition "Missing" not found`)
+}
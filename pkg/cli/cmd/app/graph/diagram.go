@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// diagram is the set of nodes and edges of the application graph, shared by the diagram formats.
+type diagram struct {
+	nodes []diagramNode
+	edges []diagramEdge
+}
+
+// diagramNode is a resource of the application graph. Output resources are the resources deployed for a Radius
+// resource, such as a Kubernetes Deployment.
+type diagramNode struct {
+	id           string
+	name         string
+	resourceType string
+	output       bool
+}
+
+// diagramEdge is a connection from one resource to another, or from a resource to one of its output resources.
+type diagramEdge struct {
+	from   int
+	to     int
+	output bool
+}
+
+// buildDiagram builds the diagram of the application graph. Connections are reported by both of the connected
+// resources, so an edge is added once for each pair of resources and always points in the direction of the
+// connection.
+func buildDiagram(applicationResources []*v20231001preview.ApplicationGraphResource) *diagram {
+	sortResources(applicationResources)
+
+	d := &diagram{}
+	nodes := map[string]int{}
+	edges := map[diagramEdge]bool{}
+
+	addNode := func(node diagramNode) int {
+		key := strings.ToLower(node.id)
+		if index, ok := nodes[key]; ok {
+			return index
+		}
+
+		nodes[key] = len(d.nodes)
+		d.nodes = append(d.nodes, node)
+		return nodes[key]
+	}
+
+	addEdge := func(edge diagramEdge) {
+		if edge.from == edge.to || edges[edge] {
+			return
+		}
+
+		edges[edge] = true
+		d.edges = append(d.edges, edge)
+	}
+
+	// Add the resources of the application first so that they are listed before any resource they connect to.
+	for _, resource := range applicationResources {
+		addNode(diagramNode{id: *resource.ID, name: *resource.Name, resourceType: *resource.Type})
+	}
+
+	for _, resource := range applicationResources {
+		index := nodes[strings.ToLower(*resource.ID)]
+
+		for _, connection := range resource.Connections {
+			connectionID, err := resources.Parse(*connection.ID)
+			if err != nil {
+				continue
+			}
+
+			other := addNode(diagramNode{id: *connection.ID, name: connectionID.Name(), resourceType: connectionID.Type()})
+			if *connection.Direction == v20231001preview.DirectionOutbound {
+				addEdge(diagramEdge{from: index, to: other})
+			} else {
+				addEdge(diagramEdge{from: other, to: index})
+			}
+		}
+
+		for _, outputResource := range resource.OutputResources {
+			other := addNode(diagramNode{id: *outputResource.ID, name: *outputResource.Name, resourceType: *outputResource.Type, output: true})
+			addEdge(diagramEdge{from: index, to: other, output: true})
+		}
+	}
+
+	return d
+}
+
+// displayDot builds the formatted output for the application graph as a Graphviz DOT diagram.
+func displayDot(applicationResources []*v20231001preview.ApplicationGraphResource, applicationName string) string {
+	d := buildDiagram(applicationResources)
+
+	output := &strings.Builder{}
+	output.WriteString(fmt.Sprintf("digraph %s {\n", quoteDot(applicationName)))
+	output.WriteString("  rankdir=LR;\n")
+	output.WriteString("  node [shape=box, style=rounded];\n")
+
+	for _, node := range d.nodes {
+		label := quoteDot(node.name + "\n" + node.resourceType)
+		if node.output {
+			output.WriteString(fmt.Sprintf("  %s [label=%s, style=dashed];\n", quoteDot(node.id), label))
+		} else {
+			output.WriteString(fmt.Sprintf("  %s [label=%s];\n", quoteDot(node.id), label))
+		}
+	}
+
+	for _, edge := range d.edges {
+		from, to := quoteDot(d.nodes[edge.from].id), quoteDot(d.nodes[edge.to].id)
+		if edge.output {
+			output.WriteString(fmt.Sprintf("  %s -> %s [style=dashed, arrowhead=none];\n", from, to))
+		} else {
+			output.WriteString(fmt.Sprintf("  %s -> %s;\n", from, to))
+		}
+	}
+
+	output.WriteString("}\n")
+	return output.String()
+}
+
+// displayMermaid builds the formatted output for the application graph as a Mermaid flowchart.
+func displayMermaid(applicationResources []*v20231001preview.ApplicationGraphResource, applicationName string) string {
+	d := buildDiagram(applicationResources)
+
+	output := &strings.Builder{}
+	output.WriteString("---\n")
+	output.WriteString(fmt.Sprintf("title: %s\n", applicationName))
+	output.WriteString("---\n")
+	output.WriteString("flowchart LR\n")
+
+	// Mermaid node IDs can't contain the characters of a resource ID, so nodes are identified by their position.
+	for i, node := range d.nodes {
+		label := quoteMermaid(node.name) + "<br/>" + quoteMermaid(node.resourceType)
+		if node.output {
+			output.WriteString(fmt.Sprintf("  n%d[/\"%s\"/]\n", i, label))
+		} else {
+			output.WriteString(fmt.Sprintf("  n%d[\"%s\"]\n", i, label))
+		}
+	}
+
+	for _, edge := range d.edges {
+		if edge.output {
+			output.WriteString(fmt.Sprintf("  n%d -.- n%d\n", edge.from, edge.to))
+		} else {
+			output.WriteString(fmt.Sprintf("  n%d --> n%d\n", edge.from, edge.to))
+		}
+	}
+
+	return output.String()
+}
+
+// quoteDot quotes a string as a DOT identifier.
+func quoteDot(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// quoteMermaid escapes the characters of a string that have a meaning in Mermaid labels.
+func quoteMermaid(value string) string {
+	replacer := strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;")
+	return replacer.Replace(value)
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package graph
+
+import (
+	"testing"
+
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func makeDiagramTestGraph() []*corerpv20231001preview.ApplicationGraphResource {
+	return []*corerpv20231001preview.ApplicationGraphResource{
+		{
+			ID:                to.Ptr(redisResourceID),
+			Name:              to.Ptr(redisResourceName),
+			Type:              to.Ptr(redisResourceType),
+			ProvisioningState: to.Ptr(provisioningStateSuccess),
+			OutputResources: []*corerpv20231001preview.ApplicationGraphOutputResource{
+				{
+					ID:   to.Ptr(awsMemoryDBResourceID),
+					Type: to.Ptr("aws: AWS.MemoryDB/Cluster"),
+					Name: to.Ptr("redis-aqbjixghynqgg"),
+				},
+			},
+			Connections: []*corerpv20231001preview.ApplicationGraphConnection{
+				{
+					ID:        to.Ptr(containerResourceID),
+					Direction: &directionInbound,
+				},
+			},
+		},
+		{
+			ID:                to.Ptr(containerResourceID),
+			Name:              to.Ptr(containerResourceName),
+			Type:              to.Ptr(containerResourceType),
+			ProvisioningState: to.Ptr(provisioningStateSuccess),
+			OutputResources: []*corerpv20231001preview.ApplicationGraphOutputResource{
+				{
+					ID:   to.Ptr("/planes/kubernetes/local/namespaces/test-app/providers/apps/Deployment/webapp"),
+					Type: to.Ptr("kubernetes: apps/Deployment"),
+					Name: to.Ptr("webapp"),
+				},
+			},
+			Connections: []*corerpv20231001preview.ApplicationGraphConnection{
+				{
+					ID:        to.Ptr(redisResourceID),
+					Direction: &directionOutbound,
+				},
+				{
+					// Connections to resources outside of the application are included in the diagram.
+					ID:        to.Ptr(azureRedisCacheResourceID),
+					Direction: &directionOutbound,
+				},
+			},
+		},
+	}
+}
+
+func Test_displayDot(t *testing.T) {
+	t.Run("empty graph", func(t *testing.T) {
+		expected := `digraph "cool-app" {
+  rankdir=LR;
+  node [shape=box, style=rounded];
+}
+`
+		actual := displayDot([]*corerpv20231001preview.ApplicationGraphResource{}, "cool-app")
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("application with connections and output resources", func(t *testing.T) {
+		expected := `digraph "test-app" {
+  rankdir=LR;
+  node [shape=box, style=rounded];
+  "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/webapp" [label="webapp\nApplications.Core/containers"];
+  "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis" [label="redis\nApplications.Datastores/redisCaches"];
+  "/planes/azure/azure/subscriptions/00000000/resourceGroups/azure-group/providers/Microsoft.Cache/Redis/redis" [label="redis\nMicrosoft.Cache/Redis"];
+  "/planes/kubernetes/local/namespaces/test-app/providers/apps/Deployment/webapp" [label="webapp\nkubernetes: apps/Deployment", style=dashed];
+  "/planes/aws/aws/accounts/00000000/regions/us-west-2/providers/AWS.MemoryDB/Cluster/redis-aqbjixghynqgg" [label="redis-aqbjixghynqgg\naws: AWS.MemoryDB/Cluster", style=dashed];
+  "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/webapp" -> "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis";
+  "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/webapp" -> "/planes/azure/azure/subscriptions/00000000/resourceGroups/azure-group/providers/Microsoft.Cache/Redis/redis";
+  "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/webapp" -> "/planes/kubernetes/local/namespaces/test-app/providers/apps/Deployment/webapp" [style=dashed, arrowhead=none];
+  "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis" -> "/planes/aws/aws/accounts/00000000/regions/us-west-2/providers/AWS.MemoryDB/Cluster/redis-aqbjixghynqgg" [style=dashed, arrowhead=none];
+}
+`
+		actual := displayDot(makeDiagramTestGraph(), "test-app")
+		require.Equal(t, expected, actual)
+	})
+}
+
+func Test_displayMermaid(t *testing.T) {
+	t.Run("empty graph", func(t *testing.T) {
+		expected := `---
+title: cool-app
+---
+flowchart LR
+`
+		actual := displayMermaid([]*corerpv20231001preview.ApplicationGraphResource{}, "cool-app")
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("application with connections and output resources", func(t *testing.T) {
+		expected := `---
+title: test-app
+---
+flowchart LR
+  n0["webapp<br/>Applications.Core/containers"]
+  n1["redis<br/>Applications.Datastores/redisCaches"]
+  n2["redis<br/>Microsoft.Cache/Redis"]
+  n3[/"webapp<br/>kubernetes: apps/Deployment"/]
+  n4[/"redis-aqbjixghynqgg<br/>aws: AWS.MemoryDB/Cluster"/]
+  n0 --> n1
+  n0 --> n2
+  n0 -.- n3
+  n1 -.- n4
+`
+		actual := displayMermaid(makeDiagramTestGraph(), "test-app")
+		require.Equal(t, expected, actual)
+	})
+}
+
+func Test_quoteDot(t *testing.T) {
+	require.Equal(t, `"say \"hello\"\nC:\\app"`, quoteDot("say \"hello\"\nC:\\app"))
+}
+
+func Test_quoteMermaid(t *testing.T) {
+	require.Equal(t, "#quot;a#quot; #lt;b#gt;", quoteMermaid(`"a" <b>`))
+}
@@ -7,6 +7,9 @@ package graph
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -19,6 +22,19 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	// formatDot is the output format for a Graphviz DOT diagram of the application graph.
+	formatDot = "dot"
+
+	// formatMermaid is the output format for a Mermaid flowchart of the application graph.
+	formatMermaid = "mermaid"
+)
+
+// supportedFormats returns the output formats supported by the `rad app graph` command.
+func supportedFormats() []string {
+	return []string{output.FormatPlainText, output.FormatJson, formatDot, formatMermaid}
+}
+
 // NewCommand creates an instance of the command and runner for the `rad app graph` command.
 func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	runner := NewRunner(factory)
@@ -32,13 +48,23 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 rad app graph
 
 # Show graph for specified application
-rad app graph my-application`,
+rad app graph my-application
+
+# Show graph for specified application in JSON format
+rad app graph my-application --output json
+
+# Show graph for specified application as a Graphviz DOT diagram
+rad app graph my-application --output dot | dot -Tsvg -o my-application.svg
+
+# Show graph for specified application as a Mermaid flowchart
+rad app graph my-application --output mermaid`,
 		RunE: framework.RunCommand(runner),
 	}
 
 	commonflags.AddWorkspaceFlag(cmd)
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
+	cmd.Flags().StringP("output", "o", output.FormatPlainText, fmt.Sprintf("output format (supported formats are %s)", strings.Join(supportedFormats(), ", ")))
 
 	return cmd, runner
 }
@@ -50,6 +76,7 @@ type Runner struct {
 	Output            output.Interface
 
 	ApplicationName string
+	Format          string
 	Workspace       *workspaces.Workspace
 }
 
@@ -80,6 +107,16 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.Format = strings.ToLower(strings.TrimSpace(format))
+	if !slices.Contains(supportedFormats(), r.Format) {
+		return clierrors.Message("Unsupported output format %q. Supported formats are %s.", format, strings.Join(supportedFormats(), ", "))
+	}
+
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(cmd.Context(), *r.Workspace)
 	if err != nil {
 		return err
@@ -108,8 +145,18 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 	graph := applicationGraphResponse.Resources
-	display := display(graph, r.ApplicationName)
-	r.Output.LogInfo(display)
+
+	switch r.Format {
+	case output.FormatJson:
+		sortResources(graph)
+		return r.Output.WriteFormatted(output.FormatJson, graph, output.FormatterOptions{})
+	case formatDot:
+		r.Output.LogInfo(displayDot(graph, r.ApplicationName))
+	case formatMermaid:
+		r.Output.LogInfo(displayMermaid(graph, r.ApplicationName))
+	default:
+		r.Output.LogInfo(display(graph, r.ApplicationName))
+	}
 
 	return nil
 }
@@ -95,6 +95,35 @@ func Test_Validate(t *testing.T) {
 					Times(1)
 			},
 		},
+		{
+			Name:          "Graph command with diagram output format",
+			Input:         []string{"test-app", "--output", "mermaid"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.ApplicationManagementClient.EXPECT().
+					GetApplication(gomock.Any(), "test-app").
+					Return(application, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "test-app", runner.ApplicationName)
+				require.Equal(t, formatMermaid, runner.Format)
+			},
+		},
+		{
+			Name:          "Graph command with unsupported output format",
+			Input:         []string{"test-app", "--output", "table"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Graph command with incorrect args",
 			Input:         []string{"foo", "bar"},
@@ -208,3 +237,92 @@ Resources:
 
 	require.Equal(t, expected, outputSink.Writes)
 }
+
+func Test_Run_JSON(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graph := corerpv20231001preview.ApplicationGraphResponse{
+		Resources: []*corerpv20231001preview.ApplicationGraphResource{
+			{
+				ID:                to.Ptr(redisResourceID),
+				Name:              to.Ptr(redisResourceName),
+				Type:              to.Ptr(redisResourceType),
+				ProvisioningState: to.Ptr(provisioningStateSuccess),
+			},
+			{
+				ID:                to.Ptr(containerResourceID),
+				Name:              to.Ptr(containerResourceName),
+				Type:              to.Ptr(containerResourceType),
+				ProvisioningState: to.Ptr(provisioningStateSuccess),
+			},
+		},
+	}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		GetApplicationGraph(gomock.Any(), "test-app").
+		Return(graph, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+		Output:            outputSink,
+
+		// Populated by Validate()
+		ApplicationName: "test-app",
+		Format:          output.FormatJson,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	// Resources are sorted the same way as the text output, with containers first.
+	expected := []any{
+		output.FormattedOutput{
+			Format:  output.FormatJson,
+			Obj:     graph.Resources,
+			Options: output.FormatterOptions{},
+		},
+	}
+
+	require.Equal(t, expected, outputSink.Writes)
+	require.Equal(t, containerResourceName, *graph.Resources[0].Name)
+}
+
+func Test_Run_Dot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graph := corerpv20231001preview.ApplicationGraphResponse{Resources: makeDiagramTestGraph()}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		GetApplicationGraph(gomock.Any(), "test-app").
+		Return(graph, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+		Output:            outputSink,
+
+		// Populated by Validate()
+		ApplicationName: "test-app",
+		Format:          formatDot,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.LogOutput{
+			Format: displayDot(makeDiagramTestGraph(), "test-app"),
+		},
+	}
+
+	require.Equal(t, expected, outputSink.Writes)
+}
@@ -50,3 +50,53 @@ func gatewayFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// jobFormat returns a FormatterOptions object which contains a list of columns to be used for
+// formatting the output of a list of job statuses.
+func jobFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "JOB",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "KIND",
+				JSONPath: "{ .Kind }",
+			},
+			{
+				Heading:  "STATUS",
+				JSONPath: "{ .Status }",
+			},
+			{
+				Heading:  "LAST RUN",
+				JSONPath: "{ .LastRun }",
+			},
+		},
+	}
+}
+
+// watchResourceFormat returns a FormatterOptions object which contains a list of columns to be used for
+// formatting the status of the resources of an application in watch mode.
+func watchResourceFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "STATE",
+				JSONPath: "{ .State }",
+			},
+			{
+				Heading:  "REPLICAS",
+				JSONPath: "{ .Replicas }",
+			},
+		},
+	}
+}
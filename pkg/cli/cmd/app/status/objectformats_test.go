@@ -52,3 +52,19 @@ func Test_GetApplicationGatewaysTableFormat(t *testing.T) {
 	expected := "GATEWAY   ENDPOINT\ntest      test-endpoint\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_GetApplicationJobsTableFormat(t *testing.T) {
+	obj := clients.JobStatus{
+		Name:    "test",
+		Kind:    "CronJob",
+		Status:  clients.JobStatusSucceeded,
+		LastRun: "2024-01-01T00:00:00Z",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, jobFormat())
+	require.NoError(t, err)
+
+	expected := "JOB       KIND      STATUS     LAST RUN\ntest      CronJob   Succeeded  2024-01-01T00:00:00Z\n"
+	require.Equal(t, expected, buffer.String())
+}
@@ -18,6 +18,7 @@ package status
 
 import (
 	"context"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -38,8 +39,14 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Show Radius Application status",
-		Long:  `Show Radius Application status, such as public endpoints and resource count. Shows details for the user's default application (if configured) by default.`,
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Show Radius Application status, such as public endpoints, resource count and the last run of job workloads. Shows details for the user's default application (if configured) by default.
+
+Specify '--watch' to continuously refresh the provisioning state of the application and its resources, the readiness of
+the replicas of their Kubernetes Deployments, the endpoints of the gateways and the failures. The command exits
+successfully once every resource is provisioned and its replicas are ready, and with an error as soon as a resource or
+a Deployment fails, or when the '--timeout' elapses. This allows scripts and CI pipelines to wait for an application to
+become healthy.`,
+		Args: cobra.MaximumNArgs(1),
 		Example: `
 # Show status of current application
 rad app status
@@ -49,6 +56,12 @@ rad app status my-app
 
 # Show status of specified application in a specified resource group
 rad app status my-app --group my-group
+
+# Watch the status of the current application until it is healthy
+rad app status --watch
+
+# Wait at most 5 minutes for the specified application to become healthy
+rad app status my-app --watch --timeout 5m
 `,
 		RunE: framework.RunCommand(runner),
 	}
@@ -57,6 +70,8 @@ rad app status my-app --group my-group
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("watch", false, "Refresh the status until the application is healthy or fails")
+	cmd.Flags().Duration("timeout", 0, "The maximum time to watch the status, for example '5m'. Watches without a time limit by default")
 
 	return cmd, runner
 }
@@ -70,6 +85,14 @@ type Runner struct {
 
 	ApplicationName string
 	Format          string
+	Watch           bool
+	Timeout         time.Duration
+
+	// WatchInterval is the interval between two refreshes of the status in watch mode.
+	WatchInterval time.Duration
+
+	// Display displays the status in watch mode. A display is chosen for the output if it is not set.
+	Display watchDisplay
 }
 
 // NewRunner creates an instance of the runner for the `rad app status` command.
@@ -78,6 +101,7 @@ func NewRunner(factory framework.Factory) *Runner {
 		ConnectionFactory: factory.GetConnectionFactory(),
 		ConfigHolder:      factory.GetConfigHolder(),
 		Output:            factory.GetOutput(),
+		WatchInterval:     defaultWatchInterval,
 	}
 }
 
@@ -112,6 +136,24 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 	r.Format = format
 
+	r.Watch, err = cmd.Flags().GetBool("watch")
+	if err != nil {
+		return err
+	}
+
+	r.Timeout, err = cmd.Flags().GetDuration("timeout")
+	if err != nil {
+		return err
+	}
+
+	if r.Watch && r.Format != output.FormatTable {
+		return clierrors.Message("The '--watch' flag only supports the %q output format.", output.FormatTable)
+	} else if !r.Watch && cmd.Flags().Changed("timeout") {
+		return clierrors.Message("The '--timeout' flag can only be specified with '--watch'.")
+	} else if r.Timeout < 0 {
+		return clierrors.Message("The timeout %q is invalid. Specify a positive duration, for example '5m'.", r.Timeout)
+	}
+
 	return nil
 }
 
@@ -119,6 +161,7 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 //
 
 // Run() retrieves the application status and its associated gateways from the given workspace and returns it in the specified format.
+// In watch mode, it refreshes the status until the application is healthy or fails.
 // It returns an error if the application is not found or if there is an error while retrieving the application status.
 func (r *Runner) Run(ctx context.Context) error {
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
@@ -126,6 +169,15 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.Watch {
+		diagnosticsClient, err := r.ConnectionFactory.CreateDiagnosticsClient(ctx, *r.Workspace)
+		if err != nil {
+			return err
+		}
+
+		return r.watch(ctx, client, diagnosticsClient)
+	}
+
 	application, err := client.GetApplication(ctx, r.ApplicationName)
 	if clients.Is404Error(err) {
 		return clierrors.Message("The application %q was not found or has been deleted.", r.ApplicationName)
@@ -167,6 +219,21 @@ func (r *Runner) Run(ctx context.Context) error {
 				Endpoint: *publicEndpoint,
 			})
 		}
+
+		if !isJobWorkload(resource.Properties) {
+			continue
+		}
+
+		jobStatus, err := diagnosticsClient.GetJobStatus(ctx, clients.JobStatusOptions{
+			ResourceID: resourceID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if jobStatus != nil {
+			applicationStatus.Jobs = append(applicationStatus.Jobs, *jobStatus)
+		}
 	}
 
 	err = r.Output.WriteFormatted(r.Format, applicationStatus, statusFormat())
@@ -174,7 +241,13 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	if r.Format == output.FormatTable && len(applicationStatus.Gateways) > 0 {
+	// Structured formats include the gateways and jobs in the application status. The table only has columns for the
+	// application, so they are written as separate tables.
+	if r.Format != output.FormatTable {
+		return nil
+	}
+
+	if len(applicationStatus.Gateways) > 0 {
 		// Print newline for readability
 		r.Output.LogInfo("")
 
@@ -184,5 +257,26 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	if len(applicationStatus.Jobs) > 0 {
+		// Print newline for readability
+		r.Output.LogInfo("")
+
+		err = r.Output.WriteFormatted(r.Format, applicationStatus.Jobs, jobFormat())
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// isJobWorkload returns true if the resource properties specify a job or cronJob workload.
+func isJobWorkload(properties map[string]any) bool {
+	workload, ok := properties["workload"].(map[string]any)
+	if !ok {
+		return false
+	}
+
+	kind, _ := workload["kind"].(string)
+	return kind == "job" || kind == "cronJob"
+}
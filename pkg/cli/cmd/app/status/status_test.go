@@ -19,6 +19,7 @@ package status
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
@@ -83,6 +84,38 @@ func Test_Validate(t *testing.T) {
 				Config:         radcli.LoadEmptyConfig(t),
 			},
 		},
+		{
+			Name:          "Status Command with watch",
+			Input:         []string{"test-app", "--watch", "--timeout", "5m"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.True(t, runner.Watch)
+				require.Equal(t, 5*time.Minute, runner.Timeout)
+			},
+		},
+		{
+			Name:          "Status Command with watch and JSON output",
+			Input:         []string{"test-app", "--watch", "-o", "json"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+			},
+		},
+		{
+			Name:          "Status Command with timeout without watch",
+			Input:         []string{"test-app", "--timeout", "5m"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+			},
+		},
 		{
 			Name:          "Status Command with incorrect args",
 			Input:         []string{"foo", "bar"},
@@ -191,6 +224,191 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expected, outputSink.Writes)
 	})
 
+	t.Run("Success: Application With Job", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		application := v20231001preview.ApplicationResource{
+			Name: to.Ptr("test-app"),
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+
+		resourceList := []generated.GenericResource{
+			{
+				Name: to.Ptr("test-job"),
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-job"),
+				Properties: map[string]any{
+					"workload": map[string]any{
+						"kind":     "cronJob",
+						"schedule": "0 * * * *",
+					},
+				},
+			},
+		}
+
+		appManagementClient.EXPECT().
+			ListResourcesInApplication(gomock.Any(), "test-app").
+			Return(resourceList, nil).
+			Times(1)
+
+		jobID := mustParse(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-job")
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: jobID}).
+			Return(nil, nil).
+			Times(1)
+
+		jobStatus := clients.JobStatus{
+			Name:    "test-job",
+			Kind:    "CronJob",
+			Status:  clients.JobStatusSucceeded,
+			LastRun: "2024-01-01T00:00:00Z",
+		}
+		diagnosticsClient.EXPECT().
+			GetJobStatus(gomock.Any(), clients.JobStatusOptions{ResourceID: jobID}).
+			Return(&jobStatus, nil).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:  "kind-kind",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{
+				ApplicationsManagementClient: appManagementClient,
+				DiagnosticsClient:            diagnosticsClient,
+			},
+			Workspace:       workspace,
+			Format:          "table",
+			Output:          outputSink,
+			ApplicationName: "test-app",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		applicationStatus := clients.ApplicationStatus{
+			Name:          "test-app",
+			ResourceCount: 1,
+			Jobs:          []clients.JobStatus{jobStatus},
+		}
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus,
+				Options: statusFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus.Jobs,
+				Options: jobFormat(),
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: Structured Output", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		application := v20231001preview.ApplicationResource{
+			Name: to.Ptr("test-app"),
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(application, nil).
+			Times(1)
+
+		resourceList := []generated.GenericResource{
+			{
+				Name: to.Ptr("test-gateway"),
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/test-gateway"),
+			},
+			{
+				Name: to.Ptr("test-job"),
+				ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-job"),
+				Properties: map[string]any{
+					"workload": map[string]any{"kind": "job"},
+				},
+			},
+		}
+
+		appManagementClient.EXPECT().
+			ListResourcesInApplication(gomock.Any(), "test-app").
+			Return(resourceList, nil).
+			Times(1)
+
+		gatewayID := mustParse(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/test-gateway")
+		jobID := mustParse(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-job")
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: gatewayID}).
+			Return(to.Ptr("http://localhost:8080"), nil).
+			Times(1)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: jobID}).
+			Return(nil, nil).
+			Times(1)
+
+		jobStatus := clients.JobStatus{
+			Name:   "test-job",
+			Kind:   "Job",
+			Status: clients.JobStatusSucceeded,
+		}
+		diagnosticsClient.EXPECT().
+			GetJobStatus(gomock.Any(), clients.JobStatusOptions{ResourceID: jobID}).
+			Return(&jobStatus, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{
+				ApplicationsManagementClient: appManagementClient,
+				DiagnosticsClient:            diagnosticsClient,
+			},
+			Workspace:       &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Format:          output.FormatYaml,
+			Output:          outputSink,
+			ApplicationName: "test-app",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		// The gateways and jobs are part of the application status instead of separate tables.
+		expected := []any{
+			output.FormattedOutput{
+				Format: output.FormatYaml,
+				Obj: clients.ApplicationStatus{
+					Name:          "test-app",
+					ResourceCount: 2,
+					Gateways:      []clients.GatewayStatus{{Name: "test-gateway", Endpoint: "http://localhost:8080"}},
+					Jobs:          []clients.JobStatus{jobStatus},
+				},
+				Options: statusFormat(),
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
 	t.Run("Error: Application Not Found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
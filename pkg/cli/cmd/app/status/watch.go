@@ -0,0 +1,348 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gosuri/uilive"
+	"github.com/mattn/go-isatty"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// defaultWatchInterval is the interval between two refreshes of the status in watch mode.
+	defaultWatchInterval = 2 * time.Second
+
+	provisioningStateSucceeded = "Succeeded"
+	provisioningStateFailed    = "Failed"
+	provisioningStateCanceled  = "Canceled"
+)
+
+// watchResource is the status of a resource of the application in watch mode.
+type watchResource struct {
+	Name     string
+	Type     string
+	State    string
+	Replicas string
+
+	// Error is the error of the latest operation on a failed or canceled resource.
+	Error string
+}
+
+// watchStatus is the status of the application and its resources in watch mode.
+type watchStatus struct {
+	Resources []watchResource
+	Gateways  []clients.GatewayStatus
+
+	// Failures describes the resources and Deployments that failed.
+	Failures []string
+
+	// Pending is the number of resources that are not yet provisioned or whose Deployments are not yet ready.
+	Pending int
+}
+
+// watchDisplay displays the status of the application in watch mode.
+type watchDisplay interface {
+	// Update displays the given view of the status.
+	Update(view string)
+
+	// Stop stops updating the display.
+	Stop()
+}
+
+// interactiveDisplay repaints the view in place on a terminal.
+type interactiveDisplay struct {
+	writer *uilive.Writer
+}
+
+// Update repaints the view.
+func (d *interactiveDisplay) Update(view string) {
+	fmt.Fprint(d.writer, view)
+	_ = d.writer.Flush()
+}
+
+// Stop stops repainting the view, leaving the last view on the terminal.
+func (d *interactiveDisplay) Stop() {
+	d.writer.Stop()
+}
+
+// logDisplay writes the view to the output every time it changes, for non-interactive output such as CI logs.
+type logDisplay struct {
+	output output.Interface
+	last   string
+}
+
+// Update writes the view if it changed.
+func (d *logDisplay) Update(view string) {
+	if view == d.last {
+		return
+	}
+
+	d.last = view
+	d.output.LogInfo("%s", view)
+}
+
+// Stop does nothing as the views are already written.
+func (d *logDisplay) Stop() {
+}
+
+// newWatchDisplay returns an interactive display if the output is a terminal, and a log display otherwise.
+func newWatchDisplay(out output.Interface) watchDisplay {
+	if isatty.IsTerminal(os.Stdout.Fd()) {
+		writer := uilive.New()
+		writer.Start()
+		return &interactiveDisplay{writer: writer}
+	}
+
+	return &logDisplay{output: out}
+}
+
+// watch refreshes the status of the application until all of its resources are provisioned and their Deployments are
+// ready, any of them fails, or the timeout elapses.
+func (r *Runner) watch(ctx context.Context, client clients.ApplicationsManagementClient, diagnosticsClient clients.DiagnosticsClient) error {
+	if r.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.Timeout)
+		defer cancel()
+	}
+
+	display := r.Display
+	if display == nil {
+		display = newWatchDisplay(r.Output)
+	}
+
+	for {
+		status, err := computeWatchStatus(ctx, client, diagnosticsClient, r.ApplicationName)
+		if clients.Is404Error(err) {
+			display.Stop()
+			return clierrors.Message("The application %q was not found or has been deleted.", r.ApplicationName)
+		} else if errors.Is(err, context.DeadlineExceeded) {
+			display.Stop()
+			return clierrors.Message("Timed out after %s waiting for the application %q to become healthy.", r.Timeout, r.ApplicationName)
+		} else if err != nil {
+			display.Stop()
+			return err
+		}
+
+		view, err := renderWatchStatus(status)
+		if err != nil {
+			display.Stop()
+			return err
+		}
+		display.Update(view)
+
+		if len(status.Failures) > 0 {
+			display.Stop()
+			return clierrors.Message("The application %q has failed resources.", r.ApplicationName)
+		} else if status.Pending == 0 {
+			display.Stop()
+			r.Output.LogInfo("The application %q is healthy.", r.ApplicationName)
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			display.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return clierrors.Message("Timed out after %s waiting for the application %q to become healthy.", r.Timeout, r.ApplicationName)
+			}
+			return ctx.Err()
+		case <-time.After(r.WatchInterval):
+		}
+	}
+}
+
+// computeWatchStatus returns the provisioning state and replica readiness of the application and its resources, the
+// endpoints of its gateways and its failures.
+func computeWatchStatus(ctx context.Context, client clients.ApplicationsManagementClient, diagnosticsClient clients.DiagnosticsClient, applicationName string) (*watchStatus, error) {
+	application, err := client.GetApplication(ctx, applicationName)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceList, err := client.ListResourcesInApplication(ctx, applicationName)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(resourceList, func(i, j int) bool {
+		return strings.ToLower(*resourceList[i].Name) < strings.ToLower(*resourceList[j].Name)
+	})
+
+	status := &watchStatus{
+		Resources: []watchResource{},
+		Gateways:  []clients.GatewayStatus{},
+		Failures:  []string{},
+	}
+
+	applicationState := ""
+	if application.Properties != nil && application.Properties.ProvisioningState != nil {
+		applicationState = string(*application.Properties.ProvisioningState)
+	}
+	applicationEntry := watchResource{Name: *application.Name, Type: *application.Type, State: applicationState}
+	applicationEntry.Error, err = getOperationError(ctx, client, *application.ID, applicationState)
+	if err != nil {
+		return nil, err
+	}
+	status.addResource(applicationEntry)
+
+	for _, resource := range resourceList {
+		resourceID, err := resources.ParseResource(*resource.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		state, _ := resource.Properties["provisioningState"].(string)
+		entry := watchResource{Name: *resource.Name, Type: *resource.Type, State: state}
+		entry.Error, err = getOperationError(ctx, client, *resource.ID, state)
+		if err != nil {
+			return nil, err
+		}
+
+		replicaStatuses, err := diagnosticsClient.GetReplicaStatus(ctx, clients.ReplicaStatusOptions{
+			ResourceID: resourceID,
+			Properties: resource.Properties,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		replicas := []string{}
+		ready := true
+		for _, replicaStatus := range replicaStatuses {
+			replicas = append(replicas, fmt.Sprintf("%d/%d", replicaStatus.ReadyReplicas, replicaStatus.Replicas))
+			if replicaStatus.ReadyReplicas < replicaStatus.Replicas {
+				ready = false
+			}
+			if replicaStatus.Message != "" {
+				status.Failures = append(status.Failures, fmt.Sprintf("Deployment %q of resource %q: %s", replicaStatus.Name, entry.Name, replicaStatus.Message))
+			}
+		}
+		entry.Replicas = strings.Join(replicas, ", ")
+
+		status.addResource(entry)
+		if !ready && isSucceeded(entry.State) {
+			status.Pending++
+		}
+
+		publicEndpoint, err := diagnosticsClient.GetPublicEndpoint(ctx, clients.EndpointOptions{
+			ResourceID: resourceID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if publicEndpoint != nil {
+			status.Gateways = append(status.Gateways, clients.GatewayStatus{
+				Name:     *resource.Name,
+				Endpoint: *publicEndpoint,
+			})
+		}
+	}
+
+	return status, nil
+}
+
+// getOperationError returns the error message of the latest operation on the resource if it is failed or canceled,
+// and an empty string otherwise or if the operation is no longer available.
+func getOperationError(ctx context.Context, client clients.ApplicationsManagementClient, resourceID string, state string) (string, error) {
+	if !isFailed(state) {
+		return "", nil
+	}
+
+	operationStatus, err := client.GetLatestOperationStatus(ctx, resourceID)
+	if clients.Is404Error(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	if operationStatus == nil || operationStatus.Error == nil {
+		return "", nil
+	}
+
+	return operationStatus.Error.Message, nil
+}
+
+// addResource adds the resource to the status, and records whether it is pending or failed.
+func (s *watchStatus) addResource(resource watchResource) {
+	s.Resources = append(s.Resources, resource)
+
+	switch {
+	case isFailed(resource.State) && resource.Error != "":
+		s.Failures = append(s.Failures, fmt.Sprintf("Resource %q of type %q is in the %s state: %s", resource.Name, resource.Type, resource.State, resource.Error))
+	case isFailed(resource.State):
+		s.Failures = append(s.Failures, fmt.Sprintf("Resource %q of type %q is in the %s state.", resource.Name, resource.Type, resource.State))
+	case !isSucceeded(resource.State):
+		s.Pending++
+	}
+}
+
+// isFailed returns true if the provisioning state is Failed or Canceled.
+func isFailed(state string) bool {
+	return strings.EqualFold(state, provisioningStateFailed) || strings.EqualFold(state, provisioningStateCanceled)
+}
+
+// isSucceeded returns true if the provisioning state is Succeeded. Resources without a provisioning state are not
+// provisioned asynchronously and are considered as succeeded.
+func isSucceeded(state string) bool {
+	return state == "" || strings.EqualFold(state, provisioningStateSucceeded)
+}
+
+// renderWatchStatus renders the status as tables of the resources and gateways, followed by the failures.
+func renderWatchStatus(status *watchStatus) (string, error) {
+	formatter, err := output.NewFormatter(output.FormatTable)
+	if err != nil {
+		return "", err
+	}
+
+	buffer := &bytes.Buffer{}
+	err = formatter.Format(status.Resources, buffer, watchResourceFormat())
+	if err != nil {
+		return "", err
+	}
+
+	if len(status.Gateways) > 0 {
+		buffer.WriteString("\n")
+		err = formatter.Format(status.Gateways, buffer, gatewayFormat())
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if len(status.Failures) > 0 {
+		buffer.WriteString("\nFailures:\n")
+		for _, failure := range status.Failures {
+			buffer.WriteString("  " + failure + "\n")
+		}
+	} else if status.Pending > 0 {
+		fmt.Fprintf(buffer, "\nWaiting for %d resources to become ready...\n", status.Pending)
+	}
+
+	return buffer.String(), nil
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testApplicationID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+	testContainerID   = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend"
+	testGatewayID     = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/gateway"
+)
+
+func testApplication() v20231001preview.ApplicationResource {
+	return v20231001preview.ApplicationResource{
+		ID:   to.Ptr(testApplicationID),
+		Name: to.Ptr("test-app"),
+		Type: to.Ptr("Applications.Core/applications"),
+		Properties: &v20231001preview.ApplicationProperties{
+			ProvisioningState: to.Ptr(v20231001preview.ProvisioningStateSucceeded),
+		},
+	}
+}
+
+func testResources(containerState string) []generated.GenericResource {
+	return []generated.GenericResource{
+		{
+			ID:         to.Ptr(testGatewayID),
+			Name:       to.Ptr("gateway"),
+			Type:       to.Ptr("Applications.Core/gateways"),
+			Properties: map[string]any{"provisioningState": "Succeeded"},
+		},
+		{
+			ID:         to.Ptr(testContainerID),
+			Name:       to.Ptr("frontend"),
+			Type:       to.Ptr("Applications.Core/containers"),
+			Properties: map[string]any{"provisioningState": containerState},
+		},
+	}
+}
+
+func newWatchRunner(client clients.ApplicationsManagementClient, diagnosticsClient clients.DiagnosticsClient, outputSink *output.MockOutput) *Runner {
+	return &Runner{
+		ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client, DiagnosticsClient: diagnosticsClient},
+		Workspace:         &workspaces.Workspace{},
+		Output:            outputSink,
+		ApplicationName:   "test-app",
+		Format:            output.FormatTable,
+		Watch:             true,
+		WatchInterval:     time.Millisecond,
+		Display:           &logDisplay{output: outputSink},
+	}
+}
+
+func Test_Watch(t *testing.T) {
+	t.Run("Success: Application Becomes Healthy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(testApplication(), nil).
+			Times(3)
+		gomock.InOrder(
+			client.EXPECT().
+				ListResourcesInApplication(gomock.Any(), "test-app").
+				Return(testResources("Updating"), nil).
+				Times(1),
+			client.EXPECT().
+				ListResourcesInApplication(gomock.Any(), "test-app").
+				Return(testResources("Succeeded"), nil).
+				Times(2),
+		)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, options clients.EndpointOptions) (*string, error) {
+				if options.ResourceID.String() == testGatewayID {
+					return to.Ptr("http://localhost:8080"), nil
+				}
+				return nil, nil
+			}).
+			AnyTimes()
+		// The replicas of the container become ready on the third refresh.
+		containerRefreshes := 0
+		diagnosticsClient.EXPECT().
+			GetReplicaStatus(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, options clients.ReplicaStatusOptions) ([]clients.ReplicaStatus, error) {
+				if options.ResourceID.String() != testContainerID {
+					return nil, nil
+				}
+
+				containerRefreshes++
+				if containerRefreshes < 3 {
+					return []clients.ReplicaStatus{{Name: "frontend", ReadyReplicas: 0, Replicas: 2}}, nil
+				}
+				return []clients.ReplicaStatus{{Name: "frontend", ReadyReplicas: 2, Replicas: 2}}, nil
+			}).
+			Times(6)
+
+		outputSink := &output.MockOutput{}
+		runner := newWatchRunner(client, diagnosticsClient, outputSink)
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 4)
+		require.Contains(t, outputSink.Writes[0].(output.LogOutput).Params[0], "Updating")
+		require.Contains(t, outputSink.Writes[1].(output.LogOutput).Params[0], "0/2")
+		require.Contains(t, outputSink.Writes[2].(output.LogOutput).Params[0], "2/2")
+		require.Contains(t, outputSink.Writes[2].(output.LogOutput).Params[0], "http://localhost:8080")
+		require.Equal(t, output.LogOutput{Format: "The application %q is healthy.", Params: []any{"test-app"}}, outputSink.Writes[3])
+	})
+
+	t.Run("Error: Resource Failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(testApplication(), nil).
+			Times(1)
+		client.EXPECT().
+			ListResourcesInApplication(gomock.Any(), "test-app").
+			Return(testResources("Failed"), nil).
+			Times(1)
+		client.EXPECT().
+			GetLatestOperationStatus(gomock.Any(), testContainerID).
+			Return(&v1.AsyncOperationStatus{
+				Status: v1.ProvisioningStateFailed,
+				Error:  &v1.ErrorDetails{Code: v1.CodeInternal, Message: "recipe deployment failed: the image could not be pulled"},
+			}, nil).
+			Times(1)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			AnyTimes()
+		diagnosticsClient.EXPECT().
+			GetReplicaStatus(gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			AnyTimes()
+
+		outputSink := &output.MockOutput{}
+		runner := newWatchRunner(client, diagnosticsClient, outputSink)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The application %q has failed resources.", "test-app"), err)
+		require.Contains(t, outputSink.Writes[0].(output.LogOutput).Params[0], "Resource \"frontend\" of type \"Applications.Core/containers\" is in the Failed state: recipe deployment failed: the image could not be pulled")
+	})
+
+	t.Run("Error: Resource Failed Without Operation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(testApplication(), nil).
+			Times(1)
+		client.EXPECT().
+			ListResourcesInApplication(gomock.Any(), "test-app").
+			Return(testResources("Canceled"), nil).
+			Times(1)
+		client.EXPECT().
+			GetLatestOperationStatus(gomock.Any(), testContainerID).
+			Return(nil, radcli.Create404Error()).
+			Times(1)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			AnyTimes()
+		diagnosticsClient.EXPECT().
+			GetReplicaStatus(gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			AnyTimes()
+
+		outputSink := &output.MockOutput{}
+		runner := newWatchRunner(client, diagnosticsClient, outputSink)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The application %q has failed resources.", "test-app"), err)
+		require.Contains(t, outputSink.Writes[0].(output.LogOutput).Params[0], "Resource \"frontend\" of type \"Applications.Core/containers\" is in the Canceled state.")
+	})
+
+	t.Run("Error: Deployment Failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(testApplication(), nil).
+			Times(1)
+		client.EXPECT().
+			ListResourcesInApplication(gomock.Any(), "test-app").
+			Return(testResources("Succeeded"), nil).
+			Times(1)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			AnyTimes()
+		diagnosticsClient.EXPECT().
+			GetReplicaStatus(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, options clients.ReplicaStatusOptions) ([]clients.ReplicaStatus, error) {
+				if options.ResourceID.String() != testContainerID {
+					return nil, nil
+				}
+				return []clients.ReplicaStatus{{Name: "frontend", Replicas: 1, Message: "ReplicaSet \"frontend-abc\" has timed out progressing."}}, nil
+			}).
+			AnyTimes()
+
+		outputSink := &output.MockOutput{}
+		runner := newWatchRunner(client, diagnosticsClient, outputSink)
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("The application %q has failed resources.", "test-app"), err)
+		require.Contains(t, outputSink.Writes[0].(output.LogOutput).Params[0], "Deployment \"frontend\" of resource \"frontend\": ReplicaSet \"frontend-abc\" has timed out progressing.")
+	})
+
+	t.Run("Error: Timeout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(testApplication(), nil).
+			MinTimes(1)
+		client.EXPECT().
+			ListResourcesInApplication(gomock.Any(), "test-app").
+			Return(testResources("Updating"), nil).
+			MinTimes(1)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			AnyTimes()
+		diagnosticsClient.EXPECT().
+			GetReplicaStatus(gomock.Any(), gomock.Any()).
+			Return(nil, nil).
+			AnyTimes()
+
+		outputSink := &output.MockOutput{}
+		runner := newWatchRunner(client, diagnosticsClient, outputSink)
+		runner.Timeout = 50 * time.Millisecond
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("Timed out after %s waiting for the application %q to become healthy.", 50*time.Millisecond, "test-app"), err)
+
+		// The view is only written when it changes.
+		require.Len(t, outputSink.Writes, 1)
+	})
+}
+
+func Test_renderWatchStatus(t *testing.T) {
+	status := &watchStatus{
+		Resources: []watchResource{
+			{Name: "test-app", Type: "Applications.Core/applications", State: "Succeeded"},
+			{Name: "frontend", Type: "Applications.Core/containers", State: "Updating", Replicas: "1/2"},
+		},
+		Gateways: []clients.GatewayStatus{},
+		Failures: []string{},
+		Pending:  1,
+	}
+
+	view, err := renderWatchStatus(status)
+	require.NoError(t, err)
+
+	expected := "RESOURCE  TYPE                            STATE      REPLICAS\n" +
+		"test-app  Applications.Core/applications  Succeeded  \n" +
+		"frontend  Applications.Core/containers    Updating   1/2\n" +
+		"\n" +
+		"Waiting for 1 resources to become ready...\n"
+	require.Equal(t, expected, view)
+}
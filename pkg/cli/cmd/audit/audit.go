@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	audit_list "github.com/radius-project/radius/pkg/cli/cmd/audit/list"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for reading the audit log, with subcommands for listing audit records.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Read the audit log",
+		Long: `Read the audit log
+
+When the audit log is enabled, Radius records every create, update, delete and action request that it receives. Each record contains the caller, the resource, the API version, the correlation and operation IDs, the result and a digest of the request body.
+`,
+		Example: `
+# List the audit records of the last hour
+rad audit list --since 1h
+
+# List the audit records of a resource group
+rad audit list -g prod
+`,
+	}
+
+	list, _ := audit_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import "github.com/radius-project/radius/pkg/cli/output"
+
+// AuditRecordFormat returns a FormatterOptions object containing a list of columns with their headings and JSONPaths.
+func AuditRecordFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "TIME",
+				JSONPath: "{ .Properties.Timestamp }",
+			},
+			{
+				Heading:  "PRINCIPAL",
+				JSONPath: "{ .Properties.PrincipalName }",
+			},
+			{
+				Heading:  "METHOD",
+				JSONPath: "{ .Properties.Method }",
+			},
+			{
+				Heading:  "RESOURCE",
+				JSONPath: "{ .Properties.ResourceID }",
+			},
+			{
+				Heading:  "RESULT",
+				JSONPath: "{ .Properties.Result }",
+			},
+		},
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/to"
+	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AuditRecordFormat(t *testing.T) {
+	obj := ucpv20231001preview.AuditRecordResource{
+		Name: to.Ptr("a1b2c3"),
+		ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/System.Audit/records/a1b2c3"),
+		Properties: &ucpv20231001preview.AuditRecordProperties{
+			Timestamp:     to.Ptr(time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC)),
+			PrincipalName: to.Ptr("alice"),
+			Method:        to.Ptr("PUT"),
+			ResourceID:    to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app"),
+			Result:        to.Ptr(ucpv20231001preview.AuditResultSucceeded),
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, AuditRecordFormat())
+	require.NoError(t, err)
+
+	expected := "TIME                           PRINCIPAL  METHOD    RESOURCE                                                                                     RESULT\n2026-10-19 08:30:00 +0000 UTC  alice      PUT       /planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app  Succeeded\n"
+	require.Equal(t, expected, buffer.String())
+}
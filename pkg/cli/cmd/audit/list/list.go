@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/audit/common"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad audit list` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit records",
+		Long: `List the audit records of the local plane, newest first.
+
+Use --group or --scope to only list the records of resources in a resource group or scope, and --since or --start-time and --end-time to only list the records in a time range.`,
+		Example: `
+# List all audit records
+rad audit list
+
+# List the audit records of the last 24 hours in the specified resource group
+rad audit list -g prod --since 24h
+
+# List the audit records in a time range
+rad audit list --start-time 2024-05-01T00:00:00Z --end-time 2024-05-02T00:00:00Z
+`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().String("scope", "", "The resource ID of the scope to list audit records for, for example '/planes/radius/local/resourceGroups/prod'")
+	cmd.Flags().Duration("since", 0, "Only list audit records newer than a relative duration, for example '1h'")
+	cmd.Flags().String("start-time", "", "Only list audit records at or after this time, in RFC 3339 format")
+	cmd.Flags().String("end-time", "", "Only list audit records at or before this time, in RFC 3339 format")
+
+	cmd.MarkFlagsMutuallyExclusive("group", "scope")
+	cmd.MarkFlagsMutuallyExclusive("since", "start-time")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad audit list` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Scope             string
+	StartTime         *time.Time
+	EndTime           *time.Time
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad audit list` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad audit list` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.Workspace = workspace
+	r.Format = format
+
+	if cmd.Flags().Changed("group") {
+		r.Scope, err = cli.RequireScope(cmd, *workspace)
+		if err != nil {
+			return err
+		}
+	} else {
+		r.Scope, err = cmd.Flags().GetString("scope")
+		if err != nil {
+			return err
+		}
+
+		if r.Scope != "" {
+			if _, err := resources.ParseScope(r.Scope); err != nil {
+				return clierrors.Message("The scope %q is not a valid resource ID.", r.Scope)
+			}
+		}
+	}
+
+	since, err := cmd.Flags().GetDuration("since")
+	if err != nil {
+		return err
+	}
+
+	if since < 0 {
+		return clierrors.Message("The duration %q is invalid. Specify a positive duration, for example '1h'.", since)
+	} else if since > 0 {
+		startTime := time.Now().Add(-since)
+		r.StartTime = &startTime
+	}
+
+	if r.StartTime == nil {
+		r.StartTime, err = parseTimeFlag(cmd, "start-time")
+		if err != nil {
+			return err
+		}
+	}
+
+	r.EndTime, err = parseTimeFlag(cmd, "end-time")
+	if err != nil {
+		return err
+	}
+
+	if r.StartTime != nil && r.EndTime != nil && r.EndTime.Before(*r.StartTime) {
+		return clierrors.Message("The end time must not be before the start time.")
+	}
+
+	return nil
+}
+
+// Run runs the `rad audit list` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	options := &v20231001preview.AuditRecordsClientListOptions{
+		StartTime: r.StartTime,
+		EndTime:   r.EndTime,
+	}
+	if r.Scope != "" {
+		options.Scope = &r.Scope
+	}
+
+	records, err := client.ListAuditRecords(ctx, "local", options)
+	if err != nil {
+		return err
+	}
+
+	return r.Output.WriteFormatted(r.Format, records, common.AuditRecordFormat())
+}
+
+func parseTimeFlag(cmd *cobra.Command, name string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, clierrors.Message("The value %q of the '--%s' flag is invalid. Specify a time in RFC 3339 format, for example '2024-05-01T00:00:00Z'.", value, name)
+	}
+
+	return &parsed, nil
+}
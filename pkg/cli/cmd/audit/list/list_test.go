@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/cmd/audit/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "List Command without filters",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Empty(t, runner.(*Runner).Scope)
+				require.Nil(t, runner.(*Runner).StartTime)
+				require.Nil(t, runner.(*Runner).EndTime)
+			},
+		},
+		{
+			Name:          "List Command with group flag",
+			Input:         []string{"-g", "prod"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "/planes/radius/local/resourceGroups/prod", runner.(*Runner).Scope)
+			},
+		},
+		{
+			Name:          "List Command with scope flag",
+			Input:         []string{"--scope", "/planes/radius/local/resourceGroups/prod"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "/planes/radius/local/resourceGroups/prod", runner.(*Runner).Scope)
+			},
+		},
+		{
+			Name:          "List Command with since flag",
+			Input:         []string{"--since", "1h"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.NotNil(t, runner.(*Runner).StartTime)
+				require.WithinDuration(t, time.Now().Add(-time.Hour), *runner.(*Runner).StartTime, time.Minute)
+			},
+		},
+		{
+			Name:          "List Command with time range",
+			Input:         []string{"--start-time", "2024-05-01T00:00:00Z", "--end-time", "2024-05-02T00:00:00Z"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), *runner.(*Runner).StartTime)
+				require.Equal(t, time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), *runner.(*Runner).EndTime)
+			},
+		},
+		{
+			Name:          "List Command with end time before start time",
+			Input:         []string{"--start-time", "2024-05-02T00:00:00Z", "--end-time", "2024-05-01T00:00:00Z"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "List Command with invalid start time",
+			Input:         []string{"--start-time", "yesterday"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "List Command with negative since",
+			Input:         []string{"--since", "-1h"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+
+		{
+			Name:          "List Command with invalid scope",
+			Input:         []string{"--scope", "prod"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "List Command with incorrect args",
+			Input:         []string{"records"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("List audit records", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		records := []v20231001preview.AuditRecordResource{
+			{
+				Name: to.Ptr("a1b2c3"),
+				Properties: &v20231001preview.AuditRecordProperties{
+					PrincipalName: to.Ptr("alice"),
+					Method:        to.Ptr("PUT"),
+					Result:        to.Ptr(v20231001preview.AuditResultSucceeded),
+				},
+			},
+		}
+
+		startTime := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		expectedOptions := &v20231001preview.AuditRecordsClientListOptions{
+			Scope:     to.Ptr("/planes/radius/local/resourceGroups/test-group"),
+			StartTime: &startTime,
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListAuditRecords(gomock.Any(), "local", expectedOptions).
+			Return(records, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Name: "kind-kind"},
+			Output:            outputSink,
+			Scope:             "/planes/radius/local/resourceGroups/test-group",
+			StartTime:         &startTime,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     records,
+				Options: common.AuditRecordFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
@@ -18,7 +18,10 @@ package deploy
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
 	"sort"
 	"strings"
 
@@ -79,6 +82,16 @@ When passing multiple parameters in a single file, use the format described here
 
 You can specify parameters using multiple sources. Parameters can be overridden based on the 
 order they are provided. Parameters appearing later in the argument list will override those defined earlier.
+
+Use the '--watch' flag to keep watching the template, the local modules it references and its parameter files
+after the deployment. The template is rebuilt each time one of these files changes, and redeployed if the compiled
+template or the parameters changed. Press CTRL+C to stop watching.
+
+Use '--output json' to report the progress of the deployment as a stream of JSON events, one per line, instead of
+the interactive progress display. Each event has a type, a timestamp and, for resource events, the ID, type and name
+of the resource. Completion events include the duration of the operation in seconds, and failure events include the
+error details. The last event reports the deployed resources, the outputs of the template and the public endpoints.
+This format is intended for CI pipelines and other tools, and cannot be combined with '--watch'.
 `,
 		Example: `
 # deploy a Bicep template
@@ -114,6 +127,13 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+
+# redeploy the template each time it, its modules or its parameter files change
+rad deploy myapp.bicep --watch
+
+# report the progress of the deployment as a stream of JSON events
+rad deploy myapp.bicep --output json
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -124,10 +144,17 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
+	commonflags.AddOutputFlagWithPlainText(cmd)
+	AddWatchFlag(cmd)
 
 	return cmd, runner
 }
 
+// AddWatchFlag adds a flag to the given command that allows the user to redeploy the template when its files change.
+func AddWatchFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("watch", false, "Watch the template, its modules and parameter files, and redeploy when they change")
+}
+
 // Runner is the runner implementation for the `rad deploy` command.
 type Runner struct {
 	Bicep                   bicep.Interface
@@ -137,14 +164,28 @@ type Runner struct {
 	Deploy                  deploy.Interface
 	Output                  output.Interface
 
+	// EventOutput is where the deployment events are written with the JSON output format.
+	EventOutput io.Writer
+
 	ApplicationName     string
 	EnvironmentNameOrID string
 	FilePath            string
+	Format              string
 	Parameters          map[string]map[string]any
 	Template            map[string]any
+	Watch               bool
 	Workspace           *workspaces.Workspace
 	Providers           *clients.Providers
 	EnvResult           *EnvironmentCheckResult
+
+	// parameterArgs are the values of the --parameters flag. They are parsed again when the template is redeployed in
+	// watch mode so that changes to parameter files are picked up.
+	parameterArgs []string
+
+	// deployedTemplate and deployedParameters are the template and parameters of the last successful deployment. They
+	// are used in watch mode to skip deployments when the compiled template and parameters did not change.
+	deployedTemplate   map[string]any
+	deployedParameters map[string]map[string]any
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -155,6 +196,7 @@ func NewRunner(factory framework.Factory) *Runner {
 		ConfigHolder:      factory.GetConfigHolder(),
 		Deploy:            factory.GetDeploy(),
 		Output:            factory.GetOutput(),
+		EventOutput:       os.Stdout,
 		Providers:         &clients.Providers{},
 	}
 }
@@ -246,6 +288,32 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	r.parameterArgs = parameterArgs
+
+	// The watch flag is not registered by commands that reuse this runner without deploying, such as `rad diff`.
+	if cmd.Flags().Lookup("watch") != nil {
+		r.Watch, err = cmd.Flags().GetBool("watch")
+		if err != nil {
+			return err
+		}
+	}
+
+	// The output flag is inherited from the root command by commands that reuse this runner, such as `rad run`. Only
+	// `rad deploy` and `rad diff` register their own.
+	if cmd.LocalFlags().Lookup("output") != nil {
+		r.Format, err = cli.RequireOutput(cmd)
+		if err != nil {
+			return err
+		}
+
+		if r.Format != output.FormatPlainText && r.Format != output.FormatJson {
+			return clierrors.Message("Unsupported output format %q. Supported formats are %s and %s.", r.Format, output.FormatPlainText, output.FormatJson)
+		}
+
+		if r.Format == output.FormatJson && r.Watch {
+			return clierrors.Message("The --watch flag cannot be used with the %s output format.", output.FormatJson)
+		}
+	}
 
 	return nil
 }
@@ -255,20 +323,26 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 // Run deploys a Bicep template into an environment from a workspace, optionally creating an application if
 // specified, and displays progress and completion messages. It returns an error if any of the operations fail.
+//
+// In watch mode, Run keeps redeploying the template when its files change until the context is cancelled. Deployment
+// failures are reported without stopping the command, so that the user can fix the template and try again.
 func (r *Runner) Run(ctx context.Context) error {
-	// Use the template that was prepared during validation
-	template := r.Template
-
-	// This is the earliest point where we can inject parameters, we have
-	// to wait until the template is prepared.
-	err := r.injectAutomaticParameters(template)
-	if err != nil {
+	err := r.DeployTemplate(ctx)
+	if !r.Watch {
 		return err
+	} else if err != nil {
+		r.Output.LogInfo("Deployment failed: %v", err)
 	}
 
-	// This is the earliest point where we can report missing parameters, we have
-	// to wait until the template is prepared.
-	err = r.reportMissingParameters(template)
+	return r.WatchAndRedeploy(ctx)
+}
+
+// DeployTemplate deploys the template that was prepared during validation, optionally creating the application if
+// specified.
+func (r *Runner) DeployTemplate(ctx context.Context) error {
+	template := r.Template
+
+	err := r.ResolveParameters(template)
 	if err != nil {
 		return err
 	}
@@ -336,15 +410,39 @@ func (r *Runner) Run(ctx context.Context) error {
 		Parameters:        r.Parameters,
 		ProgressText:      progressText,
 		CompletionText:    "Deployment Complete",
+		Format:            r.Format,
+		EventOutput:       r.EventOutput,
 		Providers:         r.Providers,
 	})
-	if err != nil {
+	reportedErr := &deploy.ReportedError{}
+	if errors.As(err, &reportedErr) {
+		// The failure has already been reported as a deployment event.
+		return clierrors.ExitCode(1)
+	} else if err != nil {
 		return err
 	}
 
+	r.deployedTemplate = template
+	r.deployedParameters = r.Parameters
+
 	return nil
 }
 
+// ResolveParameters injects the environment and application parameters into the parameters of the template, and
+// reports the parameters that are missing a value.
+func (r *Runner) ResolveParameters(template map[string]any) error {
+	// This is the earliest point where we can inject parameters, we have
+	// to wait until the template is prepared.
+	err := r.injectAutomaticParameters(template)
+	if err != nil {
+		return err
+	}
+
+	// This is the earliest point where we can report missing parameters, we have
+	// to wait until the template is prepared.
+	return r.reportMissingParameters(template)
+}
+
 func (r *Runner) injectAutomaticParameters(template map[string]any) error {
 	if r.Providers.Radius.EnvironmentID != "" {
 		err := bicep.InjectEnvironmentParam(template, r.Parameters, r.Providers.Radius.EnvironmentID)
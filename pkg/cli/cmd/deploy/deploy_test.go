@@ -18,12 +18,14 @@ package deploy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
 	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
 	"github.com/radius-project/radius/pkg/cli/bicep"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/config"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/deploy"
@@ -92,6 +94,99 @@ func Test_Validate(t *testing.T) {
 
 			},
 		},
+		{
+			Name:          "rad deploy - valid with watch",
+			Input:         []string{"app.bicep", "--watch", "-p", "foo=bar"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.Bicep.EXPECT().
+					PrepareTemplate("app.bicep").
+					Return(map[string]any{}, nil).
+					Times(1)
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), radcli.TestEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{
+						ID: to.Ptr(radcli.TestEnvironmentID),
+					}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.True(t, runner.Watch)
+				require.Equal(t, []string{"foo=bar"}, runner.parameterArgs)
+			},
+		},
+		{
+			Name:          "rad deploy - valid with json output",
+			Input:         []string{"app.bicep", "--output", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.Bicep.EXPECT().
+					PrepareTemplate("app.bicep").
+					Return(map[string]any{}, nil).
+					Times(1)
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), radcli.TestEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{
+						ID: to.Ptr(radcli.TestEnvironmentID),
+					}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, output.FormatJson, runner.Format)
+			},
+		},
+		{
+			Name:          "rad deploy - unsupported output format",
+			Input:         []string{"app.bicep", "--output", "table"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.Bicep.EXPECT().
+					PrepareTemplate("app.bicep").
+					Return(map[string]any{}, nil).
+					Times(1)
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), radcli.TestEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{
+						ID: to.Ptr(radcli.TestEnvironmentID),
+					}, nil).
+					Times(1)
+			},
+		},
+		{
+			Name:          "rad deploy - json output with watch",
+			Input:         []string{"app.bicep", "--output", "json", "--watch"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.Bicep.EXPECT().
+					PrepareTemplate("app.bicep").
+					Return(map[string]any{}, nil).
+					Times(1)
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), radcli.TestEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{
+						ID: to.Ptr(radcli.TestEnvironmentID),
+					}, nil).
+					Times(1)
+			},
+		},
 		{
 			Name:          "rad deploy - app set by directory config",
 			Input:         []string{"app.bicep", "-e", "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Core/environments/prod"},
@@ -900,6 +995,179 @@ func Test_Run(t *testing.T) {
 		// is always empty.
 		require.Empty(t, outputSink.Writes)
 	})
+
+	t.Run("Deployment failure with json output", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name: "kind-kind",
+		}
+		providers := &clients.Providers{
+			Radius: &clients.RadiusProvider{
+				EnvironmentID: radcli.TestEnvironmentID,
+			},
+		}
+
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+				require.Equal(t, output.FormatJson, o.Format)
+				return clients.DeploymentResult{}, &deploy.ReportedError{Err: errors.New("deployment failed")}
+			}).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Deploy:              deployMock,
+			Output:              outputSink,
+			FilePath:            "app.bicep",
+			Format:              output.FormatJson,
+			EnvironmentNameOrID: radcli.TestEnvironmentID,
+			Parameters:          map[string]map[string]any{},
+			Workspace:           workspace,
+			Providers:           providers,
+			Template:            map[string]any{},
+		}
+
+		// The failure is reported as a deployment event, so only the exit code is returned.
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.ExitCode(1), err)
+		require.Empty(t, outputSink.Writes)
+	})
+
+	t.Run("Unreported failure with json output", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name: "kind-kind",
+		}
+		providers := &clients.Providers{
+			Radius: &clients.RadiusProvider{
+				EnvironmentID: radcli.TestEnvironmentID,
+			},
+		}
+
+		deployMock := deploy.NewMockInterface(ctrl)
+		deployMock.EXPECT().
+			DeployWithProgress(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+				require.Equal(t, output.FormatJson, o.Format)
+				return clients.DeploymentResult{}, errors.New("deployment failed")
+			}).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Deploy:              deployMock,
+			Output:              outputSink,
+			FilePath:            "app.bicep",
+			Format:              output.FormatJson,
+			EnvironmentNameOrID: radcli.TestEnvironmentID,
+			Parameters:          map[string]map[string]any{},
+			Workspace:           workspace,
+			Providers:           providers,
+			Template:            map[string]any{},
+		}
+
+		// The failure was not reported as a deployment event, so the error is returned.
+		err := runner.Run(context.Background())
+		require.EqualError(t, err, "deployment failed")
+		require.Empty(t, outputSink.Writes)
+	})
+}
+
+func Test_redeployOnChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name: "kind-kind",
+	}
+	provider := &clients.Providers{
+		Radius: &clients.RadiusProvider{
+			EnvironmentID: radcli.TestEnvironmentID,
+		},
+	}
+
+	template := map[string]any{}
+	changedTemplate := map[string]any{"resources": map[string]any{}}
+
+	bicepMock := bicep.NewMockInterface(ctrl)
+	gomock.InOrder(
+		// The template is rebuilt without changes, so it is not redeployed.
+		bicepMock.EXPECT().PrepareTemplate("app.bicep").Return(map[string]any{}, nil),
+		// The template is rebuilt with changes, so it is redeployed.
+		bicepMock.EXPECT().PrepareTemplate("app.bicep").Return(changedTemplate, nil),
+		// The template fails to build, which is reported without stopping the loop.
+		bicepMock.EXPECT().PrepareTemplate("app.bicep").Return(nil, fmt.Errorf("failed to build template")),
+	)
+
+	deployed := []map[string]any{}
+	deployMock := deploy.NewMockInterface(ctrl)
+	deployMock.EXPECT().
+		DeployWithProgress(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, o deploy.Options) (clients.DeploymentResult, error) {
+			deployed = append(deployed, o.Template)
+			return clients.DeploymentResult{}, nil
+		}).
+		Times(2)
+
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		Bicep:               bicepMock,
+		Deploy:              deployMock,
+		Output:              outputSink,
+		FilePath:            "app.bicep",
+		EnvironmentNameOrID: radcli.TestEnvironmentID,
+		Parameters:          map[string]map[string]any{},
+		Workspace:           workspace,
+		Providers:           provider,
+		Template:            template,
+		Watch:               true,
+	}
+
+	err := runner.DeployTemplate(context.Background())
+	require.NoError(t, err)
+
+	changes := make(chan []string, 3)
+	changes <- []string{"app.bicep"}
+	changes <- []string{"app.bicep", "modules/db.bicep"}
+	changes <- []string{"app.bicep"}
+	close(changes)
+
+	err = runner.redeployOnChange(context.Background(), changes)
+	require.NoError(t, err)
+
+	require.Equal(t, []map[string]any{template, changedTemplate}, deployed)
+
+	watching := output.LogOutput{Format: "Watching %s for changes. Press CTRL+C to stop.", Params: []any{"app.bicep"}}
+	expected := []any{
+		watching,
+		output.LogOutput{Format: "Detected changes to %s.", Params: []any{"app.bicep"}},
+		output.LogOutput{Format: "The compiled template and parameters did not change. Skipping deployment."},
+		watching,
+		output.LogOutput{Format: "Detected changes to %s.", Params: []any{"app.bicep, modules/db.bicep"}},
+		watching,
+		output.LogOutput{Format: "Detected changes to %s.", Params: []any{"app.bicep"}},
+		output.LogOutput{Format: "Deployment failed: %v", Params: []any{fmt.Errorf("failed to build template")}},
+		watching,
+	}
+	require.Equal(t, expected, outputSink.Writes)
 }
 
 func Test_injectAutomaticParameters(t *testing.T) {
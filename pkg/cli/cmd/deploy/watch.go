@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+)
+
+// WatchAndRedeploy watches the template, the local modules it references and its parameter files, and redeploys the
+// template when they change. It returns when the context is cancelled.
+func (r *Runner) WatchAndRedeploy(ctx context.Context) error {
+	fs := filesystem.NewOSFS()
+	parser := bicep.ParameterParser{FileSystem: fs}
+	watcher := bicep.Watcher{FileSystem: fs}
+
+	changes := watcher.Watch(ctx, r.FilePath, parser.FilePaths(r.parameterArgs...))
+	return r.redeployOnChange(ctx, changes)
+}
+
+// redeployOnChange redeploys the template each time a list of changed files is received, until the channel is closed
+// or the context is cancelled. Failures are reported and don't stop the loop.
+func (r *Runner) redeployOnChange(ctx context.Context, changes <-chan []string) error {
+	r.Output.LogInfo("Watching %s for changes. Press CTRL+C to stop.", r.FilePath)
+
+	for changed := range changes {
+		r.Output.LogInfo("Detected changes to %s.", strings.Join(changed, ", "))
+
+		err := r.redeploy(ctx)
+		if ctx.Err() != nil {
+			// The user cancelled while the template was being redeployed.
+			return nil
+		} else if err != nil {
+			r.Output.LogInfo("Deployment failed: %v", err)
+		}
+
+		r.Output.LogInfo("Watching %s for changes. Press CTRL+C to stop.", r.FilePath)
+	}
+
+	return nil
+}
+
+// redeploy rebuilds the template and parses the parameters again, and deploys them if they differ from the last
+// successful deployment.
+func (r *Runner) redeploy(ctx context.Context) error {
+	template, err := r.Bicep.PrepareTemplate(r.FilePath)
+	if err != nil {
+		return err
+	}
+
+	parser := bicep.ParameterParser{FileSystem: filesystem.NewOSFS()}
+	parameters, err := parser.Parse(r.parameterArgs...)
+	if err != nil {
+		return err
+	}
+
+	r.Template = template
+	r.Parameters = parameters
+
+	// Inject the automatic parameters before the comparison, so that they compare equal to the last deployment.
+	err = r.injectAutomaticParameters(template)
+	if err != nil {
+		return err
+	}
+
+	if reflect.DeepEqual(template, r.deployedTemplate) && reflect.DeepEqual(parameters, r.deployedParameters) {
+		r.Output.LogInfo("The compiled template and parameters did not change. Skipping deployment.")
+		return nil
+	}
+
+	return r.DeployTemplate(ctx)
+}
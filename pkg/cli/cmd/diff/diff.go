@@ -0,0 +1,163 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	deploycmd "github.com/radius-project/radius/pkg/cli/cmd/deploy"
+	"github.com/radius-project/radius/pkg/cli/diff"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// exitCodeChanges is the exit code of the command when deploying the template would change resources. It
+	// differs from the exit code for errors so that CI pipelines can tell them apart.
+	exitCodeChanges = 2
+)
+
+// NewCommand creates an instance of the command and runner for the `rad diff` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "diff [file]",
+		Short: "Compare a template with the deployed resources",
+		Long: `Compare a Bicep or ARM template with the deployed resources
+
+The diff command compiles a Bicep or ARM template and compares the Radius resources it declares with the resources
+deployed to your default environment (unless otherwise specified), without deploying the template. The environment and
+application parameters are injected the same way as 'rad deploy'.
+
+Resources are reported as created, modified or unchanged. Resources that belong to an application of the template but
+are not declared in it are reported as ignored, because 'rad deploy' is incremental and does not delete them. Only the
+properties declared in the template are compared. Values that are only known during the deployment, such as the
+outputs of other resources, are not compared.
+
+The command exits with code 0 if deploying the template would not create or modify any resource, 2 if it would, and 1
+if an error occurs. Ignored resources do not affect the exit code.
+
+The diff command accepts the same parameters as the 'rad deploy' command. See the 'rad deploy' help for more information.
+`,
+		Example: `
+# compare a Bicep template with the deployed resources
+rad diff myapp.bicep
+
+# compare using a specific environment and application
+rad diff myapp.bicep --environment production --application myapp
+
+# compare and specify parameters
+rad diff myapp.bicep --parameters version=latest
+
+# compare and output the differences in JSON format
+rad diff myapp.bicep --output json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddEnvironmentNameFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	commonflags.AddParameterFlag(cmd)
+	commonflags.AddOutputFlagWithPlainText(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad diff` command.
+type Runner struct {
+	deploycmd.Runner
+	Format string
+}
+
+// NewRunner creates a new instance of the `rad diff` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Runner: *deploycmd.NewRunner(factory),
+	}
+}
+
+// Validate runs validation for the `rad diff` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	err := r.Runner.Validate(cmd, args)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	if format != output.FormatPlainText && format != output.FormatJson {
+		return clierrors.Message("Unsupported output format %q. Supported formats are %s and %s.", format, output.FormatPlainText, output.FormatJson)
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad diff` command.
+func (r *Runner) Run(ctx context.Context) error {
+	err := r.ResolveParameters(r.Template)
+	if err != nil {
+		return err
+	}
+
+	resources, warnings, err := diff.ResolveResources(r.Template, r.Parameters, r.Workspace.Scope)
+	if err != nil {
+		return clierrors.MessageWithCause(err, "The template %q could not be evaluated.", r.FilePath)
+	}
+
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	applicationIDs := []string{}
+	if r.Providers != nil && r.Providers.Radius != nil {
+		applicationIDs = append(applicationIDs, r.Providers.Radius.ApplicationID)
+	}
+
+	result, err := diff.Compute(ctx, client, resources, applicationIDs)
+	if err != nil {
+		return err
+	}
+	result.Warnings = warnings
+
+	if r.Format == output.FormatJson {
+		err = r.Output.WriteFormatted(output.FormatJson, result, output.FormatterOptions{})
+		if err != nil {
+			return err
+		}
+	} else {
+		r.Output.LogInfo("%s", diff.Display(result))
+	}
+
+	if result.HasChanges() {
+		return clierrors.ExitCode(exitCodeChanges)
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	deploycmd "github.com/radius-project/radius/pkg/cli/cmd/deploy"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/diff"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testScope         = "/planes/radius/local/resourceGroups/test-group"
+	testEnvironmentID = testScope + "/providers/Applications.Core/environments/test-env"
+	testApplicationID = testScope + "/providers/Applications.Core/applications/my-app"
+	testContainerID   = testScope + "/providers/Applications.Core/containers/frontend"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	// NOTE: most of the validation of this command is shared with the `rad deploy` command.
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "rad diff - valid",
+			Input:         []string{"app.bicep", "-e", testEnvironmentID},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.Bicep.EXPECT().
+					PrepareTemplate("app.bicep").
+					Return(map[string]any{}, nil).
+					Times(1)
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), testEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, output.FormatPlainText, runner.(*Runner).Format)
+			},
+		},
+		{
+			Name:          "rad diff - valid with json output",
+			Input:         []string{"app.bicep", "-e", testEnvironmentID, "-o", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.Bicep.EXPECT().
+					PrepareTemplate("app.bicep").
+					Return(map[string]any{}, nil).
+					Times(1)
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), testEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, output.FormatJson, runner.(*Runner).Format)
+			},
+		},
+		{
+			Name:          "rad diff - unsupported output format",
+			Input:         []string{"app.bicep", "-e", testEnvironmentID, "-o", "table"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.Bicep.EXPECT().
+					PrepareTemplate("app.bicep").
+					Return(map[string]any{}, nil).
+					Times(1)
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), testEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{}, nil).
+					Times(1)
+			},
+		},
+		{
+			Name:          "rad diff - too many args",
+			Input:         []string{"app.bicep", "other.bicep"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func makeTestTemplate() map[string]any {
+	return map[string]any{
+		"parameters": map[string]any{
+			"environment": map[string]any{"type": "string"},
+		},
+		"resources": map[string]any{
+			"app": map[string]any{
+				"import": "Radius",
+				"type":   "Applications.Core/applications@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "my-app",
+					"properties": map[string]any{
+						"environment": "[parameters('environment')]",
+					},
+				},
+			},
+		},
+	}
+}
+
+func makeTestRunner(client clients.ApplicationsManagementClient, format string) (*Runner, *output.MockOutput) {
+	outputSink := &output.MockOutput{}
+	runner := &Runner{
+		Runner: deploycmd.Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: client},
+			Output:            outputSink,
+			FilePath:          "app.bicep",
+			Parameters:        map[string]map[string]any{},
+			Template:          makeTestTemplate(),
+			Workspace: &workspaces.Workspace{
+				Name:  "test-workspace",
+				Scope: testScope,
+			},
+			Providers: &clients.Providers{
+				Radius: &clients.RadiusProvider{
+					EnvironmentID: testEnvironmentID,
+				},
+			},
+		},
+		Format: format,
+	}
+
+	return runner, outputSink
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("no changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResource(gomock.Any(), "Applications.Core/applications", testApplicationID).
+			Return(generated.GenericResource{
+				ID:         to.Ptr(testApplicationID),
+				Properties: map[string]any{"environment": testEnvironmentID},
+			}, nil).
+			Times(1)
+		client.EXPECT().
+			ListResourcesInApplication(gomock.Any(), testApplicationID).
+			Return([]generated.GenericResource{{ID: to.Ptr(testApplicationID)}}, nil).
+			Times(1)
+
+		runner, outputSink := makeTestRunner(client, output.FormatPlainText)
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "%s",
+				Params: []any{"No changes. The deployed resources match the template.\n"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResource(gomock.Any(), "Applications.Core/applications", testApplicationID).
+			Return(generated.GenericResource{}, radcli.Create404Error()).
+			Times(1)
+		client.EXPECT().
+			ListResourcesInApplication(gomock.Any(), testApplicationID).
+			Return(nil, radcli.Create404Error()).
+			Times(1)
+
+		runner, _ := makeTestRunner(client, output.FormatJson)
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Equal(t, 2, clierrors.GetExitCode(err))
+	})
+
+	t.Run("ignored resources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetResource(gomock.Any(), "Applications.Core/applications", testApplicationID).
+			Return(generated.GenericResource{
+				ID:         to.Ptr(testApplicationID),
+				Properties: map[string]any{"environment": testEnvironmentID},
+			}, nil).
+			Times(1)
+		client.EXPECT().
+			ListResourcesInApplication(gomock.Any(), testApplicationID).
+			Return([]generated.GenericResource{
+				{ID: to.Ptr(testContainerID), Name: to.Ptr("frontend"), Type: to.Ptr("Applications.Core/containers")},
+			}, nil).
+			Times(1)
+
+		runner, outputSink := makeTestRunner(client, output.FormatJson)
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: output.FormatJson,
+				Obj: &diff.Result{
+					Changes: []diff.ResourceChange{
+						{
+							ID:         testApplicationID,
+							Type:       "Applications.Core/applications",
+							Name:       "my-app",
+							ChangeType: diff.ChangeTypeNoChange,
+							Properties: []diff.PropertyChange{},
+						},
+						{
+							ID:         testContainerID,
+							Type:       "Applications.Core/containers",
+							Name:       "frontend",
+							ChangeType: diff.ChangeTypeIgnore,
+						},
+					},
+					Warnings: []string{},
+				},
+				Options: output.FormatterOptions{},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
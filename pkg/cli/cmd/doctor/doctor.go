@@ -0,0 +1,322 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/doctor"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	"github.com/radius-project/radius/pkg/version"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// defaultPlaneName is the name of the Radius plane used when the scope of the workspace does not specify one.
+	defaultPlaneName = "local"
+
+	checkStatusPassed = "Passed"
+	checkStatusFailed = "Failed"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad doctor` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose the rad CLI, the current workspace and the Radius control plane",
+		Long: `Diagnose the rad CLI, the current workspace and the Radius control plane.
+
+The doctor command runs a set of checks and reports the result of each of them, with an action to take for every check
+that fails. The checks verify that:
+
+- The Kubernetes cluster of the workspace is reachable and Radius is installed.
+- The pods of the Radius control plane are running and ready.
+- The custom resource definitions of Radius are present.
+- The Universal Control Plane (UCP) is reachable.
+- The registered cloud provider credentials are valid.
+- The recipes of the environment of the workspace can be resolved.
+- The Bicep compiler is present.
+- The local clock is synchronized with the clock of the cluster.
+
+Checks with the Error severity make the command fail. Use '--output json' to produce a report to attach to a support ticket.`,
+		Example: `
+# Diagnose the current workspace
+rad doctor
+
+# Diagnose a specific workspace
+rad doctor --workspace my-workspace
+
+# Produce a JSON report for a support ticket
+rad doctor --output json > rad-doctor.json
+`,
+		Args: cobra.NoArgs,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad doctor` command.
+type Runner struct {
+	Bicep             bicep.Interface
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Helm              helm.Interface
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	Format      string
+	KubeContext string
+
+	// Checks are the checks to run. The checks of the workspace are created if it is not set.
+	Checks []preflight.PreflightCheck
+}
+
+// Report is the output of the `rad doctor` command.
+type Report struct {
+	CLI       CLIReport       `json:"cli"`
+	Workspace WorkspaceReport `json:"workspace"`
+	Checks    []CheckReport   `json:"checks"`
+}
+
+// CLIReport describes the rad CLI running the checks.
+type CLIReport struct {
+	Release string `json:"release"`
+	Version string `json:"version"`
+	Commit  string `json:"commit"`
+}
+
+// WorkspaceReport describes the workspace the checks ran against.
+type WorkspaceReport struct {
+	Name        string `json:"name,omitempty"`
+	Connection  string `json:"connection"`
+	Scope       string `json:"scope,omitempty"`
+	Environment string `json:"environment,omitempty"`
+}
+
+// CheckReport is the result of a check.
+type CheckReport struct {
+	Name        string `json:"name"`
+	Severity    string `json:"severity"`
+	Status      string `json:"status"`
+	Message     string `json:"message"`
+	Error       string `json:"error,omitempty"`
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// NewRunner creates a new instance of the `rad doctor` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		Bicep:             factory.GetBicep(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Helm:              factory.GetHelmInterface(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad doctor` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("The workspace %q does not connect to a Kubernetes cluster.", r.Workspace.Name)
+	}
+	r.KubeContext = kubeContext
+
+	return nil
+}
+
+// Run runs the `rad doctor` command.
+func (r *Runner) Run(ctx context.Context) error {
+	checks := r.Checks
+	if checks == nil {
+		var err error
+		checks, err = r.createChecks(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	registry := preflight.NewRegistry(r.Output)
+	for _, check := range checks {
+		registry.AddCheck(check)
+	}
+
+	report := r.newReport(registry.RunAllChecks(ctx))
+
+	// Structured formats write the full report, which includes the remediations, so that the output can be parsed. The
+	// table only has columns for the checks, so the remediations are written after it.
+	if r.Format != output.FormatTable {
+		err := r.Output.WriteFormatted(r.Format, report, output.FormatterOptions{})
+		if err != nil {
+			return err
+		}
+	} else {
+		err := r.Output.WriteFormatted(r.Format, report.Checks, checkFormat())
+		if err != nil {
+			return err
+		}
+
+		r.writeRemediations(report.Checks)
+	}
+
+	failed := 0
+	for _, check := range report.Checks {
+		if check.Status == checkStatusFailed && check.Severity == string(preflight.SeverityError) {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return clierrors.Message("%d checks with the %s severity failed.", failed, preflight.SeverityError)
+	}
+
+	return nil
+}
+
+// createChecks creates the checks of the workspace.
+func (r *Runner) createChecks(ctx context.Context) ([]preflight.PreflightCheck, error) {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialClient, err := r.ConnectionFactory.CreateCredentialManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return []preflight.PreflightCheck{
+		preflight.NewKubernetesConnectivityCheck(r.KubeContext),
+		preflight.NewRadiusInstallationCheck(r.Helm, r.KubeContext),
+		doctor.NewControlPlaneCheck(r.KubeContext),
+		doctor.NewCRDCheck(r.KubeContext),
+		doctor.NewUCPCheck(client, r.planeName()),
+		doctor.NewCredentialCheck(credentialClient),
+		doctor.NewRecipeCheck(client, r.Workspace.Environment),
+		doctor.NewBicepCheck(r.Bicep),
+		doctor.NewClockSkewCheck(r.KubeContext),
+	}, nil
+}
+
+// planeName returns the name of the Radius plane of the scope of the workspace.
+func (r *Runner) planeName() string {
+	scope, err := resources.ParseScope(r.Workspace.Scope)
+	if err != nil || scope.FindScope("radius") == "" {
+		return defaultPlaneName
+	}
+
+	return scope.FindScope("radius")
+}
+
+// newReport creates the report of the workspace from the results of the checks.
+func (r *Runner) newReport(results []preflight.CheckResult) Report {
+	report := Report{
+		CLI: CLIReport{
+			Release: version.Release(),
+			Version: version.Version(),
+			Commit:  version.Commit(),
+		},
+		Workspace: WorkspaceReport{
+			Name:        r.Workspace.Name,
+			Connection:  r.Workspace.FmtConnection(),
+			Scope:       r.Workspace.Scope,
+			Environment: r.Workspace.Environment,
+		},
+		Checks: []CheckReport{},
+	}
+
+	for _, result := range results {
+		check := CheckReport{
+			Name:        result.Check.Name(),
+			Severity:    string(result.Severity),
+			Status:      checkStatusPassed,
+			Message:     result.Message,
+			Remediation: result.Remediation,
+		}
+
+		if result.Failed() {
+			check.Status = checkStatusFailed
+		}
+
+		if result.Error != nil {
+			check.Error = result.Error.Error()
+			if check.Message == "" {
+				check.Message = check.Error
+			}
+		}
+
+		report.Checks = append(report.Checks, check)
+	}
+
+	return report
+}
+
+// writeRemediations writes the actions to take for the checks that failed.
+func (r *Runner) writeRemediations(checks []CheckReport) {
+	lines := []string{}
+	for _, check := range checks {
+		if check.Status != checkStatusFailed {
+			continue
+		}
+
+		reason := check.Message
+		if check.Error != "" && check.Error != check.Message {
+			reason = fmt.Sprintf("%s (%s)", check.Message, check.Error)
+		}
+
+		lines = append(lines, fmt.Sprintf("- %s [%s]: %s", check.Name, check.Severity, reason))
+		if check.Remediation != "" {
+			lines = append(lines, fmt.Sprintf("  Remediation: %s", check.Remediation))
+		}
+	}
+
+	if len(lines) == 0 {
+		r.Output.LogInfo("\nAll checks passed.")
+		return
+	}
+
+	r.Output.LogInfo("\nFailed checks:\n%s", strings.Join(lines, "\n"))
+}
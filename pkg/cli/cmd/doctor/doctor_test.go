@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+)
+
+// testCheck implements RemediableCheck for testing
+type testCheck struct {
+	name     string
+	severity preflight.CheckSeverity
+	success  bool
+	message  string
+	err      error
+}
+
+func (c *testCheck) Name() string                      { return c.name }
+func (c *testCheck) Severity() preflight.CheckSeverity { return c.severity }
+func (c *testCheck) Remediation() string               { return "Fix " + c.name }
+func (c *testCheck) Run(ctx context.Context) (bool, string, error) {
+	return c.success, c.message, c.err
+}
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "rad doctor - valid",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, output.FormatTable, runner.(*Runner).Format)
+				require.Equal(t, "test-context", runner.(*Runner).KubeContext)
+			},
+		},
+		{
+			Name:          "rad doctor - valid with json output",
+			Input:         []string{"-o", "json"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, output.FormatJson, runner.(*Runner).Format)
+			},
+		},
+		{
+			Name:          "rad doctor - fallback workspace",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "rad doctor - too many args",
+			Input:         []string{"foo"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Name: "test-workspace",
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "test-context",
+		},
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	t.Run("all checks pass", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:    outputSink,
+			Workspace: workspace,
+			Format:    output.FormatTable,
+			Checks: []preflight.PreflightCheck{
+				&testCheck{name: "Check 1", severity: preflight.SeverityError, success: true, message: "Check 1 passed"},
+			},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: output.FormatTable,
+				Obj: []CheckReport{
+					{Name: "Check 1", Severity: "Error", Status: "Passed", Message: "Check 1 passed"},
+				},
+				Options: checkFormat(),
+			},
+			output.LogOutput{
+				Format: "\nAll checks passed.",
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("warning check fails", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:    outputSink,
+			Workspace: workspace,
+			Format:    output.FormatTable,
+			Checks: []preflight.PreflightCheck{
+				&testCheck{name: "Check 1", severity: preflight.SeverityWarning, success: false, message: "Check 1 failed"},
+			},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, outputSink.Writes, 2)
+		require.Equal(t, output.LogOutput{
+			Format: "\nFailed checks:\n%s",
+			Params: []any{"- Check 1 [Warning]: Check 1 failed\n  Remediation: Fix Check 1"},
+		}, outputSink.Writes[1])
+	})
+
+	t.Run("error check fails with json output", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:    outputSink,
+			Workspace: workspace,
+			Format:    output.FormatJson,
+			Checks: []preflight.PreflightCheck{
+				&testCheck{name: "Check 1", severity: preflight.SeverityError, success: false, err: errors.New("connection refused")},
+				&testCheck{name: "Check 2", severity: preflight.SeverityWarning, success: true, message: "Check 2 passed"},
+			},
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.Message("%d checks with the %s severity failed.", 1, preflight.SeverityError), err)
+
+		require.Len(t, outputSink.Writes, 1)
+		report := outputSink.Writes[0].(output.FormattedOutput).Obj.(Report)
+		require.Equal(t, WorkspaceReport{
+			Name:       "test-workspace",
+			Connection: workspace.FmtConnection(),
+			Scope:      "/planes/radius/local/resourceGroups/test-group",
+		}, report.Workspace)
+		require.Equal(t, []CheckReport{
+			{Name: "Check 1", Severity: "Error", Status: "Failed", Message: "connection refused", Error: "connection refused", Remediation: "Fix Check 1"},
+			{Name: "Check 2", Severity: "Warning", Status: "Passed", Message: "Check 2 passed"},
+		}, report.Checks)
+	})
+
+	t.Run("warning check fails with yaml output", func(t *testing.T) {
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Output:    outputSink,
+			Workspace: workspace,
+			Format:    output.FormatYaml,
+			Checks: []preflight.PreflightCheck{
+				&testCheck{name: "Check 1", severity: preflight.SeverityWarning, success: false, message: "Check 1 failed"},
+			},
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		// The full report is written without the remediations text, so that the output can be parsed.
+		require.Len(t, outputSink.Writes, 1)
+		formatted := outputSink.Writes[0].(output.FormattedOutput)
+		require.Equal(t, output.FormatYaml, formatted.Format)
+		require.Equal(t, []CheckReport{
+			{Name: "Check 1", Severity: "Warning", Status: "Failed", Message: "Check 1 failed", Remediation: "Fix Check 1"},
+		}, formatted.Obj.(Report).Checks)
+	})
+}
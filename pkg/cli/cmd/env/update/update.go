@@ -102,6 +102,7 @@ type Runner struct {
 	Output            output.Interface
 
 	EnvName       string
+	Format        string
 	clearEnvAzure bool
 	clearEnvAws   bool
 	providers     *corerp.Providers
@@ -144,6 +145,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	r.Format, err = cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
 	// TODO: Validate Azure scope components (https://github.com/radius-project/radius/issues/5155)
 	if cmd.Flags().Changed(commonflags.AzureSubscriptionIdFlag) || cmd.Flags().Changed(commonflags.AzureResourceGroupFlag) {
 		azureSubId, err := cli.RequireAzureSubscriptionId(cmd)
@@ -262,7 +268,12 @@ func (r *Runner) Run(ctx context.Context) error {
 		Providers:   providerCount,
 	}
 
-	err = r.Output.WriteFormatted("table", obj, environmentFormat())
+	// The table summarizes the environment, structured formats include the whole environment.
+	if r.Format == output.FormatTable {
+		err = r.Output.WriteFormatted(r.Format, obj, environmentFormat())
+	} else {
+		err = r.Output.WriteFormatted(r.Format, env, output.FormatterOptions{})
+	}
 	if err != nil {
 		return err
 	}
@@ -149,6 +149,7 @@ func Test_Update(t *testing.T) {
 			Workspace:         workspace,
 			Output:            outputSink,
 			EnvName:           "test-env",
+			Format:            output.FormatTable,
 			providers:         testProviders,
 		}
 
@@ -201,6 +202,7 @@ func Test_Update(t *testing.T) {
 			Workspace:         workspace,
 			Output:            outputSink,
 			EnvName:           "test-env",
+			Format:            output.FormatTable,
 			providers:         testProviders,
 		}
 
@@ -264,6 +266,7 @@ func Test_Update(t *testing.T) {
 			Workspace:         workspace,
 			Output:            outputSink,
 			EnvName:           "test-env",
+			Format:            output.FormatTable,
 			providers:         testProviders,
 		}
 
@@ -334,6 +337,7 @@ func Test_Update(t *testing.T) {
 			Workspace:         workspace,
 			Output:            outputSink,
 			EnvName:           "test-env",
+			Format:            output.FormatTable,
 			providers:         testProviders,
 		}
 
@@ -366,6 +370,65 @@ func Test_Update(t *testing.T) {
 		require.Equal(t, expected, outputSink.Writes)
 	})
 
+	t.Run("Success: Update Environment With JSON Output", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		environment := corerp.EnvironmentResource{
+			Name: to.Ptr("test-env"),
+			Properties: &corerp.EnvironmentProperties{
+				Recipes: map[string]map[string]corerp.RecipePropertiesClassification{},
+			},
+		}
+
+		testProviders := &corerp.Providers{
+			Azure: &corerp.ProvidersAzure{
+				Scope: to.Ptr("/subscriptions/testSubId/resourceGroups/test-group"),
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetEnvironment(gomock.Any(), "test-env").
+			Return(environment, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			CreateOrUpdateEnvironment(gomock.Any(), "test-env", gomock.Any()).
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Output:            outputSink,
+			EnvName:           "test-env",
+			Format:            output.FormatJson,
+			providers:         testProviders,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		environment.Properties.Providers = testProviders
+		expected := []any{
+			output.LogOutput{
+				Format: "Updating Environment...",
+			},
+			output.FormattedOutput{
+				Format:  output.FormatJson,
+				Obj:     environment,
+				Options: output.FormatterOptions{},
+			},
+			output.LogOutput{
+				Format: "Successfully updated environment %q.",
+				Params: []any{"test-env"},
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
 	t.Run("Update Environment With Existing Providers", func(t *testing.T) {
 		testCases := []struct {
 			name              string
@@ -503,6 +566,7 @@ func Test_Update(t *testing.T) {
 					Workspace:         workspace,
 					Output:            outputSink,
 					EnvName:           "test-env",
+					Format:            output.FormatTable,
 					providers:         tc.expectedProviders,
 					clearEnvAzure:     tc.clearEnvAzure,
 				}
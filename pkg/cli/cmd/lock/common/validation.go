@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package common
+
+import (
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/spf13/cobra"
+)
+
+// RequireResourceGroup returns the name of the resource group that contains the locks, which is read from the `--group`
+// flag or the scope of the workspace.
+func RequireResourceGroup(cmd *cobra.Command, workspace workspaces.Workspace) (string, error) {
+	scope, err := cli.RequireScope(cmd, workspace)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := resources.ParseScope(scope)
+	if err != nil {
+		return "", err
+	}
+
+	resourceGroup := id.FindScope(resources_radius.ScopeResourceGroups)
+	if resourceGroup == "" {
+		return "", clierrors.Message("The scope %q is not a resource group, use `--group` to pass in a resource group name.", scope)
+	}
+
+	return resourceGroup, nil
+}
+
+// ValidateLockLevel validates the lock level and returns it with the canonical casing.
+func ValidateLockLevel(level string) (v20231001preview.LockLevel, error) {
+	supported := []string{}
+	for _, value := range v20231001preview.PossibleLockLevelValues() {
+		if strings.EqualFold(level, string(value)) {
+			return value, nil
+		}
+		supported = append(supported, string(value))
+	}
+
+	return "", clierrors.Message("The lock level %q is not supported. Supported levels: %s.", level, strings.Join(supported, ", "))
+}
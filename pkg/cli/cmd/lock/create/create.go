@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package create
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/lock/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad lock create` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "create lockname",
+		Short: "Create or update a management lock",
+		Long: `Create or update a management lock
+
+The lock is created in the resource group specified with the --group flag, or the resource group of the workspace. By default the lock applies to every resource in the resource group. Use the --resource flag to lock a single resource and its child resources. A lock on an application or environment also protects the resources in it.
+
+- A CanNotDelete lock blocks deleting the locked resources.
+- A ReadOnly lock blocks creating, updating and deleting the locked resources, and invoking actions on them.
+`,
+		Example: `
+# Protect every resource in the default resource group from deletion
+rad lock create protect-all --level CanNotDelete
+
+# Make an environment read-only
+rad lock create freeze-env --level ReadOnly --resource /planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod -g prod --notes "Production environment"
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().String("level", "", "The level of the lock: CanNotDelete or ReadOnly")
+	_ = cmd.MarkFlagRequired("level")
+	cmd.Flags().String("resource", "", "The resource ID of the resource to lock. Defaults to every resource in the resource group")
+	cmd.Flags().String("notes", "", "Notes about the lock")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad lock create` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ResourceGroupName string
+	LockName          string
+	Level             v20231001preview.LockLevel
+	ResourceID        string
+	Notes             string
+}
+
+// NewRunner creates a new instance of the `rad lock create` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad lock create` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := common.RequireResourceGroup(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+
+	level, err := cmd.Flags().GetString("level")
+	if err != nil {
+		return err
+	}
+
+	r.Level, err = common.ValidateLockLevel(level)
+	if err != nil {
+		return err
+	}
+
+	r.ResourceID, err = cmd.Flags().GetString("resource")
+	if err != nil {
+		return err
+	}
+
+	if r.ResourceID != "" {
+		id, err := resources.Parse(r.ResourceID)
+		if err != nil {
+			return clierrors.Message("The resource %q is not a valid resource ID.", r.ResourceID)
+		}
+
+		r.ResourceID = id.String()
+	}
+
+	r.Notes, err = cmd.Flags().GetString("notes")
+	if err != nil {
+		return err
+	}
+
+	r.Workspace = workspace
+	r.ResourceGroupName = resourceGroup
+	r.LockName = args[0]
+
+	return nil
+}
+
+// Run runs the `rad lock create` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Creating lock %q in resource group %q...", r.LockName, r.ResourceGroupName)
+
+	lock := &v20231001preview.LockResource{
+		Properties: &v20231001preview.LockProperties{
+			Level: to.Ptr(r.Level),
+		},
+	}
+	if r.ResourceID != "" {
+		lock.Properties.ResourceID = to.Ptr(r.ResourceID)
+	}
+	if r.Notes != "" {
+		lock.Properties.Notes = to.Ptr(r.Notes)
+	}
+
+	err = client.CreateOrUpdateLock(ctx, "local", r.ResourceGroupName, r.LockName, lock)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Lock %q created.", r.LockName)
+	return nil
+}
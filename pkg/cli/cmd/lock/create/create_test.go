@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package create
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Create Command with valid args",
+			Input:         []string{"protect", "--level", "CanNotDelete"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "test-resource-group", r.ResourceGroupName)
+				require.Equal(t, "protect", r.LockName)
+				require.Equal(t, v20231001preview.LockLevelCanNotDelete, r.Level)
+				require.Empty(t, r.ResourceID)
+			},
+		},
+		{
+			Name:          "Create Command with resource and case-insensitive level",
+			Input:         []string{"freeze", "--level", "readonly", "--resource", "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Core/applications/app", "--notes", "frozen"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, v20231001preview.LockLevelReadOnly, r.Level)
+				require.Equal(t, "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Core/applications/app", r.ResourceID)
+				require.Equal(t, "frozen", r.Notes)
+			},
+		},
+		{
+			Name:          "Create Command with group flag and fallback workspace",
+			Input:         []string{"protect", "--level", "CanNotDelete", "-g", "prod"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "prod", r.ResourceGroupName)
+			},
+		},
+		{
+			Name:          "Create Command without resource group",
+			Input:         []string{"protect", "--level", "CanNotDelete"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+		{
+			Name:          "Create Command without level",
+			Input:         []string{"protect"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create Command with invalid level",
+			Input:         []string{"protect", "--level", "NoDelete"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create Command with invalid resource",
+			Input:         []string{"protect", "--level", "ReadOnly", "--resource", "app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Create Command with too many args",
+			Input:         []string{"a", "b", "--level", "ReadOnly"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Create lock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		resourceID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/app"
+		expected := &v20231001preview.LockResource{
+			Properties: &v20231001preview.LockProperties{
+				Level:      to.Ptr(v20231001preview.LockLevelReadOnly),
+				ResourceID: to.Ptr(resourceID),
+				Notes:      to.Ptr("frozen"),
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			CreateOrUpdateLock(gomock.Any(), "local", "test-group", "freeze", expected).
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Name: "kind-kind"},
+			Output:            outputSink,
+			ResourceGroupName: "test-group",
+			LockName:          "freeze",
+			Level:             v20231001preview.LockLevelReadOnly,
+			ResourceID:        resourceID,
+			Notes:             "frozen",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expectedOutput := []any{
+			output.LogOutput{
+				Format: "Creating lock %q in resource group %q...",
+				Params: []any{"freeze", "test-group"},
+			},
+			output.LogOutput{
+				Format: "Lock %q created.",
+				Params: []any{"freeze"},
+			},
+		}
+		require.Equal(t, expectedOutput, outputSink.Writes)
+	})
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/lock/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/prompt"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+)
+
+const (
+	deleteConfirmationMsg = "Are you sure you want to delete lock %q? The resources it protects can be changed or deleted afterwards."
+	msgDeletingLock       = "Deleting lock %s...\n"
+	msgLockDeleted        = "Lock %s deleted."
+	msgLockNotFound       = "Lock %s does not exist or has already been deleted."
+	msgLockNotDeleted     = "Lock %q NOT deleted"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad lock delete` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "delete lockname",
+		Short: "Delete a management lock",
+		Long:  "Delete a management lock from the resource group specified with the --group flag, or the resource group of the workspace.",
+		Example: `
+# Delete a lock
+rad lock delete protect-all
+
+# Delete a lock in the specified resource group and bypass the confirmation prompt
+rad lock delete protect-all -g prod --yes
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddConfirmationFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad lock delete` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	InputPrompter     prompt.Interface
+	Workspace         *workspaces.Workspace
+	ResourceGroupName string
+	LockName          string
+	Confirm           bool
+}
+
+// NewRunner creates a new instance of the `rad lock delete` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+		InputPrompter:     factory.GetPrompter(),
+	}
+}
+
+// Validate runs validation for the `rad lock delete` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	resourceGroup, err := common.RequireResourceGroup(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Confirm, err = cmd.Flags().GetBool("yes")
+	if err != nil {
+		return err
+	}
+
+	r.Workspace = workspace
+	r.ResourceGroupName = resourceGroup
+	r.LockName = args[0]
+
+	return nil
+}
+
+// Run runs the `rad lock delete` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	if !r.Confirm {
+		confirmed, err := prompt.YesOrNoPrompt(fmt.Sprintf(deleteConfirmationMsg, r.LockName), prompt.ConfirmNo, r.InputPrompter)
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			r.Output.LogInfo(msgLockNotDeleted, r.LockName)
+			return nil
+		}
+	}
+
+	r.Output.LogInfo(msgDeletingLock, r.LockName)
+
+	deleted, err := client.DeleteLock(ctx, "local", r.ResourceGroupName, r.LockName)
+	if err != nil {
+		return fmt.Errorf("failed to delete lock %s: %w", r.LockName, err)
+	}
+
+	if deleted {
+		r.Output.LogInfo(msgLockDeleted, r.LockName)
+	} else {
+		r.Output.LogInfo(msgLockNotFound, r.LockName)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package lock
+
+import (
+	lock_create "github.com/radius-project/radius/pkg/cli/cmd/lock/create"
+	lock_delete "github.com/radius-project/radius/pkg/cli/cmd/lock/delete"
+	lock_list "github.com/radius-project/radius/pkg/cli/cmd/lock/list"
+	lock_show "github.com/radius-project/radius/pkg/cli/cmd/lock/show"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates a new cobra command for managing management locks, with subcommands for creating, deleting, listing
+// and showing locks.
+func NewCommand(factory framework.Factory) *cobra.Command {
+	// This command is not runnable, and thus has no runner.
+	cmd := &cobra.Command{
+		Use:   "lock",
+		Short: "Manage management locks",
+		Long: `Manage management locks
+
+Management locks protect resource groups and the resources in them from accidental changes. A lock is created in a resource group and applies to every resource in it, or to a single resource (such as an application or environment) and its child resources.
+
+- A CanNotDelete lock blocks deleting the locked resources.
+- A ReadOnly lock blocks creating, updating and deleting the locked resources, and invoking actions on them.
+
+Deleting a resource group is blocked while it contains any lock. Remove the lock to make changes again.
+`,
+		Example: `
+# Protect every resource in the default resource group from deletion
+rad lock create protect-all --level CanNotDelete
+
+# Make an application read-only
+rad lock create freeze-app --level ReadOnly --resource /planes/radius/local/resourceGroups/prod/providers/Applications.Core/applications/myapp -g prod
+
+# List locks in the default resource group
+rad lock list
+
+# Show details of a lock
+rad lock show protect-all
+
+# Delete a lock
+rad lock delete protect-all
+`,
+	}
+
+	create, _ := lock_create.NewCommand(factory)
+	cmd.AddCommand(create)
+
+	delete, _ := lock_delete.NewCommand(factory)
+	cmd.AddCommand(delete)
+
+	list, _ := lock_list.NewCommand(factory)
+	cmd.AddCommand(list)
+
+	show, _ := lock_show.NewCommand(factory)
+	cmd.AddCommand(show)
+
+	return cmd
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// maxPageSize is the maximum number of resources the query API returns in a page.
+	maxPageSize = 1000
+)
+
+// NewCommand creates an instance of the command and runner for the `rad query` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "query [filter]",
+		Short: "Query resources",
+		Long: `Query the resources of the local plane across resource groups and resource types.
+
+The filter is an expression over the resource type, ID, name, location, tags and properties of each resource. Comparisons use the operators '==', '!=', '=~' and '!~' (case-insensitive equality), 'contains', 'startswith', 'endswith' and 'in', and can be combined with 'and', 'or', 'not' and parentheses. String literals are single-quoted.
+
+Use --group or --scope to only query the resources in a resource group or scope, and --select to choose the properties to show.`,
+		Example: `
+# Query all resources
+rad query
+
+# Query all Redis caches in the prod environment across every resource group
+rad query "type == 'Applications.Datastores/redisCaches' and properties.environment endswith '/environments/prod'"
+
+# Query the resources owned by a team in a resource group, showing their names and hosts
+rad query "tags.team == 'payments'" -g prod --select name --select properties.host
+
+# Query the first 10 containers in JSON format
+rad query "type =~ 'applications.core/containers'" --top 10 -o json
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().StringArray("scope", []string{}, "The resource ID of a scope to query, for example '/planes/radius/local/resourceGroups/prod'. Can be specified multiple times")
+	cmd.Flags().StringArray("select", []string{}, "A property path to show for each resource, for example 'properties.host'. Can be specified multiple times")
+	cmd.Flags().Int("top", 0, "The maximum number of resources to return. Returns every matching resource by default")
+
+	cmd.MarkFlagsMutuallyExclusive("group", "scope")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad query` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Filter            string
+	Scopes            []string
+	Select            []string
+	Top               int
+	Format            string
+}
+
+// NewRunner creates a new instance of the `rad query` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad query` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+
+	r.Workspace = workspace
+	r.Format = format
+
+	if len(args) > 0 {
+		r.Filter = args[0]
+	}
+
+	if cmd.Flags().Changed("group") {
+		scope, err := cli.RequireScope(cmd, *workspace)
+		if err != nil {
+			return err
+		}
+		r.Scopes = []string{scope}
+	} else {
+		r.Scopes, err = cmd.Flags().GetStringArray("scope")
+		if err != nil {
+			return err
+		}
+
+		for _, scope := range r.Scopes {
+			if _, err := resources.ParseScope(scope); err != nil {
+				return clierrors.Message("The scope %q is not a valid resource ID.", scope)
+			}
+		}
+	}
+
+	r.Select, err = cmd.Flags().GetStringArray("select")
+	if err != nil {
+		return err
+	}
+
+	r.Top, err = cmd.Flags().GetInt("top")
+	if err != nil {
+		return err
+	}
+
+	if r.Top < 0 {
+		return clierrors.Message("The value %d of the '--top' flag is invalid. Specify a positive number.", r.Top)
+	}
+
+	return nil
+}
+
+// Run runs the `rad query` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	request := v20231001preview.ResourceQueryRequest{
+		Scopes: to.SliceOfPtrs(r.Scopes...),
+		Select: to.SliceOfPtrs(r.Select...),
+	}
+	if r.Filter != "" {
+		request.Filter = &r.Filter
+	}
+
+	results := []map[string]any{}
+	for {
+		if r.Top > 0 {
+			request.Top = to.Ptr(int32(min(r.Top-len(results), maxPageSize)))
+		}
+
+		page, err := client.QueryResources(ctx, "local", request)
+		if err != nil {
+			return err
+		}
+
+		results = append(results, page.Data...)
+		if page.SkipToken == nil || (r.Top > 0 && len(results) >= r.Top) {
+			break
+		}
+
+		request.SkipToken = page.SkipToken
+	}
+
+	return r.Output.WriteFormatted(r.Format, results, resourceFormat(r.Select))
+}
+
+// resourceFormat returns the columns of the table of resources. The selected property paths are shown when they are
+// specified, otherwise the name, type and ID of each resource are shown.
+func resourceFormat(paths []string) output.FormatterOptions {
+	if len(paths) == 0 {
+		return output.FormatterOptions{
+			Columns: []output.Column{
+				{
+					Heading:  "RESOURCE",
+					JSONPath: "{ .name }",
+				},
+				{
+					Heading:  "TYPE",
+					JSONPath: "{ .type }",
+				},
+				{
+					Heading:  "ID",
+					JSONPath: "{ .id }",
+				},
+			},
+		}
+	}
+
+	columns := []output.Column{}
+	for _, path := range paths {
+		// The query API returns each selected property keyed by its path, so the dots are escaped to match a single key.
+		columns = append(columns, output.Column{
+			Heading:  strings.ToUpper(path),
+			JSONPath: fmt.Sprintf("{ .%s }", strings.ReplaceAll(path, ".", `\.`)),
+		})
+	}
+
+	return output.FormatterOptions{Columns: columns}
+}
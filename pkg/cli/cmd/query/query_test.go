@@ -0,0 +1,235 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	prodCacheID = "/planes/radius/local/resourceGroups/prod/providers/Applications.Datastores/redisCaches/cache"
+	devCacheID  = "/planes/radius/local/resourceGroups/dev/providers/Applications.Datastores/redisCaches/cache"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Query Command without filter",
+			Input:         []string{},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Empty(t, runner.(*Runner).Filter)
+				require.Empty(t, runner.(*Runner).Scopes)
+				require.Empty(t, runner.(*Runner).Select)
+				require.Zero(t, runner.(*Runner).Top)
+			},
+		},
+		{
+			Name:          "Query Command with filter and group flag",
+			Input:         []string{"type == 'Applications.Datastores/redisCaches'", "-g", "prod"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, "type == 'Applications.Datastores/redisCaches'", runner.(*Runner).Filter)
+				require.Equal(t, []string{"/planes/radius/local/resourceGroups/prod"}, runner.(*Runner).Scopes)
+			},
+		},
+		{
+			Name:          "Query Command with scope, select and top flags",
+			Input:         []string{"--scope", "/planes/radius/local/resourceGroups/prod", "--scope", "/planes/radius/local/resourceGroups/dev", "--select", "name", "--select", "properties.host", "--top", "10"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, []string{"/planes/radius/local/resourceGroups/prod", "/planes/radius/local/resourceGroups/dev"}, runner.(*Runner).Scopes)
+				require.Equal(t, []string{"name", "properties.host"}, runner.(*Runner).Select)
+				require.Equal(t, 10, runner.(*Runner).Top)
+			},
+		},
+		{
+			Name:          "Query Command with invalid scope",
+			Input:         []string{"--scope", "prod"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Query Command with negative top",
+			Input:         []string{"--top", "-1"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Query Command with too many args",
+			Input:         []string{"type == 'a'", "type == 'b'"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Query every page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		filter := "type == 'Applications.Datastores/redisCaches'"
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			QueryResources(gomock.Any(), "local", v20231001preview.ResourceQueryRequest{
+				Filter: &filter,
+				Scopes: []*string{},
+				Select: []*string{},
+			}).
+			Return(v20231001preview.ResourceQueryResponse{
+				Count:     to.Ptr(int64(1)),
+				Data:      []map[string]any{{"id": devCacheID}},
+				SkipToken: to.Ptr("1"),
+			}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			QueryResources(gomock.Any(), "local", v20231001preview.ResourceQueryRequest{
+				Filter:    &filter,
+				Scopes:    []*string{},
+				Select:    []*string{},
+				SkipToken: to.Ptr("1"),
+			}).
+			Return(v20231001preview.ResourceQueryResponse{
+				Count: to.Ptr(int64(1)),
+				Data:  []map[string]any{{"id": prodCacheID}},
+			}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Name: "kind-kind"},
+			Output:            outputSink,
+			Filter:            filter,
+			Format:            "table",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     []map[string]any{{"id": devCacheID}, {"id": prodCacheID}},
+				Options: resourceFormat(nil),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Query top resources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			QueryResources(gomock.Any(), "local", v20231001preview.ResourceQueryRequest{
+				Scopes: to.SliceOfPtrs("/planes/radius/local/resourceGroups/prod"),
+				Select: to.SliceOfPtrs("id"),
+				Top:    to.Ptr(int32(1)),
+			}).
+			Return(v20231001preview.ResourceQueryResponse{
+				Count:     to.Ptr(int64(1)),
+				Data:      []map[string]any{{"id": prodCacheID}},
+				SkipToken: to.Ptr("1"),
+			}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Name: "kind-kind"},
+			Output:            outputSink,
+			Scopes:            []string{"/planes/radius/local/resourceGroups/prod"},
+			Select:            []string{"id"},
+			Top:               1,
+			Format:            "json",
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     []map[string]any{{"id": prodCacheID}},
+				Options: resourceFormat([]string{"id"}),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
+
+func Test_resourceFormat(t *testing.T) {
+	resources := []map[string]any{
+		{"name": "cache", "type": "Applications.Datastores/redisCaches", "id": prodCacheID},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, resources, buffer, resourceFormat(nil))
+	require.NoError(t, err)
+	require.Equal(t, "RESOURCE  TYPE                                 ID\ncache     Applications.Datastores/redisCaches  "+prodCacheID+"\n", buffer.String())
+
+	selected := []map[string]any{
+		{"name": "cache", "properties.host": "cache.prod.svc.cluster.local"},
+	}
+
+	buffer = &bytes.Buffer{}
+	err = output.Write(output.FormatTable, selected, buffer, resourceFormat([]string{"name", "properties.host"}))
+	require.NoError(t, err)
+	require.Equal(t, "NAME      PROPERTIES.HOST\ncache     cache.prod.svc.cluster.local\n", buffer.String())
+}
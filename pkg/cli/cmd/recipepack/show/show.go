@@ -130,7 +130,7 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	if r.Format != "json" {
+	if r.Format == output.FormatPlainText || r.Format == output.FormatTable {
 		err = r.Output.WriteFormatted(output.FormatTable, recipePack, objectformats.GetRecipePackTableFormat())
 		if err != nil {
 			return err
@@ -0,0 +1,216 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exec
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/util/exec"
+	"k8s.io/kubectl/pkg/util/term"
+)
+
+const (
+	// containerType is the only resource type supported by the command.
+	containerType = "Applications.Core/containers"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource exec` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "exec [resourceType] [resourceName] -- [command]",
+		Short: "Run a command in a running containers resource",
+		Long: `Run a command in a running resource. Currently only supports the resource type 'Applications.Core/containers'.
+
+The command runs in a running replica of the resource, in the resource's primary container. In scenarios like Dapr where
+multiple containers are in use, the '--container <name>' option can specify the desired container. Use '--replica' to
+run the command in a specific replica.
+
+Specify '--stdin' to pass the standard input to the command and '--tty' to allocate a terminal, for example to run an
+interactive shell. The exit code of the command is the exit code of 'rad resource exec'.`,
+		Example: `
+# list the files in the working directory of the 'webapp' resource of the current default app
+rad resource exec Applications.Core/containers webapp -- ls -la
+
+# run an interactive shell in the 'orders' resource of the 'icecream-store' application
+rad resource exec Applications.Core/containers orders --application icecream-store -it -- /bin/sh
+
+# run a command in the 'daprd' sidecar container of the 'orders' resource
+rad resource exec Applications.Core/containers orders --container daprd -- ./daprd --version
+`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	cmd.Flags().StringP("container", "c", "", "The container to run the command in. Defaults to the primary container of the resource")
+	cmd.Flags().String("replica", "", "The replica to run the command in. Defaults to a running replica of the resource")
+	cmd.Flags().BoolP("stdin", "i", false, "Pass the standard input to the command")
+	cmd.Flags().BoolP("tty", "t", false, "Allocate a terminal for the command")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource exec` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	ResourceName    string
+	Container       string
+	Replica         string
+	Command         []string
+	Stdin           bool
+	TTY             bool
+
+	// InputStream, OutputStream and ErrorStream are the standard streams of the command.
+	InputStream  io.Reader
+	OutputStream io.Writer
+	ErrorStream  io.Writer
+}
+
+// NewRunner creates a new instance of the `rad resource exec` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		InputStream:       os.Stdin,
+		OutputStream:      os.Stdout,
+		ErrorStream:       os.Stderr,
+	}
+}
+
+// Validate runs validation for the `rad resource exec` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplication(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+
+	dash := cmd.ArgsLenAtDash()
+	if dash != 2 || len(args) < 3 {
+		return clierrors.Message("Specify the resource type, the resource name and the command to run after '--', for example: rad resource exec %s <name> -- ls", containerType)
+	}
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args[:dash])
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(resourceProviderName+"/"+resourceTypeName, containerType) {
+		return clierrors.Message("Only %s is supported.", containerType)
+	}
+	r.ResourceName = resourceName
+	r.Command = args[dash:]
+
+	r.Container, err = cmd.Flags().GetString("container")
+	if err != nil {
+		return err
+	}
+
+	r.Replica, err = cmd.Flags().GetString("replica")
+	if err != nil {
+		return err
+	}
+
+	r.Stdin, err = cmd.Flags().GetBool("stdin")
+	if err != nil {
+		return err
+	}
+
+	r.TTY, err = cmd.Flags().GetBool("tty")
+	if err != nil {
+		return err
+	}
+
+	if r.TTY {
+		tty := term.TTY{In: r.InputStream}
+		if !r.Stdin {
+			r.Output.LogInfo("Unable to use a TTY: specify '--stdin' to pass the standard input to the command.")
+			r.TTY = false
+		} else if !tty.IsTerminalIn() {
+			r.Output.LogInfo("Unable to use a TTY: the standard input is not a terminal.")
+			r.TTY = false
+		}
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource exec` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateDiagnosticsClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	options := clients.ExecOptions{
+		Application: r.ApplicationName,
+		Resource:    r.ResourceName,
+		Replica:     r.Replica,
+		Container:   r.Container,
+		Command:     r.Command,
+		Stdout:      r.OutputStream,
+		Stderr:      r.ErrorStream,
+		TTY:         r.TTY,
+	}
+	if r.Stdin {
+		options.Stdin = r.InputStream
+	}
+
+	err = client.Exec(ctx, options)
+	var exitError exec.ExitError
+	if errors.As(err, &exitError) && exitError.Exited() {
+		// The command has written its output, return its exit code.
+		return clierrors.ExitCode(exitError.ExitStatus())
+	} else if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to run the command in resource %q.", r.ResourceName)
+	}
+
+	return nil
+}
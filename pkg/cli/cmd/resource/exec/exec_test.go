@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exec
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"k8s.io/client-go/util/exec"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Exec Command",
+			Input:         []string{"Applications.Core/containers", "frontend", "-a", "test-app", "--", "ls", "-la"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "test-app", runner.ApplicationName)
+				require.Equal(t, "frontend", runner.ResourceName)
+				require.Equal(t, []string{"ls", "-la"}, runner.Command)
+				require.False(t, runner.Stdin)
+				require.False(t, runner.TTY)
+			},
+		},
+		{
+			Name:          "Valid Exec Command with container and replica",
+			Input:         []string{"Applications.Core/containers", "frontend", "-a", "test-app", "-c", "daprd", "--replica", "frontend-abc", "--", "ls"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "daprd", runner.Container)
+				require.Equal(t, "frontend-abc", runner.Replica)
+			},
+		},
+		{
+			Name:          "Exec Command with TTY when input is not a terminal",
+			Input:         []string{"Applications.Core/containers", "frontend", "-a", "test-app", "-it", "--", "/bin/sh"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.True(t, runner.Stdin)
+				require.False(t, runner.TTY)
+			},
+		},
+		{
+			Name:          "Exec Command without command",
+			Input:         []string{"Applications.Core/containers", "frontend", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Exec Command without dash",
+			Input:         []string{"Applications.Core/containers", "frontend", "ls", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Exec Command with unsupported resource type",
+			Input:         []string{"Applications.Core/gateways", "gateway", "-a", "test-app", "--", "ls"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		stdin := strings.NewReader("input")
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			Exec(gomock.Any(), clients.ExecOptions{
+				Application: "test-app",
+				Resource:    "frontend",
+				Container:   "daprd",
+				Command:     []string{"cat"},
+				Stdin:       stdin,
+				Stdout:      stdout,
+				Stderr:      stderr,
+			}).
+			Return(nil).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DiagnosticsClient: diagnosticsClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{},
+			ApplicationName:   "test-app",
+			ResourceName:      "frontend",
+			Container:         "daprd",
+			Command:           []string{"cat"},
+			Stdin:             true,
+			InputStream:       stdin,
+			OutputStream:      stdout,
+			ErrorStream:       stderr,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("Command exits with an exit code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			Exec(gomock.Any(), gomock.Any()).
+			Return(exec.CodeExitError{Err: errors.New("command terminated with exit code 3"), Code: 3}).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DiagnosticsClient: diagnosticsClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{},
+			ApplicationName:   "test-app",
+			ResourceName:      "frontend",
+			Command:           []string{"false"},
+		}
+
+		err := runner.Run(context.Background())
+		require.Equal(t, clierrors.ExitCode(3), err)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			Exec(gomock.Any(), gomock.Any()).
+			Return(errors.New("failed to find a running replica for resource frontend")).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DiagnosticsClient: diagnosticsClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{},
+			ApplicationName:   "test-app",
+			ResourceName:      "frontend",
+			Command:           []string{"ls"},
+		}
+
+		err := runner.Run(context.Background())
+		require.True(t, clierrors.IsFriendlyError(err))
+		require.Contains(t, err.Error(), "Failed to run the command in resource \"frontend\".")
+	})
+}
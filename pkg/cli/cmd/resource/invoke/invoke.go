@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invoke
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the `rad resource invoke` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "invoke [resource type] [name] [action]",
+		Short: "Invoke a custom action of a resource",
+		Long: `Invoke a custom action of a resource
+
+Custom actions are declared by the resource type in its manifest, for example to list secrets or rotate a key.
+
+The request body of the action can be passed as a JSON string with the --data flag, or as a JSON file with the -f flag. The response of the action is printed as JSON.`,
+		Example: `
+# Invoke an action without a request body
+rad resource invoke 'MyCompany.Resources/databases' mydb listSecrets
+
+# Invoke an action with a request body
+rad resource invoke 'MyCompany.Resources/databases' mydb rotateKey --data '{"keyName": "primary"}'
+
+# Invoke an action with a request body (from file)
+rad resource invoke 'MyCompany.Resources/databases' mydb rotateKey -f /path/to/input.json`,
+		Args: cobra.ExactArgs(3),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddFromFileFlagVar(cmd, &runner.InputFilePath)
+	_ = cmd.MarkFlagFilename("from-file", "json")
+	cmd.Flags().StringVar(&runner.Data, "data", "", "The request body of the action as a JSON string")
+	cmd.MarkFlagsMutuallyExclusive("from-file", "data")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource invoke` command.
+type Runner struct {
+	ConnectionFactory connections.Factory
+	ConfigHolder      *framework.ConfigHolder
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	ActionName                     string
+	InputFilePath                  string
+	Data                           string
+	Body                           map[string]any
+}
+
+// NewRunner creates an instance of the runner for the `rad resource invoke` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource invoke` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+	r.ActionName = args[2]
+
+	data := []byte(r.Data)
+	if r.InputFilePath != "" {
+		data, err = os.ReadFile(r.InputFilePath)
+		if err != nil {
+			return clierrors.Message("Failed to read input file: %v", err)
+		}
+	}
+
+	if len(data) > 0 {
+		err = json.Unmarshal(data, &r.Body)
+		if err != nil {
+			return clierrors.Message("Invalid input, the request body must be a JSON object: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource invoke` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	response, err := client.InvokeResourceAction(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName, r.ActionName, r.Body)
+	if err != nil {
+		return err
+	}
+
+	return r.Output.WriteFormatted(output.FormatJson, response, output.FormatterOptions{})
+}
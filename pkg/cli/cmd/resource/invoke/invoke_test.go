@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invoke
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	inputFile := filepath.Join(t.TempDir(), "input.json")
+	err := os.WriteFile(inputFile, []byte(`{"keyName": "primary"}`), 0644)
+	require.NoError(t, err)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Invoke Command",
+			Input:         []string{"MyCompany.Resources/databases", "mydb", "listSecrets"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Valid Invoke Command with data",
+			Input:         []string{"MyCompany.Resources/databases", "mydb", "rotateKey", "--data", `{"keyName": "primary"}`},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, map[string]any{"keyName": "primary"}, runner.(*Runner).Body)
+			},
+		},
+		{
+			Name:          "Valid Invoke Command with input file",
+			Input:         []string{"MyCompany.Resources/databases", "mydb", "rotateKey", "-f", inputFile},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.Equal(t, map[string]any{"keyName": "primary"}, runner.(*Runner).Body)
+			},
+		},
+		{
+			Name:          "Invoke Command with invalid data",
+			Input:         []string{"MyCompany.Resources/databases", "mydb", "rotateKey", "--data", `["primary"]`},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Invoke Command with missing input file",
+			Input:         []string{"MyCompany.Resources/databases", "mydb", "rotateKey", "-f", filepath.Join(t.TempDir(), "missing.json")},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Invoke Command with invalid resource type",
+			Input:         []string{"databases", "mydb", "listSecrets"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Invoke Command with insufficient args",
+			Input:         []string{"MyCompany.Resources/databases", "mydb"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	body := map[string]any{"keyName": "primary"}
+	response := map[string]any{"key": "new-key"}
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		InvokeResourceAction(gomock.Any(), "MyCompany.Resources/databases", "mydb", "rotateKey", body).
+		Return(response, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+
+	runner := &Runner{
+		ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Output:                         outputSink,
+		Workspace:                      &workspaces.Workspace{},
+		FullyQualifiedResourceTypeName: "MyCompany.Resources/databases",
+		ResourceName:                   "mydb",
+		ActionName:                     "rotateKey",
+		Body:                           body,
+	}
+
+	err := runner.Run(context.Background())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.FormattedOutput{
+			Format:  "json",
+			Obj:     response,
+			Options: output.FormatterOptions{},
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+)
+
+const (
+	operatorEquals    = "="
+	operatorNotEquals = "!="
+	operatorExists    = "exists"
+	operatorNotExists = "!"
+
+	sortOrderAscending  = "asc"
+	sortOrderDescending = "desc"
+
+	environmentField = "properties.environment"
+	applicationField = "properties.application"
+)
+
+// fieldRoots are the top-level fields of a resource that can be used in field paths. They match the
+// fields of the resource in the JSON output of the command.
+var fieldRoots = []string{"id", "name", "type", "location", "tags", "properties", "systemData"}
+
+// tagRequirement is a requirement of a selector on the tags of a resource.
+type tagRequirement struct {
+	Key      string
+	Operator string
+	Value    string
+}
+
+// fieldRequirement is a requirement on the value of a field of a resource.
+type fieldRequirement struct {
+	Path     string
+	Operator string
+	Value    string
+}
+
+// parseSelector parses a selector such as 'team=web,tier!=data,owner,!temporary'. Each requirement
+// of the selector must be satisfied by the tags of a resource.
+func parseSelector(selector string) ([]tagRequirement, error) {
+	requirements := []tagRequirement{}
+	if strings.TrimSpace(selector) == "" {
+		return requirements, nil
+	}
+
+	for _, part := range strings.Split(selector, ",") {
+		part = strings.TrimSpace(part)
+
+		var requirement tagRequirement
+		if key, value, found := strings.Cut(part, operatorNotEquals); found {
+			requirement = tagRequirement{Key: key, Operator: operatorNotEquals, Value: value}
+		} else if key, value, found := strings.Cut(part, "=="); found {
+			requirement = tagRequirement{Key: key, Operator: operatorEquals, Value: value}
+		} else if key, value, found := strings.Cut(part, operatorEquals); found {
+			requirement = tagRequirement{Key: key, Operator: operatorEquals, Value: value}
+		} else if key, found := strings.CutPrefix(part, operatorNotExists); found {
+			requirement = tagRequirement{Key: key, Operator: operatorNotExists}
+		} else {
+			requirement = tagRequirement{Key: part, Operator: operatorExists}
+		}
+
+		requirement.Key = strings.TrimSpace(requirement.Key)
+		requirement.Value = strings.TrimSpace(requirement.Value)
+		if requirement.Key == "" {
+			return nil, clierrors.Message("The selector %q is invalid. Specify requirements such as 'key=value', 'key!=value', 'key' or '!key', separated by commas.", selector)
+		}
+
+		requirements = append(requirements, requirement)
+	}
+
+	return requirements, nil
+}
+
+// parseFieldRequirements parses field requirements such as 'properties.environment=/planes/radius/local/...'
+// or 'name!=frontend'.
+func parseFieldRequirements(fields []string) ([]fieldRequirement, error) {
+	requirements := []fieldRequirement{}
+	for _, field := range fields {
+		var requirement fieldRequirement
+		if path, value, found := strings.Cut(field, operatorNotEquals); found {
+			requirement = fieldRequirement{Path: path, Operator: operatorNotEquals, Value: value}
+		} else if path, value, found := strings.Cut(field, "=="); found {
+			requirement = fieldRequirement{Path: path, Operator: operatorEquals, Value: value}
+		} else if path, value, found := strings.Cut(field, operatorEquals); found {
+			requirement = fieldRequirement{Path: path, Operator: operatorEquals, Value: value}
+		} else {
+			return nil, clierrors.Message("The field filter %q is invalid. Specify filters such as 'path=value' or 'path!=value'.", field)
+		}
+
+		requirement.Path = strings.TrimSpace(requirement.Path)
+		requirement.Value = strings.TrimSpace(requirement.Value)
+		err := validateFieldPath(requirement.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		requirements = append(requirements, requirement)
+	}
+
+	return requirements, nil
+}
+
+// validateFieldPath validates that a field path such as 'properties.environment' starts with a field of a resource.
+func validateFieldPath(path string) error {
+	root, _, _ := strings.Cut(path, ".")
+	for _, fieldRoot := range fieldRoots {
+		if root == fieldRoot {
+			return nil
+		}
+	}
+
+	return clierrors.Message("The field %q is invalid. Field paths start with one of: %s.", path, strings.Join(fieldRoots, ", "))
+}
+
+// resourceObject returns the resource as it is written in the JSON output of the command, so field paths
+// match the output that users see.
+func resourceObject(resource generated.GenericResource) (map[string]any, error) {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := map[string]any{}
+	err = json.Unmarshal(b, &obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// lookupField returns the value at the field path in the object, and whether it was found.
+func lookupField(obj map[string]any, path string) (any, bool) {
+	var current any = obj
+	for _, segment := range strings.Split(path, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		current, ok = m[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// scalarString returns the string representation of a scalar value, and whether the value is a scalar.
+func scalarString(value any) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case bool:
+		return strconv.FormatBool(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	default:
+		return "", false
+	}
+}
+
+// matchesSelector returns true if the tags of the resource satisfy every requirement of the selector.
+func matchesSelector(resource generated.GenericResource, requirements []tagRequirement) bool {
+	for _, requirement := range requirements {
+		value, found := resource.Tags[requirement.Key]
+		found = found && value != nil
+
+		switch requirement.Operator {
+		case operatorExists:
+			if !found {
+				return false
+			}
+		case operatorNotExists:
+			if found {
+				return false
+			}
+		case operatorEquals:
+			if !found || *value != requirement.Value {
+				return false
+			}
+		case operatorNotEquals:
+			if found && *value == requirement.Value {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// matchesFields returns true if the resource satisfies every field requirement. Values are compared
+// case-insensitively, like the names and IDs of resources.
+func matchesFields(obj map[string]any, requirements []fieldRequirement) bool {
+	for _, requirement := range requirements {
+		equal := false
+		if value, found := lookupField(obj, requirement.Path); found {
+			if s, ok := scalarString(value); ok {
+				equal = strings.EqualFold(s, requirement.Value)
+			}
+		}
+
+		if equal != (requirement.Operator == operatorEquals) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// serverSideFilters returns the environment and application IDs of the field requirements that the list API
+// can evaluate, and the requirements that must be evaluated by the client.
+func serverSideFilters(requirements []fieldRequirement) (environmentID string, applicationID string, remaining []fieldRequirement) {
+	remaining = []fieldRequirement{}
+	for _, requirement := range requirements {
+		if requirement.Operator == operatorEquals && requirement.Path == environmentField && environmentID == "" {
+			environmentID = requirement.Value
+		} else if requirement.Operator == operatorEquals && requirement.Path == applicationField && applicationID == "" {
+			applicationID = requirement.Value
+		} else {
+			remaining = append(remaining, requirement)
+		}
+	}
+
+	return environmentID, applicationID, remaining
+}
+
+// filterResources returns the resources that satisfy the selector and the field requirements.
+func filterResources(resources []generated.GenericResource, selector []tagRequirement, fields []fieldRequirement) ([]generated.GenericResource, error) {
+	results := []generated.GenericResource{}
+	for _, resource := range resources {
+		if !matchesSelector(resource, selector) {
+			continue
+		}
+
+		if len(fields) > 0 {
+			obj, err := resourceObject(resource)
+			if err != nil {
+				return nil, err
+			}
+
+			if !matchesFields(obj, fields) {
+				continue
+			}
+		}
+
+		results = append(results, resource)
+	}
+
+	return results, nil
+}
+
+// sortResources sorts the resources by the value at the field path. Numbers are compared numerically and other
+// values as case-insensitive strings. Resources without a value are written last, whatever the order.
+func sortResources(resources []generated.GenericResource, path string, order string) error {
+	type sortEntry struct {
+		resource generated.GenericResource
+		value    any
+		found    bool
+	}
+
+	entries := make([]sortEntry, len(resources))
+	for i, resource := range resources {
+		obj, err := resourceObject(resource)
+		if err != nil {
+			return err
+		}
+
+		value, found := lookupField(obj, path)
+		if found {
+			_, found = scalarString(value)
+		}
+		entries[i] = sortEntry{resource: resource, value: value, found: found}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if !a.found || !b.found {
+			return a.found && !b.found
+		}
+
+		var compare int
+		an, aIsNumber := a.value.(float64)
+		bn, bIsNumber := b.value.(float64)
+		if aIsNumber && bIsNumber {
+			if an < bn {
+				compare = -1
+			} else if an > bn {
+				compare = 1
+			}
+		} else {
+			as, _ := scalarString(a.value)
+			bs, _ := scalarString(b.value)
+			compare = strings.Compare(strings.ToLower(as), strings.ToLower(bs))
+		}
+
+		if order == sortOrderDescending {
+			return compare > 0
+		}
+		return compare < 0
+	})
+
+	for i, entry := range entries {
+		resources[i] = entry.resource
+	}
+
+	return nil
+}
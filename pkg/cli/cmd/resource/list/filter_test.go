@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package list
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestResource(name string, tags map[string]*string, properties map[string]any) generated.GenericResource {
+	return generated.GenericResource{
+		ID:         to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/MyCompany.Resources/testResources/" + name),
+		Name:       to.Ptr(name),
+		Type:       to.Ptr("MyCompany.Resources/testResources"),
+		Tags:       tags,
+		Properties: properties,
+	}
+}
+
+func names(resources []generated.GenericResource) []string {
+	result := []string{}
+	for _, resource := range resources {
+		result = append(result, *resource.Name)
+	}
+	return result
+}
+
+func Test_parseSelector(t *testing.T) {
+	requirements, err := parseSelector("team=web, tier!=data,owner,!temporary,env==prod")
+	require.NoError(t, err)
+	require.Equal(t, []tagRequirement{
+		{Key: "team", Operator: operatorEquals, Value: "web"},
+		{Key: "tier", Operator: operatorNotEquals, Value: "data"},
+		{Key: "owner", Operator: operatorExists},
+		{Key: "temporary", Operator: operatorNotExists},
+		{Key: "env", Operator: operatorEquals, Value: "prod"},
+	}, requirements)
+
+	requirements, err = parseSelector("")
+	require.NoError(t, err)
+	require.Empty(t, requirements)
+
+	_, err = parseSelector("team=web,=data")
+	require.Error(t, err)
+}
+
+func Test_parseFieldRequirements(t *testing.T) {
+	requirements, err := parseFieldRequirements([]string{"properties.environment=env", "name!=frontend", "location==global"})
+	require.NoError(t, err)
+	require.Equal(t, []fieldRequirement{
+		{Path: "properties.environment", Operator: operatorEquals, Value: "env"},
+		{Path: "name", Operator: operatorNotEquals, Value: "frontend"},
+		{Path: "location", Operator: operatorEquals, Value: "global"},
+	}, requirements)
+
+	_, err = parseFieldRequirements([]string{"name"})
+	require.Error(t, err)
+
+	_, err = parseFieldRequirements([]string{"environment=env"})
+	require.Error(t, err)
+}
+
+func Test_filterResources(t *testing.T) {
+	resources := []generated.GenericResource{
+		createTestResource("a", map[string]*string{"team": to.Ptr("web")}, map[string]any{"replicas": float64(2), "enabled": true}),
+		createTestResource("b", map[string]*string{"team": to.Ptr("data"), "temporary": to.Ptr("")}, map[string]any{"replicas": float64(1)}),
+		createTestResource("c", nil, map[string]any{"config": map[string]any{"tier": "Premium"}}),
+	}
+
+	testcases := []struct {
+		name     string
+		selector string
+		fields   []string
+		expected []string
+	}{
+		{name: "no filters", expected: []string{"a", "b", "c"}},
+		{name: "tag equals", selector: "team=web", expected: []string{"a"}},
+		{name: "tag not equals", selector: "team!=web", expected: []string{"b", "c"}},
+		{name: "tag exists", selector: "team", expected: []string{"a", "b"}},
+		{name: "tag does not exist", selector: "!temporary", expected: []string{"a", "c"}},
+		{name: "number field", fields: []string{"properties.replicas=2"}, expected: []string{"a"}},
+		{name: "bool field", fields: []string{"properties.enabled=true"}, expected: []string{"a"}},
+		{name: "nested field case-insensitive", fields: []string{"properties.config.tier=premium"}, expected: []string{"c"}},
+		{name: "field not equals", fields: []string{"name!=a"}, expected: []string{"b", "c"}},
+		{name: "object field", fields: []string{"properties.config=x"}, expected: []string{}},
+		{name: "selector and fields", selector: "team", fields: []string{"properties.replicas!=2"}, expected: []string{"b"}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := parseSelector(tc.selector)
+			require.NoError(t, err)
+			fields, err := parseFieldRequirements(tc.fields)
+			require.NoError(t, err)
+
+			results, err := filterResources(resources, selector, fields)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, names(results))
+		})
+	}
+}
+
+func Test_serverSideFilters(t *testing.T) {
+	requirements := []fieldRequirement{
+		{Path: environmentField, Operator: operatorEquals, Value: "env-id"},
+		{Path: applicationField, Operator: operatorNotEquals, Value: "app-id"},
+		{Path: "name", Operator: operatorEquals, Value: "a"},
+	}
+
+	environmentID, applicationID, remaining := serverSideFilters(requirements)
+	require.Equal(t, "env-id", environmentID)
+	require.Equal(t, "", applicationID)
+	require.Equal(t, requirements[1:], remaining)
+}
+
+func Test_sortResources(t *testing.T) {
+	resources := []generated.GenericResource{
+		createTestResource("B", nil, map[string]any{"replicas": float64(10)}),
+		createTestResource("c", nil, nil),
+		createTestResource("a", nil, map[string]any{"replicas": float64(9)}),
+	}
+
+	err := sortResources(resources, "name", sortOrderAscending)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "B", "c"}, names(resources))
+
+	err = sortResources(resources, "name", sortOrderDescending)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "B", "a"}, names(resources))
+
+	err = sortResources(resources, "properties.replicas", sortOrderAscending)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "B", "c"}, names(resources))
+
+	err = sortResources(resources, "properties.replicas", sortOrderDescending)
+	require.NoError(t, err)
+	require.Equal(t, []string{"B", "a", "c"}, names(resources))
+}
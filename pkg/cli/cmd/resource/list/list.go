@@ -18,6 +18,7 @@ package list
 
 import (
 	"context"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -32,6 +33,8 @@ import (
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
 	"github.com/spf13/cobra"
 )
 
@@ -46,7 +49,18 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "list [resourceType]",
 		Short: "Lists resources",
-		Long:  "List all resources of specified type",
+		Long: `List all resources of specified type.
+
+Resources can be filtered by their tags with '--selector', using requirements such as 'key=value', 'key!=value',
+'key' (the tag exists) and '!key' (the tag does not exist), separated by commas.
+
+Resources can be filtered by their fields with '--field', using filters such as 'path=value' or 'path!=value'. Field paths
+match the JSON output of the command, for example 'name', 'location' or 'properties.environment'. Values are compared
+case-insensitively. The 'properties.environment' and 'properties.application' fields accept the name of an environment
+or application in the resource group of the workspace, and are evaluated by the server when possible.
+
+Use '--all-groups' to list the resources of every resource group of the plane, and '--sort-by' to sort the resources by
+a field path.`,
 		Example: `
 sample list of resourceType: Applications.Core/containers, Applications.Core/gateways, Applications.Dapr/daprPubSubBrokers, Applications.Core/extenders, Applications.Datastores/mongoDatabases, Applications.Messaging/rabbitMQMessageQueues, Applications.Datastores/redisCaches, Applications.Datastores/sqlDatabases, Applications.Dapr/daprStateStores, Applications.Dapr/daprSecretStores
 
@@ -60,6 +74,15 @@ rad resource list Applications.Core/containers --application icecream-store
 
 # list all resources of a specified type in an application (shorthand flag)
 rad resource list Applications.Core/containers -a icecream-store
+
+# list all resources of a specified type in every resource group
+rad resource list Applications.Core/containers --all-groups
+
+# list all resources of a specified type with the tag 'team' set to 'web'
+rad resource list Applications.Core/containers --selector team=web
+
+# list all resources of a specified type in an environment, sorted by name in descending order
+rad resource list Applications.Core/containers --field properties.environment=production --sort-by name --sort-order desc
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -69,6 +92,11 @@ rad resource list Applications.Core/containers -a icecream-store
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().StringP("selector", "l", "", "Filter resources by their tags, for example 'team=web,tier!=data'")
+	cmd.Flags().StringArray("field", []string{}, "Filter resources by a field, for example 'properties.environment=production'. Can be specified multiple times")
+	cmd.Flags().Bool("all-groups", false, "List the resources of every resource group of the plane")
+	cmd.Flags().String("sort-by", "", "Sort resources by a field, for example 'name' or 'properties.environment'")
+	cmd.Flags().String("sort-order", sortOrderAscending, "The sort order when '--sort-by' is specified: 'asc' or 'desc'")
 
 	return cmd, runner
 }
@@ -85,6 +113,11 @@ type Runner struct {
 	ResourceType              string
 	ResourceTypeSuffix        string
 	ResourceProviderNamespace string
+	Selector                  []tagRequirement
+	Fields                    []fieldRequirement
+	AllGroups                 bool
+	SortBy                    string
+	SortOrder                 string
 }
 
 // NewRunner creates a new instance of the `rad resource list` runner.
@@ -133,16 +166,87 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 	}
 	r.Format = format
 
+	selector, err := cmd.Flags().GetString("selector")
+	if err != nil {
+		return err
+	}
+	r.Selector, err = parseSelector(selector)
+	if err != nil {
+		return err
+	}
+
+	fields, err := cmd.Flags().GetStringArray("field")
+	if err != nil {
+		return err
+	}
+	r.Fields, err = parseFieldRequirements(fields)
+	if err != nil {
+		return err
+	}
+	for i, field := range r.Fields {
+		r.Fields[i].Value = r.resolveFieldValue(field)
+	}
+
+	r.AllGroups, err = cmd.Flags().GetBool("all-groups")
+	if err != nil {
+		return err
+	}
+	if r.AllGroups {
+		if cmd.Flags().Changed("group") || cmd.Flags().Changed("application") {
+			return clierrors.Message("The '--all-groups' flag cannot be combined with '--group' or '--application'. Use '--field properties.application=<id>' to filter resources by application.")
+		}
+
+		// The default application of the workspace belongs to a single resource group.
+		r.ApplicationName = ""
+	}
+
+	r.SortBy, err = cmd.Flags().GetString("sort-by")
+	if err != nil {
+		return err
+	}
+	if r.SortBy != "" {
+		err = validateFieldPath(r.SortBy)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.SortOrder, err = cmd.Flags().GetString("sort-order")
+	if err != nil {
+		return err
+	}
+	if r.SortOrder != sortOrderAscending && r.SortOrder != sortOrderDescending {
+		return clierrors.Message("The sort order %q is invalid. Specify 'asc' or 'desc'.", r.SortOrder)
+	}
+
 	return nil
 }
 
+// resolveFieldValue returns the ID of the environment or application when a field requirement on the
+// environment or application of the resources specifies a name.
+func (r *Runner) resolveFieldValue(field fieldRequirement) string {
+	if field.Value == "" || strings.HasPrefix(field.Value, "/") {
+		return field.Value
+	}
+
+	switch field.Path {
+	case environmentField:
+		return r.Workspace.Scope + "/providers/Applications.Core/environments/" + field.Value
+	case applicationField:
+		return r.Workspace.Scope + "/providers/Applications.Core/applications/" + field.Value
+	default:
+		return field.Value
+	}
+}
+
 // Run runs the `rad resource list` command.
 //
 
 // Run checks if an application name is provided and if so, checks if the application exists in the workspace, then
 // lists all resources of the specified type in the application, and finally writes the resources to the output in the
-// specified format. If no application name is provided, it lists all resources of the specified type. An error is
-// returned if the application does not exist in the workspace.
+// specified format. If no application name is provided, it lists all resources of the specified type, in every resource
+// group when '--all-groups' is specified. The resources are then filtered by the selector and field filters and sorted.
+// An error is returned if the application does not exist in the workspace.
 func (r *Runner) Run(ctx context.Context) error {
 	// Initialize the client factory if it hasn't been set externally.
 	// This allows for flexibility where a test UCPClientFactory can be set externally during testing.
@@ -163,13 +267,9 @@ func (r *Runner) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	fields := r.Fields
 	var resourceList []generated.GenericResource
-	if r.ApplicationName == "" {
-		resourceList, err = client.ListResourcesOfType(ctx, r.ResourceType)
-		if err != nil {
-			return err
-		}
-	} else {
+	if r.ApplicationName != "" {
 		_, err = client.GetApplication(ctx, r.ApplicationName)
 		if clients.Is404Error(err) {
 			return clierrors.Message("The application %q could not be found in workspace %q. Make sure you specify the correct application with '-a/--application'.", r.ApplicationName, r.Workspace.Name)
@@ -181,7 +281,67 @@ func (r *Runner) Run(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+	} else {
+		// The list API filters resources by environment and application, the other filters are evaluated here.
+		var environmentID, applicationID string
+		environmentID, applicationID, fields = serverSideFilters(r.Fields)
+		if r.AllGroups || environmentID != "" || applicationID != "" {
+			resourceList, err = r.listResourcesInResourceGroups(ctx, client, environmentID, applicationID)
+		} else {
+			resourceList, err = client.ListResourcesOfType(ctx, r.ResourceType)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	resourceList, err = filterResources(resourceList, r.Selector, fields)
+	if err != nil {
+		return err
+	}
+
+	if r.SortBy != "" {
+		err = sortResources(resourceList, r.SortBy, r.SortOrder)
+		if err != nil {
+			return err
+		}
 	}
 
 	return r.Output.WriteFormatted(r.Format, resourceList, objectformats.GetGenericResourceTableFormat())
 }
+
+// listResourcesInResourceGroups lists the resources of the resource type in the resource group of the workspace,
+// or in every resource group of the plane when '--all-groups' is specified, filtered by environment and application.
+func (r *Runner) listResourcesInResourceGroups(ctx context.Context, client clients.ApplicationsManagementClient, environmentID string, applicationID string) ([]generated.GenericResource, error) {
+	scope, err := resources.ParseScope(r.Workspace.Scope)
+	if err != nil {
+		return nil, err
+	}
+	planeName := scope.FindScope("radius")
+
+	groupNames := []string{scope.FindScope(resources_radius.ScopeResourceGroups)}
+	if r.AllGroups {
+		groups, err := client.ListResourceGroups(ctx, planeName)
+		if err != nil {
+			return nil, err
+		}
+
+		groupNames = []string{}
+		for _, group := range groups {
+			if group.Name != nil {
+				groupNames = append(groupNames, *group.Name)
+			}
+		}
+	}
+
+	resourceList := []generated.GenericResource{}
+	for _, groupName := range groupNames {
+		results, err := client.ListResourcesOfTypeInResourceGroupFiltered(ctx, planeName, groupName, r.ResourceType, environmentID, applicationID)
+		if err != nil {
+			return nil, err
+		}
+		resourceList = append(resourceList, results...)
+	}
+
+	return resourceList, nil
+}
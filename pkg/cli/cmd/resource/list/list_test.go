@@ -30,6 +30,8 @@ import (
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
 	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -87,6 +89,64 @@ func Test_Validate(t *testing.T) {
 				Config:         configWithWorkspace,
 			},
 		},
+		{
+			Name:          "List Command with filters and sorting",
+			Input:         []string{"Applications.Core/containers", "--selector", "team=web", "--field", "properties.environment=test-env", "--field", "name!=a", "--sort-by", "name", "--sort-order", "desc"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, []tagRequirement{{Key: "team", Operator: operatorEquals, Value: "web"}}, runner.Selector)
+				require.Equal(t, []fieldRequirement{
+					{Path: environmentField, Operator: operatorEquals, Value: runner.Workspace.Scope + "/providers/Applications.Core/environments/test-env"},
+					{Path: "name", Operator: operatorNotEquals, Value: "a"},
+				}, runner.Fields)
+				require.Equal(t, "name", runner.SortBy)
+				require.Equal(t, sortOrderDescending, runner.SortOrder)
+			},
+		},
+		{
+			Name:          "List Command with all groups",
+			Input:         []string{"Applications.Core/containers", "--all-groups"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				require.True(t, r.(*Runner).AllGroups)
+			},
+		},
+		{
+			Name:          "List Command with all groups and group",
+			Input:         []string{"Applications.Core/containers", "--all-groups", "-g", "my-group"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "List Command with invalid field",
+			Input:         []string{"Applications.Core/containers", "--field", "environment=test-env"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "List Command with invalid sort order",
+			Input:         []string{"Applications.Core/containers", "--sort-by", "name", "--sort-order", "up"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "List Command with too many args",
 			Input:         []string{"invalidResourceType", "foo"},
@@ -230,4 +290,61 @@ func Test_Run(t *testing.T) {
 			require.Equal(t, expected, outputSink.Writes)
 		})
 	})
+
+	t.Run("List resources by type in all groups with filters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		environmentID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/test-env"
+		a := radcli.CreateResource("MyCompany.Resources/testResources", "a")
+		a.Tags = map[string]*string{"team": to.Ptr("web")}
+		b := radcli.CreateResource("MyCompany.Resources/testResources", "b")
+		b.Tags = map[string]*string{"team": to.Ptr("web")}
+		c := radcli.CreateResource("MyCompany.Resources/testResources", "c")
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourceGroups(gomock.Any(), "local").
+			Return([]ucp.ResourceGroupResource{radcli.CreateResourceGroup("group-1"), radcli.CreateResourceGroup("group-2")}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesOfTypeInResourceGroupFiltered(gomock.Any(), "local", "group-1", "MyCompany.Resources/testResources", environmentID, "").
+			Return([]generated.GenericResource{a, c}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListResourcesOfTypeInResourceGroupFiltered(gomock.Any(), "local", "group-2", "MyCompany.Resources/testResources", environmentID, "").
+			Return([]generated.GenericResource{b}, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		clientFactory, err := manifest.NewTestClientFactory(manifest.WithResourceProviderServerNoError)
+		require.NoError(t, err)
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			UCPClientFactory:          clientFactory,
+			Output:                    outputSink,
+			Workspace:                 &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			ResourceType:              "MyCompany.Resources/testResources",
+			Format:                    "table",
+			ResourceTypeSuffix:        "testResources",
+			ResourceProviderNamespace: "MyCompany.Resources",
+			Selector:                  []tagRequirement{{Key: "team", Operator: operatorEquals, Value: "web"}},
+			Fields:                    []fieldRequirement{{Path: environmentField, Operator: operatorEquals, Value: environmentID}},
+			AllGroups:                 true,
+			SortBy:                    "name",
+			SortOrder:                 sortOrderDescending,
+		}
+
+		err = runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     []generated.GenericResource{b, a},
+				Options: objectformats.GetGenericResourceTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -0,0 +1,222 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// containerType is the only resource type supported by the command.
+	containerType = "Applications.Core/containers"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource port-forward` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "port-forward [resourceType] [resourceName] [[localPort:]remotePort...]",
+		Short: "Forward local ports to a running containers resource",
+		Long: `Forward one or more local ports to a running resource. Currently only supports the resource type 'Applications.Core/containers'.
+
+The ports are forwarded to a running replica of the resource. Each port is specified as '<localPort>:<remotePort>', or as
+'<port>' to use the same local and remote port. Use ':<remotePort>' to let the system choose a local port.
+
+When no ports are specified, the ports of the resource's primary container are forwarded. In scenarios like Dapr where
+multiple containers are in use, the '--container <name>' option can specify the container whose ports are forwarded.
+
+Press CTRL+C to exit the command and stop forwarding.`,
+		Example: `
+# forward the ports of the 'webapp' resource of the current default app
+rad resource port-forward Applications.Core/containers webapp
+
+# forward local port 5000 to port 80 of the 'orders' resource of the 'icecream-store' application
+rad resource port-forward Applications.Core/containers orders 5000:80 --application icecream-store
+
+# forward the ports of the 'daprd' sidecar container of the 'orders' resource
+rad resource port-forward Applications.Core/containers orders --container daprd
+
+# forward port 8080 on all local addresses
+rad resource port-forward Applications.Core/containers webapp 8080 --address 0.0.0.0
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddApplicationNameFlag(cmd)
+	cmd.Flags().StringP("container", "c", "", "The container whose ports are forwarded when no ports are specified. Defaults to the primary container of the resource")
+	cmd.Flags().String("replica", "", "The replica to forward the ports to. Defaults to a running replica of the resource")
+	cmd.Flags().StringSlice("address", []string{"localhost"}, "The local addresses to listen on")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource port-forward` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	ApplicationName string
+	ResourceName    string
+	Container       string
+	Replica         string
+	Ports           []string
+	Addresses       []string
+
+	// OutputStream and ErrorStream receive the status of the forwarded ports.
+	OutputStream io.Writer
+	ErrorStream  io.Writer
+}
+
+// NewRunner creates a new instance of the `rad resource port-forward` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+		OutputStream:      os.Stdout,
+		ErrorStream:       os.Stderr,
+	}
+}
+
+// Validate runs validation for the `rad resource port-forward` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config, r.ConfigHolder.DirectoryConfig)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.ApplicationName, err = cli.RequireApplication(cmd, *workspace)
+	if err != nil {
+		return err
+	}
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(resourceProviderName+"/"+resourceTypeName, containerType) {
+		return clierrors.Message("Only %s is supported.", containerType)
+	}
+	r.ResourceName = resourceName
+
+	r.Ports = []string{}
+	for _, port := range args[2:] {
+		err = validatePort(port)
+		if err != nil {
+			return err
+		}
+		r.Ports = append(r.Ports, port)
+	}
+
+	r.Container, err = cmd.Flags().GetString("container")
+	if err != nil {
+		return err
+	}
+	if r.Container != "" && len(r.Ports) > 0 {
+		return clierrors.Message("The '--container' flag selects the ports to forward and cannot be combined with ports.")
+	}
+
+	r.Replica, err = cmd.Flags().GetString("replica")
+	if err != nil {
+		return err
+	}
+
+	r.Addresses, err = cmd.Flags().GetStringSlice("address")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePort validates a port specified as '<localPort>:<remotePort>', '<port>' or ':<remotePort>'.
+func validatePort(port string) error {
+	local, remote, found := strings.Cut(port, ":")
+	if !found {
+		remote = local
+		local = ""
+	}
+
+	if !isPortNumber(remote, false) || !isPortNumber(local, true) {
+		return clierrors.Message("The port %q is invalid. Specify ports as '<localPort>:<remotePort>' or '<port>', with port numbers between 1 and 65535.", port)
+	}
+
+	return nil
+}
+
+// isPortNumber returns true if the value is a port number between 1 and 65535, or empty if allowEmpty is set.
+func isPortNumber(value string, allowEmpty bool) bool {
+	if value == "" {
+		return allowEmpty
+	}
+
+	number, err := strconv.Atoi(value)
+	return err == nil && number >= 1 && number <= 65535
+}
+
+// Run runs the `rad resource port-forward` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateDiagnosticsClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Forwarding ports to resource %q of application %q. Press CTRL+C to stop.", r.ResourceName, r.ApplicationName)
+
+	err = client.PortForward(ctx, clients.PortForwardOptions{
+		Application: r.ApplicationName,
+		Resource:    r.ResourceName,
+		Replica:     r.Replica,
+		Container:   r.Container,
+		Ports:       r.Ports,
+		Addresses:   r.Addresses,
+		Out:         r.OutputStream,
+		ErrOut:      r.ErrorStream,
+	})
+	if err != nil {
+		return clierrors.MessageWithCause(err, "Failed to forward ports to resource %q.", r.ResourceName)
+	}
+
+	return nil
+}
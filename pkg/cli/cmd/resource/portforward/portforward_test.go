@@ -0,0 +1,201 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Port-forward Command",
+			Input:         []string{"Applications.Core/containers", "frontend", "-a", "test-app"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, "test-app", runner.ApplicationName)
+				require.Equal(t, "frontend", runner.ResourceName)
+				require.Empty(t, runner.Ports)
+				require.Equal(t, []string{"localhost"}, runner.Addresses)
+			},
+		},
+		{
+			Name:          "Valid Port-forward Command with ports",
+			Input:         []string{"Applications.Core/containers", "frontend", "5000:80", "8080", ":3000", "-a", "test-app", "--address", "0.0.0.0"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				runner := r.(*Runner)
+				require.Equal(t, []string{"5000:80", "8080", ":3000"}, runner.Ports)
+				require.Equal(t, []string{"0.0.0.0"}, runner.Addresses)
+			},
+		},
+		{
+			Name:          "Valid Port-forward Command with container",
+			Input:         []string{"Applications.Core/containers", "frontend", "-a", "test-app", "--container", "daprd"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, r framework.Runner) {
+				require.Equal(t, "daprd", r.(*Runner).Container)
+			},
+		},
+		{
+			Name:          "Port-forward Command with container and ports",
+			Input:         []string{"Applications.Core/containers", "frontend", "8080", "-a", "test-app", "--container", "daprd"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Port-forward Command with invalid port",
+			Input:         []string{"Applications.Core/containers", "frontend", "5000:http", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Port-forward Command with unsupported resource type",
+			Input:         []string{"Applications.Core/gateways", "gateway", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Port-forward Command without resource name",
+			Input:         []string{"Applications.Core/containers", "-a", "test-app"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_validatePort(t *testing.T) {
+	for _, port := range []string{"80", "5000:80", ":80", "65535:1"} {
+		require.NoError(t, validatePort(port), port)
+	}
+
+	for _, port := range []string{"", ":", "0", "80:", "65536", "a:80", "1:2:3"} {
+		require.Error(t, validatePort(port), port)
+	}
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			PortForward(gomock.Any(), clients.PortForwardOptions{
+				Application: "test-app",
+				Resource:    "frontend",
+				Ports:       []string{"5000:80"},
+				Addresses:   []string{"localhost"},
+				Out:         stdout,
+				ErrOut:      stderr,
+			}).
+			Return(nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DiagnosticsClient: diagnosticsClient},
+			Output:            outputSink,
+			Workspace:         &workspaces.Workspace{},
+			ApplicationName:   "test-app",
+			ResourceName:      "frontend",
+			Ports:             []string{"5000:80"},
+			Addresses:         []string{"localhost"},
+			OutputStream:      stdout,
+			ErrorStream:       stderr,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Forwarding ports to resource %q of application %q. Press CTRL+C to stop.",
+				Params: []any{"frontend", "test-app"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			PortForward(gomock.Any(), gomock.Any()).
+			Return(errors.New("container \"frontend\" of resource \"frontend\" does not have any TCP ports, specify the ports to forward")).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{DiagnosticsClient: diagnosticsClient},
+			Output:            &output.MockOutput{},
+			Workspace:         &workspaces.Workspace{},
+			ApplicationName:   "test-app",
+			ResourceName:      "frontend",
+		}
+
+		err := runner.Run(context.Background())
+		require.True(t, clierrors.IsFriendlyError(err))
+		require.Contains(t, err.Error(), "Failed to forward ports to resource \"frontend\".")
+	})
+}
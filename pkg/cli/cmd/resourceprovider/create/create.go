@@ -21,6 +21,7 @@ import (
 
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/common"
 	"github.com/radius-project/radius/pkg/cli/framework"
@@ -46,6 +47,10 @@ Resource providers are the entities that implement resource types such as 'Appli
 Creating a resource provider defines new resource types that can be used in applications.
 
 Input can be passed in using a JSON or YAML file using the --from-file option.
+
+Before the resource provider is updated, the manifest is compared with the registered resource types. Changes that
+break existing clients or existing resources, such as removing a property or changing the storage API version without
+a conversion, are reported and the update is rejected. Use the --force option to apply the manifest anyway.
 `,
 		Example: `
 # Create a resource provider from YAML file
@@ -53,6 +58,9 @@ rad resource-provider create --from-file /path/to/input.yaml
 
 # Create a resource provider from JSON file
 rad resource-provider create --from-file /path/to/input.json
+
+# Update a resource provider even if the manifest contains breaking changes
+rad resource-provider create --from-file /path/to/input.yaml --force
 `,
 		Args: cobra.ExactArgs(0),
 		RunE: framework.RunCommand(runner),
@@ -63,6 +71,7 @@ rad resource-provider create --from-file /path/to/input.json
 	commonflags.AddFromFileFlagVar(cmd, &runner.ResourceProviderManifestFilePath)
 	_ = cmd.MarkFlagRequired("from-file")
 	_ = cmd.MarkFlagFilename("from-file", "yaml", "json")
+	cmd.Flags().BoolVar(&runner.Force, "force", false, "Apply the manifest even if it contains breaking changes to registered resource types.")
 
 	return cmd, runner
 }
@@ -77,6 +86,7 @@ type Runner struct {
 
 	ResourceProviderManifestFilePath string
 	ResourceProvider                 *manifest.ResourceProvider
+	Force                            bool
 	Logger                           func(format string, args ...any)
 }
 
@@ -126,6 +136,18 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
+	if !r.Force {
+		registered, err := manifest.GetRegisteredResourceProvider(ctx, r.UCPClientFactory, "local", *r.ResourceProvider)
+		if err != nil {
+			return err
+		}
+
+		changes := manifest.CheckBreakingChanges(registered, r.ResourceProvider)
+		if len(changes) > 0 {
+			return clierrors.Message("The manifest contains breaking changes to the registered resource types:\n%s\n\nUse --force to apply the manifest anyway.", manifest.FormatBreakingChanges(changes))
+		}
+	}
+
 	// Proceed with registering manifests
 	if err := manifest.RegisterFile(ctx, r.UCPClientFactory, "local", r.ResourceProviderManifestFilePath, r.Logger); err != nil {
 		return err
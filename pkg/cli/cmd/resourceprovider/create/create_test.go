@@ -20,12 +20,19 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/manifest"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpfake "github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 )
@@ -102,3 +109,74 @@ func Test_Run(t *testing.T) {
 		require.Contains(t, logOutput, fmt.Sprintf("Creating API Version %s/%s@%s", resourceProviderData.Namespace, expectedResourceType, expectedAPIVersion))
 	})
 }
+
+func Test_Run_BreakingChanges(t *testing.T) {
+	newClientFactory := func(t *testing.T) *v20231001preview.ClientFactory {
+		apiVersionsServer := manifest.WithAPIVersionServerNoError()
+		apiVersionsServer.NewListPager = func(planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+			resp.AddPage(http.StatusOK, v20231001preview.APIVersionsClientListResponse{
+				APIVersionResourceListResult: v20231001preview.APIVersionResourceListResult{
+					Value: []*v20231001preview.APIVersionResource{
+						{
+							Name: to.Ptr("2025-01-01-preview"),
+							Properties: &v20231001preview.APIVersionProperties{
+								Schema: map[string]any{
+									"type":       "object",
+									"properties": map[string]any{"host": map[string]any{"type": "string"}},
+								},
+							},
+						},
+					},
+				},
+			}, nil)
+			return
+		}
+
+		serverFactory := ucpfake.ServerFactory{
+			ResourceProvidersServer: manifest.WithResourceProviderServerNoError(),
+			ResourceTypesServer:     manifest.WithResourceTypeServerNoError(),
+			APIVersionsServer:       apiVersionsServer,
+			LocationsServer:         manifest.WithLocationServerNoError(),
+		}
+
+		clientFactory, err := v20231001preview.NewClientFactory(&azfake.TokenCredential{}, &armpolicy.ClientOptions{
+			ClientOptions: policy.ClientOptions{Transport: ucpfake.NewServerFactoryTransport(&serverFactory)},
+		})
+		require.NoError(t, err)
+		return clientFactory
+	}
+
+	resourceProviderData, err := manifest.ReadFile("testdata/valid.yaml")
+	require.NoError(t, err)
+
+	t.Run("Error: breaking changes are rejected", func(t *testing.T) {
+		runner := &Runner{
+			UCPClientFactory:                 newClientFactory(t),
+			Output:                           &output.MockOutput{},
+			Workspace:                        &workspaces.Workspace{},
+			ResourceProvider:                 resourceProviderData,
+			Format:                           "table",
+			ResourceProviderManifestFilePath: "testdata/valid.yaml",
+		}
+
+		err := runner.Run(context.Background())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "MyCompany.Resources/testResources@2025-01-01-preview host: the property was removed")
+		require.Contains(t, err.Error(), "--force")
+	})
+
+	t.Run("Success: breaking changes are applied with --force", func(t *testing.T) {
+		runner := &Runner{
+			UCPClientFactory:                 newClientFactory(t),
+			Output:                           &output.MockOutput{},
+			Workspace:                        &workspaces.Workspace{},
+			ResourceProvider:                 resourceProviderData,
+			Format:                           "table",
+			ResourceProviderManifestFilePath: "testdata/valid.yaml",
+			Force:                            true,
+		}
+
+		err := runner.Run(context.Background())
+		require.NoError(t, err)
+	})
+}
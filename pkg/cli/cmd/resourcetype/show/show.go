@@ -118,7 +118,7 @@ func (r *Runner) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	if r.Format != "json" {
+	if r.Format == output.FormatTable {
 		err = r.display(&resourceTypeDetails)
 		if err != nil {
 			return err
@@ -65,6 +65,8 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 The run command compiles a Bicep or ARM template and runs it in your default environment (unless otherwise specified). It also automatically port-forwards container ports and streams container logs to a user's terminal.
 		
 The run command accepts the same parameters as the 'rad deploy' command. See the 'rad deploy' help for more information.
+
+Use the '--watch' flag to redeploy the application when the template, the local modules it references or its parameter files change. Port-forwarding and log streaming continue across redeployments.
 	`,
 		Example: `
 # Run app.bicep
@@ -78,6 +80,9 @@ rad run app.bicep --parameters version=latest
 
 # Run app.bicep and specify parameters from multiple sources
 rad run app.bicep --parameters @myfile.json --parameters version=latest
+
+# Run app.bicep and redeploy it each time it, its modules or its parameter files change
+rad run app.bicep --watch
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -88,6 +93,7 @@ rad run app.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	cmd.Flags().StringArrayP("parameters", "p", []string{}, "Specify parameters for the deployment")
+	deploycmd.AddWatchFlag(cmd)
 
 	return cmd, runner
 }
@@ -133,10 +139,12 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 //
 
 // Run starts port-forwarding and log streaming for a given application in a given Kubernetes context, and
-// returns an error if any of the operations fail.
+// returns an error if any of the operations fail. In watch mode, the application is redeployed when its files change
+// while port-forwarding and log streaming continue.
 func (r *Runner) Run(ctx context.Context) error {
-	// Call into base first to deploy, and then set up port-forwards and logs.
-	err := r.Runner.Run(ctx)
+	// Deploy first, and then set up port-forwards and logs. The application must be deployed to find its namespace, so
+	// a failure of the first deployment stops the command even in watch mode.
+	err := r.Runner.DeployTemplate(ctx)
 	if err != nil {
 		return err
 	}
@@ -253,6 +261,14 @@ func (r *Runner) Run(ctx context.Context) error {
 		})
 	})
 
+	// Redeploy on changes. Port-forwarding and log streaming select pods by application label, so they pick up the
+	// pods replaced by a redeployment.
+	if r.Watch {
+		group.Go(func() error {
+			return r.Runner.WatchAndRedeploy(ctx)
+		})
+	}
+
 	err = group.Wait()
 
 	// context.Canceled here means the user canceled.
@@ -30,11 +30,12 @@ type MockFactory struct {
 	ApplicationsManagementClient clients.ApplicationsManagementClient
 	CredentialManagementClient   cli_credential.CredentialManagementClient
 	DiagnosticsClient            clients.DiagnosticsClient
+	DeploymentClient             clients.DeploymentClient
 }
 
 // CreateDeploymentClient function takes in a context and a workspace and returns a DeploymentClient and an error, if any.
 func (f *MockFactory) CreateDeploymentClient(ctx context.Context, workspace workspaces.Workspace) (clients.DeploymentClient, error) {
-	return nil, nil
+	return f.DeploymentClient, nil
 }
 
 // CreateDiagnosticsClient function takes in a context and a workspace and returns a DiagnosticsClient without any errors.
@@ -18,6 +18,7 @@ package deploy
 
 import (
 	"context"
+	"os"
 	"sync"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -31,6 +32,14 @@ import (
 // DeployWithProgress injects environment and application parameters into the template, displays progress updates while
 // deploying, and logs the deployment results and public endpoints. If an error occurs, an error is returned.
 func DeployWithProgress(ctx context.Context, options Options) (clients.DeploymentResult, error) {
+	if options.Format == output.FormatJson {
+		eventOutput := options.EventOutput
+		if eventOutput == nil {
+			eventOutput = os.Stdout
+		}
+		return DeployWithEvents(ctx, options, NewEventWriter(eventOutput))
+	}
+
 	deploymentClient, err := options.ConnectionFactory.CreateDeploymentClient(ctx, options.Workspace)
 	if err != nil {
 		return clients.DeploymentResult{}, err
@@ -99,3 +108,96 @@ func DeployWithProgress(ctx context.Context, options Options) (clients.Deploymen
 
 	return result, nil
 }
+
+// DeployWithEvents runs a deployment and writes its progress and result as deployment events, so that they can be
+// parsed by scripts and CI pipelines. The deployment of each resource is reported with resource events, followed by a
+// deploymentSucceeded event with the resources, outputs and public endpoints, or a deploymentFailed event.
+//
+// When the deployment fails, the error is wrapped in a ReportedError if the deploymentFailed event was written.
+func DeployWithEvents(ctx context.Context, options Options, writer *EventWriter) (clients.DeploymentResult, error) {
+	start := writer.Now()
+	fail := func(err error) (clients.DeploymentResult, error) {
+		writeErr := writer.Write(Event{
+			Type:            EventDeploymentFailed,
+			DurationSeconds: writer.Now().Sub(start).Seconds(),
+			Error:           NewEventError(err),
+		})
+		if writeErr != nil {
+			return clients.DeploymentResult{}, err
+		}
+
+		return clients.DeploymentResult{}, &ReportedError{Err: err}
+	}
+
+	deploymentClient, err := options.ConnectionFactory.CreateDeploymentClient(ctx, options.Workspace)
+	if err != nil {
+		return fail(err)
+	}
+
+	err = writer.Write(Event{Type: EventDeploymentStarted})
+	if err != nil {
+		return clients.DeploymentResult{}, err
+	}
+
+	progressChan := make(chan clients.ResourceProgress, 1)
+	listener := NewJSONListener(progressChan, writer)
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		listener.Run()
+		wg.Done()
+	}()
+
+	result, err := deploymentClient.Deploy(ctx, clients.DeploymentOptions{
+		Template:     options.Template,
+		Parameters:   options.Parameters,
+		Providers:    options.Providers,
+		ProgressChan: progressChan,
+	})
+
+	// Drain the resource events before writing the result of the deployment.
+	wg.Wait()
+	if err != nil {
+		return fail(err)
+	}
+
+	event := Event{
+		Type:            EventDeploymentSucceeded,
+		DurationSeconds: writer.Now().Sub(start).Seconds(),
+		Resources:       []EventResource{},
+		Outputs:         result.Outputs,
+		Endpoints:       []EventEndpoint{},
+	}
+
+	for _, resource := range result.Resources {
+		if output.ShowResource(resource) {
+			event.Resources = append(event.Resources, NewEventResource(resource))
+		}
+	}
+
+	if len(result.Resources) > 0 {
+		diagnosticsClient, err := options.ConnectionFactory.CreateDiagnosticsClient(ctx, options.Workspace)
+		if err != nil {
+			return fail(err)
+		}
+
+		endpoints, err := FindPublicEndpoints(ctx, diagnosticsClient, result)
+		if err != nil {
+			return fail(err)
+		}
+
+		for _, entry := range endpoints {
+			event.Endpoints = append(event.Endpoints, EventEndpoint{
+				Resource: NewEventResource(entry.Resource),
+				Endpoint: entry.Endpoint,
+			})
+		}
+	}
+
+	err = writer.Write(event)
+	if err != nil {
+		return clients.DeploymentResult{}, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/output"
+	ucpresources "github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// EventType is the type of a deployment event.
+type EventType string
+
+const (
+	// EventDeploymentStarted is written when the deployment starts.
+	EventDeploymentStarted EventType = "deploymentStarted"
+
+	// EventDeploymentSucceeded is written when the deployment succeeds. It contains the deployed resources, the
+	// outputs of the template and the public endpoints.
+	EventDeploymentSucceeded EventType = "deploymentSucceeded"
+
+	// EventDeploymentFailed is written when the deployment fails. It contains the error of the deployment.
+	EventDeploymentFailed EventType = "deploymentFailed"
+
+	// EventResourceStarted is written when the deployment of a resource starts.
+	EventResourceStarted EventType = "resourceStarted"
+
+	// EventResourceSucceeded is written when the deployment of a resource succeeds.
+	EventResourceSucceeded EventType = "resourceSucceeded"
+
+	// EventResourceFailed is written when the deployment of a resource fails. It contains the error of the resource
+	// when the deployment reported one.
+	EventResourceFailed EventType = "resourceFailed"
+)
+
+// Event is a deployment event. Events are written as newline-delimited JSON so that they can be parsed while the
+// deployment is in progress.
+type Event struct {
+	Type      EventType `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+
+	// Resource is the resource of a resource event.
+	Resource *EventResource `json:"resource,omitempty"`
+
+	// DurationSeconds is the time between the start and the end of the deployment or of the deployment of the
+	// resource. It is omitted for resources that completed before their start was observed.
+	DurationSeconds float64 `json:"durationSeconds,omitempty"`
+
+	// Error is the error of a failed deployment or resource.
+	Error *v1.ErrorDetails `json:"error,omitempty"`
+
+	// Resources are the resources of a successful deployment.
+	Resources []EventResource `json:"resources,omitempty"`
+
+	// Outputs are the outputs of the template of a successful deployment.
+	Outputs map[string]clients.DeploymentOutput `json:"outputs,omitempty"`
+
+	// Endpoints are the public endpoints of the resources of a successful deployment.
+	Endpoints []EventEndpoint `json:"endpoints,omitempty"`
+}
+
+// EventResource identifies a resource in a deployment event.
+type EventResource struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// EventEndpoint is a public endpoint of a resource in a deployment event.
+type EventEndpoint struct {
+	Resource EventResource `json:"resource"`
+	Endpoint string        `json:"endpoint"`
+}
+
+// NewEventResource creates the EventResource for the given resource ID.
+func NewEventResource(id ucpresources.ID) EventResource {
+	return EventResource{
+		ID:   id.String(),
+		Type: id.Type(),
+		Name: id.Name(),
+	}
+}
+
+// NewEventError converts an error to the ErrorDetails of a deployment event. The error details returned by the
+// Radius API are used when available.
+func NewEventError(err error) *v1.ErrorDetails {
+	if details := clientv2.TryUnfoldResponseError(err); details != nil {
+		return details
+	}
+
+	return &v1.ErrorDetails{Message: err.Error()}
+}
+
+// ReportedError is returned by DeployWithEvents when the failure of the deployment has been written as a
+// deploymentFailed event, so that callers do not report it a second time.
+type ReportedError struct {
+	Err error
+}
+
+// Error returns the message of the underlying error.
+func (e *ReportedError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ReportedError) Unwrap() error {
+	return e.Err
+}
+
+// EventWriter writes deployment events as newline-delimited JSON. EventWriter can be used concurrently.
+type EventWriter struct {
+	encoder *json.Encoder
+	mutex   sync.Mutex
+
+	// Now returns the current time. It is used to set the timestamp of the events.
+	Now func() time.Time
+}
+
+// NewEventWriter creates an EventWriter that writes to the given writer.
+func NewEventWriter(writer io.Writer) *EventWriter {
+	return &EventWriter{
+		encoder: json.NewEncoder(writer),
+		Now:     time.Now,
+	}
+}
+
+// Write sets the timestamp of the event and writes it as a line of JSON.
+func (w *EventWriter) Write(event Event) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	event.Timestamp = w.Now().UTC()
+	return w.encoder.Encode(event)
+}
+
+// JSONListener is a ProgressListener that writes the progress of the resources as deployment events.
+type JSONListener struct {
+	progressChan <-chan clients.ResourceProgress
+	writer       *EventWriter
+}
+
+// NewJSONListener creates a JSONListener that writes the progress of the resources to the given EventWriter.
+func NewJSONListener(progressChan <-chan clients.ResourceProgress, writer *EventWriter) *JSONListener {
+	return &JSONListener{
+		progressChan: progressChan,
+		writer:       writer,
+	}
+}
+
+// Run writes an event for each resource update received from the progressChan channel, until the channel is closed.
+// Errors writing events are ignored so that the channel is always drained.
+func (listener *JSONListener) Run() {
+	// Time at which the start of each resource was observed, to compute durations.
+	started := map[string]time.Time{}
+
+	for update := range listener.progressChan {
+		if !output.ShowResource(update.Resource) {
+			continue
+		}
+
+		resource := NewEventResource(update.Resource)
+		event := Event{Resource: &resource}
+
+		switch update.Status {
+		case clients.StatusStarted:
+			started[resource.ID] = listener.writer.Now()
+			event.Type = EventResourceStarted
+		case clients.StatusCompleted:
+			event.Type = EventResourceSucceeded
+		case clients.StatusFailed:
+			event.Type = EventResourceFailed
+			event.Error = update.Error
+		default:
+			continue
+		}
+
+		if start, ok := started[resource.ID]; ok && update.Status != clients.StatusStarted {
+			event.DurationSeconds = listener.writer.Now().Sub(start).Seconds()
+		}
+
+		_ = listener.writer.Write(event)
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	ucpresources "github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testContainerID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend"
+	testGatewayID   = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/gateway"
+)
+
+// fakeDeploymentClient reports the given progress updates and returns the given result.
+type fakeDeploymentClient struct {
+	progress []clients.ResourceProgress
+	result   clients.DeploymentResult
+	err      error
+}
+
+func (c *fakeDeploymentClient) Deploy(ctx context.Context, options clients.DeploymentOptions) (clients.DeploymentResult, error) {
+	for _, update := range c.progress {
+		options.ProgressChan <- update
+	}
+	close(options.ProgressChan)
+
+	return c.result, c.err
+}
+
+// newTestEventWriter returns an EventWriter whose clock advances by one second each time it is read.
+func newTestEventWriter(buffer *bytes.Buffer) *EventWriter {
+	writer := NewEventWriter(buffer)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	writer.Now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	return writer
+}
+
+func readEvents(t *testing.T, buffer *bytes.Buffer) []Event {
+	events := []Event{}
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+		event := Event{}
+		require.NoError(t, json.Unmarshal([]byte(line), &event))
+		events = append(events, event)
+	}
+
+	return events
+}
+
+func Test_JSONListener(t *testing.T) {
+	container := ucpresources.MustParse(testContainerID)
+	gateway := ucpresources.MustParse(testGatewayID)
+	failure := &v1.ErrorDetails{Code: "Conflict", Message: "The gateway hostname is already in use."}
+
+	progressChan := make(chan clients.ResourceProgress, 4)
+	progressChan <- clients.ResourceProgress{Resource: container, Status: clients.StatusStarted}
+	progressChan <- clients.ResourceProgress{Resource: container, Status: clients.StatusCompleted}
+	progressChan <- clients.ResourceProgress{Resource: gateway, Status: clients.StatusFailed, Error: failure}
+	close(progressChan)
+
+	buffer := &bytes.Buffer{}
+	NewJSONListener(progressChan, newTestEventWriter(buffer)).Run()
+
+	events := readEvents(t, buffer)
+	require.Len(t, events, 3)
+
+	require.Equal(t, EventResourceStarted, events[0].Type)
+	require.Equal(t, &EventResource{ID: testContainerID, Type: "Applications.Core/containers", Name: "frontend"}, events[0].Resource)
+	require.Zero(t, events[0].DurationSeconds)
+
+	// The start was observed at the first tick, the completion at the third (after the timestamp of the start event).
+	require.Equal(t, EventResourceSucceeded, events[1].Type)
+	require.Equal(t, 2.0, events[1].DurationSeconds)
+
+	// The start of the gateway was not observed, so the duration is unknown.
+	require.Equal(t, EventResourceFailed, events[2].Type)
+	require.Equal(t, "gateway", events[2].Resource.Name)
+	require.Equal(t, failure, events[2].Error)
+	require.Zero(t, events[2].DurationSeconds)
+}
+
+func Test_DeployWithEvents(t *testing.T) {
+	container := ucpresources.MustParse(testContainerID)
+	gateway := ucpresources.MustParse(testGatewayID)
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: container}).
+			Return(nil, nil).
+			Times(1)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: gateway}).
+			Return(to.Ptr("http://localhost"), nil).
+			Times(1)
+
+		deploymentClient := &fakeDeploymentClient{
+			progress: []clients.ResourceProgress{
+				{Resource: container, Status: clients.StatusStarted},
+				{Resource: container, Status: clients.StatusCompleted},
+			},
+			result: clients.DeploymentResult{
+				Resources: []ucpresources.ID{container, gateway},
+				Outputs: map[string]clients.DeploymentOutput{
+					"url": {Type: "String", Value: "http://localhost"},
+				},
+			},
+		}
+
+		buffer := &bytes.Buffer{}
+		result, err := DeployWithEvents(context.Background(), Options{
+			ConnectionFactory: &connections.MockFactory{DeploymentClient: deploymentClient, DiagnosticsClient: diagnosticsClient},
+			Workspace:         workspaces.Workspace{},
+		}, newTestEventWriter(buffer))
+		require.NoError(t, err)
+		require.Equal(t, deploymentClient.result, result)
+
+		events := readEvents(t, buffer)
+		require.Len(t, events, 4)
+		require.Equal(t, EventDeploymentStarted, events[0].Type)
+		require.Equal(t, EventResourceStarted, events[1].Type)
+		require.Equal(t, EventResourceSucceeded, events[2].Type)
+
+		last := events[3]
+		require.Equal(t, EventDeploymentSucceeded, last.Type)
+		require.Positive(t, last.DurationSeconds)
+		require.Equal(t, []EventResource{
+			{ID: testContainerID, Type: "Applications.Core/containers", Name: "frontend"},
+			{ID: testGatewayID, Type: "Applications.Core/gateways", Name: "gateway"},
+		}, last.Resources)
+		require.Equal(t, map[string]clients.DeploymentOutput{"url": {Type: "String", Value: "http://localhost"}}, last.Outputs)
+		require.Equal(t, []EventEndpoint{
+			{Resource: EventResource{ID: testGatewayID, Type: "Applications.Core/gateways", Name: "gateway"}, Endpoint: "http://localhost"},
+		}, last.Endpoints)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		deploymentClient := &fakeDeploymentClient{
+			progress: []clients.ResourceProgress{
+				{Resource: container, Status: clients.StatusFailed, Error: &v1.ErrorDetails{Code: "BadRequest", Message: "The image is invalid."}},
+			},
+			err: errors.New("the deployment failed"),
+		}
+
+		buffer := &bytes.Buffer{}
+		_, err := DeployWithEvents(context.Background(), Options{
+			ConnectionFactory: &connections.MockFactory{DeploymentClient: deploymentClient},
+			Workspace:         workspaces.Workspace{},
+		}, newTestEventWriter(buffer))
+		require.EqualError(t, err, "the deployment failed")
+		require.ErrorAs(t, err, new(*ReportedError))
+
+		events := readEvents(t, buffer)
+		require.Len(t, events, 3)
+		require.Equal(t, EventDeploymentStarted, events[0].Type)
+		require.Equal(t, EventResourceFailed, events[1].Type)
+		require.Equal(t, "The image is invalid.", events[1].Error.Message)
+		require.Equal(t, EventDeploymentFailed, events[2].Type)
+		require.Equal(t, &v1.ErrorDetails{Message: "the deployment failed"}, events[2].Error)
+	})
+
+	t.Run("public endpoint failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
+		diagnosticsClient.EXPECT().
+			GetPublicEndpoint(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("the cluster is unreachable")).
+			Times(1)
+
+		deploymentClient := &fakeDeploymentClient{
+			result: clients.DeploymentResult{
+				Resources: []ucpresources.ID{container},
+			},
+		}
+
+		buffer := &bytes.Buffer{}
+		_, err := DeployWithEvents(context.Background(), Options{
+			ConnectionFactory: &connections.MockFactory{DeploymentClient: deploymentClient, DiagnosticsClient: diagnosticsClient},
+			Workspace:         workspaces.Workspace{},
+		}, newTestEventWriter(buffer))
+		require.EqualError(t, err, "the cluster is unreachable")
+		require.ErrorAs(t, err, new(*ReportedError))
+
+		events := readEvents(t, buffer)
+		require.Len(t, events, 2)
+		require.Equal(t, EventDeploymentStarted, events[0].Type)
+		require.Equal(t, EventDeploymentFailed, events[1].Type)
+		require.Equal(t, &v1.ErrorDetails{Message: "the cluster is unreachable"}, events[1].Error)
+	})
+}
@@ -18,6 +18,7 @@ package deploy
 
 import (
 	"context"
+	"io"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/connections"
@@ -53,6 +54,13 @@ type Options struct {
 
 	// CompleteText is a message displayed on the console when deployment completes.
 	CompletionText string
+
+	// Format is the output format. With the JSON format, the progress and the result of the deployment are written
+	// to the console as newline-delimited JSON events instead of ProgressText and CompletionText.
+	Format string
+
+	// EventOutput is where the deployment events are written with the JSON format. Defaults to the standard output.
+	EventOutput io.Writer
 }
 
 var _ Interface = (*Impl)(nil)
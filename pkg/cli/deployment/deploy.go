@@ -270,10 +270,15 @@ func (dc *ResourceDeploymentClient) monitorProgress(ctx context.Context, name st
 
 			if current != next && progressChan != nil {
 				status[id.String()] = next
-				progressChan <- clients.ResourceProgress{
+				progress := clients.ResourceProgress{
 					Resource: id,
 					Status:   next,
 				}
+				if next == clients.StatusFailed && operation.Properties.StatusMessage != nil {
+					progress.Error = newErrorDetails(operation.Properties.StatusMessage.Error)
+				}
+
+				progressChan <- progress
 			}
 		}
 	}
@@ -281,6 +286,32 @@ func (dc *ResourceDeploymentClient) monitorProgress(ctx context.Context, name st
 	return nil
 }
 
+// newErrorDetails converts the error reported by a deployment operation to ErrorDetails.
+func newErrorDetails(in *armresources.ErrorResponse) *v1.ErrorDetails {
+	if in == nil {
+		return nil
+	}
+
+	out := &v1.ErrorDetails{}
+	if in.Code != nil {
+		out.Code = *in.Code
+	}
+	if in.Message != nil {
+		out.Message = *in.Message
+	}
+	if in.Target != nil {
+		out.Target = *in.Target
+	}
+
+	for _, detail := range in.Details {
+		if converted := newErrorDetails(detail); converted != nil {
+			out.Details = append(out.Details, converted)
+		}
+	}
+
+	return out
+}
+
 func (dc *ResourceDeploymentClient) listOperations(ctx context.Context, name string) ([]*armresources.DeploymentOperation, error) {
 	var resourceId string
 
@@ -19,8 +19,11 @@ package deployment
 import (
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/stretchr/testify/require"
 )
 
@@ -89,3 +92,32 @@ func Test_GetProviderConfigsWithAzProvider(t *testing.T) {
 	providerConfig := resourceDeploymentClient.GetProviderConfigs(options)
 	require.Equal(t, providerConfig, expectedConfig)
 }
+
+func Test_NewErrorDetails(t *testing.T) {
+	require.Nil(t, newErrorDetails(nil))
+
+	in := &armresources.ErrorResponse{
+		Code:    to.Ptr("DeploymentFailed"),
+		Message: to.Ptr("At least one resource deployment operation failed."),
+		Details: []*armresources.ErrorResponse{
+			{
+				Code:    to.Ptr("RecipeDeploymentFailed"),
+				Message: to.Ptr("The recipe failed."),
+				Target:  to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis"),
+			},
+		},
+	}
+
+	expected := &v1.ErrorDetails{
+		Code:    "DeploymentFailed",
+		Message: "At least one resource deployment operation failed.",
+		Details: []*v1.ErrorDetails{
+			{
+				Code:    "RecipeDeploymentFailed",
+				Message: "The recipe failed.",
+				Target:  "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/redis",
+			},
+		},
+	}
+	require.Equal(t, expected, newErrorDetails(in))
+}
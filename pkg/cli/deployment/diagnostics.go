@@ -23,21 +23,31 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	cli_portforward "github.com/radius-project/radius/pkg/cli/kubernetes/portforward"
 	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 
 	"io"
 	"net/http"
 	"os"
 	"os/signal"
+	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/client-go/transport/spdy"
+	"k8s.io/kubectl/pkg/util/term"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -78,6 +88,231 @@ func (dc *ARMDiagnosticsClient) GetPublicEndpoint(ctx context.Context, options c
 	return &url, nil
 }
 
+// GetJobStatus checks if the resource type is Applications.Core/containers and the container runs as a job or cronJob
+// workload, then looks up the Job or CronJob output resource in Kubernetes and returns the status of its last run. It
+// returns nil if the resource does not run as a job.
+func (dc *ARMDiagnosticsClient) GetJobStatus(ctx context.Context, options clients.JobStatusOptions) (*clients.JobStatus, error) {
+	if !strings.EqualFold("Applications.Core/containers", options.ResourceID.Type()) {
+		return nil, nil
+	}
+
+	response, err := dc.ContainerClient.Get(ctx, options.ResourceID.Name(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	localID, id, err := findJobOutputResource(response.Properties)
+	if err != nil {
+		return nil, fmt.Errorf("could not find job for container %q: %w", options.ResourceID.Name(), err)
+	} else if id == nil {
+		return nil, nil
+	}
+
+	return getJobStatus(ctx, dc.K8sTypedClient, options.ResourceID.Name(), localID, *id)
+}
+
+// getJobStatus looks up the Job or CronJob output resource of the container in Kubernetes and returns the status of its
+// last run. A Job that no longer exists was deleted after it finished, for example because its ttlSecondsAfterFinished
+// expired, so it is reported as finished. A CronJob that no longer exists is reported as unknown.
+func getJobStatus(ctx context.Context, k8sClient k8s.Interface, container string, localID string, id resources.ID) (*clients.JobStatus, error) {
+	_, _, namespace, name := resources_kubernetes.ToParts(id)
+	switch localID {
+	case rpv1.LocalIDJob:
+		job, err := k8sClient.BatchV1().Jobs(namespace).Get(ctx, name, v1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return &clients.JobStatus{Name: container, Kind: "Job", Status: clients.JobStatusFinished}, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get job %q for container %q: %w", name, container, err)
+		}
+		return newJobStatus(container, job), nil
+	default:
+		cronJob, err := k8sClient.BatchV1().CronJobs(namespace).Get(ctx, name, v1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return &clients.JobStatus{Name: container, Kind: "CronJob", Status: clients.JobStatusUnknown}, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get cron job %q for container %q: %w", name, container, err)
+		}
+		return newCronJobStatus(container, cronJob), nil
+	}
+}
+
+// findJobOutputResource returns the local ID and resource ID of the Job or CronJob output resource from the
+// properties of a container, or nil if the container does not have one.
+func findJobOutputResource(properties map[string]any) (string, *resources.ID, error) {
+	status, ok := properties["status"].(map[string]any)
+	if !ok {
+		return "", nil, nil
+	}
+
+	outputResources, ok := status["outputResources"].([]any)
+	if !ok {
+		return "", nil, nil
+	}
+
+	for _, obj := range outputResources {
+		outputResource, ok := obj.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		localID, _ := outputResource["localId"].(string)
+		if localID != rpv1.LocalIDJob && localID != rpv1.LocalIDCronJob {
+			continue
+		}
+
+		value, _ := outputResource["id"].(string)
+		id, err := resources.Parse(value)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return localID, &id, nil
+	}
+
+	return "", nil, nil
+}
+
+// GetReplicaStatus finds the Kubernetes Deployments in the output resources of the resource, and returns the number of
+// ready and desired replicas of each Deployment. Deployments that no longer exist are skipped.
+func (dc *ARMDiagnosticsClient) GetReplicaStatus(ctx context.Context, options clients.ReplicaStatusOptions) ([]clients.ReplicaStatus, error) {
+	results := []clients.ReplicaStatus{}
+	for _, id := range findDeploymentOutputResources(options.Properties) {
+		_, _, namespace, name := resources_kubernetes.ToParts(id)
+		deployment, err := dc.K8sTypedClient.AppsV1().Deployments(namespace).Get(ctx, name, v1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get deployment %q for resource %q: %w", name, options.ResourceID.Name(), err)
+		}
+
+		results = append(results, newReplicaStatus(deployment))
+	}
+
+	return results, nil
+}
+
+// findDeploymentOutputResources returns the resource IDs of the Kubernetes Deployment output resources from the
+// properties of a resource. Output resources with an invalid ID are skipped.
+func findDeploymentOutputResources(properties map[string]any) []resources.ID {
+	status, ok := properties["status"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	outputResources, ok := status["outputResources"].([]any)
+	if !ok {
+		return nil
+	}
+
+	ids := []resources.ID{}
+	for _, obj := range outputResources {
+		outputResource, ok := obj.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		value, _ := outputResource["id"].(string)
+		id, err := resources.Parse(value)
+		if err != nil {
+			continue
+		}
+
+		if strings.EqualFold(id.Type(), "apps/Deployment") {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// newReplicaStatus returns the replica readiness of the given deployment, and the reason its rollout failed if the
+// deployment reports a failure.
+func newReplicaStatus(deployment *appsv1.Deployment) clients.ReplicaStatus {
+	status := clients.ReplicaStatus{
+		Name:          deployment.Name,
+		ReadyReplicas: deployment.Status.ReadyReplicas,
+		Replicas:      1,
+	}
+
+	if deployment.Spec.Replicas != nil {
+		status.Replicas = *deployment.Spec.Replicas
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		switch {
+		case condition.Type == appsv1.DeploymentProgressing && condition.Status == corev1.ConditionFalse:
+			status.Message = condition.Message
+		case condition.Type == appsv1.DeploymentReplicaFailure && condition.Status == corev1.ConditionTrue:
+			status.Message = condition.Message
+		}
+	}
+
+	return status
+}
+
+// newJobStatus returns the status of the run of the given job.
+func newJobStatus(name string, job *batchv1.Job) *clients.JobStatus {
+	status := &clients.JobStatus{
+		Name:   name,
+		Kind:   "Job",
+		Status: clients.JobStatusPending,
+	}
+
+	if job.Status.StartTime != nil {
+		status.LastRun = job.Status.StartTime.UTC().Format(time.RFC3339)
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch condition.Type {
+		case batchv1.JobComplete:
+			status.Status = clients.JobStatusSucceeded
+			return status
+		case batchv1.JobFailed:
+			status.Status = clients.JobStatusFailed
+			return status
+		}
+	}
+
+	if job.Status.Active > 0 {
+		status.Status = clients.JobStatusRunning
+	}
+
+	return status
+}
+
+// newCronJobStatus returns the status of the last scheduled run of the given cron job.
+func newCronJobStatus(name string, cronJob *batchv1.CronJob) *clients.JobStatus {
+	status := &clients.JobStatus{
+		Name: name,
+		Kind: "CronJob",
+	}
+
+	lastSchedule := cronJob.Status.LastScheduleTime
+	if lastSchedule != nil {
+		status.LastRun = lastSchedule.UTC().Format(time.RFC3339)
+	}
+
+	lastSuccess := cronJob.Status.LastSuccessfulTime
+	switch {
+	case len(cronJob.Status.Active) > 0:
+		status.Status = clients.JobStatusRunning
+	case cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend:
+		status.Status = clients.JobStatusSuspended
+	case lastSchedule == nil:
+		status.Status = clients.JobStatusScheduled
+	case lastSuccess != nil && !lastSuccess.Before(lastSchedule):
+		status.Status = clients.JobStatusSucceeded
+	default:
+		status.Status = clients.JobStatusFailed
+	}
+
+	return status
+}
+
 // Expose function finds a running replica of the container, prints the replica name, sets up a signal notification,
 // creates channels for errors, readiness and stopping, and runs a portforwarding process.
 func (dc *ARMDiagnosticsClient) Expose(ctx context.Context, options clients.ExposeOptions) (failed chan error, stop chan struct{}, signals chan os.Signal, err error) {
@@ -149,6 +384,187 @@ func (dc *ARMDiagnosticsClient) Logs(ctx context.Context, options clients.LogsOp
 	return streams, err
 }
 
+// Exec finds a running replica of the container, and runs the command in the selected container of the replica using
+// the exec subresource of the pod. When a TTY is requested, the local terminal is put in raw mode and its size is
+// forwarded to the remote terminal.
+func (dc *ARMDiagnosticsClient) Exec(ctx context.Context, options clients.ExecOptions) error {
+	replica, err := dc.findReplica(ctx, options.Application, options.Resource, options.Replica)
+	if err != nil {
+		return err
+	}
+
+	container, err := findContainer(replica, options.Resource, options.Container)
+	if err != nil {
+		return err
+	}
+
+	request := dc.K8sTypedClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(replica.Namespace).
+		Name(replica.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container.Name,
+			Command:   options.Command,
+			Stdin:     options.Stdin != nil,
+			Stdout:    options.Stdout != nil,
+			// The remote terminal combines stdout and stderr.
+			Stderr: options.Stderr != nil && !options.TTY,
+			TTY:    options.TTY,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(dc.RestConfig, "POST", request.URL())
+	if err != nil {
+		return err
+	}
+
+	tty := term.TTY{In: options.Stdin, Out: options.Stdout, Raw: options.TTY}
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:  options.Stdin,
+		Stdout: options.Stdout,
+		Stderr: options.Stderr,
+		Tty:    options.TTY,
+	}
+	if options.TTY {
+		streamOptions.Stderr = nil
+		if sizeQueue := tty.MonitorSize(tty.GetSize()); sizeQueue != nil {
+			streamOptions.TerminalSizeQueue = &terminalSizeQueue{delegate: sizeQueue}
+		}
+	}
+
+	return tty.Safe(func() error {
+		return executor.StreamWithContext(ctx, streamOptions)
+	})
+}
+
+// terminalSizeQueue adapts the terminal size queue of the local terminal to the terminal size queue of the remote command.
+type terminalSizeQueue struct {
+	delegate term.TerminalSizeQueue
+}
+
+// Next returns the next size of the local terminal, or nil when the terminal is closed.
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	size := q.delegate.Next()
+	if size == nil {
+		return nil
+	}
+
+	return &remotecommand.TerminalSize{Width: size.Width, Height: size.Height}
+}
+
+// PortForward finds a running replica of the container, and forwards the local ports to it until the context is
+// cancelled. When no ports are specified, the ports of the selected container of the replica are forwarded.
+func (dc *ARMDiagnosticsClient) PortForward(ctx context.Context, options clients.PortForwardOptions) error {
+	replica, err := dc.findReplica(ctx, options.Application, options.Resource, options.Replica)
+	if err != nil {
+		return err
+	}
+
+	ports := options.Ports
+	if len(ports) == 0 {
+		container, err := findContainer(replica, options.Resource, options.Container)
+		if err != nil {
+			return err
+		}
+
+		for _, port := range container.Ports {
+			if port.Protocol == "" || port.Protocol == corev1.ProtocolTCP {
+				ports = append(ports, fmt.Sprintf("%d", port.ContainerPort))
+			}
+		}
+
+		if len(ports) == 0 {
+			return fmt.Errorf("container %q of resource %q does not have any TCP ports, specify the ports to forward", container.Name, options.Resource)
+		}
+	}
+
+	addresses := options.Addresses
+	if len(addresses) == 0 {
+		addresses = []string{"localhost"}
+	}
+
+	out := options.Out
+	if out == nil {
+		out = io.Discard
+	}
+	errOut := options.ErrOut
+	if errOut == nil {
+		errOut = io.Discard
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			close(stop)
+		case <-done:
+		}
+	}()
+
+	fw, err := newPortForwarder(dc.RestConfig, dc.K8sTypedClient, replica, addresses, ports, stop, nil, out, errOut)
+	if err != nil {
+		return err
+	}
+
+	return fw.ForwardPorts()
+}
+
+// findReplica returns the specified replica of the container, or a running replica of the container selected with
+// the labels that Radius applies to the pods of the container.
+func (dc *ARMDiagnosticsClient) findReplica(ctx context.Context, application string, resource string, replica string) (*corev1.Pod, error) {
+	namespace, err := dc.findNamespaceOfContainer(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if replica != "" {
+		return getSpecificReplica(ctx, dc.K8sTypedClient, namespace, resource, replica)
+	}
+
+	selector, err := cli_portforward.CreateLabelSelectorForResource(application, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := dc.K8sTypedClient.CoreV1().Pods(namespace).List(ctx, v1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list running replicas for resource %v: %w", resource, err)
+	}
+
+	for _, pod := range pods.Items {
+		// Skip the replicas that are shutting down, such as the replicas of a previous deployment.
+		if pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp == nil {
+			return &pod, nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to find a running replica for resource %v", resource)
+}
+
+// findContainer returns the container of the replica with the given name, or the primary container of the resource
+// if the name is empty. Sidecar containers, such as the Dapr sidecar, are selected by name.
+func findContainer(replica *corev1.Pod, resource string, name string) (*corev1.Container, error) {
+	if name == "" {
+		name = getAppContainerName(replica)
+		if name == "" {
+			return nil, fmt.Errorf("failed to find the default container for resource '%s'. use '--container <name>' to specify the name", resource)
+		}
+	}
+
+	names := []string{}
+	containers := append(append([]corev1.Container{}, replica.Spec.Containers...), replica.Spec.InitContainers...)
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i], nil
+		}
+		names = append(names, containers[i].Name)
+	}
+
+	return nil, fmt.Errorf("container %q not found in replica %s of resource %q, available containers: %s", name, replica.Name, resource, strings.Join(names, ", "))
+}
+
 func (dc *ARMDiagnosticsClient) findNamespaceOfContainer(ctx context.Context, resourceName string) (string, error) {
 	containerResponse, err := dc.ContainerClient.Get(ctx, resourceName, nil)
 	if err != nil {
@@ -291,18 +707,6 @@ func getRunningReplicas(ctx context.Context, client *k8s.Clientset, namespace st
 }
 
 func runPortforward(restconfig *rest.Config, client *k8s.Clientset, replica *corev1.Pod, ready chan struct{}, stop <-chan struct{}, localPort int, remotePort int) error {
-	// Build URL so we can open a port-forward via SPDY
-	url := client.CoreV1().RESTClient().Post().
-		Resource("pods").
-		Namespace(replica.Namespace).
-		Name(replica.Name).
-		SubResource("portforward").URL()
-
-	transport, upgrader, err := spdy.RoundTripperFor(restconfig)
-	if err != nil {
-		return err
-	}
-
 	out := io.Discard
 	errOut := io.Discard
 	if true {
@@ -311,9 +715,7 @@ func runPortforward(restconfig *rest.Config, client *k8s.Clientset, replica *cor
 	}
 
 	ports := []string{fmt.Sprintf("%d:%d", localPort, remotePort)}
-	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", url)
-
-	fw, err := portforward.NewOnAddresses(dialer, []string{"localhost"}, ports, stop, ready, out, errOut)
+	fw, err := newPortForwarder(restconfig, client, replica, []string{"localhost"}, ports, stop, ready, out, errOut)
 	if err != nil {
 		return err
 	}
@@ -321,6 +723,24 @@ func runPortforward(restconfig *rest.Config, client *k8s.Clientset, replica *cor
 	return fw.ForwardPorts()
 }
 
+// newPortForwarder creates a port-forwarder that connects to the replica using the portforward subresource of the pod.
+func newPortForwarder(restconfig *rest.Config, client *k8s.Clientset, replica *corev1.Pod, addresses []string, ports []string, stop <-chan struct{}, ready chan struct{}, out io.Writer, errOut io.Writer) (*portforward.PortForwarder, error) {
+	// Build URL so we can open a port-forward via SPDY
+	url := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(replica.Namespace).
+		Name(replica.Name).
+		SubResource("portforward").URL()
+
+	transport, upgrader, err := spdy.RoundTripperFor(restconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", url)
+	return portforward.NewOnAddresses(dialer, addresses, ports, stop, ready, out, errOut)
+}
+
 func getAppContainerName(replica *corev1.Pod) string {
 	// The container name will be the resource name
 	resource := replica.Labels[k8slabels.LabelRadiusResource]
@@ -0,0 +1,333 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deployment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func Test_findJobOutputResource(t *testing.T) {
+	t.Run("no status", func(t *testing.T) {
+		localID, id, err := findJobOutputResource(map[string]any{})
+		require.NoError(t, err)
+		require.Empty(t, localID)
+		require.Nil(t, id)
+	})
+
+	t.Run("cron job", func(t *testing.T) {
+		properties := map[string]any{
+			"status": map[string]any{
+				"outputResources": []any{
+					map[string]any{
+						"localId": "ServiceAccount",
+						"id":      "/planes/kubernetes/local/namespaces/default-app/providers/core/ServiceAccount/test",
+					},
+					map[string]any{
+						"localId": "CronJob",
+						"id":      "/planes/kubernetes/local/namespaces/default-app/providers/batch/CronJob/test",
+					},
+				},
+			},
+		}
+
+		localID, id, err := findJobOutputResource(properties)
+		require.NoError(t, err)
+		require.Equal(t, rpv1.LocalIDCronJob, localID)
+		require.Equal(t, "/planes/kubernetes/local/namespaces/default-app/providers/batch/CronJob/test", id.String())
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		properties := map[string]any{
+			"status": map[string]any{
+				"outputResources": []any{
+					map[string]any{
+						"localId": "Job",
+						"id":      "invalid",
+					},
+				},
+			},
+		}
+
+		_, _, err := findJobOutputResource(properties)
+		require.Error(t, err)
+	})
+}
+
+func Test_getJobStatus(t *testing.T) {
+	jobID := resources.MustParse("/planes/kubernetes/local/namespaces/default-app/providers/batch/Job/test")
+	cronJobID := resources.MustParse("/planes/kubernetes/local/namespaces/default-app/providers/batch/CronJob/test")
+
+	t.Run("job", func(t *testing.T) {
+		k8sClient := fake.NewSimpleClientset(&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default-app"},
+			Status:     batchv1.JobStatus{Active: 1},
+		})
+
+		status, err := getJobStatus(context.Background(), k8sClient, "test", rpv1.LocalIDJob, jobID)
+		require.NoError(t, err)
+		require.Equal(t, &clients.JobStatus{Name: "test", Kind: "Job", Status: clients.JobStatusRunning}, status)
+	})
+
+	t.Run("job deleted after it finished", func(t *testing.T) {
+		k8sClient := fake.NewSimpleClientset()
+
+		status, err := getJobStatus(context.Background(), k8sClient, "test", rpv1.LocalIDJob, jobID)
+		require.NoError(t, err)
+		require.Equal(t, &clients.JobStatus{Name: "test", Kind: "Job", Status: clients.JobStatusFinished}, status)
+	})
+
+	t.Run("cron job not found", func(t *testing.T) {
+		k8sClient := fake.NewSimpleClientset()
+
+		status, err := getJobStatus(context.Background(), k8sClient, "test", rpv1.LocalIDCronJob, cronJobID)
+		require.NoError(t, err)
+		require.Equal(t, &clients.JobStatus{Name: "test", Kind: "CronJob", Status: clients.JobStatusUnknown}, status)
+	})
+
+	t.Run("job get fails", func(t *testing.T) {
+		k8sClient := fake.NewSimpleClientset()
+		k8sClient.PrependReactor("get", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("test error")
+		})
+
+		_, err := getJobStatus(context.Background(), k8sClient, "test", rpv1.LocalIDJob, jobID)
+		require.EqualError(t, err, "failed to get job \"test\" for container \"test\": test error")
+	})
+}
+
+func Test_newJobStatus(t *testing.T) {
+	start := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		status   batchv1.JobStatus
+		expected string
+	}{
+		{
+			name:     "pending",
+			status:   batchv1.JobStatus{},
+			expected: clients.JobStatusPending,
+		},
+		{
+			name:     "running",
+			status:   batchv1.JobStatus{Active: 1, StartTime: &start},
+			expected: clients.JobStatusRunning,
+		},
+		{
+			name: "succeeded",
+			status: batchv1.JobStatus{
+				StartTime:  &start,
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+			},
+			expected: clients.JobStatusSucceeded,
+		},
+		{
+			name: "failed",
+			status: batchv1.JobStatus{
+				StartTime:  &start,
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}},
+			},
+			expected: clients.JobStatusFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := newJobStatus("test", &batchv1.Job{Status: tt.status})
+			require.Equal(t, "test", status.Name)
+			require.Equal(t, "Job", status.Kind)
+			require.Equal(t, tt.expected, status.Status)
+			if tt.status.StartTime != nil {
+				require.Equal(t, "2024-01-01T00:00:00Z", status.LastRun)
+			}
+		})
+	}
+}
+
+func Test_newCronJobStatus(t *testing.T) {
+	earlier := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name     string
+		cronJob  batchv1.CronJob
+		expected string
+	}{
+		{
+			name:     "never run",
+			cronJob:  batchv1.CronJob{},
+			expected: clients.JobStatusScheduled,
+		},
+		{
+			name: "suspended",
+			cronJob: batchv1.CronJob{
+				Spec: batchv1.CronJobSpec{Suspend: to.Ptr(true)},
+			},
+			expected: clients.JobStatusSuspended,
+		},
+		{
+			name: "running",
+			cronJob: batchv1.CronJob{
+				Status: batchv1.CronJobStatus{Active: []corev1.ObjectReference{{Name: "test-1"}}, LastScheduleTime: &later},
+			},
+			expected: clients.JobStatusRunning,
+		},
+		{
+			name: "last run succeeded",
+			cronJob: batchv1.CronJob{
+				Status: batchv1.CronJobStatus{LastScheduleTime: &earlier, LastSuccessfulTime: &later},
+			},
+			expected: clients.JobStatusSucceeded,
+		},
+		{
+			name: "last run failed",
+			cronJob: batchv1.CronJob{
+				Status: batchv1.CronJobStatus{LastScheduleTime: &later, LastSuccessfulTime: &earlier},
+			},
+			expected: clients.JobStatusFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := newCronJobStatus("test", &tt.cronJob)
+			require.Equal(t, "test", status.Name)
+			require.Equal(t, "CronJob", status.Kind)
+			require.Equal(t, tt.expected, status.Status)
+		})
+	}
+}
+
+func Test_findContainer(t *testing.T) {
+	replica := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "frontend-abc",
+			Labels: map[string]string{"radapp.io/resource": "frontend"},
+		},
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init"}},
+			Containers:     []corev1.Container{{Name: "frontend"}, {Name: "daprd"}},
+		},
+	}
+
+	t.Run("primary container", func(t *testing.T) {
+		container, err := findContainer(replica, "frontend", "")
+		require.NoError(t, err)
+		require.Equal(t, "frontend", container.Name)
+	})
+
+	t.Run("sidecar container", func(t *testing.T) {
+		container, err := findContainer(replica, "frontend", "daprd")
+		require.NoError(t, err)
+		require.Equal(t, "daprd", container.Name)
+	})
+
+	t.Run("init container", func(t *testing.T) {
+		container, err := findContainer(replica, "frontend", "init")
+		require.NoError(t, err)
+		require.Equal(t, "init", container.Name)
+	})
+
+	t.Run("container not found", func(t *testing.T) {
+		_, err := findContainer(replica, "frontend", "missing")
+		require.EqualError(t, err, `container "missing" not found in replica frontend-abc of resource "frontend", available containers: frontend, daprd, init`)
+	})
+}
+
+func Test_findDeploymentOutputResources(t *testing.T) {
+	require.Empty(t, findDeploymentOutputResources(map[string]any{}))
+
+	properties := map[string]any{
+		"status": map[string]any{
+			"outputResources": []any{
+				map[string]any{
+					"localId": "Deployment",
+					"id":      "/planes/kubernetes/local/namespaces/default-app/providers/apps/Deployment/frontend",
+				},
+				map[string]any{
+					"localId": "Service",
+					"id":      "/planes/kubernetes/local/namespaces/default-app/providers/core/Service/frontend",
+				},
+				map[string]any{
+					"id": "invalid",
+				},
+				map[string]any{
+					"id": "/planes/kubernetes/local/namespaces/default-app/providers/apps/Deployment/redis",
+				},
+			},
+		},
+	}
+
+	ids := findDeploymentOutputResources(properties)
+	require.Len(t, ids, 2)
+	require.Equal(t, "/planes/kubernetes/local/namespaces/default-app/providers/apps/Deployment/frontend", ids[0].String())
+	require.Equal(t, "/planes/kubernetes/local/namespaces/default-app/providers/apps/Deployment/redis", ids[1].String())
+}
+
+func Test_newReplicaStatus(t *testing.T) {
+	t.Run("ready", func(t *testing.T) {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "frontend"},
+			Spec:       appsv1.DeploymentSpec{Replicas: to.Ptr(int32(3))},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 3},
+		}
+
+		require.Equal(t, clients.ReplicaStatus{Name: "frontend", ReadyReplicas: 3, Replicas: 3}, newReplicaStatus(deployment))
+	})
+
+	t.Run("default replicas", func(t *testing.T) {
+		deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "frontend"}}
+		require.Equal(t, clients.ReplicaStatus{Name: "frontend", Replicas: 1}, newReplicaStatus(deployment))
+	})
+
+	t.Run("progress deadline exceeded", func(t *testing.T) {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "frontend"},
+			Spec:       appsv1.DeploymentSpec{Replicas: to.Ptr(int32(2))},
+			Status: appsv1.DeploymentStatus{
+				ReadyReplicas: 1,
+				Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse, Message: "Deployment does not have minimum availability."},
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded", Message: "ReplicaSet \"frontend-abc\" has timed out progressing."},
+				},
+			},
+		}
+
+		require.Equal(t, clients.ReplicaStatus{
+			Name:          "frontend",
+			ReadyReplicas: 1,
+			Replicas:      2,
+			Message:       "ReplicaSet \"frontend-abc\" has timed out progressing.",
+		}, newReplicaStatus(deployment))
+	})
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+)
+
+// ChangeType is the type of change the deployment of a template makes to a resource.
+type ChangeType string
+
+const (
+	// ChangeTypeCreate means that the resource does not exist and will be created.
+	ChangeTypeCreate ChangeType = "Create"
+
+	// ChangeTypeModify means that the resource exists and some of its properties will change.
+	ChangeTypeModify ChangeType = "Modify"
+
+	// ChangeTypeIgnore means that the resource belongs to an application of the template, but is not declared in
+	// the template. Deployments are incremental, so the resource is left unchanged.
+	ChangeTypeIgnore ChangeType = "Ignore"
+
+	// ChangeTypeNoChange means that the resource exists and its properties match the template.
+	ChangeTypeNoChange ChangeType = "NoChange"
+)
+
+// Result is the difference between a template and the deployed resources.
+type Result struct {
+	// Changes are the changes to each resource, in the order they are declared in the template, followed by the
+	// ignored resources.
+	Changes []ResourceChange `json:"changes"`
+
+	// Warnings describe the parts of the template that could not be compared.
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// ResourceChange is the change to a single resource.
+type ResourceChange struct {
+	// ID is the resource ID.
+	ID string `json:"id"`
+
+	// Type is the resource type.
+	Type string `json:"type"`
+
+	// Name is the resource name.
+	Name string `json:"name"`
+
+	// ChangeType is the type of change.
+	ChangeType ChangeType `json:"changeType"`
+
+	// Before are the properties of the ignored resource.
+	Before map[string]any `json:"before,omitempty"`
+
+	// After are the properties of the created resource.
+	After map[string]any `json:"after,omitempty"`
+
+	// Properties are the changed properties of the modified resource.
+	Properties []PropertyChange `json:"properties,omitempty"`
+}
+
+// PropertyChange is the change to a single property of a resource.
+type PropertyChange struct {
+	// Path is the path of the property, with the names of nested properties separated by dots.
+	Path string `json:"path"`
+
+	// Before is the deployed value of the property, or nil if the property is not set.
+	Before any `json:"before"`
+
+	// After is the value of the property in the template.
+	After any `json:"after"`
+}
+
+// Count returns the number of resource changes of the given type.
+func (r *Result) Count(changeType ChangeType) int {
+	count := 0
+	for _, change := range r.Changes {
+		if change.ChangeType == changeType {
+			count++
+		}
+	}
+
+	return count
+}
+
+// HasChanges returns true if deploying the template would create or modify resources. Ignored resources are not
+// changes, because the deployment does not delete them.
+func (r *Result) HasChanges() bool {
+	return r.Count(ChangeTypeCreate)+r.Count(ChangeTypeModify) > 0
+}
+
+// Compute compares the resources of a template with the deployed resources. Resources that belong to the applications
+// of the template, or to the given additional applications, but that are not declared in the template are reported
+// as ignored.
+//
+// Only the properties declared in the template are compared, because deployed resources also have properties set by
+// Radius, such as their status. Properties whose value is only known during the deployment are not compared.
+func Compute(ctx context.Context, client clients.ApplicationsManagementClient, resources []Resource, additionalApplicationIDs []string) (*Result, error) {
+	result := &Result{Changes: []ResourceChange{}}
+	declared := map[string]bool{}
+
+	for _, resource := range resources {
+		declared[strings.ToLower(resource.ID)] = true
+
+		change := ResourceChange{
+			ID:   resource.ID,
+			Type: resource.Type,
+			Name: resource.Name,
+		}
+
+		deployed, err := client.GetResource(ctx, resource.Type, resource.ID)
+		if clients.Is404Error(err) {
+			change.ChangeType = ChangeTypeCreate
+			change.After = resource.Properties
+			result.Changes = append(result.Changes, change)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		change.Properties = compareProperties(resource.Properties, deployed.Properties)
+		change.ChangeType = ChangeTypeNoChange
+		if len(change.Properties) > 0 {
+			change.ChangeType = ChangeTypeModify
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	ignored := []ResourceChange{}
+	for _, applicationID := range getApplicationIDs(resources, additionalApplicationIDs) {
+		applicationResources, err := client.ListResourcesInApplication(ctx, applicationID)
+		if clients.Is404Error(err) {
+			// The application will be created by the deployment.
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, deployed := range applicationResources {
+			if deployed.ID == nil || declared[strings.ToLower(*deployed.ID)] {
+				continue
+			}
+
+			declared[strings.ToLower(*deployed.ID)] = true
+			ignored = append(ignored, ResourceChange{
+				ID:         *deployed.ID,
+				Type:       stringValue(deployed.Type),
+				Name:       stringValue(deployed.Name),
+				ChangeType: ChangeTypeIgnore,
+				Before:     deployed.Properties,
+			})
+		}
+	}
+
+	sort.Slice(ignored, func(i, j int) bool {
+		return strings.ToLower(ignored[i].ID) < strings.ToLower(ignored[j].ID)
+	})
+	result.Changes = append(result.Changes, ignored...)
+
+	return result, nil
+}
+
+// getApplicationIDs returns the IDs of the applications declared in the template or referenced by its resources,
+// and the additional application IDs.
+func getApplicationIDs(resources []Resource, additionalApplicationIDs []string) []string {
+	ids := []string{}
+	seen := map[string]bool{}
+	add := func(id string) {
+		if id == "" || seen[strings.ToLower(id)] {
+			return
+		}
+
+		seen[strings.ToLower(id)] = true
+		ids = append(ids, id)
+	}
+
+	for _, resource := range resources {
+		if strings.HasSuffix(strings.ToLower(resource.Type), "/applications") {
+			add(resource.ID)
+		}
+
+		if application, ok := resource.Properties["application"].(string); ok {
+			add(application)
+		}
+	}
+
+	for _, id := range additionalApplicationIDs {
+		add(id)
+	}
+
+	return ids
+}
+
+// compareProperties returns the changes to the properties declared in the template.
+func compareProperties(desired map[string]any, deployed map[string]any) []PropertyChange {
+	changes := []PropertyChange{}
+	compareObjects("", desired, deployed, &changes)
+	return changes
+}
+
+func compareObjects(path string, desired map[string]any, deployed map[string]any, changes *[]PropertyChange) {
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		propertyPath := key
+		if path != "" {
+			propertyPath = path + "." + key
+		}
+
+		deployedValue, _ := lookup(deployed, key)
+		compareValues(propertyPath, desired[key], deployedValue, changes)
+	}
+}
+
+func compareValues(path string, desired any, deployed any, changes *[]PropertyChange) {
+	if desiredObject, ok := desired.(map[string]any); ok {
+		if deployedObject, ok := deployed.(map[string]any); ok {
+			compareObjects(path, desiredObject, deployedObject, changes)
+			return
+		}
+	}
+
+	if !equalValues(desired, deployed) {
+		*changes = append(*changes, PropertyChange{Path: path, Before: deployed, After: desired})
+	}
+}
+
+// equalValues compares a value of the template with a deployed value. Unknown values are equal to any value, and
+// resource IDs are compared without regard to case.
+func equalValues(desired any, deployed any) bool {
+	switch d := desired.(type) {
+	case UnknownValue:
+		return true
+	case string:
+		other, ok := deployed.(string)
+		if !ok {
+			return false
+		}
+
+		if strings.HasPrefix(d, "/") && strings.HasPrefix(other, "/") {
+			return strings.EqualFold(d, other)
+		}
+		return d == other
+	case map[string]any:
+		other, ok := deployed.(map[string]any)
+		if !ok || len(d) != len(other) {
+			return false
+		}
+
+		for key, value := range d {
+			otherValue, ok := lookup(other, key)
+			if !ok || !equalValues(value, otherValue) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		other, ok := deployed.([]any)
+		if !ok || len(d) != len(other) {
+			return false
+		}
+
+		for i := range d {
+			if !equalValues(d[i], other[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Numbers are compared through their JSON representation, because parameters and deployed values don't use
+	// the same Go types.
+	desiredJSON, err := json.Marshal(desired)
+	if err != nil {
+		return reflect.DeepEqual(desired, deployed)
+	}
+
+	deployedJSON, err := json.Marshal(deployed)
+	if err != nil {
+		return reflect.DeepEqual(desired, deployed)
+	}
+
+	return string(desiredJSON) == string(deployedJSON)
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+const (
+	testApplicationID = testScope + "/providers/Applications.Core/applications/my-app"
+	testFrontendID    = testScope + "/providers/Applications.Core/containers/frontend"
+	testBackendID     = testScope + "/providers/Applications.Core/containers/backend"
+	testCacheID       = testScope + "/providers/Applications.Datastores/redisCaches/cache"
+)
+
+func makeTestResources() []Resource {
+	return []Resource{
+		{
+			ID:         testApplicationID,
+			Type:       "Applications.Core/applications",
+			Name:       "my-app",
+			Properties: map[string]any{"environment": testScope + "/providers/Applications.Core/environments/test-env"},
+		},
+		{
+			ID:   testFrontendID,
+			Type: "Applications.Core/containers",
+			Name: "frontend",
+			Properties: map[string]any{
+				"application": testApplicationID,
+				"container": map[string]any{
+					"image": "nginx:1.25",
+					"ports": map[string]any{"web": map[string]any{"containerPort": float64(80)}},
+				},
+				"status": UnknownValue{Expression: "[reference('app').properties.status]"},
+			},
+		},
+		{
+			ID:         testBackendID,
+			Type:       "Applications.Core/containers",
+			Name:       "backend",
+			Properties: map[string]any{"application": testApplicationID},
+		},
+	}
+}
+
+func Test_Compute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockApplicationsManagementClient(ctrl)
+
+	client.EXPECT().
+		GetResource(gomock.Any(), "Applications.Core/applications", testApplicationID).
+		Return(generated.GenericResource{
+			ID: to.Ptr(testApplicationID),
+			Properties: map[string]any{
+				// Resource IDs are compared without regard to case.
+				"environment":       testScope + "/providers/applications.core/environments/test-env",
+				"provisioningState": "Succeeded",
+			},
+		}, nil).
+		Times(1)
+	client.EXPECT().
+		GetResource(gomock.Any(), "Applications.Core/containers", testFrontendID).
+		Return(generated.GenericResource{
+			ID: to.Ptr(testFrontendID),
+			Properties: map[string]any{
+				"application": testApplicationID,
+				"container": map[string]any{
+					"image": "nginx:1.24",
+					"ports": map[string]any{"web": map[string]any{"containerPort": 80}},
+				},
+				"status": map[string]any{"outputResources": []any{}},
+			},
+		}, nil).
+		Times(1)
+	client.EXPECT().
+		GetResource(gomock.Any(), "Applications.Core/containers", testBackendID).
+		Return(generated.GenericResource{}, radcli.Create404Error()).
+		Times(1)
+	client.EXPECT().
+		ListResourcesInApplication(gomock.Any(), testApplicationID).
+		Return([]generated.GenericResource{
+			{ID: to.Ptr(testFrontendID), Name: to.Ptr("frontend"), Type: to.Ptr("Applications.Core/containers")},
+			{ID: to.Ptr(testCacheID), Name: to.Ptr("cache"), Type: to.Ptr("Applications.Datastores/redisCaches"), Properties: map[string]any{"application": testApplicationID}},
+		}, nil).
+		Times(1)
+
+	result, err := Compute(context.Background(), client, makeTestResources(), []string{testApplicationID})
+	require.NoError(t, err)
+
+	expected := &Result{
+		Changes: []ResourceChange{
+			{
+				ID:         testApplicationID,
+				Type:       "Applications.Core/applications",
+				Name:       "my-app",
+				ChangeType: ChangeTypeNoChange,
+				Properties: []PropertyChange{},
+			},
+			{
+				ID:         testFrontendID,
+				Type:       "Applications.Core/containers",
+				Name:       "frontend",
+				ChangeType: ChangeTypeModify,
+				Properties: []PropertyChange{
+					{Path: "container.image", Before: "nginx:1.24", After: "nginx:1.25"},
+				},
+			},
+			{
+				ID:         testBackendID,
+				Type:       "Applications.Core/containers",
+				Name:       "backend",
+				ChangeType: ChangeTypeCreate,
+				After:      map[string]any{"application": testApplicationID},
+			},
+			{
+				ID:         testCacheID,
+				Type:       "Applications.Datastores/redisCaches",
+				Name:       "cache",
+				ChangeType: ChangeTypeIgnore,
+				Before:     map[string]any{"application": testApplicationID},
+			},
+		},
+	}
+	require.Equal(t, expected, result)
+	require.True(t, result.HasChanges())
+	require.Equal(t, 1, result.Count(ChangeTypeModify))
+}
+
+func Test_Compute_NewApplication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockApplicationsManagementClient(ctrl)
+
+	client.EXPECT().
+		GetResource(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(generated.GenericResource{}, radcli.Create404Error()).
+		Times(3)
+	client.EXPECT().
+		ListResourcesInApplication(gomock.Any(), testApplicationID).
+		Return(nil, radcli.Create404Error()).
+		Times(1)
+
+	result, err := Compute(context.Background(), client, makeTestResources(), []string{})
+	require.NoError(t, err)
+	require.Equal(t, 3, result.Count(ChangeTypeCreate))
+	require.Len(t, result.Changes, 3)
+}
+
+func Test_Compute_NoChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := clients.NewMockApplicationsManagementClient(ctrl)
+
+	resources := []Resource{makeTestResources()[2]}
+	client.EXPECT().
+		GetResource(gomock.Any(), "Applications.Core/containers", testBackendID).
+		Return(generated.GenericResource{ID: to.Ptr(testBackendID), Properties: map[string]any{"application": testApplicationID}}, nil).
+		Times(1)
+	client.EXPECT().
+		ListResourcesInApplication(gomock.Any(), testApplicationID).
+		Return([]generated.GenericResource{{ID: to.Ptr(testBackendID)}, {ID: to.Ptr(testCacheID)}}, nil).
+		Times(1)
+
+	result, err := Compute(context.Background(), client, resources, []string{testApplicationID})
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Count(ChangeTypeIgnore))
+	require.False(t, result.HasChanges())
+}
+
+func Test_equalValues(t *testing.T) {
+	testcases := []struct {
+		name     string
+		desired  any
+		deployed any
+		expected bool
+	}{
+		{name: "equal strings", desired: "a", deployed: "a", expected: true},
+		{name: "different strings", desired: "a", deployed: "A", expected: false},
+		{name: "resource ids", desired: "/planes/radius/local/A", deployed: "/planes/radius/local/a", expected: true},
+		{name: "numbers", desired: float64(1), deployed: int32(1), expected: true},
+		{name: "unknown", desired: UnknownValue{}, deployed: "anything", expected: true},
+		{name: "missing", desired: "a", deployed: nil, expected: false},
+		{name: "arrays", desired: []any{"a", float64(1)}, deployed: []any{"a", 1}, expected: true},
+		{name: "arrays of different length", desired: []any{"a"}, deployed: []any{"a", "b"}, expected: false},
+		{name: "objects", desired: map[string]any{"a": "b"}, deployed: map[string]any{"a": "b"}, expected: true},
+		{name: "objects with extra keys", desired: map[string]any{"a": "b"}, deployed: map[string]any{"a": "b", "c": "d"}, expected: false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, equalValues(tc.desired, tc.deployed))
+		})
+	}
+}
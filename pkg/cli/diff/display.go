@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// Display builds the formatted output for the result as text. Created, modified and ignored resources are colored
+// when the output is a terminal.
+func Display(result *Result) string {
+	create := color.New(color.FgGreen)
+	modify := color.New(color.FgYellow)
+	ignore := color.New(color.Faint)
+
+	output := &strings.Builder{}
+	if !result.HasChanges() {
+		output.WriteString("No changes. The deployed resources match the template.\n")
+	} else {
+		output.WriteString(fmt.Sprintf("Resource changes: %d to create, %d to modify, %d unchanged.\n",
+			result.Count(ChangeTypeCreate), result.Count(ChangeTypeModify), result.Count(ChangeTypeNoChange)))
+	}
+
+	for _, change := range result.Changes {
+		switch change.ChangeType {
+		case ChangeTypeCreate:
+			output.WriteString("\n")
+			output.WriteString(create.Sprintf("+ %s (%s)", change.Name, change.Type))
+			output.WriteString("\n")
+
+			keys := make([]string, 0, len(change.After))
+			for key := range change.After {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				output.WriteString(create.Sprintf("    %s: %s", key, formatValue(change.After[key])))
+				output.WriteString("\n")
+			}
+		case ChangeTypeModify:
+			output.WriteString("\n")
+			output.WriteString(modify.Sprintf("~ %s (%s)", change.Name, change.Type))
+			output.WriteString("\n")
+
+			for _, property := range change.Properties {
+				output.WriteString(modify.Sprintf("    %s: %s => %s", property.Path, formatValue(property.Before), formatValue(property.After)))
+				output.WriteString("\n")
+			}
+		case ChangeTypeIgnore:
+			output.WriteString("\n")
+			output.WriteString(ignore.Sprintf("* %s (%s) is not declared in the template and will not be changed", change.Name, change.Type))
+			output.WriteString("\n")
+		}
+	}
+
+	if len(result.Warnings) > 0 {
+		output.WriteString("\nWarnings:\n")
+		for _, warning := range result.Warnings {
+			output.WriteString(fmt.Sprintf("  - %s\n", warning))
+		}
+	}
+
+	return output.String()
+}
+
+func formatValue(value any) string {
+	if unknown, ok := value.(UnknownValue); ok {
+		return unknown.String()
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(b)
+}
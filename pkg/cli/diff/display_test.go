@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Display(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	t.Cleanup(func() { color.NoColor = noColor })
+
+	t.Run("no changes", func(t *testing.T) {
+		result := &Result{
+			Changes: []ResourceChange{
+				{ID: testBackendID, Type: "Applications.Core/containers", Name: "backend", ChangeType: ChangeTypeNoChange},
+			},
+		}
+
+		require.Equal(t, "No changes. The deployed resources match the template.\n", Display(result))
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		result := &Result{
+			Changes: []ResourceChange{
+				{
+					ID:         testFrontendID,
+					Type:       "Applications.Core/containers",
+					Name:       "frontend",
+					ChangeType: ChangeTypeModify,
+					Properties: []PropertyChange{
+						{Path: "container.image", Before: "nginx:1.24", After: "nginx:1.25"},
+						{Path: "container.env", Before: nil, After: UnknownValue{}},
+					},
+				},
+				{
+					ID:         testBackendID,
+					Type:       "Applications.Core/containers",
+					Name:       "backend",
+					ChangeType: ChangeTypeCreate,
+					After:      map[string]any{"container": map[string]any{"image": "redis"}, "application": testApplicationID},
+				},
+				{
+					ID:         testCacheID,
+					Type:       "Applications.Datastores/redisCaches",
+					Name:       "cache",
+					ChangeType: ChangeTypeIgnore,
+				},
+			},
+			Warnings: []string{"Module \"module\" is not included in the diff."},
+		}
+
+		expected := `Resource changes: 1 to create, 1 to modify, 0 unchanged.
+
+~ frontend (Applications.Core/containers)
+    container.image: "nginx:1.24" => "nginx:1.25"
+    container.env: null => (known after deployment)
+
++ backend (Applications.Core/containers)
+    application: "` + testApplicationID + `"
+    container: {"image":"redis"}
+
+* cache (Applications.Datastores/redisCaches) is not declared in the template and will not be changed
+
+Warnings:
+  - Module "module" is not included in the diff.
+`
+		require.Equal(t, expected, Display(result))
+	})
+}
@@ -0,0 +1,704 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// errUnknown is returned while evaluating an expression whose value is only known during the deployment, such as
+// the runtime properties of a resource or the result of a function that is not supported.
+var errUnknown = errors.New("the value is only known during the deployment")
+
+// UnknownValue is the value of a template expression that can only be evaluated during the deployment.
+type UnknownValue struct {
+	// Expression is the template expression.
+	Expression string
+}
+
+// String returns the text displayed for the unknown value.
+func (u UnknownValue) String() string {
+	return "(known after deployment)"
+}
+
+// MarshalJSON marshals the unknown value as the text displayed for it.
+func (u UnknownValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// evaluator evaluates the ARM template expressions used by compiled Bicep files. It supports the subset of the
+// expression language needed to compute the names and properties of Radius resources. Expressions that can't be
+// evaluated before the deployment evaluate to an UnknownValue.
+type evaluator struct {
+	// scope is the resource group the template is deployed to.
+	scope string
+
+	// parameters are the values of the template parameters, including default values.
+	parameters map[string]any
+
+	// variables are the template variables. They are evaluated when they are used.
+	variables map[string]any
+
+	// resources are the identities of the resources declared in the template, by symbolic name.
+	resources map[string]map[string]any
+
+	// evaluating tracks the variables being evaluated to detect cycles.
+	evaluating map[string]bool
+}
+
+// evaluate evaluates the expressions in the given template value. Values are copied so that the template is not
+// modified.
+func (e *evaluator) evaluate(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return e.evaluateString(v)
+	case map[string]any:
+		result := map[string]any{}
+		for key, item := range v {
+			evaluated, err := e.evaluate(item)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = evaluated
+		}
+		return result, nil
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			evaluated, err := e.evaluate(item)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = evaluated
+		}
+		return result, nil
+	default:
+		return value, nil
+	}
+}
+
+// evaluateString evaluates a template string, which is an expression if it is enclosed in brackets.
+func (e *evaluator) evaluateString(value string) (any, error) {
+	if !strings.HasPrefix(value, "[") || !strings.HasSuffix(value, "]") {
+		return value, nil
+	}
+
+	// A string starting with two brackets is a literal string starting with one bracket.
+	if strings.HasPrefix(value, "[[") {
+		return value[1:], nil
+	}
+
+	p := &parser{input: value[1 : len(value)-1], evaluator: e}
+	result, err := p.parse()
+	if errors.Is(err, errUnknown) {
+		return UnknownValue{Expression: value}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to evaluate expression %q: %w", value, err)
+	}
+
+	return result, nil
+}
+
+// parser parses and evaluates a single template expression.
+type parser struct {
+	input     string
+	position  int
+	evaluator *evaluator
+}
+
+func (p *parser) parse() (any, error) {
+	value, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipWhitespace()
+	if p.position != len(p.input) {
+		return nil, fmt.Errorf("unexpected character %q at position %d", p.input[p.position], p.position)
+	}
+
+	return value, nil
+}
+
+// parseExpression parses a literal or a function call, followed by any number of property accesses. The whole
+// expression is parsed even if part of it is unknown, so that the enclosing expression can continue parsing.
+func (p *parser) parseExpression() (any, error) {
+	p.skipWhitespace()
+	if p.position >= len(p.input) {
+		return nil, errors.New("unexpected end of expression")
+	}
+
+	var value any
+	var err error
+	c := p.input[p.position]
+	switch {
+	case c == '\'':
+		value, err = p.parseString()
+	case c == '-' || unicode.IsDigit(rune(c)):
+		value, err = p.parseNumber()
+	case unicode.IsLetter(rune(c)):
+		value, err = p.parseFunctionCall()
+	default:
+		return nil, fmt.Errorf("unexpected character %q at position %d", c, p.position)
+	}
+
+	unknown := errors.Is(err, errUnknown)
+	if err != nil && !unknown {
+		return nil, err
+	}
+
+	for {
+		p.skipWhitespace()
+		if p.position >= len(p.input) {
+			break
+		}
+
+		if p.input[p.position] == '.' {
+			p.position++
+			name := p.parseIdentifier()
+			if name == "" {
+				return nil, fmt.Errorf("expected a property name at position %d", p.position)
+			}
+
+			if !unknown {
+				value, err = property(value, name)
+			}
+		} else if p.input[p.position] == '[' {
+			p.position++
+			index, indexErr := p.parseExpression()
+			if errors.Is(indexErr, errUnknown) {
+				unknown = true
+			} else if indexErr != nil {
+				return nil, indexErr
+			}
+
+			if err := p.expect(']'); err != nil {
+				return nil, err
+			}
+
+			if !unknown {
+				value, err = indexValue(value, index)
+			}
+		} else {
+			break
+		}
+
+		if errors.Is(err, errUnknown) {
+			unknown = true
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	if unknown {
+		return nil, errUnknown
+	}
+
+	return value, nil
+}
+
+func (p *parser) parseFunctionCall() (any, error) {
+	name := p.parseIdentifier()
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+
+	args := []any{}
+	unknown := false
+	p.skipWhitespace()
+	if p.position < len(p.input) && p.input[p.position] == ')' {
+		p.position++
+	} else {
+		for {
+			arg, err := p.parseExpression()
+			if errors.Is(err, errUnknown) {
+				// Keep parsing to report syntax errors, but the result of the function is unknown.
+				unknown = true
+			} else if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+
+			p.skipWhitespace()
+			if p.position >= len(p.input) {
+				return nil, errors.New("unexpected end of expression")
+			}
+
+			if p.input[p.position] == ')' {
+				p.position++
+				break
+			}
+
+			if err := p.expect(','); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if unknown {
+		return nil, errUnknown
+	}
+
+	return p.evaluator.call(name, args)
+}
+
+func (p *parser) parseString() (any, error) {
+	// Skip the opening quote. Quotes are escaped by doubling them.
+	p.position++
+	builder := strings.Builder{}
+	for p.position < len(p.input) {
+		c := p.input[p.position]
+		p.position++
+		if c != '\'' {
+			builder.WriteByte(c)
+			continue
+		}
+
+		if p.position < len(p.input) && p.input[p.position] == '\'' {
+			builder.WriteByte('\'')
+			p.position++
+			continue
+		}
+
+		return builder.String(), nil
+	}
+
+	return nil, errors.New("unterminated string literal")
+}
+
+func (p *parser) parseNumber() (any, error) {
+	start := p.position
+	p.position++
+	for p.position < len(p.input) && unicode.IsDigit(rune(p.input[p.position])) {
+		p.position++
+	}
+
+	value, err := strconv.ParseFloat(p.input[start:p.position], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number %q", p.input[start:p.position])
+	}
+
+	return value, nil
+}
+
+func (p *parser) parseIdentifier() string {
+	p.skipWhitespace()
+	start := p.position
+	for p.position < len(p.input) {
+		c := rune(p.input[p.position])
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' {
+			break
+		}
+		p.position++
+	}
+
+	return p.input[start:p.position]
+}
+
+func (p *parser) expect(c byte) error {
+	p.skipWhitespace()
+	if p.position >= len(p.input) || p.input[p.position] != c {
+		return fmt.Errorf("expected %q at position %d", c, p.position)
+	}
+
+	p.position++
+	return nil
+}
+
+func (p *parser) skipWhitespace() {
+	for p.position < len(p.input) && unicode.IsSpace(rune(p.input[p.position])) {
+		p.position++
+	}
+}
+
+// call evaluates a template function. Functions that are not supported evaluate to an unknown value.
+func (e *evaluator) call(name string, args []any) (any, error) {
+	switch strings.ToLower(name) {
+	case "parameters":
+		key, err := stringArgument(name, args, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		value, ok := lookup(e.parameters, key)
+		if !ok {
+			return nil, errUnknown
+		}
+		return value, nil
+	case "variables":
+		key, err := stringArgument(name, args, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return e.variable(key)
+	case "reference", "resourceinfo":
+		key, err := stringArgument(name, args, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only the identity of the resource is known before the deployment, so other properties are unknown.
+		resource, ok := lookup(e.resources, key)
+		if !ok {
+			return nil, errUnknown
+		}
+		return resource, nil
+	case "resourceid":
+		return e.resourceID(args)
+	case "resourcegroup":
+		segments := strings.Split(e.scope, "/")
+		return map[string]any{"id": e.scope, "name": segments[len(segments)-1]}, nil
+	case "format":
+		return format(args)
+	case "concat":
+		return concat(args)
+	case "tolower", "toupper":
+		value, err := stringArgument(name, args, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.EqualFold(name, "toLower") {
+			return strings.ToLower(value), nil
+		}
+		return strings.ToUpper(value), nil
+	case "string":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("function %s expects 1 argument", name)
+		}
+
+		if value, ok := args[0].(string); ok {
+			return value, nil
+		}
+
+		b, err := json.Marshal(args[0])
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case "if":
+		if len(args) != 3 {
+			return nil, fmt.Errorf("function %s expects 3 arguments", name)
+		}
+
+		condition, ok := args[0].(bool)
+		if !ok {
+			return nil, fmt.Errorf("function %s expects a boolean condition", name)
+		}
+
+		if condition {
+			return args[1], nil
+		}
+		return args[2], nil
+	case "equals":
+		if len(args) != 2 {
+			return nil, fmt.Errorf("function %s expects 2 arguments", name)
+		}
+		return reflect.DeepEqual(args[0], args[1]), nil
+	case "not":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("function %s expects 1 argument", name)
+		}
+
+		value, ok := args[0].(bool)
+		if !ok {
+			return nil, fmt.Errorf("function %s expects a boolean argument", name)
+		}
+		return !value, nil
+	case "and", "or":
+		isAnd := strings.EqualFold(name, "and")
+		result := isAnd
+		for _, arg := range args {
+			value, ok := arg.(bool)
+			if !ok {
+				return nil, fmt.Errorf("function %s expects boolean arguments", name)
+			}
+
+			if isAnd {
+				result = result && value
+			} else {
+				result = result || value
+			}
+		}
+		return result, nil
+	case "empty":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("function %s expects 1 argument", name)
+		}
+
+		if args[0] == nil {
+			return true, nil
+		}
+
+		value := reflect.ValueOf(args[0])
+		switch value.Kind() {
+		case reflect.String, reflect.Map, reflect.Slice:
+			return value.Len() == 0, nil
+		}
+		return false, nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	case "createarray":
+		return args, nil
+	case "createobject":
+		if len(args)%2 != 0 {
+			return nil, fmt.Errorf("function %s expects an even number of arguments", name)
+		}
+
+		result := map[string]any{}
+		for i := 0; i < len(args); i += 2 {
+			key, ok := args[i].(string)
+			if !ok {
+				return nil, fmt.Errorf("function %s expects string keys", name)
+			}
+			result[key] = args[i+1]
+		}
+		return result, nil
+	default:
+		return nil, errUnknown
+	}
+}
+
+// variable evaluates the template variable with the given name.
+func (e *evaluator) variable(name string) (any, error) {
+	key := strings.ToLower(name)
+	if e.evaluating[key] {
+		return nil, fmt.Errorf("variable %q references itself", name)
+	}
+
+	value, ok := lookup(e.variables, name)
+	if !ok {
+		return nil, fmt.Errorf("variable %q is not declared", name)
+	}
+
+	e.evaluating[key] = true
+	defer delete(e.evaluating, key)
+
+	evaluated, err := e.evaluate(value)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unknown values nested in the variable make the whole expression that uses it unknown.
+	if _, ok := evaluated.(UnknownValue); ok {
+		return nil, errUnknown
+	}
+
+	return evaluated, nil
+}
+
+// resourceID computes the ID of a resource in the resource group of the deployment, from its type and names.
+// Nested resource types, such as 'A.B/parents/children', take one name per type segment.
+func (e *evaluator) resourceID(args []any) (any, error) {
+	if len(args) < 2 {
+		return nil, errors.New("function resourceId expects a resource type and a name")
+	}
+
+	values := []string{}
+	for i := range args {
+		value, err := stringArgument("resourceId", args, i)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	// The resource type is the first argument that contains a '/'. Any arguments before it are scopes, such as a
+	// subscription ID and resource group name, which are not supported for Radius resources.
+	typeIndex := -1
+	for i, value := range values {
+		if strings.Contains(value, "/") {
+			typeIndex = i
+			break
+		}
+	}
+	if typeIndex != 0 {
+		return nil, errUnknown
+	}
+
+	typeSegments := strings.Split(values[0], "/")
+	names := values[1:]
+	if len(names) != len(typeSegments)-1 {
+		return nil, fmt.Errorf("function resourceId expects %d names for resource type %q", len(typeSegments)-1, values[0])
+	}
+
+	id := e.scope + "/providers/" + typeSegments[0]
+	for i, name := range names {
+		id += "/" + typeSegments[i+1] + "/" + name
+	}
+
+	return id, nil
+}
+
+func format(args []any) (any, error) {
+	if len(args) == 0 {
+		return nil, errors.New("function format expects a format string")
+	}
+
+	formatString, ok := args[0].(string)
+	if !ok {
+		return nil, errors.New("function format expects a format string")
+	}
+
+	builder := strings.Builder{}
+	for i := 0; i < len(formatString); i++ {
+		c := formatString[i]
+		switch {
+		case c == '{' && i+1 < len(formatString) && formatString[i+1] == '{':
+			builder.WriteByte('{')
+			i++
+		case c == '}' && i+1 < len(formatString) && formatString[i+1] == '}':
+			builder.WriteByte('}')
+			i++
+		case c == '{':
+			end := strings.IndexByte(formatString[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid format string %q", formatString)
+			}
+
+			// Format specifiers, such as '{0:N2}', are not supported.
+			index, err := strconv.Atoi(formatString[i+1 : i+end])
+			if err != nil {
+				return nil, errUnknown
+			}
+			if index < 0 || index+1 >= len(args) {
+				return nil, fmt.Errorf("format string %q references argument %d, which is not provided", formatString, index)
+			}
+
+			builder.WriteString(toText(args[index+1]))
+			i += end
+		default:
+			builder.WriteByte(c)
+		}
+	}
+
+	return builder.String(), nil
+}
+
+func concat(args []any) (any, error) {
+	if len(args) > 0 {
+		if _, ok := args[0].([]any); ok {
+			result := []any{}
+			for _, arg := range args {
+				items, ok := arg.([]any)
+				if !ok {
+					return nil, errors.New("function concat expects all arguments to be arrays or strings")
+				}
+				result = append(result, items...)
+			}
+			return result, nil
+		}
+	}
+
+	builder := strings.Builder{}
+	for _, arg := range args {
+		builder.WriteString(toText(arg))
+	}
+	return builder.String(), nil
+}
+
+// property returns the value of a property of an object. Properties that are not present are unknown, because
+// they are typically runtime properties of a resource.
+func property(value any, name string) (any, error) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("cannot access property %q of a value of type %T", name, value)
+	}
+
+	result, ok := lookup(object, name)
+	if !ok {
+		return nil, errUnknown
+	}
+
+	return result, nil
+}
+
+func indexValue(value any, index any) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		name, ok := index.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot index an object with a value of type %T", index)
+		}
+		return property(v, name)
+	case []any:
+		i, ok := index.(float64)
+		if !ok || int(i) < 0 || int(i) >= len(v) {
+			return nil, fmt.Errorf("invalid array index %v", index)
+		}
+		return v[int(i)], nil
+	default:
+		return nil, fmt.Errorf("cannot index a value of type %T", value)
+	}
+}
+
+func stringArgument(function string, args []any, index int) (string, error) {
+	if index >= len(args) {
+		return "", fmt.Errorf("function %s expects at least %d arguments", function, index+1)
+	}
+
+	value, ok := args[index].(string)
+	if !ok {
+		return "", fmt.Errorf("function %s expects argument %d to be a string", function, index+1)
+	}
+
+	return value, nil
+}
+
+// lookup finds a value by key without regard to case, as names are case-insensitive in templates.
+func lookup[T any](values map[string]T, key string) (T, bool) {
+	if value, ok := values[key]; ok {
+		return value, true
+	}
+
+	for k, value := range values {
+		if strings.EqualFold(k, key) {
+			return value, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+func toText(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return ""
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testScope = "/planes/radius/local/resourceGroups/test-group"
+
+func Test_evaluate(t *testing.T) {
+	e := &evaluator{
+		scope: testScope,
+		parameters: map[string]any{
+			"environment": testScope + "/providers/Applications.Core/environments/test-env",
+			"port":        float64(8080),
+			"enabled":     true,
+		},
+		variables: map[string]any{
+			"prefix": "[format('{0}-app', 'my')]",
+		},
+		resources: map[string]map[string]any{
+			"app": {
+				"id":   testScope + "/providers/Applications.Core/applications/my-app",
+				"name": "my-app",
+			},
+		},
+		evaluating: map[string]bool{},
+	}
+
+	testcases := []struct {
+		name     string
+		input    any
+		expected any
+	}{
+		{name: "literal", input: "hello", expected: "hello"},
+		{name: "escaped bracket", input: "[[not an expression]", expected: "[not an expression]"},
+		{name: "number", input: float64(3), expected: float64(3)},
+		{name: "parameter", input: "[parameters('environment')]", expected: testScope + "/providers/Applications.Core/environments/test-env"},
+		{name: "parameter case insensitive", input: "[parameters('PORT')]", expected: float64(8080)},
+		{name: "variable", input: "[variables('prefix')]", expected: "my-app"},
+		{name: "format", input: "[format('{0}:{1}', 'host', parameters('port'))]", expected: "host:8080"},
+		{name: "concat", input: "[concat('a', 'b', 'c')]", expected: "abc"},
+		{name: "toLower", input: "[toLower('ABC')]", expected: "abc"},
+		{name: "string", input: "[string(parameters('port'))]", expected: "8080"},
+		{name: "if", input: "[if(parameters('enabled'), 'on', 'off')]", expected: "on"},
+		{name: "not equals", input: "[not(equals('a', 'b'))]", expected: true},
+		{name: "and or", input: "[and(true(), or(false(), true()))]", expected: true},
+		{name: "empty", input: "[empty('')]", expected: true},
+		{name: "create object", input: "[createObject('a', 1)]", expected: map[string]any{"a": float64(1)}},
+		{name: "create array", input: "[createArray('a', 'b')]", expected: []any{"a", "b"}},
+		{name: "resource id", input: "[resourceId('Applications.Core/containers', 'frontend')]", expected: testScope + "/providers/Applications.Core/containers/frontend"},
+		{name: "resource group", input: "[resourceGroup().name]", expected: "test-group"},
+		{name: "reference id", input: "[reference('app').id]", expected: testScope + "/providers/Applications.Core/applications/my-app"},
+		{name: "resource info name", input: "[resourceInfo('app')['name']]", expected: "my-app"},
+		{
+			name:     "nested values",
+			input:    map[string]any{"items": []any{"[parameters('port')]"}},
+			expected: map[string]any{"items": []any{float64(8080)}},
+		},
+		{
+			name:     "runtime property is unknown",
+			input:    "[reference('app').properties.status]",
+			expected: UnknownValue{Expression: "[reference('app').properties.status]"},
+		},
+		{
+			name:     "unsupported function is unknown",
+			input:    "[format('{0}', uniqueString('a'))]",
+			expected: UnknownValue{Expression: "[format('{0}', uniqueString('a'))]"},
+		},
+		{
+			name:     "missing parameter is unknown",
+			input:    "[parameters('missing')]",
+			expected: UnknownValue{Expression: "[parameters('missing')]"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := e.evaluate(tc.input)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_evaluate_Errors(t *testing.T) {
+	e := &evaluator{
+		scope:      testScope,
+		parameters: map[string]any{},
+		variables: map[string]any{
+			"self": "[variables('self')]",
+		},
+		resources:  map[string]map[string]any{},
+		evaluating: map[string]bool{},
+	}
+
+	testcases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "cyclic variable", input: "[variables('self')]", expected: "variable \"self\" references itself"},
+		{name: "undeclared variable", input: "[variables('missing')]", expected: "variable \"missing\" is not declared"},
+		{name: "invalid syntax", input: "[concat('a']", expected: "failed to evaluate expression"},
+		{name: "wrong number of names", input: "[resourceId('Applications.Core/containers')]", expected: "function resourceId expects a resource type and a name"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := e.evaluate(tc.input)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.expected)
+		})
+	}
+}
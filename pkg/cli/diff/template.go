@@ -0,0 +1,227 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+)
+
+const (
+	// deploymentsResourceType is the resource type of the nested deployments created for Bicep modules.
+	deploymentsResourceType = "Microsoft.Resources/deployments"
+)
+
+// Resource is a Radius resource declared in a template, with its template expressions evaluated.
+type Resource struct {
+	// SymbolicName is the symbolic name of the resource in the template.
+	SymbolicName string
+
+	// ID is the resource ID of the resource.
+	ID string
+
+	// Type is the resource type, without API version.
+	Type string
+
+	// APIVersion is the API version used by the template for the resource.
+	APIVersion string
+
+	// Name is the name of the resource.
+	Name string
+
+	// Properties are the properties of the resource. Values that are only known during the deployment are
+	// represented by UnknownValue.
+	Properties map[string]any
+}
+
+// ResolveResources evaluates the Radius resources declared in a compiled Bicep template, deployed to the given
+// resource group scope with the given parameters. Resources that can't be evaluated before the deployment, such as
+// resources declared in modules or loops, are not returned. A warning is returned for each of them instead.
+func ResolveResources(template map[string]any, parameters map[string]map[string]any, scope string) ([]Resource, []string, error) {
+	declared, ok := template["resources"].(map[string]any)
+	if !ok {
+		if _, isArray := template["resources"].([]any); isArray {
+			return nil, nil, errors.New("the template does not use symbolic resource names, which are required for Radius resources")
+		}
+
+		return []Resource{}, []string{}, nil
+	}
+
+	e := &evaluator{
+		scope:      scope,
+		parameters: map[string]any{},
+		resources:  map[string]map[string]any{},
+		evaluating: map[string]bool{},
+	}
+	e.variables, _ = template["variables"].(map[string]any)
+
+	err := e.resolveParameters(template, parameters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Resources are processed by symbolic name so that the results are stable.
+	symbolicNames := make([]string, 0, len(declared))
+	for symbolicName := range declared {
+		symbolicNames = append(symbolicNames, symbolicName)
+	}
+	sort.Strings(symbolicNames)
+
+	// The identities of all resources are computed first, so that resources can reference each other.
+	resources := []Resource{}
+	warnings := []string{}
+	for _, symbolicName := range symbolicNames {
+		body, ok := declared[symbolicName].(map[string]any)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid template: resource %q must be an object", symbolicName)
+		}
+
+		resourceType, apiVersion, _ := strings.Cut(fmt.Sprint(body["type"]), "@")
+		if strings.EqualFold(resourceType, deploymentsResourceType) {
+			warnings = append(warnings, fmt.Sprintf("Module %q is not included in the diff.", symbolicName))
+			continue
+		}
+
+		if !isRadiusResource(body, resourceType) {
+			continue
+		}
+
+		if _, ok := body["copy"]; ok {
+			warnings = append(warnings, fmt.Sprintf("Resource %q is declared in a loop and is not included in the diff.", symbolicName))
+			continue
+		}
+
+		properties, _ := body["properties"].(map[string]any)
+		name, err := e.evaluate(properties["name"])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		nameString, ok := name.(string)
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("The name of resource %q is only known during the deployment. The resource is not included in the diff.", symbolicName))
+			continue
+		}
+
+		resource := Resource{
+			SymbolicName: symbolicName,
+			ID:           scope + "/providers/" + resourceType + "/" + nameString,
+			Type:         resourceType,
+			APIVersion:   apiVersion,
+			Name:         nameString,
+		}
+		e.resources[symbolicName] = map[string]any{
+			"id":         resource.ID,
+			"name":       resource.Name,
+			"type":       resource.Type,
+			"apiVersion": resource.APIVersion,
+		}
+
+		// Existing resources are referenced by the template, but not deployed.
+		if existing, _ := body["existing"].(bool); existing {
+			continue
+		}
+
+		if condition, ok := body["condition"]; ok {
+			evaluated, err := e.evaluate(condition)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if enabled, ok := evaluated.(bool); !ok {
+				warnings = append(warnings, fmt.Sprintf("The condition of resource %q is only known during the deployment. The resource is not included in the diff.", symbolicName))
+				continue
+			} else if !enabled {
+				continue
+			}
+		}
+
+		resources = append(resources, resource)
+	}
+
+	for i := range resources {
+		body := declared[resources[i].SymbolicName].(map[string]any)
+		properties, _ := body["properties"].(map[string]any)
+
+		evaluated, err := e.evaluate(properties["properties"])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resources[i].Properties, _ = evaluated.(map[string]any)
+		if resources[i].Properties == nil {
+			resources[i].Properties = map[string]any{}
+		}
+	}
+
+	return resources, warnings, nil
+}
+
+// resolveParameters computes the value of each template parameter from the deployment parameters or its default
+// value.
+func (e *evaluator) resolveParameters(template map[string]any, parameters map[string]map[string]any) error {
+	declared, err := bicep.ExtractParameters(template)
+	if err != nil {
+		return err
+	}
+
+	for name, declaration := range declared {
+		if parameter, ok := lookup(parameters, name); ok {
+			e.parameters[name] = parameter["value"]
+			continue
+		}
+
+		defaultValue, ok := bicep.DefaultValue(declaration)
+		if !ok {
+			continue
+		}
+
+		evaluated, err := e.evaluate(defaultValue)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := evaluated.(UnknownValue); !ok {
+			e.parameters[name] = evaluated
+		}
+	}
+
+	return nil
+}
+
+// isRadiusResource returns true if the resource is deployed through the Radius Bicep extension. Resources of other
+// extensions, such as Kubernetes, have types without a provider namespace, and Azure and AWS resources are deployed
+// to other planes.
+func isRadiusResource(body map[string]any, resourceType string) bool {
+	_, hasImport := body["import"]
+	_, hasExtension := body["extension"]
+	if !hasImport && !hasExtension {
+		return false
+	}
+
+	namespace, _, ok := strings.Cut(resourceType, "/")
+	if !ok || !strings.Contains(namespace, ".") {
+		return false
+	}
+
+	lower := strings.ToLower(namespace)
+	return !strings.HasPrefix(lower, "microsoft.") && !strings.HasPrefix(lower, "aws.")
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func makeTestTemplate() map[string]any {
+	return map[string]any{
+		"languageVersion": "2.0",
+		"parameters": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"image":       map[string]any{"type": "string", "defaultValue": "nginx:latest"},
+			"replicas":    map[string]any{"type": "int", "defaultValue": float64(1)},
+		},
+		"variables": map[string]any{
+			"appName": "my-app",
+		},
+		"resources": map[string]any{
+			"app": map[string]any{
+				"import": "Radius",
+				"type":   "Applications.Core/applications@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "[variables('appName')]",
+					"properties": map[string]any{
+						"environment": "[parameters('environment')]",
+					},
+				},
+			},
+			"frontend": map[string]any{
+				"import": "Radius",
+				"type":   "Applications.Core/containers@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "frontend",
+					"properties": map[string]any{
+						"application": "[reference('app').id]",
+						"container": map[string]any{
+							"image": "[parameters('image')]",
+						},
+						"status": "[reference('app').properties.status]",
+					},
+				},
+			},
+			"existingEnv": map[string]any{
+				"existing": true,
+				"import":   "Radius",
+				"type":     "Applications.Core/environments@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "test-env",
+				},
+			},
+			"disabled": map[string]any{
+				"import":    "Radius",
+				"type":      "Applications.Core/containers@2023-10-01-preview",
+				"condition": "[equals(parameters('replicas'), 0)]",
+				"properties": map[string]any{
+					"name": "disabled",
+				},
+			},
+			"loop": map[string]any{
+				"import": "Radius",
+				"type":   "Applications.Core/containers@2023-10-01-preview",
+				"copy":   map[string]any{"name": "loop", "count": float64(2)},
+				"properties": map[string]any{
+					"name": "[format('loop-{0}', copyIndex())]",
+				},
+			},
+			"random": map[string]any{
+				"import": "Radius",
+				"type":   "Applications.Core/containers@2023-10-01-preview",
+				"properties": map[string]any{
+					"name": "[uniqueString(resourceGroup().id)]",
+				},
+			},
+			"module": map[string]any{
+				"type": "Microsoft.Resources/deployments@2022-09-01",
+				"properties": map[string]any{
+					"name": "module",
+				},
+			},
+			"namespace": map[string]any{
+				"import": "Kubernetes",
+				"type":   "core/Namespace@v1",
+				"properties": map[string]any{
+					"metadata": map[string]any{"name": "test"},
+				},
+			},
+		},
+	}
+}
+
+func Test_ResolveResources(t *testing.T) {
+	parameters := map[string]map[string]any{
+		"environment": {"value": testScope + "/providers/Applications.Core/environments/test-env"},
+		"image":       {"value": "nginx:1.25"},
+	}
+
+	resources, warnings, err := ResolveResources(makeTestTemplate(), parameters, testScope)
+	require.NoError(t, err)
+
+	expected := []Resource{
+		{
+			SymbolicName: "app",
+			ID:           testScope + "/providers/Applications.Core/applications/my-app",
+			Type:         "Applications.Core/applications",
+			APIVersion:   "2023-10-01-preview",
+			Name:         "my-app",
+			Properties: map[string]any{
+				"environment": testScope + "/providers/Applications.Core/environments/test-env",
+			},
+		},
+		{
+			SymbolicName: "frontend",
+			ID:           testScope + "/providers/Applications.Core/containers/frontend",
+			Type:         "Applications.Core/containers",
+			APIVersion:   "2023-10-01-preview",
+			Name:         "frontend",
+			Properties: map[string]any{
+				"application": testScope + "/providers/Applications.Core/applications/my-app",
+				"container": map[string]any{
+					"image": "nginx:1.25",
+				},
+				"status": UnknownValue{Expression: "[reference('app').properties.status]"},
+			},
+		},
+	}
+	require.Equal(t, expected, resources)
+
+	expectedWarnings := []string{
+		"Resource \"loop\" is declared in a loop and is not included in the diff.",
+		"Module \"module\" is not included in the diff.",
+		"The name of resource \"random\" is only known during the deployment. The resource is not included in the diff.",
+	}
+	require.Equal(t, expectedWarnings, warnings)
+}
+
+func Test_ResolveResources_NoResources(t *testing.T) {
+	resources, warnings, err := ResolveResources(map[string]any{}, map[string]map[string]any{}, testScope)
+	require.NoError(t, err)
+	require.Empty(t, resources)
+	require.Empty(t, warnings)
+}
+
+func Test_ResolveResources_NonSymbolicTemplate(t *testing.T) {
+	template := map[string]any{
+		"resources": []any{},
+	}
+
+	_, _, err := ResolveResources(template, map[string]map[string]any{}, testScope)
+	require.Error(t, err)
+	require.Equal(t, "the template does not use symbolic resource names, which are required for Radius resources", err.Error())
+}
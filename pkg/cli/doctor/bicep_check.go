@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+)
+
+// Ensure BicepCheck implements RemediableCheck interface
+var _ preflight.RemediableCheck = (*BicepCheck)(nil)
+
+// BicepCheck validates that the Bicep compiler used by the rad CLI is present and can run.
+type BicepCheck struct {
+	bicep bicep.Interface
+}
+
+// NewBicepCheck creates a new Bicep compiler check.
+func NewBicepCheck(bicepInterface bicep.Interface) *BicepCheck {
+	return &BicepCheck{
+		bicep: bicepInterface,
+	}
+}
+
+// Name returns the name of this check.
+func (b *BicepCheck) Name() string {
+	return "Bicep Compiler"
+}
+
+// Severity returns the severity level of this check.
+func (b *BicepCheck) Severity() preflight.CheckSeverity {
+	return preflight.SeverityWarning
+}
+
+// Remediation returns the action to take when the Bicep compiler cannot run.
+func (b *BicepCheck) Remediation() string {
+	return fmt.Sprintf("The Bicep compiler is downloaded the first time 'rad deploy' or 'rad run' builds a Bicep file. "+
+		"Check that the download is not blocked, or set the %s environment variable to the path of a Bicep compiler.", bicep.BicepEnvVar)
+}
+
+// Run executes the Bicep compiler check.
+func (b *BicepCheck) Run(ctx context.Context) (bool, string, error) {
+	output, err := b.bicep.Call("--version")
+	if err != nil {
+		return false, "The Bicep compiler cannot run", err
+	}
+
+	version := regexp.MustCompile(bicep.SemanticVersionRegex).FindString(string(output))
+	if version == "" {
+		return false, fmt.Sprintf("Failed to parse the Bicep compiler version from %q", string(output)), nil
+	}
+
+	return true, fmt.Sprintf("The Bicep compiler is present (version: %s)", version), nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/bicep"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBicepCheck_Properties(t *testing.T) {
+	check := NewBicepCheck(nil)
+	assert.Equal(t, "Bicep Compiler", check.Name())
+	assert.Equal(t, preflight.SeverityWarning, check.Severity())
+	assert.Contains(t, check.Remediation(), bicep.BicepEnvVar)
+}
+
+func TestBicepCheck_Run(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("present", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		bicepMock := bicep.NewMockInterface(ctrl)
+		bicepMock.EXPECT().
+			Call("--version").
+			Return([]byte("Bicep CLI version 0.30.23 (ec3612124a)"), nil).
+			Times(1)
+
+		check := NewBicepCheck(bicepMock)
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, pass)
+		assert.Equal(t, "The Bicep compiler is present (version: 0.30.23)", msg)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		bicepMock := bicep.NewMockInterface(ctrl)
+		bicepMock.EXPECT().
+			Call("--version").
+			Return(nil, errors.New("bicep not installed")).
+			Times(1)
+
+		check := NewBicepCheck(bicepMock)
+		pass, msg, err := check.Run(ctx)
+
+		require.ErrorContains(t, err, "bicep not installed")
+		assert.False(t, pass)
+		assert.Equal(t, "The Bicep compiler cannot run", msg)
+	})
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// MaxClockSkew is the maximum difference between the local clock and the clock of the cluster before the
+	// clock skew check fails. Larger differences cause token and signature validation failures with cloud providers.
+	MaxClockSkew = time.Minute
+)
+
+// ServerTimeFunc returns the current time of the server.
+type ServerTimeFunc func(ctx context.Context) (time.Time, error)
+
+// Ensure ClockSkewCheck implements RemediableCheck interface
+var _ preflight.RemediableCheck = (*ClockSkewCheck)(nil)
+
+// ClockSkewCheck validates that the local clock is synchronized with the clock of the Kubernetes API server.
+type ClockSkewCheck struct {
+	serverTime ServerTimeFunc
+	now        func() time.Time
+}
+
+// NewClockSkewCheck creates a new check that reads the time of the Kubernetes API server of the given context.
+func NewClockSkewCheck(kubeContext string) *ClockSkewCheck {
+	return NewClockSkewCheckWithServerTime(func(ctx context.Context) (time.Time, error) {
+		return kubernetesServerTime(ctx, kubeContext)
+	})
+}
+
+// NewClockSkewCheckWithServerTime creates a new check that reads the time of the server with the given function.
+func NewClockSkewCheckWithServerTime(serverTime ServerTimeFunc) *ClockSkewCheck {
+	return &ClockSkewCheck{
+		serverTime: serverTime,
+		now:        time.Now,
+	}
+}
+
+// Name returns the name of this check.
+func (c *ClockSkewCheck) Name() string {
+	return "Clock Skew"
+}
+
+// Severity returns the severity level of this check.
+func (c *ClockSkewCheck) Severity() preflight.CheckSeverity {
+	return preflight.SeverityWarning
+}
+
+// Remediation returns the action to take when the clocks are not synchronized.
+func (c *ClockSkewCheck) Remediation() string {
+	return "Synchronize the clock of this machine and of the cluster nodes with a time server (NTP)."
+}
+
+// Run executes the clock skew check.
+func (c *ClockSkewCheck) Run(ctx context.Context) (bool, string, error) {
+	start := c.now()
+	serverTime, err := c.serverTime(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get the time of the cluster: %w", err)
+	}
+	end := c.now()
+
+	// Compare with the middle of the request to account for its latency.
+	localTime := start.Add(end.Sub(start) / 2)
+	skew := serverTime.Sub(localTime).Round(time.Second)
+	if skew < 0 {
+		skew = -skew
+	}
+
+	if skew > MaxClockSkew {
+		return false, fmt.Sprintf("The local clock differs from the cluster clock by %s, more than the maximum of %s", skew, MaxClockSkew), nil
+	}
+
+	return true, fmt.Sprintf("The local clock differs from the cluster clock by %s", skew), nil
+}
+
+// kubernetesServerTime returns the time of the Kubernetes API server from the Date header of a version request.
+func kubernetesServerTime(ctx context.Context, kubeContext string) (time.Time, error) {
+	config, err := newClientConfig(kubeContext)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	client, err := rest.HTTPClientFor(config)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to create Kubernetes HTTP client: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, config.Host+"/version", nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer response.Body.Close()
+
+	return http.ParseTime(response.Header.Get("Date"))
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClockSkewCheck_Properties(t *testing.T) {
+	check := NewClockSkewCheck("test-context")
+	assert.Equal(t, "Clock Skew", check.Name())
+	assert.Equal(t, preflight.SeverityWarning, check.Severity())
+	assert.NotEmpty(t, check.Remediation())
+}
+
+func TestClockSkewCheck_Run(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newCheck := func(serverTime time.Time, err error) *ClockSkewCheck {
+		check := NewClockSkewCheckWithServerTime(func(ctx context.Context) (time.Time, error) {
+			return serverTime, err
+		})
+		check.now = func() time.Time { return now }
+		return check
+	}
+
+	t.Run("synchronized", func(t *testing.T) {
+		pass, msg, err := newCheck(now.Add(-5*time.Second), nil).Run(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, pass)
+		assert.Equal(t, "The local clock differs from the cluster clock by 5s", msg)
+	})
+
+	t.Run("skewed", func(t *testing.T) {
+		pass, msg, err := newCheck(now.Add(3*time.Minute), nil).Run(ctx)
+
+		require.NoError(t, err)
+		assert.False(t, pass)
+		assert.Equal(t, "The local clock differs from the cluster clock by 3m0s, more than the maximum of 1m0s", msg)
+	})
+
+	t.Run("server time unavailable", func(t *testing.T) {
+		pass, _, err := newCheck(time.Time{}, errors.New("connection refused")).Run(ctx)
+
+		require.ErrorContains(t, err, "connection refused")
+		assert.False(t, pass)
+	})
+}
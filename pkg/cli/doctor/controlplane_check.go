@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Ensure ControlPlaneCheck implements RemediableCheck interface
+var _ preflight.RemediableCheck = (*ControlPlaneCheck)(nil)
+
+// ControlPlaneCheck validates that the pods of the Radius control plane are running and ready.
+type ControlPlaneCheck struct {
+	kubeContext string
+	clientset   kubernetes.Interface
+}
+
+// NewControlPlaneCheck creates a new check that will create its own client.
+func NewControlPlaneCheck(kubeContext string) *ControlPlaneCheck {
+	return &ControlPlaneCheck{
+		kubeContext: kubeContext,
+	}
+}
+
+// NewControlPlaneCheckWithClientset creates a new check with an existing client.
+func NewControlPlaneCheckWithClientset(kubeContext string, clientset kubernetes.Interface) *ControlPlaneCheck {
+	return &ControlPlaneCheck{
+		kubeContext: kubeContext,
+		clientset:   clientset,
+	}
+}
+
+// Name returns the name of this check.
+func (c *ControlPlaneCheck) Name() string {
+	return "Control Plane Pods"
+}
+
+// Severity returns the severity level of this check.
+func (c *ControlPlaneCheck) Severity() preflight.CheckSeverity {
+	return preflight.SeverityError
+}
+
+// Remediation returns the action to take when the control plane is not healthy.
+func (c *ControlPlaneCheck) Remediation() string {
+	return fmt.Sprintf("Inspect the failing pods with 'kubectl describe pod -n %s' and 'kubectl logs -n %s'. "+
+		"Run 'rad install kubernetes' if Radius is not installed, or 'rad install kubernetes --reinstall' to repair the installation.",
+		helm.RadiusSystemNamespace, helm.RadiusSystemNamespace)
+}
+
+// Run executes the control plane check.
+func (c *ControlPlaneCheck) Run(ctx context.Context) (bool, string, error) {
+	clientset := c.clientset
+	if clientset == nil {
+		var err error
+		clientset, err = newClientset(c.kubeContext)
+		if err != nil {
+			return false, "", err
+		}
+	}
+
+	pods, err := clientset.CoreV1().Pods(helm.RadiusSystemNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, "", fmt.Errorf("failed to list pods in namespace %s: %w", helm.RadiusSystemNamespace, err)
+	}
+
+	if len(pods.Items) == 0 {
+		return false, fmt.Sprintf("No pods found in namespace %s", helm.RadiusSystemNamespace), nil
+	}
+
+	unhealthy := []string{}
+	for _, pod := range pods.Items {
+		if reason := podUnhealthyReason(&pod); reason != "" {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s (%s)", pod.Name, reason))
+		}
+	}
+	sort.Strings(unhealthy)
+
+	if len(unhealthy) > 0 {
+		return false, fmt.Sprintf("%d of %d pods are not ready: %s", len(unhealthy), len(pods.Items), strings.Join(unhealthy, ", ")), nil
+	}
+
+	return true, fmt.Sprintf("All %d pods in namespace %s are ready", len(pods.Items), helm.RadiusSystemNamespace), nil
+}
+
+// podUnhealthyReason returns the reason why the pod is not ready, or an empty string if the pod is ready. Pods of
+// completed jobs are considered as healthy.
+func podUnhealthyReason(pod *corev1.Pod) string {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return ""
+	case corev1.PodRunning:
+	default:
+		return string(pod.Status.Phase)
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Ready {
+			continue
+		}
+
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+			return fmt.Sprintf("container %s: %s", status.Name, status.State.Waiting.Reason)
+		}
+
+		return fmt.Sprintf("container %s is not ready", status.Name)
+	}
+
+	return ""
+}
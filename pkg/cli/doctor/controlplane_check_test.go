@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/helm"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newPod(name string, phase corev1.PodPhase, statuses ...corev1.ContainerStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: helm.RadiusSystemNamespace},
+		Status: corev1.PodStatus{
+			Phase:             phase,
+			ContainerStatuses: statuses,
+		},
+	}
+}
+
+func TestControlPlaneCheck_Properties(t *testing.T) {
+	check := NewControlPlaneCheck("test-context")
+	assert.Equal(t, "Control Plane Pods", check.Name())
+	assert.Equal(t, preflight.SeverityError, check.Severity())
+	assert.NotEmpty(t, check.Remediation())
+}
+
+func TestControlPlaneCheck_Run(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("all pods ready", func(t *testing.T) {
+		clientset := fake.NewClientset(
+			newPod("ucp", corev1.PodRunning, corev1.ContainerStatus{Name: "ucp", Ready: true}),
+			newPod("applications-rp", corev1.PodRunning, corev1.ContainerStatus{Name: "applications-rp", Ready: true}),
+			newPod("database-init", corev1.PodSucceeded),
+		)
+
+		check := NewControlPlaneCheckWithClientset("test", clientset)
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, pass)
+		assert.Equal(t, "All 3 pods in namespace radius-system are ready", msg)
+	})
+
+	t.Run("no pods", func(t *testing.T) {
+		clientset := fake.NewClientset()
+
+		check := NewControlPlaneCheckWithClientset("test", clientset)
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.False(t, pass)
+		assert.Equal(t, "No pods found in namespace radius-system", msg)
+	})
+
+	t.Run("unhealthy pods", func(t *testing.T) {
+		clientset := fake.NewClientset(
+			newPod("ucp", corev1.PodRunning, corev1.ContainerStatus{
+				Name:  "ucp",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			}),
+			newPod("controller", corev1.PodPending),
+			newPod("applications-rp", corev1.PodRunning, corev1.ContainerStatus{Name: "applications-rp", Ready: true}),
+		)
+
+		check := NewControlPlaneCheckWithClientset("test", clientset)
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.False(t, pass)
+		assert.Equal(t, "2 of 3 pods are not ready: controller (Pending), ucp (container ucp: CrashLoopBackOff)", msg)
+	})
+}
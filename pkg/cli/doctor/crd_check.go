@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RequiredCRDs are the custom resource definitions installed by the Radius Helm chart.
+var RequiredCRDs = []string{
+	"deploymentresources.radapp.io",
+	"deploymenttemplates.radapp.io",
+	"recipes.radapp.io",
+	"queuemessages.ucp.dev",
+	"resources.ucp.dev",
+}
+
+// Ensure CRDCheck implements RemediableCheck interface
+var _ preflight.RemediableCheck = (*CRDCheck)(nil)
+
+// CRDCheck validates that the custom resource definitions of Radius are present in the cluster.
+type CRDCheck struct {
+	kubeContext string
+	clientset   apiextensionsclient.Interface
+}
+
+// NewCRDCheck creates a new check that will create its own client.
+func NewCRDCheck(kubeContext string) *CRDCheck {
+	return &CRDCheck{
+		kubeContext: kubeContext,
+	}
+}
+
+// NewCRDCheckWithClientset creates a new check with an existing client.
+func NewCRDCheckWithClientset(kubeContext string, clientset apiextensionsclient.Interface) *CRDCheck {
+	return &CRDCheck{
+		kubeContext: kubeContext,
+		clientset:   clientset,
+	}
+}
+
+// Name returns the name of this check.
+func (c *CRDCheck) Name() string {
+	return "Custom Resource Definitions"
+}
+
+// Severity returns the severity level of this check.
+func (c *CRDCheck) Severity() preflight.CheckSeverity {
+	return preflight.SeverityError
+}
+
+// Remediation returns the action to take when custom resource definitions are missing.
+func (c *CRDCheck) Remediation() string {
+	return "Reinstall Radius with 'rad install kubernetes --reinstall' to restore the missing custom resource definitions."
+}
+
+// Run executes the custom resource definition check.
+func (c *CRDCheck) Run(ctx context.Context) (bool, string, error) {
+	clientset := c.clientset
+	if clientset == nil {
+		var err error
+		clientset, err = newAPIExtensionsClientset(c.kubeContext)
+		if err != nil {
+			return false, "", err
+		}
+	}
+
+	missing := []string{}
+	for _, name := range RequiredCRDs {
+		_, err := clientset.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			missing = append(missing, name)
+		} else if err != nil {
+			return false, "", fmt.Errorf("failed to get custom resource definition %s: %w", name, err)
+		}
+	}
+
+	if len(missing) > 0 {
+		return false, fmt.Sprintf("Missing custom resource definitions: %s", strings.Join(missing, ", ")), nil
+	}
+
+	return true, fmt.Sprintf("All %d custom resource definitions are present", len(RequiredCRDs)), nil
+}
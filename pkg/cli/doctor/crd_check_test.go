@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newCRDs(names ...string) []runtime.Object {
+	objects := []runtime.Object{}
+	for _, name := range names {
+		objects = append(objects, &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: name}})
+	}
+
+	return objects
+}
+
+func TestCRDCheck_Properties(t *testing.T) {
+	check := NewCRDCheck("test-context")
+	assert.Equal(t, "Custom Resource Definitions", check.Name())
+	assert.Equal(t, preflight.SeverityError, check.Severity())
+	assert.NotEmpty(t, check.Remediation())
+}
+
+func TestCRDCheck_Run(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("all present", func(t *testing.T) {
+		clientset := fake.NewClientset(newCRDs(RequiredCRDs...)...)
+
+		check := NewCRDCheckWithClientset("test", clientset)
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, pass)
+		assert.Equal(t, "All 5 custom resource definitions are present", msg)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		clientset := fake.NewClientset(newCRDs("deploymentresources.radapp.io", "deploymenttemplates.radapp.io", "resources.ucp.dev")...)
+
+		check := NewCRDCheckWithClientset("test", clientset)
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.False(t, pass)
+		assert.Equal(t, "Missing custom resource definitions: recipes.radapp.io, queuemessages.ucp.dev", msg)
+	})
+}
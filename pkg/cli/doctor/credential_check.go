@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+)
+
+// Ensure CredentialCheck implements RemediableCheck interface
+var _ preflight.RemediableCheck = (*CredentialCheck)(nil)
+
+// CredentialCheck validates that the cloud provider credentials registered with UCP can be read and are complete.
+type CredentialCheck struct {
+	client credential.CredentialManagementClient
+}
+
+// NewCredentialCheck creates a new cloud provider credential check.
+func NewCredentialCheck(client credential.CredentialManagementClient) *CredentialCheck {
+	return &CredentialCheck{
+		client: client,
+	}
+}
+
+// Name returns the name of this check.
+func (c *CredentialCheck) Name() string {
+	return "Cloud Provider Credentials"
+}
+
+// Severity returns the severity level of this check.
+func (c *CredentialCheck) Severity() preflight.CheckSeverity {
+	return preflight.SeverityWarning
+}
+
+// Remediation returns the action to take when a credential is invalid.
+func (c *CredentialCheck) Remediation() string {
+	return "Register the credential again with 'rad credential register azure' or 'rad credential register aws'."
+}
+
+// Run executes the cloud provider credential check.
+func (c *CredentialCheck) Run(ctx context.Context) (bool, string, error) {
+	providers, err := c.client.List(ctx)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to list credentials: %w", err)
+	}
+
+	registered := []string{}
+	invalid := []string{}
+	for _, provider := range providers {
+		if !provider.Enabled {
+			continue
+		}
+
+		registered = append(registered, provider.Name)
+
+		config, err := c.client.Get(ctx, provider.Name)
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s (%s)", provider.Name, err.Error()))
+		} else if reason := invalidCredentialReason(config); reason != "" {
+			invalid = append(invalid, fmt.Sprintf("%s (%s)", provider.Name, reason))
+		}
+	}
+
+	if len(invalid) > 0 {
+		return false, fmt.Sprintf("Invalid credentials: %s", strings.Join(invalid, ", ")), nil
+	} else if len(registered) == 0 {
+		return true, "No cloud provider credentials are registered", nil
+	}
+
+	return true, fmt.Sprintf("Credentials are registered for: %s", strings.Join(registered, ", ")), nil
+}
+
+// invalidCredentialReason returns the reason why a registered credential is incomplete, or an empty string if the
+// credential is complete.
+func invalidCredentialReason(config credential.ProviderCredentialConfiguration) string {
+	switch {
+	case config.AzureCredentials != nil:
+		azure := config.AzureCredentials
+		if azure.ServicePrincipal != nil && anyEmpty(azure.ServicePrincipal.ClientID, azure.ServicePrincipal.TenantID) {
+			return "the service principal is missing a client ID or tenant ID"
+		} else if azure.WorkloadIdentity != nil && anyEmpty(azure.WorkloadIdentity.ClientID, azure.WorkloadIdentity.TenantID) {
+			return "the workload identity is missing a client ID or tenant ID"
+		} else if azure.ServicePrincipal == nil && azure.WorkloadIdentity == nil {
+			return "the credential kind is not supported"
+		}
+	case config.AWSCredentials != nil:
+		aws := config.AWSCredentials
+		if aws.AccessKey != nil && anyEmpty(aws.AccessKey.AccessKeyID) {
+			return "the access key is missing an access key ID"
+		} else if aws.IRSA != nil && anyEmpty(aws.IRSA.RoleARN) {
+			return "the IRSA credential is missing a role ARN"
+		} else if aws.AccessKey == nil && aws.IRSA == nil {
+			return "the credential kind is not supported"
+		}
+	}
+
+	return ""
+}
+
+// anyEmpty returns true if any of the values is nil or empty.
+func anyEmpty(values ...*string) bool {
+	for _, value := range values {
+		if value == nil || *value == "" {
+			return true
+		}
+	}
+
+	return false
+}
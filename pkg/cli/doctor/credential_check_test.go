@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCredentialCheck_Properties(t *testing.T) {
+	check := NewCredentialCheck(nil)
+	assert.Equal(t, "Cloud Provider Credentials", check.Name())
+	assert.Equal(t, preflight.SeverityWarning, check.Severity())
+	assert.NotEmpty(t, check.Remediation())
+}
+
+func TestCredentialCheck_Run(t *testing.T) {
+	ctx := context.Background()
+
+	azureCredential := credential.ProviderCredentialConfiguration{
+		CloudProviderStatus: credential.CloudProviderStatus{Name: "azure", Enabled: true},
+		AzureCredentials: &credential.AzureCredentialProperties{
+			Kind: to.Ptr("ServicePrincipal"),
+			ServicePrincipal: &credential.AzureServicePrincipalCredentialProperties{
+				ClientID: to.Ptr("client-id"),
+				TenantID: to.Ptr("tenant-id"),
+			},
+		},
+	}
+
+	t.Run("no credentials", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := credential.NewMockCredentialManagementClient(ctrl)
+		client.EXPECT().
+			List(gomock.Any()).
+			Return([]credential.CloudProviderStatus{{Name: "azure"}, {Name: "aws"}}, nil).
+			Times(1)
+
+		check := NewCredentialCheck(client)
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, pass)
+		assert.Equal(t, "No cloud provider credentials are registered", msg)
+	})
+
+	t.Run("valid credentials", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := credential.NewMockCredentialManagementClient(ctrl)
+		client.EXPECT().
+			List(gomock.Any()).
+			Return([]credential.CloudProviderStatus{{Name: "azure", Enabled: true}, {Name: "aws"}}, nil).
+			Times(1)
+		client.EXPECT().
+			Get(gomock.Any(), "azure").
+			Return(azureCredential, nil).
+			Times(1)
+
+		check := NewCredentialCheck(client)
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, pass)
+		assert.Equal(t, "Credentials are registered for: azure", msg)
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := credential.NewMockCredentialManagementClient(ctrl)
+		client.EXPECT().
+			List(gomock.Any()).
+			Return([]credential.CloudProviderStatus{{Name: "azure", Enabled: true}, {Name: "aws", Enabled: true}}, nil).
+			Times(1)
+		client.EXPECT().
+			Get(gomock.Any(), "azure").
+			Return(credential.ProviderCredentialConfiguration{
+				CloudProviderStatus: credential.CloudProviderStatus{Name: "azure", Enabled: true},
+				AzureCredentials: &credential.AzureCredentialProperties{
+					ServicePrincipal: &credential.AzureServicePrincipalCredentialProperties{ClientID: to.Ptr("client-id")},
+				},
+			}, nil).
+			Times(1)
+		client.EXPECT().
+			Get(gomock.Any(), "aws").
+			Return(credential.ProviderCredentialConfiguration{}, errors.New("unable to read secret")).
+			Times(1)
+
+		check := NewCredentialCheck(client)
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.False(t, pass)
+		assert.Equal(t, "Invalid credentials: azure (the service principal is missing a client ID or tenant ID), aws (unable to read secret)", msg)
+	})
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/kubeutil"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// newClientConfig creates the Kubernetes client config for the given context.
+func newClientConfig(kubeContext string) (*rest.Config, error) {
+	config, err := kubeutil.NewClientConfig(&kubeutil.ConfigOptions{
+		ContextName: kubeContext,
+		QPS:         kubeutil.DefaultCLIQPS,
+		Burst:       kubeutil.DefaultCLIBurst,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client config: %w", err)
+	}
+
+	return config, nil
+}
+
+// newClientset creates a Kubernetes client for the given context.
+func newClientset(kubeContext string) (kubernetes.Interface, error) {
+	config, err := newClientConfig(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes client: %w", err)
+	}
+
+	return clientset, nil
+}
+
+// newAPIExtensionsClientset creates a Kubernetes API extensions client for the given context.
+func newAPIExtensionsClientset(kubeContext string) (apiextensionsclient.Interface, error) {
+	config, err := newClientConfig(kubeContext)
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := apiextensionsclient.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes API extensions client: %w", err)
+	}
+
+	return clientset, nil
+}
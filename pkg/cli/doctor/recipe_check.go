@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+)
+
+// Ensure RecipeCheck implements RemediableCheck interface
+var _ preflight.RemediableCheck = (*RecipeCheck)(nil)
+
+// RecipeCheck validates that the recipes registered to the environment of the workspace can be resolved.
+type RecipeCheck struct {
+	client      clients.ApplicationsManagementClient
+	environment string
+}
+
+// NewRecipeCheck creates a new recipe check for the given environment name or ID.
+func NewRecipeCheck(client clients.ApplicationsManagementClient, environment string) *RecipeCheck {
+	return &RecipeCheck{
+		client:      client,
+		environment: environment,
+	}
+}
+
+// Name returns the name of this check.
+func (r *RecipeCheck) Name() string {
+	return "Environment Recipes"
+}
+
+// Severity returns the severity level of this check.
+func (r *RecipeCheck) Severity() preflight.CheckSeverity {
+	return preflight.SeverityWarning
+}
+
+// Remediation returns the action to take when recipes cannot be resolved.
+func (r *RecipeCheck) Remediation() string {
+	return "Check that the template path of each failing recipe exists and is reachable from the cluster with 'rad recipe show', " +
+		"and register it again with 'rad recipe register'. Use 'rad env switch' to select an environment."
+}
+
+// Run executes the recipe check.
+func (r *RecipeCheck) Run(ctx context.Context) (bool, string, error) {
+	if r.environment == "" {
+		return false, "No environment is configured for the workspace", nil
+	}
+
+	environment, err := r.client.GetEnvironment(ctx, r.environment)
+	if clients.Is404Error(err) {
+		return false, fmt.Sprintf("The environment %q was not found", r.environment), nil
+	} else if err != nil {
+		return false, "", fmt.Errorf("failed to get environment %q: %w", r.environment, err)
+	}
+
+	if environment.Properties == nil || len(environment.Properties.Recipes) == 0 {
+		return true, fmt.Sprintf("No recipes are registered to environment %q", r.environment), nil
+	}
+
+	count := 0
+	unresolved := []string{}
+	for resourceType, recipes := range environment.Properties.Recipes {
+		for recipeName := range recipes {
+			count++
+			_, err := r.client.GetRecipeMetadata(ctx, r.environment, corerp.RecipeGetMetadata{
+				Name:         to.Ptr(recipeName),
+				ResourceType: to.Ptr(resourceType),
+			})
+			if err != nil {
+				unresolved = append(unresolved, fmt.Sprintf("%s/%s (%s)", resourceType, recipeName, err.Error()))
+			}
+		}
+	}
+	sort.Strings(unresolved)
+
+	if len(unresolved) > 0 {
+		return false, fmt.Sprintf("%d of %d recipes cannot be resolved: %s", len(unresolved), count, strings.Join(unresolved, ", ")), nil
+	}
+
+	return true, fmt.Sprintf("All %d recipes of environment %q can be resolved", count, r.environment), nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecipeCheck_Properties(t *testing.T) {
+	check := NewRecipeCheck(nil, "default")
+	assert.Equal(t, "Environment Recipes", check.Name())
+	assert.Equal(t, preflight.SeverityWarning, check.Severity())
+	assert.NotEmpty(t, check.Remediation())
+}
+
+func TestRecipeCheck_Run(t *testing.T) {
+	ctx := context.Background()
+
+	environment := corerp.EnvironmentResource{
+		Properties: &corerp.EnvironmentProperties{
+			Recipes: map[string]map[string]corerp.RecipePropertiesClassification{
+				"Applications.Datastores/redisCaches": {
+					"default": &corerp.BicepRecipeProperties{TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/redis:latest")},
+				},
+				"Applications.Datastores/sqlDatabases": {
+					"default": &corerp.BicepRecipeProperties{TemplatePath: to.Ptr("ghcr.io/radius-project/recipes/missing:latest")},
+				},
+			},
+		},
+	}
+
+	t.Run("no environment", func(t *testing.T) {
+		check := NewRecipeCheck(nil, "")
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.False(t, pass)
+		assert.Equal(t, "No environment is configured for the workspace", msg)
+	})
+
+	t.Run("all recipes resolved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetEnvironment(gomock.Any(), "default").
+			Return(environment, nil).
+			Times(1)
+		client.EXPECT().
+			GetRecipeMetadata(gomock.Any(), "default", gomock.Any()).
+			Return(corerp.RecipeGetMetadataResponse{}, nil).
+			Times(2)
+
+		check := NewRecipeCheck(client, "default")
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, pass)
+		assert.Equal(t, `All 2 recipes of environment "default" can be resolved`, msg)
+	})
+
+	t.Run("unresolved recipe", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			GetEnvironment(gomock.Any(), "default").
+			Return(environment, nil).
+			Times(1)
+		client.EXPECT().
+			GetRecipeMetadata(gomock.Any(), "default", gomock.Any()).
+			DoAndReturn(func(ctx context.Context, environment string, recipe corerp.RecipeGetMetadata) (corerp.RecipeGetMetadataResponse, error) {
+				if *recipe.ResourceType == "Applications.Datastores/sqlDatabases" {
+					return corerp.RecipeGetMetadataResponse{}, errors.New("template not found")
+				}
+				return corerp.RecipeGetMetadataResponse{}, nil
+			}).
+			Times(2)
+
+		check := NewRecipeCheck(client, "default")
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.False(t, pass)
+		assert.Equal(t, "1 of 2 recipes cannot be resolved: Applications.Datastores/sqlDatabases/default (template not found)", msg)
+	})
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+)
+
+// Ensure UCPCheck implements RemediableCheck interface
+var _ preflight.RemediableCheck = (*UCPCheck)(nil)
+
+// UCPCheck validates that the Universal Control Plane (UCP) of the workspace is reachable.
+type UCPCheck struct {
+	client    clients.ApplicationsManagementClient
+	planeName string
+}
+
+// NewUCPCheck creates a new UCP reachability check that lists the resource groups of the given Radius plane.
+func NewUCPCheck(client clients.ApplicationsManagementClient, planeName string) *UCPCheck {
+	return &UCPCheck{
+		client:    client,
+		planeName: planeName,
+	}
+}
+
+// Name returns the name of this check.
+func (u *UCPCheck) Name() string {
+	return "UCP Reachability"
+}
+
+// Severity returns the severity level of this check.
+func (u *UCPCheck) Severity() preflight.CheckSeverity {
+	return preflight.SeverityError
+}
+
+// Remediation returns the action to take when UCP cannot be reached.
+func (u *UCPCheck) Remediation() string {
+	return "Check the connection of the workspace with 'rad workspace show', and that the ucp pod in the Control Plane Pods check is ready."
+}
+
+// Run executes the UCP reachability check.
+func (u *UCPCheck) Run(ctx context.Context) (bool, string, error) {
+	groups, err := u.client.ListResourceGroups(ctx, u.planeName)
+	if err != nil {
+		return false, "Cannot reach UCP", fmt.Errorf("failed to list resource groups: %w", err)
+	}
+
+	return true, fmt.Sprintf("UCP is reachable and has %d resource groups in plane %s", len(groups), u.planeName), nil
+}
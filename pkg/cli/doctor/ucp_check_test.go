@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/upgrade/preflight"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUCPCheck_Properties(t *testing.T) {
+	check := NewUCPCheck(nil, "local")
+	assert.Equal(t, "UCP Reachability", check.Name())
+	assert.Equal(t, preflight.SeverityError, check.Severity())
+	assert.NotEmpty(t, check.Remediation())
+}
+
+func TestUCPCheck_Run(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reachable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			ListResourceGroups(gomock.Any(), "local").
+			Return([]v20231001preview.ResourceGroupResource{{}, {}}, nil).
+			Times(1)
+
+		check := NewUCPCheck(client, "local")
+		pass, msg, err := check.Run(ctx)
+
+		require.NoError(t, err)
+		assert.True(t, pass)
+		assert.Equal(t, "UCP is reachable and has 2 resource groups in plane local", msg)
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := clients.NewMockApplicationsManagementClient(ctrl)
+		client.EXPECT().
+			ListResourceGroups(gomock.Any(), "local").
+			Return(nil, errors.New("connection refused")).
+			Times(1)
+
+		check := NewUCPCheck(client, "local")
+		pass, msg, err := check.Run(ctx)
+
+		require.ErrorContains(t, err, "connection refused")
+		assert.False(t, pass)
+		assert.Equal(t, "Cannot reach UCP", msg)
+	})
+}
@@ -44,3 +44,18 @@ func CreateLabelSelectorForDashboard() (labels.Selector, error) {
 
 	return labels.NewSelector().Add(*dashboardNameLabel).Add(*dashboardPartOfLabel), nil
 }
+
+// CreateLabelSelectorForResource creates a label selector for the pods of a resource of the Radius Application.
+func CreateLabelSelectorForResource(applicationName string, resourceName string) (labels.Selector, error) {
+	applicationLabel, err := labels.NewRequirement(kubernetes.LabelRadiusApplication, selection.Equals, []string{kubernetes.NormalizeResourceName(applicationName)})
+	if err != nil {
+		return nil, err
+	}
+
+	resourceLabel, err := labels.NewRequirement(kubernetes.LabelRadiusResource, selection.Equals, []string{kubernetes.NormalizeResourceName(resourceName)})
+	if err != nil {
+		return nil, err
+	}
+
+	return labels.NewSelector().Add(*applicationLabel, *resourceLabel), nil
+}
@@ -50,3 +50,21 @@ func Test_CreateLabelSelectorForDashboard(t *testing.T) {
 
 	require.NotEqual(t, "app.kubernetes.io/part-of=radius,app.kubernetes.io/name=dashboard", selector.String())
 }
+
+func Test_CreateLabelSelectorForResource(t *testing.T) {
+	// Create a label selector for the resource "Frontend" of the application "test-app"
+	selector, err := CreateLabelSelectorForResource("test-app", "Frontend")
+	require.NoError(t, err)
+	require.NotNil(t, selector)
+	require.Equal(t, "radapp.io/application=test-app,radapp.io/resource=frontend", selector.String())
+
+	require.True(t, selector.Matches(labels.Set{
+		"radapp.io/application": "test-app",
+		"radapp.io/resource":    "frontend",
+		"pod-template-hash":     "abc",
+	}))
+	require.False(t, selector.Matches(labels.Set{
+		"radapp.io/application": "test-app",
+		"radapp.io/resource":    "backend",
+	}))
+}
@@ -0,0 +1,322 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// BreakingChange describes a change to a resource type that breaks existing clients or existing resources.
+type BreakingChange struct {
+	// ResourceType is the fully-qualified name of the resource type. Example: MyCompany.Resources/testResources
+	ResourceType string
+
+	// APIVersion is the API version that is affected by the change. Empty if the change affects the resource type.
+	APIVersion string
+
+	// Path is the path of the affected property in the schema. Empty if the change does not affect a property.
+	Path string
+
+	// Message describes the change.
+	Message string
+}
+
+// String returns a human-readable description of the breaking change.
+func (c BreakingChange) String() string {
+	location := c.ResourceType
+	if c.APIVersion != "" {
+		location += "@" + c.APIVersion
+	}
+	if c.Path != "" {
+		location += " " + c.Path
+	}
+
+	return location + ": " + c.Message
+}
+
+// CheckBreakingChanges compares an updated resource provider manifest with the registered version of the resource
+// provider, and returns the changes that break existing clients or existing resources of the registered API versions.
+//
+// Resource types and API versions that are missing from the updated manifest are not reported, because registering
+// a manifest does not remove them.
+func CheckBreakingChanges(registered *ResourceProvider, updated *ResourceProvider) []BreakingChange {
+	if registered == nil || updated == nil {
+		return nil
+	}
+
+	changes := []BreakingChange{}
+	for resourceTypeName, registeredType := range registered.Types {
+		updatedType, ok := updated.Types[resourceTypeName]
+		if !ok || registeredType == nil || updatedType == nil {
+			continue
+		}
+
+		fullName := registered.Namespace + "/" + resourceTypeName
+		changes = append(changes, checkStorageAPIVersion(fullName, registeredType, updatedType)...)
+
+		for apiVersion, registeredVersion := range registeredType.APIVersions {
+			updatedVersion, ok := updatedType.APIVersions[apiVersion]
+			if !ok || registeredVersion == nil || updatedVersion == nil {
+				continue
+			}
+
+			if registeredVersion.Conversion != nil && updatedVersion.Conversion == nil {
+				changes = append(changes, BreakingChange{ResourceType: fullName, APIVersion: apiVersion, Message: "the conversion was removed"})
+			}
+
+			registeredSchema, _ := registeredVersion.Schema.(map[string]any)
+			updatedSchema, _ := updatedVersion.Schema.(map[string]any)
+			for _, change := range compareSchemas("", registeredSchema, updatedSchema) {
+				change.ResourceType = fullName
+				change.APIVersion = apiVersion
+				changes = append(changes, change)
+			}
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].String() < changes[j].String()
+	})
+
+	return changes
+}
+
+// checkStorageAPIVersion reports a change of the storage API version that leaves existing resources without a
+// conversion to the new storage API version.
+func checkStorageAPIVersion(resourceType string, registered *ResourceType, updated *ResourceType) []BreakingChange {
+	if registered.StorageAPIVersion == nil {
+		return nil
+	}
+
+	previous := *registered.StorageAPIVersion
+	if updated.StorageAPIVersion == nil {
+		return []BreakingChange{{ResourceType: resourceType, Message: fmt.Sprintf("the storage API version %q was removed", previous)}}
+	}
+
+	if *updated.StorageAPIVersion == previous {
+		return nil
+	}
+
+	// Existing resources are stored in the previous storage API version, so they can only be read if that version
+	// declares how it is converted to the new storage API version.
+	if version, ok := updated.APIVersions[previous]; !ok || version == nil || version.Conversion == nil {
+		return []BreakingChange{{
+			ResourceType: resourceType,
+			Message:      fmt.Sprintf("the storage API version was changed from %q to %q, but %q does not declare a conversion", previous, *updated.StorageAPIVersion, previous),
+		}}
+	}
+
+	return nil
+}
+
+// compareSchemas returns the breaking changes between two versions of the same OpenAPI schema.
+func compareSchemas(path string, registered map[string]any, updated map[string]any) []BreakingChange {
+	if registered == nil || updated == nil {
+		return nil
+	}
+
+	changes := []BreakingChange{}
+
+	registeredType, _ := registered["type"].(string)
+	updatedType, _ := updated["type"].(string)
+	if registeredType != "" && updatedType != "" && registeredType != updatedType {
+		changes = append(changes, BreakingChange{Path: schemaPath(path), Message: fmt.Sprintf("the type was changed from %q to %q", registeredType, updatedType)})
+		return changes
+	}
+
+	registeredEnum, _ := registered["enum"].([]any)
+	updatedEnum, hasEnum := updated["enum"].([]any)
+	if hasEnum {
+		if len(registeredEnum) == 0 {
+			changes = append(changes, BreakingChange{Path: schemaPath(path), Message: "the allowed values were restricted to an enum"})
+		}
+
+		for _, value := range registeredEnum {
+			if !slices.ContainsFunc(updatedEnum, func(v any) bool { return reflect.DeepEqual(v, value) }) {
+				changes = append(changes, BreakingChange{Path: schemaPath(path), Message: fmt.Sprintf("the enum value %v was removed", value)})
+			}
+		}
+	}
+
+	registeredRequired := stringList(registered["required"])
+	for _, name := range stringList(updated["required"]) {
+		if !slices.Contains(registeredRequired, name) {
+			changes = append(changes, BreakingChange{Path: joinSchemaPath(path, name), Message: "the property is now required"})
+		}
+	}
+
+	registeredProperties, _ := registered["properties"].(map[string]any)
+	updatedProperties, _ := updated["properties"].(map[string]any)
+	for name, registeredProperty := range registeredProperties {
+		updatedProperty, ok := updatedProperties[name]
+		if !ok {
+			changes = append(changes, BreakingChange{Path: joinSchemaPath(path, name), Message: "the property was removed"})
+			continue
+		}
+
+		registeredPropertySchema, _ := registeredProperty.(map[string]any)
+		updatedPropertySchema, _ := updatedProperty.(map[string]any)
+		changes = append(changes, compareSchemas(joinSchemaPath(path, name), registeredPropertySchema, updatedPropertySchema)...)
+	}
+
+	registeredItems, _ := registered["items"].(map[string]any)
+	updatedItems, _ := updated["items"].(map[string]any)
+	changes = append(changes, compareSchemas(path+"[*]", registeredItems, updatedItems)...)
+
+	registeredAdditional, _ := registered["additionalProperties"].(map[string]any)
+	updatedAdditional, _ := updated["additionalProperties"].(map[string]any)
+	changes = append(changes, compareSchemas(joinSchemaPath(path, "*"), registeredAdditional, updatedAdditional)...)
+
+	return changes
+}
+
+func joinSchemaPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func schemaPath(path string) string {
+	if path == "" {
+		return "(root)"
+	}
+
+	return path
+}
+
+func stringList(value any) []string {
+	result := []string{}
+	switch v := value.(type) {
+	case []string:
+		result = append(result, v...)
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+	}
+
+	return result
+}
+
+// GetRegisteredResourceProvider fetches the registered state of the resource types declared by a resource provider
+// manifest from UCP. Resource types that are not registered are omitted from the result.
+func GetRegisteredResourceProvider(ctx context.Context, clientFactory *v20231001preview.ClientFactory, planeName string, resourceProvider ResourceProvider) (*ResourceProvider, error) {
+	result := &ResourceProvider{
+		Namespace: resourceProvider.Namespace,
+		Types:     map[string]*ResourceType{},
+	}
+
+	for resourceTypeName := range resourceProvider.Types {
+		response, err := clientFactory.NewResourceTypesClient().Get(ctx, planeName, resourceProvider.Namespace, resourceTypeName, nil)
+		if clients.Is404Error(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		resourceType := &ResourceType{
+			APIVersions: map[string]*ResourceTypeAPIVersion{},
+		}
+		if response.Properties != nil {
+			resourceType.StorageAPIVersion = response.Properties.StorageAPIVersion
+		}
+
+		pager := clientFactory.NewAPIVersionsClient().NewListPager(planeName, resourceProvider.Namespace, resourceTypeName, nil)
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, apiVersion := range page.Value {
+				if apiVersion == nil || apiVersion.Name == nil {
+					continue
+				}
+
+				version := &ResourceTypeAPIVersion{}
+				if apiVersion.Properties != nil {
+					version.Schema = apiVersion.Properties.Schema
+					version.Conversion = fromAPIVersionConversion(apiVersion.Properties.Conversion)
+				}
+
+				resourceType.APIVersions[*apiVersion.Name] = version
+			}
+		}
+
+		result.Types[resourceTypeName] = resourceType
+	}
+
+	return result, nil
+}
+
+// fromAPIVersionConversion converts the conversion of an API version in the UCP API model to the manifest.
+func fromAPIVersionConversion(conversion *v20231001preview.APIVersionConversion) *ResourceTypeAPIVersionConversion {
+	if conversion == nil {
+		return nil
+	}
+
+	result := &ResourceTypeAPIVersionConversion{}
+	if conversion.Webhook != nil && conversion.Webhook.URL != nil {
+		result.Webhook = &ResourceTypeAPIVersionConversionWebhook{URL: *conversion.Webhook.URL}
+	}
+
+	for _, rule := range conversion.Rules {
+		if rule == nil {
+			continue
+		}
+
+		converted := &ResourceTypeAPIVersionConversionRule{Value: rule.Value}
+		if rule.Kind != nil {
+			converted.Kind = string(*rule.Kind)
+		}
+		if rule.From != nil {
+			converted.From = *rule.From
+		}
+		if rule.To != nil {
+			converted.To = *rule.To
+		}
+		if rule.Path != nil {
+			converted.Path = *rule.Path
+		}
+
+		result.Rules = append(result.Rules, converted)
+	}
+
+	return result
+}
+
+// FormatBreakingChanges formats a list of breaking changes as a bulleted list.
+func FormatBreakingChanges(changes []BreakingChange) string {
+	lines := make([]string, 0, len(changes))
+	for _, change := range changes {
+		lines = append(lines, "  - "+change.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpfake "github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func newBreakingChangesProvider(storageAPIVersion *string, schema map[string]any, conversion *ResourceTypeAPIVersionConversion) *ResourceProvider {
+	return &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				StorageAPIVersion: storageAPIVersion,
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2024-01-01": {Schema: schema, Conversion: conversion},
+					"2025-01-01": {Schema: map[string]any{}},
+				},
+			},
+		},
+	}
+}
+
+func TestCheckBreakingChanges(t *testing.T) {
+	registeredSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"host": map[string]any{"type": "string"},
+			"port": map[string]any{"type": "integer"},
+			"size": map[string]any{"type": "string", "enum": []any{"S", "M", "L"}},
+			"database": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name": map[string]any{"type": "string"},
+				},
+			},
+			"tags": map[string]any{
+				"type":  "array",
+				"items": map[string]any{"type": "string"},
+			},
+		},
+		"required": []any{"host"},
+	}
+
+	rename := &ResourceTypeAPIVersionConversion{
+		Rules: []*ResourceTypeAPIVersionConversionRule{{Kind: "rename", From: "hostname", To: "host"}},
+	}
+
+	tests := []struct {
+		name       string
+		registered *ResourceProvider
+		updated    *ResourceProvider
+		expected   []string
+	}{
+		{
+			name:       "unchanged",
+			registered: newBreakingChangesProvider(nil, registeredSchema, nil),
+			updated:    newBreakingChangesProvider(nil, registeredSchema, nil),
+			expected:   []string{},
+		},
+		{
+			name:       "additive changes",
+			registered: newBreakingChangesProvider(nil, registeredSchema, nil),
+			updated: newBreakingChangesProvider(nil, map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"host":     map[string]any{"type": "string"},
+					"port":     map[string]any{"type": "integer"},
+					"size":     map[string]any{"type": "string", "enum": []any{"S", "M", "L", "XL"}},
+					"database": map[string]any{"type": "object", "properties": map[string]any{"name": map[string]any{"type": "string"}, "user": map[string]any{"type": "string"}}},
+					"tags":     map[string]any{"type": "array", "items": map[string]any{"type": "string"}},
+					"replicas": map[string]any{"type": "integer"},
+				},
+				"required": []any{"host"},
+			}, nil),
+			expected: []string{},
+		},
+		{
+			name:       "breaking schema changes",
+			registered: newBreakingChangesProvider(nil, registeredSchema, nil),
+			updated: newBreakingChangesProvider(nil, map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"host":     map[string]any{"type": "string"},
+					"port":     map[string]any{"type": "string"},
+					"size":     map[string]any{"type": "string", "enum": []any{"S", "M"}},
+					"database": map[string]any{"type": "object", "properties": map[string]any{}},
+					"tags":     map[string]any{"type": "array", "items": map[string]any{"type": "integer"}},
+				},
+				"required": []any{"host", "size"},
+			}, nil),
+			expected: []string{
+				"MyCompany.Resources/testResources@2024-01-01 database.name: the property was removed",
+				"MyCompany.Resources/testResources@2024-01-01 port: the type was changed from \"integer\" to \"string\"",
+				"MyCompany.Resources/testResources@2024-01-01 size: the enum value L was removed",
+				"MyCompany.Resources/testResources@2024-01-01 size: the property is now required",
+				"MyCompany.Resources/testResources@2024-01-01 tags[*]: the type was changed from \"string\" to \"integer\"",
+			},
+		},
+		{
+			name:       "storage API version changed with conversion",
+			registered: newBreakingChangesProvider(to.Ptr("2024-01-01"), registeredSchema, nil),
+			updated:    newBreakingChangesProvider(to.Ptr("2025-01-01"), registeredSchema, rename),
+			expected:   []string{},
+		},
+		{
+			name:       "storage API version changed without conversion",
+			registered: newBreakingChangesProvider(to.Ptr("2024-01-01"), registeredSchema, nil),
+			updated:    newBreakingChangesProvider(to.Ptr("2025-01-01"), registeredSchema, nil),
+			expected: []string{
+				"MyCompany.Resources/testResources: the storage API version was changed from \"2024-01-01\" to \"2025-01-01\", but \"2024-01-01\" does not declare a conversion",
+			},
+		},
+		{
+			name:       "storage API version and conversion removed",
+			registered: newBreakingChangesProvider(to.Ptr("2025-01-01"), registeredSchema, rename),
+			updated:    newBreakingChangesProvider(nil, registeredSchema, nil),
+			expected: []string{
+				"MyCompany.Resources/testResources: the storage API version \"2025-01-01\" was removed",
+				"MyCompany.Resources/testResources@2024-01-01: the conversion was removed",
+			},
+		},
+		{
+			name:       "resource type not registered",
+			registered: &ResourceProvider{Namespace: "MyCompany.Resources", Types: map[string]*ResourceType{}},
+			updated:    newBreakingChangesProvider(nil, registeredSchema, nil),
+			expected:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := []string{}
+			for _, change := range CheckBreakingChanges(tt.registered, tt.updated) {
+				actual = append(actual, change.String())
+			}
+			require.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestGetRegisteredResourceProvider(t *testing.T) {
+	serverFactory := ucpfake.ServerFactory{
+		ResourceTypesServer: ucpfake.ResourceTypesServer{
+			Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+				if resourceTypeName != "testResources" {
+					errResp.SetResponseError(http.StatusNotFound, "NotFound")
+					return
+				}
+
+				resp.SetResponse(http.StatusOK, v20231001preview.ResourceTypesClientGetResponse{
+					ResourceTypeResource: v20231001preview.ResourceTypeResource{
+						Name:       to.Ptr(resourceTypeName),
+						Properties: &v20231001preview.ResourceTypeProperties{StorageAPIVersion: to.Ptr("2025-01-01")},
+					},
+				}, nil)
+				return
+			},
+		},
+		APIVersionsServer: ucpfake.APIVersionsServer{
+			NewListPager: func(planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+				resp.AddPage(http.StatusOK, v20231001preview.APIVersionsClientListResponse{
+					APIVersionResourceListResult: v20231001preview.APIVersionResourceListResult{
+						Value: []*v20231001preview.APIVersionResource{
+							{
+								Name: to.Ptr("2024-01-01"),
+								Properties: &v20231001preview.APIVersionProperties{
+									Schema: map[string]any{"type": "object"},
+									Conversion: &v20231001preview.APIVersionConversion{
+										Webhook: &v20231001preview.APIVersionConversionWebhook{URL: to.Ptr("https://convert.example.com")},
+									},
+								},
+							},
+							{
+								Name:       to.Ptr("2025-01-01"),
+								Properties: &v20231001preview.APIVersionProperties{Schema: map[string]any{}},
+							},
+						},
+					},
+				}, nil)
+				return
+			},
+		},
+	}
+
+	clientFactory, err := v20231001preview.NewClientFactory(&azfake.TokenCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{Transport: ucpfake.NewServerFactoryTransport(&serverFactory)},
+	})
+	require.NoError(t, err)
+
+	manifest := ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources":  {},
+			"otherResources": {},
+		},
+	}
+
+	expected := &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				StorageAPIVersion: to.Ptr("2025-01-01"),
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2024-01-01": {
+						Schema: map[string]any{"type": "object"},
+						Conversion: &ResourceTypeAPIVersionConversion{
+							Webhook: &ResourceTypeAPIVersionConversionWebhook{URL: "https://convert.example.com"},
+						},
+					},
+					"2025-01-01": {Schema: map[string]any{}},
+				},
+			},
+		},
+	}
+
+	result, err := GetRegisteredResourceProvider(context.Background(), clientFactory, "local", manifest)
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
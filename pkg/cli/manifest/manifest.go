@@ -41,6 +41,13 @@ type ResourceType struct {
 
 	// Description of the resource type.
 	Description *string `yaml:"description,omitempty"`
+
+	// Actions is a map of custom actions for the resource type.
+	Actions map[string]*ResourceTypeAction `yaml:"actions,omitempty" validate:"dive,keys,actionName,endkeys,required"`
+
+	// StorageAPIVersion is the API version used to store resources of the resource type. When set, resources are
+	// converted to and from the storage version using the conversions declared by the other API versions.
+	StorageAPIVersion *string `yaml:"storageApiVersion,omitempty" validate:"omitempty,apiVersion"`
 }
 
 type ResourceTypeAPIVersion struct {
@@ -49,4 +56,69 @@ type ResourceTypeAPIVersion struct {
 	// TODO: this allows anything right now, and will be ignored. We'll improve this in
 	// a future pull-request.
 	Schema any `yaml:"schema" validate:"required"`
+
+	// Conversion describes how resources are converted from this API version to the storage API version.
+	Conversion *ResourceTypeAPIVersionConversion `yaml:"conversion,omitempty"`
+}
+
+// ResourceTypeAPIVersionConversion represents the conversion of an API version to the storage API version in a
+// resource provider manifest. Either rules or a webhook may be specified.
+type ResourceTypeAPIVersionConversion struct {
+	// Rules is the list of declarative conversion rules, applied in order.
+	Rules []*ResourceTypeAPIVersionConversionRule `yaml:"rules,omitempty" validate:"excluded_with=Webhook,dive,required"`
+
+	// Webhook is the webhook that converts resources.
+	Webhook *ResourceTypeAPIVersionConversionWebhook `yaml:"webhook,omitempty" validate:"excluded_with=Rules"`
+}
+
+// ResourceTypeAPIVersionConversionRule represents a declarative conversion rule in a resource provider manifest.
+type ResourceTypeAPIVersionConversionRule struct {
+	// Kind is the kind of the rule. Supported kinds are 'rename' and 'default'.
+	Kind string `yaml:"kind" validate:"required,oneof=rename default"`
+
+	// From is the property path in this API version of a 'rename' rule.
+	From string `yaml:"from,omitempty" validate:"required_if=Kind rename,excluded_unless=Kind rename"`
+
+	// To is the property path in the storage API version of a 'rename' rule.
+	To string `yaml:"to,omitempty" validate:"required_if=Kind rename,excluded_unless=Kind rename"`
+
+	// Path is the property path in the storage API version of a 'default' rule.
+	Path string `yaml:"path,omitempty" validate:"required_if=Kind default,excluded_unless=Kind default"`
+
+	// Value is the value set by a 'default' rule when the property is missing.
+	Value any `yaml:"value,omitempty"`
+}
+
+// ResourceTypeAPIVersionConversionWebhook represents a conversion webhook in a resource provider manifest.
+type ResourceTypeAPIVersionConversionWebhook struct {
+	// URL is the address of the webhook.
+	URL string `yaml:"url" validate:"required,url"`
+}
+
+// ResourceTypeAction represents a custom action of a resource type in a resource provider manifest.
+type ResourceTypeAction struct {
+	// Description of the action.
+	Description *string `yaml:"description,omitempty"`
+
+	// RequestSchema is the schema of the request body of the action.
+	RequestSchema map[string]any `yaml:"requestSchema,omitempty"`
+
+	// ResponseSchema is the schema of the response body of the action.
+	ResponseSchema map[string]any `yaml:"responseSchema,omitempty"`
+
+	// Handler is the handler that implements the action.
+	Handler ResourceTypeActionHandler `yaml:"handler"`
+}
+
+// ResourceTypeActionHandler represents the handler of a custom action in a resource provider manifest.
+type ResourceTypeActionHandler struct {
+	// Kind is the kind of the handler. Supported kinds are 'recipe', 'hook' and 'provider'.
+	Kind string `yaml:"kind" validate:"required,oneof=recipe hook provider"`
+
+	// Output is the name of the recipe output returned by a recipe handler, or of the recipe output that contains the
+	// address of the hook endpoint for a hook handler.
+	Output string `yaml:"output,omitempty" validate:"required_if=Kind hook,excluded_if=Kind provider"`
+
+	// Location is the address of the resource provider for a provider handler.
+	Location string `yaml:"location,omitempty" validate:"required_if=Kind provider,excluded_unless=Kind provider"`
 }
@@ -94,3 +94,199 @@ func TestReadFile_MissingRequiredFieldJSON(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, result)
 }
+
+func TestReadFile_ActionsYAML(t *testing.T) {
+	expected := &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Location: map[string]string{
+			"global": "http://localhost:8080",
+		},
+		Types: map[string]*ResourceType{
+			"testResources": {
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2025-01-01-preview": {
+						Schema: map[string]any{},
+					},
+				},
+				Actions: map[string]*ResourceTypeAction{
+					"getConnectionString": {
+						Description: to.Ptr("Returns the connection string of the resource."),
+						ResponseSchema: map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"connectionString": map[string]any{"type": "string"},
+							},
+						},
+						Handler: ResourceTypeActionHandler{
+							Kind:   "recipe",
+							Output: "connectionString",
+						},
+					},
+					"restart": {
+						Description: to.Ptr("Restarts the resource."),
+						Handler: ResourceTypeActionHandler{
+							Kind:   "hook",
+							Output: "restartUrl",
+						},
+					},
+					"rotateKey": {
+						RequestSchema: map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"keyName": map[string]any{"type": "string"},
+							},
+						},
+						Handler: ResourceTypeActionHandler{
+							Kind:     "provider",
+							Location: "http://localhost:9090",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := ReadFile("testdata/valid-actions.yaml")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestReadFile_InvalidActionYAML(t *testing.T) {
+	result, err := ReadFile("testdata/invalid-action.yaml")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be a valid action name")
+	require.Nil(t, result)
+}
+
+func TestReadBytes_ActionHandler(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler string
+		err     string
+	}{
+		{
+			name:    "provider without location",
+			handler: "{kind: provider}",
+			err:     "location is a required field",
+		},
+		{
+			name:    "recipe with location",
+			handler: "{kind: recipe, location: 'http://localhost:9090'}",
+			err:     "location",
+		},
+		{
+			name:    "provider with output",
+			handler: "{kind: provider, location: 'http://localhost:9090', output: value}",
+			err:     "output",
+		},
+		{
+			name:    "hook without output",
+			handler: "{kind: hook}",
+			err:     "output is a required field",
+		},
+		{
+			name:    "unsupported kind",
+			handler: "{kind: function}",
+			err:     "kind must be one of [recipe hook provider]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := "namespace: MyCompany.Resources\n" +
+				"types:\n" +
+				"  testResources:\n" +
+				"    apiVersions:\n" +
+				"      '2025-01-01-preview':\n" +
+				"        schema: {}\n" +
+				"    actions:\n" +
+				"      rotateKey:\n" +
+				"        handler: " + tt.handler + "\n"
+
+			result, err := ReadBytes([]byte(data))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.err)
+			require.Nil(t, result)
+		})
+	}
+}
+
+func TestReadFile_ValidConversionYAML(t *testing.T) {
+	expected := &ResourceProvider{
+		Namespace: "MyCompany.Resources",
+		Types: map[string]*ResourceType{
+			"testResources": {
+				StorageAPIVersion: to.Ptr("2025-01-01"),
+				APIVersions: map[string]*ResourceTypeAPIVersion{
+					"2024-01-01-preview": {
+						Schema: map[string]any{},
+						Conversion: &ResourceTypeAPIVersionConversion{
+							Rules: []*ResourceTypeAPIVersionConversionRule{
+								{Kind: "rename", From: "hostname", To: "database.host"},
+								{Kind: "default", Path: "database.port", Value: uint64(5432)},
+							},
+						},
+					},
+					"2025-01-01": {
+						Schema: map[string]any{},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := ReadFile("testdata/valid-conversion.yaml")
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func TestReadBytes_Conversion(t *testing.T) {
+	tests := []struct {
+		name       string
+		conversion string
+		err        string
+	}{
+		{
+			name:       "rename without to",
+			conversion: "{rules: [{kind: rename, from: hostname}]}",
+			err:        "to is a required field",
+		},
+		{
+			name:       "default with from",
+			conversion: "{rules: [{kind: default, path: port, from: hostname, value: 1}]}",
+			err:        "from",
+		},
+		{
+			name:       "unsupported kind",
+			conversion: "{rules: [{kind: split}]}",
+			err:        "kind must be one of [rename default]",
+		},
+		{
+			name:       "rules and webhook",
+			conversion: "{rules: [{kind: rename, from: a, to: b}], webhook: {url: 'https://example.com'}}",
+			err:        "rules",
+		},
+		{
+			name:       "invalid webhook url",
+			conversion: "{webhook: {url: 'not a url'}}",
+			err:        "url must be a valid URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := "namespace: MyCompany.Resources\n" +
+				"types:\n" +
+				"  testResources:\n" +
+				"    apiVersions:\n" +
+				"      '2025-01-01-preview':\n" +
+				"        schema: {}\n" +
+				"        conversion: " + tt.conversion + "\n"
+
+			result, err := ReadBytes([]byte(data))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tt.err)
+			require.Nil(t, result)
+		})
+	}
+}
@@ -115,6 +115,14 @@ func createValidator() yaml.StructValidator {
 		return t
 	})
 
+	_ = v.RegisterValidation("actionName", validateActionName)
+	_ = v.RegisterTranslation("actionName", translator, func(ut ut.Translator) error {
+		return ut.Add("actionName", actionNameMessage, true)
+	}, func(ut ut.Translator, fe validator.FieldError) string {
+		t, _ := ut.T("actionName", fe.Field())
+		return t
+	})
+
 	// Use the `yaml` tag for field names
 	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
 		name := strings.SplitN(fld.Tag.Get("yaml"), ",", 2)[0]
@@ -70,9 +70,11 @@ func RegisterResourceProvider(ctx context.Context, clientFactory *v20231001previ
 		err = retryOperation(ctx, func() error {
 			resourceTypePoller, err := clientFactory.NewResourceTypesClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Namespace, resourceTypeName, v20231001preview.ResourceTypeResource{
 				Properties: &v20231001preview.ResourceTypeProperties{
+					Actions:           toResourceTypeActions(resourceType.Actions),
 					Capabilities:      to.SliceOfPtrs(resourceType.Capabilities...),
 					DefaultAPIVersion: resourceType.DefaultAPIVersion,
 					Description:       resourceType.Description,
+					StorageAPIVersion: resourceType.StorageAPIVersion,
 				},
 			}, nil)
 			if err != nil {
@@ -98,7 +100,8 @@ func RegisterResourceProvider(ctx context.Context, clientFactory *v20231001previ
 			err = retryOperation(ctx, func() error {
 				apiVersionsPoller, err := clientFactory.NewAPIVersionsClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Namespace, resourceTypeName, apiVersionName, v20231001preview.APIVersionResource{
 					Properties: &v20231001preview.APIVersionProperties{
-						Schema: schema,
+						Conversion: toAPIVersionConversion(resourceType.APIVersions[apiVersionName].Conversion),
+						Schema:     schema,
 					},
 				}, nil)
 				if err != nil {
@@ -228,9 +231,11 @@ func RegisterType(ctx context.Context, clientFactory *v20231001preview.ClientFac
 	err = retryOperation(ctx, func() error {
 		resourceTypePoller, err := clientFactory.NewResourceTypesClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Namespace, typeName, v20231001preview.ResourceTypeResource{
 			Properties: &v20231001preview.ResourceTypeProperties{
+				Actions:           toResourceTypeActions(resourceType.Actions),
 				Capabilities:      to.SliceOfPtrs(resourceType.Capabilities...),
 				DefaultAPIVersion: resourceType.DefaultAPIVersion,
 				Description:       resourceType.Description,
+				StorageAPIVersion: resourceType.StorageAPIVersion,
 			},
 		}, nil)
 		if err != nil {
@@ -252,7 +257,8 @@ func RegisterType(ctx context.Context, clientFactory *v20231001preview.ClientFac
 		logIfEnabled(logger, "Creating API Version %s/%s@%s", resourceProvider.Namespace, typeName, apiVersionName)
 		apiVersionsPoller, err := clientFactory.NewAPIVersionsClient().BeginCreateOrUpdate(ctx, planeName, resourceProvider.Namespace, typeName, apiVersionName, v20231001preview.APIVersionResource{
 			Properties: &v20231001preview.APIVersionProperties{
-				Schema: schema,
+				Conversion: toAPIVersionConversion(resourceType.APIVersions[apiVersionName].Conversion),
+				Schema:     schema,
 			},
 		}, nil)
 		if err != nil {
@@ -356,6 +362,10 @@ func ValidateManifest(ctx context.Context, path string) (resourceProvider *Resou
 		return nil, fmt.Errorf("failed to validate manifest schemas: %w", err)
 	}
 
+	if err := validateManifestConversions(resourceProvider); err != nil {
+		return nil, fmt.Errorf("failed to validate manifest conversions: %w", err)
+	}
+
 	return resourceProvider, nil
 }
 
@@ -415,3 +425,66 @@ func createLocationResource(ctx context.Context, clientFactory *v20231001preview
 		return err
 	}, logger)
 }
+
+// toResourceTypeActions converts the actions of a resource type in the manifest to the UCP API model.
+func toResourceTypeActions(actions map[string]*ResourceTypeAction) map[string]*v20231001preview.ResourceTypeAction {
+	if len(actions) == 0 {
+		return nil
+	}
+
+	result := map[string]*v20231001preview.ResourceTypeAction{}
+	for name, action := range actions {
+		handler := &v20231001preview.ResourceTypeActionHandler{
+			Kind: to.Ptr(v20231001preview.ResourceTypeActionHandlerKind(action.Handler.Kind)),
+		}
+		if action.Handler.Output != "" {
+			handler.Output = to.Ptr(action.Handler.Output)
+		}
+		if action.Handler.Location != "" {
+			handler.Location = to.Ptr(action.Handler.Location)
+		}
+
+		result[name] = &v20231001preview.ResourceTypeAction{
+			Description:    action.Description,
+			RequestSchema:  action.RequestSchema,
+			ResponseSchema: action.ResponseSchema,
+			Handler:        handler,
+		}
+	}
+
+	return result
+}
+
+// toAPIVersionConversion converts the conversion of an API version in the manifest to the UCP API model.
+func toAPIVersionConversion(conversion *ResourceTypeAPIVersionConversion) *v20231001preview.APIVersionConversion {
+	if conversion == nil {
+		return nil
+	}
+
+	result := &v20231001preview.APIVersionConversion{}
+	if conversion.Webhook != nil {
+		result.Webhook = &v20231001preview.APIVersionConversionWebhook{
+			URL: to.Ptr(conversion.Webhook.URL),
+		}
+	}
+
+	for _, rule := range conversion.Rules {
+		converted := &v20231001preview.APIVersionConversionRule{
+			Kind:  to.Ptr(v20231001preview.APIVersionConversionRuleKind(rule.Kind)),
+			Value: rule.Value,
+		}
+		if rule.From != "" {
+			converted.From = to.Ptr(rule.From)
+		}
+		if rule.To != "" {
+			converted.To = to.Ptr(rule.To)
+		}
+		if rule.Path != "" {
+			converted.Path = to.Ptr(rule.Path)
+		}
+
+		result.Rules = append(result.Rules, converted)
+	}
+
+	return result
+}
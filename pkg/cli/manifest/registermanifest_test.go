@@ -1441,3 +1441,76 @@ func verifyRetryBehavior(t *testing.T, operation func() error, expectedAttempts
 		require.Equal(t, expectedAttempts-1, len(retryLines), "expected retry log messages don't match attempts")
 	}
 }
+
+func TestToResourceTypeActions(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, toResourceTypeActions(nil))
+
+	actions := map[string]*ResourceTypeAction{
+		"getConnectionString": {
+			Description: to.Ptr("Returns the connection string."),
+			Handler:     ResourceTypeActionHandler{Kind: "recipe", Output: "connectionString"},
+		},
+		"rotateKey": {
+			RequestSchema: map[string]any{"type": "object"},
+			Handler:       ResourceTypeActionHandler{Kind: "provider", Location: "http://localhost:9090"},
+		},
+	}
+
+	expected := map[string]*v20231001preview.ResourceTypeAction{
+		"getConnectionString": {
+			Description: to.Ptr("Returns the connection string."),
+			Handler: &v20231001preview.ResourceTypeActionHandler{
+				Kind:   to.Ptr(v20231001preview.ResourceTypeActionHandlerKindRecipe),
+				Output: to.Ptr("connectionString"),
+			},
+		},
+		"rotateKey": {
+			RequestSchema: map[string]any{"type": "object"},
+			Handler: &v20231001preview.ResourceTypeActionHandler{
+				Kind:     to.Ptr(v20231001preview.ResourceTypeActionHandlerKindProvider),
+				Location: to.Ptr("http://localhost:9090"),
+			},
+		},
+	}
+
+	require.Equal(t, expected, toResourceTypeActions(actions))
+}
+
+func TestToAPIVersionConversion(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, toAPIVersionConversion(nil))
+
+	conversion := &ResourceTypeAPIVersionConversion{
+		Rules: []*ResourceTypeAPIVersionConversionRule{
+			{Kind: "rename", From: "hostname", To: "database.host"},
+			{Kind: "default", Path: "database.port", Value: 5432},
+		},
+	}
+
+	expected := &v20231001preview.APIVersionConversion{
+		Rules: []*v20231001preview.APIVersionConversionRule{
+			{
+				Kind: to.Ptr(v20231001preview.APIVersionConversionRuleKindRename),
+				From: to.Ptr("hostname"),
+				To:   to.Ptr("database.host"),
+			},
+			{
+				Kind:  to.Ptr(v20231001preview.APIVersionConversionRuleKindDefault),
+				Path:  to.Ptr("database.port"),
+				Value: 5432,
+			},
+		},
+	}
+	require.Equal(t, expected, toAPIVersionConversion(conversion))
+
+	webhook := &ResourceTypeAPIVersionConversion{
+		Webhook: &ResourceTypeAPIVersionConversionWebhook{URL: "https://convert.example.com"},
+	}
+	expected = &v20231001preview.APIVersionConversion{
+		Webhook: &v20231001preview.APIVersionConversionWebhook{URL: to.Ptr("https://convert.example.com")},
+	}
+	require.Equal(t, expected, toAPIVersionConversion(webhook))
+}
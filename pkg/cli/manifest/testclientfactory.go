@@ -190,6 +190,15 @@ func WithAPIVersionServerNoError() ucpfake.APIVersionsServer {
 			resp.SetTerminalResponse(http.StatusOK, result, nil)
 			return
 		},
+		NewListPager: func(
+			planeName string,
+			resourceProviderName string,
+			resourceTypeName string,
+			options *v20231001preview.APIVersionsClientListOptions,
+		) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+			resp.AddPage(http.StatusOK, v20231001preview.APIVersionsClientListResponse{}, nil)
+			return
+		},
 	}
 	return apiVersionsServer
 }
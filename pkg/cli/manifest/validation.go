@@ -30,11 +30,13 @@ var (
 	resourceTypeRegex              = regexp.MustCompile(`^[a-z][A-Za-z0-9]+$`)
 	apiVersionRegex                = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(-preview)?$`)
 	capabilityRegex                = regexp.MustCompile(`^[A-Z][A-Za-z0-9]+$`)
+	actionNameRegex                = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
 
 	resourceProviderNamespaceMessage = "{0} must be a valid resource provider namespace. A resource provider namespace must contain two PascalCased segments separated by a '.'. Example: MyCompany.Resources"
 	resourceTypeMessage              = "{0} must be a valid resource type. A resource type should be camelCased. Example: myResourceType"
 	apiVersionMessage                = "{0} must be a valid API version. An API version must be a date in YYYY-MM-DD format, and may optionally have the suffix '-preview'. Example: 2025-01-01"
 	capabilityMessage                = "{0} must be a valid capability. A capability should use PascalCase. Example: MyCapability"
+	actionNameMessage                = "{0} must be a valid action name. An action name must start with a letter and contain only letters and digits. Example: rotateKey"
 )
 
 func resourceProviderNamespace(fl validator.FieldLevel) bool {
@@ -57,6 +59,11 @@ func validateCapability(fl validator.FieldLevel) bool {
 	return capabilityRegex.Match([]byte(str))
 }
 
+func validateActionName(fl validator.FieldLevel) bool {
+	str := fl.Field().String()
+	return actionNameRegex.Match([]byte(str))
+}
+
 // validateManifestSchemas validates schemas in a ResourceProvider
 func validateManifestSchemas(ctx context.Context, provider *ResourceProvider) error {
 	if provider == nil {
@@ -91,6 +98,67 @@ func validateManifestSchemas(ctx context.Context, provider *ResourceProvider) er
 				}
 			}
 		}
+
+		// Action schemas describe request and response bodies rather than resources, so they are only checked to be
+		// valid OpenAPI schemas.
+		for actionName, action := range resourceType.Actions {
+			for kind, actionSchema := range map[string]map[string]any{"requestSchema": action.RequestSchema, "responseSchema": action.ResponseSchema} {
+				if actionSchema == nil {
+					continue
+				}
+
+				schemaPath := fmt.Sprintf("%s/%s.actions.%s.%s", provider.Namespace, resourceTypeName, actionName, kind)
+				if _, err := schema.ConvertToOpenAPISchema(actionSchema); err != nil {
+					errors.Add(schema.NewSchemaError(schemaPath, fmt.Sprintf("failed to parse schema: %v", err)))
+				}
+			}
+		}
+	}
+
+	if errors.HasErrors() {
+		return errors
+	}
+
+	return nil
+}
+
+// validateManifestConversions validates the storage API versions and API version conversions in a ResourceProvider.
+func validateManifestConversions(provider *ResourceProvider) error {
+	if provider == nil {
+		return fmt.Errorf("provider is nil")
+	}
+
+	errors := &schema.ValidationErrors{}
+
+	for resourceTypeName, resourceType := range provider.Types {
+		typePath := fmt.Sprintf("%s/%s", provider.Namespace, resourceTypeName)
+
+		storageAPIVersion := ""
+		if resourceType.StorageAPIVersion != nil {
+			storageAPIVersion = *resourceType.StorageAPIVersion
+			if _, ok := resourceType.APIVersions[storageAPIVersion]; !ok {
+				errors.Add(schema.NewSchemaError(typePath+".storageApiVersion", fmt.Sprintf("storage API version %q is not one of the API versions of the resource type", storageAPIVersion)))
+			}
+		}
+
+		for apiVersion, versionInfo := range resourceType.APIVersions {
+			if versionInfo.Conversion == nil {
+				continue
+			}
+
+			conversionPath := fmt.Sprintf("%s@%s.conversion", typePath, apiVersion)
+			if storageAPIVersion == "" {
+				errors.Add(schema.NewSchemaError(conversionPath, "a conversion requires the resource type to declare a storage API version"))
+			} else if apiVersion == storageAPIVersion {
+				errors.Add(schema.NewSchemaError(conversionPath, "the storage API version cannot declare a conversion"))
+			}
+
+			for i, rule := range versionInfo.Conversion.Rules {
+				if rule.Kind == "default" && rule.Value == nil {
+					errors.Add(schema.NewSchemaError(fmt.Sprintf("%s.rules[%d]", conversionPath, i), "a 'default' rule requires a value"))
+				}
+			}
+		}
 	}
 
 	if errors.HasErrors() {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/stretchr/testify/require"
 )
 
@@ -495,3 +496,66 @@ func TestValidateManifestSchemas(t *testing.T) {
 		require.Len(t, validationErrors.Errors, 2)
 	})
 }
+
+func TestValidateManifestConversions(t *testing.T) {
+	newProvider := func(storageAPIVersion *string, conversions map[string]*ResourceTypeAPIVersionConversion) *ResourceProvider {
+		apiVersions := map[string]*ResourceTypeAPIVersion{}
+		for _, name := range []string{"2024-01-01", "2025-01-01"} {
+			apiVersions[name] = &ResourceTypeAPIVersion{Schema: map[string]any{}, Conversion: conversions[name]}
+		}
+
+		return &ResourceProvider{
+			Namespace: "Test.Provider",
+			Types: map[string]*ResourceType{
+				"widgets": {StorageAPIVersion: storageAPIVersion, APIVersions: apiVersions},
+			},
+		}
+	}
+
+	rename := &ResourceTypeAPIVersionConversion{
+		Rules: []*ResourceTypeAPIVersionConversionRule{{Kind: "rename", From: "hostname", To: "host"}},
+	}
+
+	t.Run("nil provider", func(t *testing.T) {
+		err := validateManifestConversions(nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "provider is nil")
+	})
+
+	t.Run("valid conversion", func(t *testing.T) {
+		err := validateManifestConversions(newProvider(to.Ptr("2025-01-01"), map[string]*ResourceTypeAPIVersionConversion{"2024-01-01": rename}))
+		require.NoError(t, err)
+	})
+
+	t.Run("storage API version without conversions", func(t *testing.T) {
+		err := validateManifestConversions(newProvider(to.Ptr("2025-01-01"), nil))
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown storage API version", func(t *testing.T) {
+		err := validateManifestConversions(newProvider(to.Ptr("2026-01-01"), nil))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "storage API version \"2026-01-01\" is not one of the API versions")
+	})
+
+	t.Run("conversion without storage API version", func(t *testing.T) {
+		err := validateManifestConversions(newProvider(nil, map[string]*ResourceTypeAPIVersionConversion{"2024-01-01": rename}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "requires the resource type to declare a storage API version")
+	})
+
+	t.Run("conversion on storage API version", func(t *testing.T) {
+		err := validateManifestConversions(newProvider(to.Ptr("2025-01-01"), map[string]*ResourceTypeAPIVersionConversion{"2025-01-01": rename}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "the storage API version cannot declare a conversion")
+	})
+
+	t.Run("default rule without value", func(t *testing.T) {
+		conversion := &ResourceTypeAPIVersionConversion{
+			Rules: []*ResourceTypeAPIVersionConversionRule{{Kind: "default", Path: "port"}},
+		}
+		err := validateManifestConversions(newProvider(to.Ptr("2025-01-01"), map[string]*ResourceTypeAPIVersionConversion{"2024-01-01": conversion}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "a 'default' rule requires a value")
+	})
+}
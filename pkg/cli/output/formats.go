@@ -20,7 +20,14 @@ const (
 	FormatJson      = "json"
 	FormatTable     = "table"
 	FormatPlainText = "plain-text"
+	FormatYaml      = "yaml"
 	DefaultFormat   = FormatTable
+
+	// FormatJsonPath is the prefix of the JSONPath format, which is specified as 'jsonpath=<template>'.
+	FormatJsonPath = "jsonpath"
+
+	// FormatGoTemplate is the prefix of the Go template format, which is specified as 'go-template=<template>'.
+	FormatGoTemplate = "go-template"
 )
 
 // SupportedFormats returns a slice of strings containing the supported formats for a request.
@@ -28,5 +35,8 @@ func SupportedFormats() []string {
 	return []string{
 		FormatJson,
 		FormatTable,
+		FormatYaml,
+		FormatJsonPath + "=<template>",
+		FormatGoTemplate + "=<template>",
 	}
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package output
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
@@ -45,19 +46,60 @@ type Formatter interface {
 	Format(obj any, writer io.Writer, options FormatterOptions) error
 }
 
-// NewFormatter takes in a string and returns a Formatter interface and an error if the format is not supported.
+// NewFormatter takes in a string and returns a Formatter interface and an error if the format is not supported. The
+// JSONPath and Go template formats take a template after an equals sign, such as 'jsonpath={.name}'.
 func NewFormatter(format string) (Formatter, error) {
-	normalized := strings.ToLower(strings.TrimSpace(format))
+	name, template, hasTemplate := strings.Cut(strings.TrimSpace(format), "=")
+	if hasTemplate {
+		normalized := strings.ToLower(name)
+		switch normalized {
+		case FormatJsonPath:
+			if template == "" {
+				return nil, fmt.Errorf("format %s requires a template, for example %s={.name}", normalized, normalized)
+			}
+			return &JSONPathFormatter{Template: template}, nil
+		case FormatGoTemplate:
+			if template == "" {
+				return nil, fmt.Errorf("format %s requires a template, for example %s={{.name}}", normalized, normalized)
+			}
+			return &GoTemplateFormatter{Template: template}, nil
+		default:
+			return nil, fmt.Errorf("unsupported format %s", format)
+		}
+	}
+
+	normalized := strings.ToLower(name)
 	switch normalized {
 	case FormatJson:
 		return &JSONFormatter{}, nil
 	case FormatTable:
 		return &TableFormatter{}, nil
+	case FormatYaml:
+		return &YAMLFormatter{}, nil
+	case FormatJsonPath, FormatGoTemplate:
+		return nil, fmt.Errorf("format %s requires a template, for example %s=<template>", normalized, normalized)
 	default:
 		return nil, fmt.Errorf("unsupported format %s", format)
 	}
 }
 
+// toJSONValue converts an object to its JSON representation as maps, slices and scalars, so that templates use the
+// same field names as the JSON format.
+func toJSONValue(obj any) (any, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var value any
+	err = json.Unmarshal(b, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
 func convertToSlice(obj any) ([]any, error) {
 	// We use reflection here because we're building a table and thus need to handle both scalars (structs)
 	// and slices/arrays of structs.
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewFormatter(t *testing.T) {
+	testcases := []struct {
+		format   string
+		expected Formatter
+	}{
+		{format: "json", expected: &JSONFormatter{}},
+		{format: " Table ", expected: &TableFormatter{}},
+		{format: "YAML", expected: &YAMLFormatter{}},
+		{format: "jsonpath={.name}", expected: &JSONPathFormatter{Template: "{.name}"}},
+		{format: "JSONPath={.spec.a=b}", expected: &JSONPathFormatter{Template: "{.spec.a=b}"}},
+		{format: "go-template={{.Name}}", expected: &GoTemplateFormatter{Template: "{{.Name}}"}},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.format, func(t *testing.T) {
+			formatter, err := NewFormatter(tc.format)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, formatter)
+		})
+	}
+}
+
+func Test_NewFormatter_Errors(t *testing.T) {
+	testcases := []struct {
+		format   string
+		expected string
+	}{
+		{format: "xml", expected: "unsupported format xml"},
+		{format: "json={.name}", expected: "unsupported format json={.name}"},
+		{format: "jsonpath", expected: "format jsonpath requires a template, for example jsonpath=<template>"},
+		{format: "jsonpath=", expected: "format jsonpath requires a template, for example jsonpath={.name}"},
+		{format: "go-template=", expected: "format go-template requires a template, for example go-template={{.name}}"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.format, func(t *testing.T) {
+			_, err := NewFormatter(tc.format)
+			require.EqualError(t, err, tc.expected)
+		})
+	}
+}
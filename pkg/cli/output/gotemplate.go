@@ -0,0 +1,52 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"text/template"
+)
+
+type GoTemplateFormatter struct {
+	// Template is the Go template, such as '{{.name}}'.
+	Template string
+}
+
+// Format executes the Go template against the JSON representation of the object and writes the result to the
+// writer. Fields are accessed using their JSON names, and lists are represented as JSON arrays, so
+// '{{range .}}{{.name}}{{"\n"}}{{end}}' prints the name of each item.
+func (f *GoTemplateFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	t, err := template.New(FormatGoTemplate).Option("missingkey=zero").Parse(f.Template)
+	if err != nil {
+		return fmt.Errorf("failed to parse Go template %q: %w", f.Template, err)
+	}
+
+	data, err := toJSONValue(obj)
+	if err != nil {
+		return err
+	}
+
+	err = t.Execute(writer, data)
+	if err != nil {
+		return fmt.Errorf("failed to execute Go template %q: %w", f.Template, err)
+	}
+
+	return nil
+}
+
+var _ Formatter = (*GoTemplateFormatter)(nil)
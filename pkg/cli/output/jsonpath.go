@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+type JSONPathFormatter struct {
+	// Template is the JSONPath template, such as '{.name}'. Templates without braces are wrapped in braces, so
+	// '.name' is equivalent to '{.name}'.
+	Template string
+}
+
+// Format evaluates the JSONPath template against the JSON representation of the object and writes the result to
+// the writer. Lists are represented as JSON arrays, so '{[*].name}' selects the name of each item.
+func (f *JSONPathFormatter) Format(obj any, writer io.Writer, options FormatterOptions) error {
+	template := f.Template
+	if !strings.Contains(template, "{") {
+		template = "{" + template + "}"
+	}
+
+	p := jsonpath.New(FormatJsonPath)
+	err := p.Parse(template)
+	if err != nil {
+		return fmt.Errorf("failed to parse JSONPath template %q: %w", f.Template, err)
+	}
+
+	data, err := toJSONValue(obj)
+	if err != nil {
+		return err
+	}
+
+	err = p.Execute(writer, data)
+	if err != nil {
+		return fmt.Errorf("failed to execute JSONPath template %q: %w", f.Template, err)
+	}
+
+	return nil
+}
+
+var _ Formatter = (*JSONPathFormatter)(nil)
@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_JSONPath(t *testing.T) {
+	obj := []templateInput{
+		{Name: "mega", Size: 3, Labels: map[string]string{"tier": "frontend"}},
+		{Name: "medium", Size: 2},
+	}
+
+	testcases := []struct {
+		name     string
+		obj      any
+		template string
+		expected string
+	}{
+		{name: "scalar field", obj: obj[0], template: "{.name}", expected: "mega"},
+		{name: "without braces", obj: obj[0], template: ".labels.tier", expected: "frontend"},
+		{name: "slice", obj: obj, template: "{[*].name}", expected: "mega medium"},
+		{name: "range", obj: obj, template: `{range [*]}{.name}={.size}{"\n"}{end}`, expected: "mega=3\nmedium=2\n"},
+		{name: "pointer", obj: &obj[1], template: "{.size}", expected: "2"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			formatter := &JSONPathFormatter{Template: tc.template}
+
+			buffer := &bytes.Buffer{}
+			err := formatter.Format(tc.obj, buffer, FormatterOptions{})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, buffer.String())
+		})
+	}
+}
+
+func Test_JSONPath_Errors(t *testing.T) {
+	t.Run("invalid template", func(t *testing.T) {
+		formatter := &JSONPathFormatter{Template: "{.name"}
+		err := formatter.Format(templateInput{}, &bytes.Buffer{}, FormatterOptions{})
+		require.ErrorContains(t, err, "failed to parse JSONPath template \"{.name\"")
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		formatter := &JSONPathFormatter{Template: "{.missing}"}
+		err := formatter.Format(templateInput{}, &bytes.Buffer{}, FormatterOptions{})
+		require.ErrorContains(t, err, "failed to execute JSONPath template \"{.missing}\"")
+	})
+}
@@ -107,6 +107,11 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 		return nil, err
 	}
 
+	workload, err := toWorkloadPropertiesDataModel(src.Properties.Workload)
+	if err != nil {
+		return nil, err
+	}
+
 	converted := &datamodel.ContainerResource{
 		BaseResource: v1.BaseResource{
 			TrackedResource: v1.TrackedResource{
@@ -143,6 +148,7 @@ func (src *ContainerResource) ConvertTo() (v1.DataModelInterface, error) {
 			ResourceProvisioning: toContainerResourceProvisioningDataModel(src.Properties.ResourceProvisioning),
 			Resources:            toResourceReferencesDataModel(src.Properties.Resources),
 			RestartPolicy:        toRestartPolicyDataModel(src.Properties.RestartPolicy),
+			Workload:             workload,
 		},
 	}
 
@@ -334,6 +340,7 @@ func (dst *ContainerResource) ConvertFrom(src v1.DataModelInterface) error {
 		Resources:            fromResourceReferencesDataModel(c.Properties.Resources),
 		ResourceProvisioning: fromContainerResourceProvisioningDataModel(c.Properties.ResourceProvisioning),
 		RestartPolicy:        fromRestartPolicyDataModel(c.Properties.RestartPolicy),
+		Workload:             fromWorkloadPropertiesDataModel(c.Properties.Workload),
 	}
 
 	return nil
@@ -705,6 +712,94 @@ func fromRestartPolicyDataModel(rp string) *RestartPolicy {
 	}
 }
 
+func toWorkloadPropertiesDataModel(w *WorkloadProperties) (*datamodel.WorkloadProperties, error) {
+	if w == nil {
+		return nil, nil
+	}
+
+	kind, err := toWorkloadKindDataModel(w.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var concurrencyPolicy string
+	if w.ConcurrencyPolicy != nil {
+		concurrencyPolicy = string(*w.ConcurrencyPolicy)
+	}
+
+	return &datamodel.WorkloadProperties{
+		Kind:                       kind,
+		Schedule:                   to.String(w.Schedule),
+		Completions:                w.Completions,
+		Parallelism:                w.Parallelism,
+		BackoffLimit:               w.BackoffLimit,
+		ActiveDeadlineSeconds:      w.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished:    w.TTLSecondsAfterFinished,
+		ConcurrencyPolicy:          concurrencyPolicy,
+		Suspend:                    w.Suspend,
+		SuccessfulJobsHistoryLimit: w.SuccessfulJobsHistoryLimit,
+		FailedJobsHistoryLimit:     w.FailedJobsHistoryLimit,
+	}, nil
+}
+
+func fromWorkloadPropertiesDataModel(w *datamodel.WorkloadProperties) *WorkloadProperties {
+	if w == nil {
+		return nil
+	}
+
+	var concurrencyPolicy *ConcurrencyPolicy
+	if w.ConcurrencyPolicy != "" {
+		concurrencyPolicy = to.Ptr(ConcurrencyPolicy(w.ConcurrencyPolicy))
+	}
+
+	var schedule *string
+	if w.Schedule != "" {
+		schedule = to.Ptr(w.Schedule)
+	}
+
+	return &WorkloadProperties{
+		Kind:                       fromWorkloadKindDataModel(w.Kind),
+		Schedule:                   schedule,
+		Completions:                w.Completions,
+		Parallelism:                w.Parallelism,
+		BackoffLimit:               w.BackoffLimit,
+		ActiveDeadlineSeconds:      w.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished:    w.TTLSecondsAfterFinished,
+		ConcurrencyPolicy:          concurrencyPolicy,
+		Suspend:                    w.Suspend,
+		SuccessfulJobsHistoryLimit: w.SuccessfulJobsHistoryLimit,
+		FailedJobsHistoryLimit:     w.FailedJobsHistoryLimit,
+	}
+}
+
+func toWorkloadKindDataModel(kind *WorkloadKind) (datamodel.WorkloadKind, error) {
+	if kind == nil {
+		return datamodel.WorkloadKindDeployment, nil
+	}
+
+	switch *kind {
+	case WorkloadKindDeployment:
+		return datamodel.WorkloadKindDeployment, nil
+	case WorkloadKindJob:
+		return datamodel.WorkloadKindJob, nil
+	case WorkloadKindCronJob:
+		return datamodel.WorkloadKindCronJob, nil
+	default:
+		return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid workload kind: %s. Supported kinds are: %v", *kind, PossibleWorkloadKindValues()))
+	}
+}
+
+func fromWorkloadKindDataModel(kind datamodel.WorkloadKind) *WorkloadKind {
+	switch kind {
+	case datamodel.WorkloadKindJob:
+		return to.Ptr(WorkloadKindJob)
+	case datamodel.WorkloadKindCronJob:
+		return to.Ptr(WorkloadKindCronJob)
+	default:
+		return to.Ptr(WorkloadKindDeployment)
+	}
+}
+
 func toPermissionDataModel(rbac *VolumePermission) datamodel.VolumePermission {
 	if rbac == nil {
 		return datamodel.VolumePermissionRead
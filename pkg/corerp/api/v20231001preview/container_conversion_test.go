@@ -306,6 +306,62 @@ func TestContainerConvertVersionedToDataModelEmptyProtocol(t *testing.T) {
 
 }
 
+func TestContainerConvertWorkload(t *testing.T) {
+	t.Run("cronJob round trip", func(t *testing.T) {
+		rawPayload := testutil.ReadFixture("containerresource-cronjob.json")
+		r := &ContainerResource{}
+		err := json.Unmarshal(rawPayload, r)
+		require.NoError(t, err)
+
+		dm, err := r.ConvertTo()
+		require.NoError(t, err)
+
+		ct := dm.(*datamodel.ContainerResource)
+		expected := &datamodel.WorkloadProperties{
+			Kind:                       datamodel.WorkloadKindCronJob,
+			Schedule:                   "*/5 * * * *",
+			Completions:                to.Ptr[int32](1),
+			Parallelism:                to.Ptr[int32](1),
+			BackoffLimit:               to.Ptr[int32](3),
+			ActiveDeadlineSeconds:      to.Ptr[int64](600),
+			ConcurrencyPolicy:          "Forbid",
+			SuccessfulJobsHistoryLimit: to.Ptr[int32](2),
+			FailedJobsHistoryLimit:     to.Ptr[int32](1),
+		}
+		require.Equal(t, expected, ct.Properties.Workload)
+		require.Equal(t, datamodel.WorkloadKindCronJob, ct.Properties.GetWorkloadKind())
+
+		versioned := &ContainerResource{}
+		err = versioned.ConvertFrom(ct)
+		require.NoError(t, err)
+		require.Equal(t, r.Properties.Workload, versioned.Properties.Workload)
+	})
+
+	t.Run("workload not set", func(t *testing.T) {
+		rawPayload := testutil.ReadFixture("containerresource-manual.json")
+		r := &ContainerResource{}
+		err := json.Unmarshal(rawPayload, r)
+		require.NoError(t, err)
+
+		dm, err := r.ConvertTo()
+		require.NoError(t, err)
+
+		ct := dm.(*datamodel.ContainerResource)
+		require.Nil(t, ct.Properties.Workload)
+		require.Equal(t, datamodel.WorkloadKindDeployment, ct.Properties.GetWorkloadKind())
+	})
+
+	t.Run("invalid workload kind", func(t *testing.T) {
+		rawPayload := testutil.ReadFixture("containerresource-invalid-workload.json")
+		r := &ContainerResource{}
+		err := json.Unmarshal(rawPayload, r)
+		require.NoError(t, err)
+
+		_, err = r.ConvertTo()
+		require.ErrorContains(t, err, "invalid workload kind: daemonSet")
+	})
+}
+
 func TestContainerConvertFromValidation(t *testing.T) {
 	validationTests := []struct {
 		src v1.DataModelInterface
@@ -108,6 +108,11 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.Simulated = true
 	}
 
+	converted.Properties.Ingress, err = toIngressDataModel(src.Properties.Ingress)
+	if err != nil {
+		return nil, err
+	}
+
 	var extensions []datamodel.Extension
 	if src.Properties.Extensions != nil {
 		for _, e := range src.Properties.Extensions {
@@ -171,6 +176,8 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.Simulated = to.Ptr(env.Properties.Simulated)
 	}
 
+	dst.Properties.Ingress = fromIngressDataModel(env.Properties.Ingress)
+
 	var extensions []ExtensionClassification
 	if env.Properties.Extensions != nil {
 		for _, e := range env.Properties.Extensions {
@@ -182,6 +189,50 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 	return nil
 }
 
+func toIngressDataModel(ingress *IngressProperties) (*datamodel.IngressProperties, error) {
+	if ingress == nil {
+		return nil, nil
+	}
+
+	converted := &datamodel.IngressProperties{
+		GatewayClassName: to.String(ingress.GatewayClassName),
+	}
+
+	var kind IngressKind
+	if ingress.Kind != nil {
+		kind = *ingress.Kind
+	}
+
+	switch kind {
+	case IngressKindContour:
+		converted.Kind = datamodel.IngressKindContour
+	case IngressKindGatewayAPI:
+		converted.Kind = datamodel.IngressKindGatewayAPI
+		if converted.GatewayClassName == "" {
+			return nil, v1.NewClientErrInvalidRequest("gatewayClassName is required when ingress kind is 'gatewayAPI'")
+		}
+	default:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.ingress.kind", ValidValue: fmt.Sprintf("one of %v", PossibleIngressKindValues())}
+	}
+
+	return converted, nil
+}
+
+func fromIngressDataModel(ingress *datamodel.IngressProperties) *IngressProperties {
+	if ingress == nil {
+		return nil
+	}
+
+	converted := &IngressProperties{
+		Kind: to.Ptr(IngressKind(ingress.Kind)),
+	}
+	if ingress.GatewayClassName != "" {
+		converted.GatewayClassName = to.Ptr(ingress.GatewayClassName)
+	}
+
+	return converted
+}
+
 func toRecipeConfigDatamodel(config *RecipeConfigProperties) datamodel.RecipeConfigProperties {
 	if config != nil {
 		recipeConfig := datamodel.RecipeConfigProperties{}
@@ -643,6 +643,68 @@ func TestFromEnvironmentComputeKindDataModel(t *testing.T) {
 	}
 }
 
+func TestConvertIngress(t *testing.T) {
+	t.Run("gatewayAPI", func(t *testing.T) {
+		rawPayload := testutil.ReadFixture("environmentresource-with-gatewayapi-ingress.json")
+		r := &EnvironmentResource{}
+		err := json.Unmarshal(rawPayload, r)
+		require.NoError(t, err)
+
+		dm, err := r.ConvertTo()
+		require.NoError(t, err)
+		env := dm.(*datamodel.Environment)
+		require.Equal(t, &datamodel.IngressProperties{Kind: datamodel.IngressKindGatewayAPI, GatewayClassName: "envoy-gateway"}, env.Properties.Ingress)
+		require.Equal(t, datamodel.IngressKindGatewayAPI, env.Properties.GetIngressKind())
+
+		versioned := &EnvironmentResource{}
+		err = versioned.ConvertFrom(env)
+		require.NoError(t, err)
+		require.Equal(t, r.Properties.Ingress, versioned.Properties.Ingress)
+	})
+
+	ingressTests := []struct {
+		name      string
+		versioned *IngressProperties
+		datamodel *datamodel.IngressProperties
+		err       error
+	}{
+		{
+			name:      "not specified",
+			versioned: nil,
+			datamodel: nil,
+		},
+		{
+			name:      "contour",
+			versioned: &IngressProperties{Kind: to.Ptr(IngressKindContour)},
+			datamodel: &datamodel.IngressProperties{Kind: datamodel.IngressKindContour},
+		},
+		{
+			name:      "gatewayAPI without gatewayClassName",
+			versioned: &IngressProperties{Kind: to.Ptr(IngressKindGatewayAPI)},
+			err:       v1.NewClientErrInvalidRequest("gatewayClassName is required when ingress kind is 'gatewayAPI'"),
+		},
+		{
+			name:      "invalid kind",
+			versioned: &IngressProperties{Kind: to.Ptr(IngressKind("nginx"))},
+			err:       &v1.ErrModelConversion{PropertyName: "$.properties.ingress.kind", ValidValue: "one of [contour gatewayAPI]"},
+		},
+	}
+
+	for _, tt := range ingressTests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress, err := toIngressDataModel(tt.versioned)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.datamodel, ingress)
+			require.Equal(t, tt.versioned, fromIngressDataModel(ingress))
+		})
+	}
+}
+
 func getTestKubernetesMetadataExtensions() []datamodel.Extension {
 	extensions := []datamodel.Extension{
 		{
@@ -17,6 +17,9 @@ limitations under the License.
 package v20231001preview
 
 import (
+	"fmt"
+	"time"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
@@ -39,12 +42,26 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 			tls.CertificateFrom = to.String(src.Properties.TLS.CertificateFrom)
 			tls.MinimumProtocolVersion = toTLSMinVersionDataModel(src.Properties.TLS.MinimumProtocolVersion)
 		}
+
+		if src.Properties.TLS.CertificateIssuer != nil {
+			issuer, err := toCertificateIssuerDataModel(src.Properties.TLS.CertificateIssuer)
+			if err != nil {
+				return nil, err
+			}
+			tls.CertificateIssuer = issuer
+			tls.MinimumProtocolVersion = toTLSMinVersionDataModel(src.Properties.TLS.MinimumProtocolVersion)
+		}
 	}
 
 	// Note: SystemData conversion isn't required since this property comes ARM and datastore.
+	policies, err := toGatewayPoliciesDataModel(src.Properties.Policies)
+	if err != nil {
+		return nil, err
+	}
+
 	routes := []datamodel.GatewayRoute{}
 	if src.Properties.Routes != nil {
-		for _, r := range src.Properties.Routes {
+		for i, r := range src.Properties.Routes {
 			s := datamodel.GatewayRoute{
 				Destination:      to.String(r.Destination),
 				Path:             to.String(r.Path),
@@ -58,6 +75,12 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 					BackendRequest: to.String(r.TimeoutPolicy.BackendRequest),
 				}
 			}
+			toGatewayRouteMatchingDataModel(r, &s)
+
+			s.Policies, err = toGatewayRoutePoliciesDataModel(r.Policies, fmt.Sprintf("$.properties.routes[%d].policies", i))
+			if err != nil {
+				return nil, err
+			}
 			routes = append(routes, s)
 		}
 	}
@@ -90,6 +113,7 @@ func (src *GatewayResource) ConvertTo() (v1.DataModelInterface, error) {
 			},
 			Hostname: hostname,
 			TLS:      tls,
+			Policies: policies,
 			Routes:   routes,
 			URL:      to.String(src.Properties.URL),
 		},
@@ -111,6 +135,8 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 			CertificateFrom:        to.Ptr(g.Properties.TLS.CertificateFrom),
 			MinimumProtocolVersion: fromTLSMinVersionDataModel(g.Properties.TLS.MinimumProtocolVersion),
 			SSLPassthrough:         to.Ptr(g.Properties.TLS.SSLPassthrough),
+			CertificateIssuer:      fromCertificateIssuerDataModel(g.Properties.TLS.CertificateIssuer),
+			CertificateStatus:      fromCertificateStatusDataModel(g.Properties.TLS.CertificateStatus),
 		}
 	}
 
@@ -129,6 +155,8 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 					BackendRequest: to.Ptr(r.TimeoutPolicy.BackendRequest),
 				}
 			}
+			fromGatewayRouteMatchingDataModel(r, s)
+			s.Policies = fromGatewayRoutePoliciesDataModel(r.Policies)
 			routes = append(routes, s)
 		}
 	}
@@ -154,6 +182,7 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 		ProvisioningState: fromProvisioningStateDataModel(g.InternalMetadata.AsyncProvisioningState),
 		Application:       to.Ptr(g.Properties.Application),
 		Hostname:          hostname,
+		Policies:          fromGatewayPoliciesDataModel(g.Properties.Policies),
 		Routes:            routes,
 		TLS:               tls,
 		URL:               to.Ptr(g.Properties.URL),
@@ -162,6 +191,66 @@ func (dst *GatewayResource) ConvertFrom(src v1.DataModelInterface) error {
 	return nil
 }
 
+func toCertificateIssuerDataModel(issuer *GatewayCertificateIssuer) (*datamodel.GatewayCertificateIssuer, error) {
+	converted := &datamodel.GatewayCertificateIssuer{
+		ClusterIssuer: to.String(issuer.ClusterIssuer),
+	}
+
+	if issuer.Kind == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.tls.certificateIssuer.kind", ValidValue: fmt.Sprintf("one of %v", PossibleGatewayCertificateIssuerKindValues())}
+	}
+
+	switch *issuer.Kind {
+	case GatewayCertificateIssuerKindAcme:
+		converted.Kind = datamodel.GatewayCertificateIssuerKindACME
+	case GatewayCertificateIssuerKindSelfSigned:
+		converted.Kind = datamodel.GatewayCertificateIssuerKindSelfSigned
+	default:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.tls.certificateIssuer.kind", ValidValue: fmt.Sprintf("one of %v", PossibleGatewayCertificateIssuerKindValues())}
+	}
+
+	return converted, nil
+}
+
+func fromCertificateIssuerDataModel(issuer *datamodel.GatewayCertificateIssuer) *GatewayCertificateIssuer {
+	if issuer == nil {
+		return nil
+	}
+
+	var kind GatewayCertificateIssuerKind
+	switch issuer.Kind {
+	case datamodel.GatewayCertificateIssuerKindACME:
+		kind = GatewayCertificateIssuerKindAcme
+	case datamodel.GatewayCertificateIssuerKindSelfSigned:
+		kind = GatewayCertificateIssuerKindSelfSigned
+	}
+
+	return &GatewayCertificateIssuer{
+		Kind:          &kind,
+		ClusterIssuer: fromOptionalString(issuer.ClusterIssuer),
+	}
+}
+
+func fromCertificateStatusDataModel(status *datamodel.GatewayCertificateStatus) *GatewayCertificateStatus {
+	if status == nil {
+		return nil
+	}
+
+	return &GatewayCertificateStatus{
+		NotAfter:    fromRFC3339(status.NotAfter),
+		RenewalTime: fromRFC3339(status.RenewalTime),
+	}
+}
+
+// fromRFC3339 parses the given RFC 3339 timestamp, returning nil if the timestamp is empty or invalid.
+func fromRFC3339(value string) *time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
 func toTLSMinVersionDataModel(tlsMinVersion *TLSMinVersion) datamodel.MinimumTLSProtocolVersion {
 	if tlsMinVersion == nil {
 		return datamodel.DefaultTLSMinVersion
@@ -190,3 +279,335 @@ func fromTLSMinVersionDataModel(tlsMinVersion datamodel.MinimumTLSProtocolVersio
 
 	return &t
 }
+
+// toGatewayRouteMatchingDataModel converts the request matching, traffic splitting, header rewriting and retry
+// properties of a versioned route to the datamodel route.
+func toGatewayRouteMatchingDataModel(src *GatewayRoute, dst *datamodel.GatewayRoute) {
+	for _, d := range src.Destinations {
+		if d == nil {
+			continue
+		}
+		dst.Destinations = append(dst.Destinations, datamodel.GatewayRouteDestination{
+			Destination: to.String(d.Destination),
+			Weight:      to.Int32(d.Weight),
+		})
+	}
+
+	for _, h := range src.Headers {
+		if h == nil {
+			continue
+		}
+		dst.Headers = append(dst.Headers, datamodel.GatewayRouteHeaderMatch{
+			Name:     to.String(h.Name),
+			Exact:    to.String(h.Exact),
+			Contains: to.String(h.Contains),
+			Present:  to.Bool(h.Present),
+		})
+	}
+
+	for _, q := range src.QueryParameters {
+		if q == nil {
+			continue
+		}
+		dst.QueryParameters = append(dst.QueryParameters, datamodel.GatewayRouteQueryParameterMatch{
+			Name:     to.String(q.Name),
+			Exact:    to.String(q.Exact),
+			Contains: to.String(q.Contains),
+			Present:  to.Bool(q.Present),
+		})
+	}
+
+	dst.Methods = to.StringArray(src.Methods)
+	dst.RequestHeaders = toGatewayRouteHeadersPolicyDataModel(src.RequestHeaders)
+	dst.ResponseHeaders = toGatewayRouteHeadersPolicyDataModel(src.ResponseHeaders)
+
+	if src.RetryPolicy != nil {
+		dst.RetryPolicy = &datamodel.GatewayRouteRetryPolicy{
+			Attempts:      to.Int32(src.RetryPolicy.Attempts),
+			PerTryTimeout: to.String(src.RetryPolicy.PerTryTimeout),
+			RetryOn:       to.StringArray(src.RetryPolicy.RetryOn),
+		}
+	}
+}
+
+// fromGatewayRouteMatchingDataModel converts the request matching, traffic splitting, header rewriting and retry
+// properties of a datamodel route to the versioned route.
+func fromGatewayRouteMatchingDataModel(src datamodel.GatewayRoute, dst *GatewayRoute) {
+	for _, d := range src.Destinations {
+		destination := &GatewayRouteDestination{
+			Destination: to.Ptr(d.Destination),
+		}
+		if d.Weight != 0 {
+			destination.Weight = to.Ptr(d.Weight)
+		}
+		dst.Destinations = append(dst.Destinations, destination)
+	}
+
+	for _, h := range src.Headers {
+		dst.Headers = append(dst.Headers, &GatewayRouteHeaderMatch{
+			Name:     to.Ptr(h.Name),
+			Exact:    fromOptionalString(h.Exact),
+			Contains: fromOptionalString(h.Contains),
+			Present:  fromOptionalBool(h.Present),
+		})
+	}
+
+	for _, q := range src.QueryParameters {
+		dst.QueryParameters = append(dst.QueryParameters, &GatewayRouteQueryParameterMatch{
+			Name:     to.Ptr(q.Name),
+			Exact:    fromOptionalString(q.Exact),
+			Contains: fromOptionalString(q.Contains),
+			Present:  fromOptionalBool(q.Present),
+		})
+	}
+
+	dst.Methods = to.ArrayofStringPtrs(src.Methods)
+	dst.RequestHeaders = fromGatewayRouteHeadersPolicyDataModel(src.RequestHeaders)
+	dst.ResponseHeaders = fromGatewayRouteHeadersPolicyDataModel(src.ResponseHeaders)
+
+	if src.RetryPolicy != nil {
+		dst.RetryPolicy = &GatewayRouteRetryPolicy{
+			Attempts:      to.Ptr(src.RetryPolicy.Attempts),
+			PerTryTimeout: fromOptionalString(src.RetryPolicy.PerTryTimeout),
+			RetryOn:       to.ArrayofStringPtrs(src.RetryPolicy.RetryOn),
+		}
+	}
+}
+
+func toGatewayPoliciesDataModel(policies *GatewayPolicies) (*datamodel.GatewayPolicies, error) {
+	if policies == nil {
+		return nil, nil
+	}
+
+	rateLimit, err := toGatewayRateLimitPolicyDataModel(policies.RateLimit, "$.properties.policies.rateLimit")
+	if err != nil {
+		return nil, err
+	}
+
+	converted := &datamodel.GatewayPolicies{
+		JWT:       toGatewayJWTPolicyDataModel(policies.Jwt),
+		RateLimit: rateLimit,
+	}
+
+	if policies.ExternalAuth != nil {
+		converted.ExternalAuth = &datamodel.GatewayExternalAuthPolicy{
+			Destination:       to.String(policies.ExternalAuth.Destination),
+			CACertificateFrom: to.String(policies.ExternalAuth.CaCertificateFrom),
+			Timeout:           to.String(policies.ExternalAuth.Timeout),
+			FailOpen:          to.Bool(policies.ExternalAuth.FailOpen),
+		}
+	}
+
+	if policies.BasicAuth != nil {
+		converted.BasicAuth = &datamodel.GatewayBasicAuthPolicy{
+			SecretFrom: to.String(policies.BasicAuth.SecretFrom),
+		}
+	}
+
+	if policies.Cors != nil {
+		converted.CORS = &datamodel.GatewayCORSPolicy{
+			AllowOrigins:     to.StringArray(policies.Cors.AllowOrigins),
+			AllowMethods:     to.StringArray(policies.Cors.AllowMethods),
+			AllowHeaders:     to.StringArray(policies.Cors.AllowHeaders),
+			ExposeHeaders:    to.StringArray(policies.Cors.ExposeHeaders),
+			AllowCredentials: to.Bool(policies.Cors.AllowCredentials),
+			MaxAge:           to.String(policies.Cors.MaxAge),
+		}
+	}
+
+	return converted, nil
+}
+
+func fromGatewayPoliciesDataModel(policies *datamodel.GatewayPolicies) *GatewayPolicies {
+	if policies == nil {
+		return nil
+	}
+
+	converted := &GatewayPolicies{
+		Jwt:       fromGatewayJWTPolicyDataModel(policies.JWT),
+		RateLimit: fromGatewayRateLimitPolicyDataModel(policies.RateLimit),
+	}
+
+	if policies.ExternalAuth != nil {
+		converted.ExternalAuth = &GatewayExternalAuthPolicy{
+			Destination:       to.Ptr(policies.ExternalAuth.Destination),
+			CaCertificateFrom: fromOptionalString(policies.ExternalAuth.CACertificateFrom),
+			Timeout:           fromOptionalString(policies.ExternalAuth.Timeout),
+			FailOpen:          fromOptionalBool(policies.ExternalAuth.FailOpen),
+		}
+	}
+
+	if policies.BasicAuth != nil {
+		converted.BasicAuth = &GatewayBasicAuthPolicy{
+			SecretFrom: to.Ptr(policies.BasicAuth.SecretFrom),
+		}
+	}
+
+	if policies.CORS != nil {
+		converted.Cors = &GatewayCorsPolicy{
+			AllowOrigins:     to.ArrayofStringPtrs(policies.CORS.AllowOrigins),
+			AllowMethods:     to.ArrayofStringPtrs(policies.CORS.AllowMethods),
+			AllowHeaders:     to.ArrayofStringPtrs(policies.CORS.AllowHeaders),
+			ExposeHeaders:    to.ArrayofStringPtrs(policies.CORS.ExposeHeaders),
+			AllowCredentials: fromOptionalBool(policies.CORS.AllowCredentials),
+			MaxAge:           fromOptionalString(policies.CORS.MaxAge),
+		}
+	}
+
+	return converted
+}
+
+func toGatewayRoutePoliciesDataModel(policies *GatewayRoutePolicies, path string) (*datamodel.GatewayRoutePolicies, error) {
+	if policies == nil {
+		return nil, nil
+	}
+
+	rateLimit, err := toGatewayRateLimitPolicyDataModel(policies.RateLimit, path+".rateLimit")
+	if err != nil {
+		return nil, err
+	}
+
+	return &datamodel.GatewayRoutePolicies{
+		JWT:       toGatewayJWTPolicyDataModel(policies.Jwt),
+		RateLimit: rateLimit,
+		Anonymous: to.Bool(policies.Anonymous),
+	}, nil
+}
+
+func fromGatewayRoutePoliciesDataModel(policies *datamodel.GatewayRoutePolicies) *GatewayRoutePolicies {
+	if policies == nil {
+		return nil
+	}
+
+	return &GatewayRoutePolicies{
+		Jwt:       fromGatewayJWTPolicyDataModel(policies.JWT),
+		RateLimit: fromGatewayRateLimitPolicyDataModel(policies.RateLimit),
+		Anonymous: fromOptionalBool(policies.Anonymous),
+	}
+}
+
+func toGatewayJWTPolicyDataModel(policy *GatewayJwtPolicy) *datamodel.GatewayJWTPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &datamodel.GatewayJWTPolicy{
+		Issuer:            to.String(policy.Issuer),
+		Audiences:         to.StringArray(policy.Audiences),
+		JWKSURI:           to.String(policy.JwksURI),
+		CACertificateFrom: to.String(policy.CaCertificateFrom),
+		ForwardToken:      to.Bool(policy.ForwardToken),
+	}
+}
+
+func fromGatewayJWTPolicyDataModel(policy *datamodel.GatewayJWTPolicy) *GatewayJwtPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &GatewayJwtPolicy{
+		Issuer:            to.Ptr(policy.Issuer),
+		Audiences:         to.ArrayofStringPtrs(policy.Audiences),
+		JwksURI:           to.Ptr(policy.JWKSURI),
+		CaCertificateFrom: fromOptionalString(policy.CACertificateFrom),
+		ForwardToken:      fromOptionalBool(policy.ForwardToken),
+	}
+}
+
+func toGatewayRateLimitPolicyDataModel(policy *GatewayRateLimitPolicy, path string) (*datamodel.GatewayRateLimitPolicy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	converted := &datamodel.GatewayRateLimitPolicy{
+		Requests: to.Int32(policy.Requests),
+		Burst:    to.Int32(policy.Burst),
+	}
+
+	if policy.Unit == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: path + ".unit", ValidValue: fmt.Sprintf("one of %v", PossibleGatewayRateLimitUnitValues())}
+	}
+
+	switch *policy.Unit {
+	case GatewayRateLimitUnitSecond:
+		converted.Unit = datamodel.GatewayRateLimitUnitSecond
+	case GatewayRateLimitUnitMinute:
+		converted.Unit = datamodel.GatewayRateLimitUnitMinute
+	case GatewayRateLimitUnitHour:
+		converted.Unit = datamodel.GatewayRateLimitUnitHour
+	default:
+		return nil, &v1.ErrModelConversion{PropertyName: path + ".unit", ValidValue: fmt.Sprintf("one of %v", PossibleGatewayRateLimitUnitValues())}
+	}
+
+	return converted, nil
+}
+
+func fromGatewayRateLimitPolicyDataModel(policy *datamodel.GatewayRateLimitPolicy) *GatewayRateLimitPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	var unit GatewayRateLimitUnit
+	switch policy.Unit {
+	case datamodel.GatewayRateLimitUnitSecond:
+		unit = GatewayRateLimitUnitSecond
+	case datamodel.GatewayRateLimitUnitMinute:
+		unit = GatewayRateLimitUnitMinute
+	case datamodel.GatewayRateLimitUnitHour:
+		unit = GatewayRateLimitUnitHour
+	}
+
+	converted := &GatewayRateLimitPolicy{
+		Requests: to.Ptr(policy.Requests),
+		Unit:     &unit,
+	}
+	if policy.Burst != 0 {
+		converted.Burst = to.Ptr(policy.Burst)
+	}
+
+	return converted
+}
+
+func toGatewayRouteHeadersPolicyDataModel(policy *GatewayRouteHeadersPolicy) *datamodel.GatewayRouteHeadersPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	converted := &datamodel.GatewayRouteHeadersPolicy{
+		Remove: to.StringArray(policy.Remove),
+	}
+	if policy.Set != nil {
+		converted.Set = to.StringMap(policy.Set)
+	}
+
+	return converted
+}
+
+func fromGatewayRouteHeadersPolicyDataModel(policy *datamodel.GatewayRouteHeadersPolicy) *GatewayRouteHeadersPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	converted := &GatewayRouteHeadersPolicy{
+		Remove: to.ArrayofStringPtrs(policy.Remove),
+	}
+	if policy.Set != nil {
+		converted.Set = *to.StringMapPtr(policy.Set)
+	}
+
+	return converted
+}
+
+func fromOptionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return to.Ptr(s)
+}
+
+func fromOptionalBool(b bool) *bool {
+	if !b {
+		return nil
+	}
+	return to.Ptr(b)
+}
@@ -19,10 +19,12 @@ package v20231001preview
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/testutil"
 	"github.com/radius-project/radius/test/testutil/resourcetypeutil"
 
@@ -384,3 +386,261 @@ func TestGatewayConvertFromValidation(t *testing.T) {
 		require.ErrorAs(t, tc.err, &err)
 	}
 }
+
+func TestGatewayRouteMatchingConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-routematching.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	expected := datamodel.GatewayRoute{
+		Path: "/api",
+		Destinations: []datamodel.GatewayRouteDestination{
+			{Destination: "http://backend-v1:3000", Weight: 90},
+			{Destination: "http://backend-v2:3000", Weight: 10},
+		},
+		Headers: []datamodel.GatewayRouteHeaderMatch{
+			{Name: "x-canary", Exact: "true"},
+			{Name: "authorization", Present: true},
+		},
+		QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{
+			{Name: "version", Contains: "beta"},
+		},
+		Methods: []string{"GET", "POST"},
+		RequestHeaders: &datamodel.GatewayRouteHeadersPolicy{
+			Set:    map[string]string{"x-forwarded-by": "radius"},
+			Remove: []string{"x-debug"},
+		},
+		ResponseHeaders: &datamodel.GatewayRouteHeadersPolicy{
+			Remove: []string{"server"},
+		},
+		RetryPolicy: &datamodel.GatewayRouteRetryPolicy{
+			Attempts:      3,
+			PerTryTimeout: "2s",
+			RetryOn:       []string{"5xx", "connect-failure"},
+		},
+	}
+	require.Equal(t, []datamodel.GatewayRoute{expected}, gw.Properties.Routes)
+}
+
+func TestGatewayRouteMatchingConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-routematching.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	route := versioned.Properties.Routes[0]
+	require.Equal(t, []*GatewayRouteDestination{
+		{Destination: to.Ptr("http://backend-v1:3000"), Weight: to.Ptr(int32(90))},
+		{Destination: to.Ptr("http://backend-v2:3000"), Weight: to.Ptr(int32(10))},
+	}, route.Destinations)
+	require.Equal(t, []*GatewayRouteHeaderMatch{
+		{Name: to.Ptr("x-canary"), Exact: to.Ptr("true")},
+		{Name: to.Ptr("authorization"), Present: to.Ptr(true)},
+	}, route.Headers)
+	require.Equal(t, []*GatewayRouteQueryParameterMatch{
+		{Name: to.Ptr("version"), Contains: to.Ptr("beta")},
+	}, route.QueryParameters)
+	require.Equal(t, to.SliceOfPtrs("GET", "POST"), route.Methods)
+	require.Equal(t, &GatewayRouteHeadersPolicy{
+		Set:    map[string]*string{"x-forwarded-by": to.Ptr("radius")},
+		Remove: to.SliceOfPtrs("x-debug"),
+	}, route.RequestHeaders)
+	require.Equal(t, &GatewayRouteHeadersPolicy{
+		Remove: to.SliceOfPtrs("server"),
+	}, route.ResponseHeaders)
+	require.Equal(t, &GatewayRouteRetryPolicy{
+		Attempts:      to.Ptr(int32(3)),
+		PerTryTimeout: to.Ptr("2s"),
+		RetryOn:       to.SliceOfPtrs("5xx", "connect-failure"),
+	}, route.RetryPolicy)
+}
+
+func TestGatewayCertificateIssuerConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresource-with-certificateissuer.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, &datamodel.GatewayPropertiesTLS{
+		MinimumProtocolVersion: datamodel.TLSMinVersion12,
+		CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+			Kind:          datamodel.GatewayCertificateIssuerKindACME,
+			ClusterIssuer: "letsencrypt",
+		},
+	}, gw.Properties.TLS)
+}
+
+func TestGatewayCertificateIssuerConvertVersionedToDataModel_InvalidKind(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresource-with-certificateissuer.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+	r.Properties.TLS.CertificateIssuer.Kind = to.Ptr(GatewayCertificateIssuerKind("vault"))
+
+	// act
+	_, err = r.ConvertTo()
+
+	// assert
+	require.Equal(t, &v1.ErrModelConversion{PropertyName: "$.properties.tls.certificateIssuer.kind", ValidValue: "one of [acme selfSigned]"}, err)
+}
+
+func TestGatewayCertificateIssuerConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-certificateissuer.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &GatewayCertificateIssuer{Kind: to.Ptr(GatewayCertificateIssuerKindSelfSigned)}, versioned.Properties.TLS.CertificateIssuer)
+	require.Equal(t, &GatewayCertificateStatus{
+		NotAfter:    to.Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		RenewalTime: to.Ptr(time.Date(2023, 12, 2, 0, 0, 0, 0, time.UTC)),
+	}, versioned.Properties.TLS.CertificateStatus)
+}
+
+func TestGatewayPoliciesConvertVersionedToDataModel(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresource-with-policies.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	dm, err := r.ConvertTo()
+
+	// assert
+	require.NoError(t, err)
+	gw := dm.(*datamodel.Gateway)
+	require.Equal(t, &datamodel.GatewayPolicies{
+		JWT: &datamodel.GatewayJWTPolicy{
+			Issuer:            "https://login.contoso.com",
+			JWKSURI:           "https://login.contoso.com/keys",
+			CACertificateFrom: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/ca",
+			ForwardToken:      true,
+		},
+		ExternalAuth: &datamodel.GatewayExternalAuthPolicy{
+			Destination: "http://authserver:9001",
+			Timeout:     "500ms",
+			FailOpen:    true,
+		},
+		BasicAuth: &datamodel.GatewayBasicAuthPolicy{
+			SecretFrom: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/users",
+		},
+		RateLimit: &datamodel.GatewayRateLimitPolicy{
+			Requests: 100,
+			Unit:     datamodel.GatewayRateLimitUnitMinute,
+		},
+		CORS: &datamodel.GatewayCORSPolicy{
+			AllowOrigins:     []string{"https://www.contoso.com"},
+			AllowMethods:     []string{"GET", "POST"},
+			AllowHeaders:     []string{"Authorization"},
+			ExposeHeaders:    []string{"X-Request-Id"},
+			AllowCredentials: true,
+			MaxAge:           "10m",
+		},
+	}, gw.Properties.Policies)
+
+	require.Nil(t, gw.Properties.Routes[0].Policies)
+	require.Equal(t, &datamodel.GatewayRoutePolicies{
+		JWT: &datamodel.GatewayJWTPolicy{
+			Issuer:    "https://login.contoso.com",
+			JWKSURI:   "https://login.contoso.com/keys",
+			Audiences: []string{"api"},
+		},
+		RateLimit: &datamodel.GatewayRateLimitPolicy{
+			Requests: 10,
+			Unit:     datamodel.GatewayRateLimitUnitSecond,
+			Burst:    5,
+		},
+	}, gw.Properties.Routes[1].Policies)
+	require.Equal(t, &datamodel.GatewayRoutePolicies{Anonymous: true}, gw.Properties.Routes[2].Policies)
+}
+
+func TestGatewayPoliciesConvertVersionedToDataModel_InvalidRateLimitUnit(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresource-with-policies.json")
+	r := &GatewayResource{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+	r.Properties.Routes[1].Policies.RateLimit.Unit = to.Ptr(GatewayRateLimitUnit("day"))
+
+	// act
+	_, err = r.ConvertTo()
+
+	// assert
+	require.Equal(t, &v1.ErrModelConversion{PropertyName: "$.properties.routes[1].policies.rateLimit.unit", ValidValue: "one of [hour minute second]"}, err)
+}
+
+func TestGatewayPoliciesConvertDataModelToVersioned(t *testing.T) {
+	// arrange
+	rawPayload := testutil.ReadFixture("gatewayresourcedatamodel-with-policies.json")
+	r := &datamodel.Gateway{}
+	err := json.Unmarshal(rawPayload, r)
+	require.NoError(t, err)
+
+	// act
+	versioned := &GatewayResource{}
+	err = versioned.ConvertFrom(r)
+
+	// assert
+	require.NoError(t, err)
+	require.Equal(t, &GatewayPolicies{
+		Jwt: &GatewayJwtPolicy{
+			Issuer:            to.Ptr("https://login.contoso.com"),
+			JwksURI:           to.Ptr("https://login.contoso.com/keys"),
+			CaCertificateFrom: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/ca"),
+			ForwardToken:      to.Ptr(true),
+		},
+		ExternalAuth: &GatewayExternalAuthPolicy{
+			Destination: to.Ptr("http://authserver:9001"),
+			Timeout:     to.Ptr("500ms"),
+			FailOpen:    to.Ptr(true),
+		},
+		BasicAuth: &GatewayBasicAuthPolicy{
+			SecretFrom: to.Ptr("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/secretStores/users"),
+		},
+		RateLimit: &GatewayRateLimitPolicy{
+			Requests: to.Ptr(int32(100)),
+			Unit:     to.Ptr(GatewayRateLimitUnitMinute),
+		},
+		Cors: &GatewayCorsPolicy{
+			AllowOrigins:     []*string{to.Ptr("https://www.contoso.com")},
+			AllowMethods:     []*string{to.Ptr("GET"), to.Ptr("POST")},
+			AllowHeaders:     []*string{to.Ptr("Authorization")},
+			ExposeHeaders:    []*string{to.Ptr("X-Request-Id")},
+			AllowCredentials: to.Ptr(true),
+			MaxAge:           to.Ptr("10m"),
+		},
+	}, versioned.Properties.Policies)
+	require.Nil(t, versioned.Properties.Routes[0].Policies)
+	require.Equal(t, &GatewayRoutePolicies{Anonymous: to.Ptr(true)}, versioned.Properties.Routes[2].Policies)
+}
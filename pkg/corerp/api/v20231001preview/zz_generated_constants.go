@@ -57,6 +57,27 @@ func PossibleCertificateTypesValues() []CertificateTypes {
 	}
 }
 
+// ConcurrencyPolicy - Specifies how concurrent runs of a scheduled job are handled
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyPolicyAllow - Allow concurrent runs
+	ConcurrencyPolicyAllow ConcurrencyPolicy = "Allow"
+	// ConcurrencyPolicyForbid - Skip the new run if the previous run has not finished
+	ConcurrencyPolicyForbid ConcurrencyPolicy = "Forbid"
+	// ConcurrencyPolicyReplace - Replace the currently running run with the new run
+	ConcurrencyPolicyReplace ConcurrencyPolicy = "Replace"
+)
+
+// PossibleConcurrencyPolicyValues returns the possible values for the ConcurrencyPolicy const type.
+func PossibleConcurrencyPolicyValues() []ConcurrencyPolicy {
+	return []ConcurrencyPolicy{
+		ConcurrencyPolicyAllow,
+		ConcurrencyPolicyForbid,
+		ConcurrencyPolicyReplace,
+	}
+}
+
 // ContainerResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values
 // are 'internal', where Radius manages the lifecycle of the resource internally, and 'manual', where a user
 // manages the resource.
@@ -134,6 +155,47 @@ func PossibleDirectionValues() []Direction {
 	}
 }
 
+// GatewayCertificateIssuerKind - Kinds of issuers for the automatically issued TLS certificate of a Gateway.
+type GatewayCertificateIssuerKind string
+
+const (
+	// GatewayCertificateIssuerKindAcme - The certificate is issued by an ACME certificate authority, such as Let's Encrypt, through
+	// a cert-manager ClusterIssuer.
+	GatewayCertificateIssuerKindAcme GatewayCertificateIssuerKind = "acme"
+	// GatewayCertificateIssuerKindSelfSigned - The certificate is issued by a self-signed certificate authority created for the
+	// gateway. Intended for development environments.
+	GatewayCertificateIssuerKindSelfSigned GatewayCertificateIssuerKind = "selfSigned"
+)
+
+// PossibleGatewayCertificateIssuerKindValues returns the possible values for the GatewayCertificateIssuerKind const type.
+func PossibleGatewayCertificateIssuerKindValues() []GatewayCertificateIssuerKind {
+	return []GatewayCertificateIssuerKind{
+		GatewayCertificateIssuerKindAcme,
+		GatewayCertificateIssuerKindSelfSigned,
+	}
+}
+
+// GatewayRateLimitUnit - The units of time of a rate limit.
+type GatewayRateLimitUnit string
+
+const (
+	// GatewayRateLimitUnitHour - Requests per hour
+	GatewayRateLimitUnitHour GatewayRateLimitUnit = "hour"
+	// GatewayRateLimitUnitMinute - Requests per minute
+	GatewayRateLimitUnitMinute GatewayRateLimitUnit = "minute"
+	// GatewayRateLimitUnitSecond - Requests per second
+	GatewayRateLimitUnitSecond GatewayRateLimitUnit = "second"
+)
+
+// PossibleGatewayRateLimitUnitValues returns the possible values for the GatewayRateLimitUnit const type.
+func PossibleGatewayRateLimitUnitValues() []GatewayRateLimitUnit {
+	return []GatewayRateLimitUnit{
+		GatewayRateLimitUnitHour,
+		GatewayRateLimitUnitMinute,
+		GatewayRateLimitUnitSecond,
+	}
+}
+
 // IAMKind - The kind of IAM provider to configure
 type IAMKind string
 
@@ -199,6 +261,24 @@ func PossibleImagePullPolicyValues() []ImagePullPolicy {
 	}
 }
 
+// IngressKind - The kind of ingress implementation
+type IngressKind string
+
+const (
+	// IngressKindContour - Gateways are rendered as Contour HTTPProxy resources
+	IngressKindContour IngressKind = "contour"
+	// IngressKindGatewayAPI - Gateways are rendered as Kubernetes Gateway API resources
+	IngressKindGatewayAPI IngressKind = "gatewayAPI"
+)
+
+// PossibleIngressKindValues returns the possible values for the IngressKind const type.
+func PossibleIngressKindValues() []IngressKind {
+	return []IngressKind{
+		IngressKindContour,
+		IngressKindGatewayAPI,
+	}
+}
+
 // ManagedStore - The managed store for the ephemeral volume
 type ManagedStore string
 
@@ -435,3 +515,24 @@ func PossibleVolumeSecretEncodingsValues() []VolumeSecretEncodings {
 		VolumeSecretEncodingsUTF8,
 	}
 }
+
+// WorkloadKind - The kind of workload used to run the container
+type WorkloadKind string
+
+const (
+	// WorkloadKindCronJob - The container runs to completion on a schedule
+	WorkloadKindCronJob WorkloadKind = "cronJob"
+	// WorkloadKindDeployment - The container runs as a long-running service
+	WorkloadKindDeployment WorkloadKind = "deployment"
+	// WorkloadKindJob - The container runs to completion once
+	WorkloadKindJob WorkloadKind = "job"
+)
+
+// PossibleWorkloadKindValues returns the possible values for the WorkloadKind const type.
+func PossibleWorkloadKindValues() []WorkloadKind {
+	return []WorkloadKind{
+		WorkloadKindCronJob,
+		WorkloadKindDeployment,
+		WorkloadKindJob,
+	}
+}
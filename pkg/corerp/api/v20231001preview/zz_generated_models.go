@@ -380,6 +380,9 @@ type ContainerProperties struct {
 	// Specifies Runtime-specific functionality
 	Runtimes *RuntimesProperties
 
+	// Specifies the kind of workload used to run the container. Defaults to a long-running deployment.
+	Workload *WorkloadProperties
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 
@@ -487,6 +490,9 @@ type EnvironmentProperties struct {
 	// The environment extension.
 	Extensions []ExtensionClassification
 
+	// The ingress implementation used to expose the gateways in the environment.
+	Ingress *IngressProperties
+
 	// Cloud providers configuration for the environment.
 	Providers *Providers
 
@@ -742,6 +748,70 @@ type Extension struct {
 // GetExtension implements the ExtensionClassification interface for type Extension.
 func (e *Extension) GetExtension() *Extension { return e }
 
+// GatewayBasicAuthPolicy - Gateway policy to authenticate requests with HTTP basic authentication.
+type GatewayBasicAuthPolicy struct {
+	// REQUIRED; The resource id of the secret store holding the users allowed to access the gateway and their password hashes
+	// in htpasswd format, under the '.htpasswd' key.
+	SecretFrom *string
+}
+
+// GatewayCertificateIssuer - Issuer of the automatically issued TLS certificate of a Gateway. Certificates are issued and
+// renewed by cert-manager.
+type GatewayCertificateIssuer struct {
+	// REQUIRED; The kind of the certificate issuer.
+	Kind *GatewayCertificateIssuerKind
+
+	// The name of the cert-manager ClusterIssuer that issues the certificate. Required when kind is 'acme'.
+	ClusterIssuer *string
+}
+
+// GatewayCertificateStatus - State of the automatically issued TLS certificate of a Gateway.
+type GatewayCertificateStatus struct {
+	// The time at which the certificate expires.
+	NotAfter *time.Time
+
+	// The time at which the certificate is renewed by the issuer.
+	RenewalTime *time.Time
+}
+
+// GatewayCorsPolicy - Gateway cross-origin resource sharing (CORS) policy
+type GatewayCorsPolicy struct {
+	// REQUIRED; The HTTP methods allowed in cross-origin requests. Ex - ['GET', 'POST'].
+	AllowMethods []*string
+
+	// REQUIRED; The origins allowed to make cross-origin requests. Ex - ['https://www.contoso.com']. '*' allows all origins.
+	AllowOrigins []*string
+
+	// If true, cross-origin requests are allowed to include credentials. Defaults to false.
+	AllowCredentials *bool
+
+	// The request headers allowed in cross-origin requests.
+	AllowHeaders []*string
+
+	// The response headers exposed to cross-origin requests.
+	ExposeHeaders []*string
+
+	// The duration for which the results of a preflight request can be cached.
+	MaxAge *string
+}
+
+// GatewayExternalAuthPolicy - Gateway policy to authorize requests with an external authorization service implementing
+// the Envoy external authorization gRPC protocol.
+type GatewayExternalAuthPolicy struct {
+	// REQUIRED; The URL of the authorization service. Ex - 'http://authserver:9001'.
+	Destination *string
+
+	// The resource id of the secret store holding the CA certificate ('ca.crt') used to verify the TLS certificate of an 'https'
+	// authorization service.
+	CaCertificateFrom *string
+
+	// If true, requests are forwarded to the service when the authorization service fails to respond. Defaults to false.
+	FailOpen *bool
+
+	// The maximum time to wait for a response from the authorization service.
+	Timeout *string
+}
+
 // GatewayHostname - Declare hostname information for the Gateway. Leaving the hostname empty auto-assigns one: mygateway.myapp.PUBLICHOSTNAMEORIP.nip.io.
 type GatewayHostname struct {
 	// Specify a fully-qualified domain name: myapp.mydomain.com. Mutually exclusive with 'prefix' and will take priority if both
@@ -753,6 +823,45 @@ type GatewayHostname struct {
 	Prefix *string
 }
 
+// GatewayJwtPolicy - Gateway policy to verify JSON Web Tokens (JWT). Tokens are read from the 'Authorization' header using
+// the 'Bearer' scheme.
+type GatewayJwtPolicy struct {
+	// REQUIRED; The issuer that tokens are required to have in the 'iss' claim.
+	Issuer *string
+
+	// REQUIRED; The HTTPS URL of the JSON Web Key Set (JWKS) used to verify the signature of tokens.
+	JwksURI *string
+
+	// The audiences that tokens are allowed to have in the 'aud' claim. Audiences are not checked when empty.
+	Audiences []*string
+
+	// The resource id of the secret store holding the CA certificate ('ca.crt') used to verify the TLS certificate of the JWKS
+	// endpoint.
+	CaCertificateFrom *string
+
+	// If true, the token is forwarded to the service after it is verified. Defaults to false.
+	ForwardToken *bool
+}
+
+// GatewayPolicies - Policies applied to the requests handled by a Gateway.
+type GatewayPolicies struct {
+	// Requires requests to carry the credentials of a user using HTTP basic authentication. Only supported for gateways rendered
+	// with the Gateway API.
+	BasicAuth *GatewayBasicAuthPolicy
+
+	// The cross-origin resource sharing (CORS) policy of the gateway.
+	Cors *GatewayCorsPolicy
+
+	// Authorizes requests with an external authorization service.
+	ExternalAuth *GatewayExternalAuthPolicy
+
+	// Requires requests to carry a JSON Web Token (JWT) that is verified by the gateway.
+	Jwt *GatewayJwtPolicy
+
+	// Limits the rate of requests handled by the gateway.
+	RateLimit *GatewayRateLimitPolicy
+}
+
 // GatewayProperties - Gateway properties
 type GatewayProperties struct {
 	// REQUIRED; Fully qualified resource ID for the application
@@ -770,6 +879,9 @@ type GatewayProperties struct {
 	// Sets Gateway to not be exposed externally (no public IP address associated). Defaults to false (exposed to internet).
 	Internal *bool
 
+	// Authentication, rate limiting and CORS policies applied to all routes of the Gateway.
+	Policies *GatewayPolicies
+
 	// TLS configuration for the Gateway.
 	TLS *GatewayTLS
 
@@ -783,6 +895,19 @@ type GatewayProperties struct {
 	URL *string
 }
 
+// GatewayRateLimitPolicy - Gateway policy to limit the rate of requests. Requests over the limit are rejected with status
+// code 429.
+type GatewayRateLimitPolicy struct {
+	// REQUIRED; The number of requests allowed per unit of time.
+	Requests *int32
+
+	// REQUIRED; The unit of time of the limit.
+	Unit *GatewayRateLimitUnit
+
+	// The number of requests above the limit allowed within a short period of time.
+	Burst *int32
+}
+
 // GatewayResource - Concrete tracked resource types can be created by aliasing this type using a specific property type.
 type GatewayResource struct {
 	// REQUIRED; The geo-location where the resource lives
@@ -840,20 +965,121 @@ type GatewayRoute struct {
 	// The URL or id of the service to route to. Ex - 'http://myservice'.
 	Destination *string
 
+	// The destinations to split the traffic of the route between, by weight. Mutually exclusive with 'destination'.
+	Destinations []*GatewayRouteDestination
+
 	// Enables websocket support for the route. Defaults to false.
 	EnableWebsockets *bool
 
+	// The header conditions that the incoming request must match. All conditions must match.
+	Headers []*GatewayRouteHeaderMatch
+
+	// The HTTP methods to match the incoming request on. Ex - ['GET', 'POST']. Matches all methods when empty.
+	Methods []*string
+
 	// The path to match the incoming request path on. Ex - /myservice.
 	Path *string
 
+	// Authentication and rate limiting policies applied to the requests matched by the route.
+	Policies *GatewayRoutePolicies
+
+	// The query parameter conditions that the incoming request must match. All conditions must match.
+	QueryParameters []*GatewayRouteQueryParameterMatch
+
 	// Optionally update the prefix when sending the request to the service. Ex - replacePrefix: '/' and path: '/myservice' will
 	// transform '/myservice/myroute' to '/myroute'
 	ReplacePrefix *string
 
+	// The headers to set or remove on the request before it is sent to the service.
+	RequestHeaders *GatewayRouteHeadersPolicy
+
+	// The headers to set or remove on the response before it is sent to the client.
+	ResponseHeaders *GatewayRouteHeadersPolicy
+
+	// The retry policy for the route.
+	RetryPolicy *GatewayRouteRetryPolicy
+
 	// The timeout policy for the route.
 	TimeoutPolicy *GatewayRouteTimeoutPolicy
 }
 
+// GatewayRouteDestination - Weighted destination of a gateway route
+type GatewayRouteDestination struct {
+	// REQUIRED; The URL or id of the service to route to. Ex - 'http://myservice'.
+	Destination *string
+
+	// The relative weight of the destination. Traffic is split evenly when no weights are specified.
+	Weight *int32
+}
+
+// GatewayRouteHeaderMatch - Gateway route condition on a request header. Exactly one of 'exact', 'contains' and 'present'
+// must be specified.
+type GatewayRouteHeaderMatch struct {
+	// REQUIRED; The name of the header.
+	Name *string
+
+	// Matches when the header value contains this value.
+	Contains *string
+
+	// Matches when the header value is equal to this value.
+	Exact *string
+
+	// Matches when the header is present, regardless of its value.
+	Present *bool
+}
+
+// GatewayRouteHeadersPolicy - Gateway route policy to rewrite headers
+type GatewayRouteHeadersPolicy struct {
+	// The names of the headers to remove.
+	Remove []*string
+
+	// The headers to set, overwriting existing values.
+	Set map[string]*string
+}
+
+// GatewayRoutePolicies - Policies applied to the requests matched by a Gateway route. Route policies take precedence over
+// the policies of the Gateway.
+type GatewayRoutePolicies struct {
+	// If true, requests matched by the route are not authenticated by the JWT, external authorization and basic authentication
+	// policies of the gateway.
+	// Defaults to false.
+	Anonymous *bool
+
+	// Requires requests matched by the route to carry a JSON Web Token (JWT) that is verified by the gateway.
+	Jwt *GatewayJwtPolicy
+
+	// Limits the rate of requests matched by the route.
+	RateLimit *GatewayRateLimitPolicy
+}
+
+// GatewayRouteQueryParameterMatch - Gateway route condition on a query parameter. Exactly one of 'exact', 'contains' and
+// 'present' must be specified.
+type GatewayRouteQueryParameterMatch struct {
+	// REQUIRED; The name of the query parameter.
+	Name *string
+
+	// Matches when the query parameter value contains this value.
+	Contains *string
+
+	// Matches when the query parameter value is equal to this value.
+	Exact *string
+
+	// Matches when the query parameter is present, regardless of its value.
+	Present *bool
+}
+
+// GatewayRouteRetryPolicy - Gateway route retry policy
+type GatewayRouteRetryPolicy struct {
+	// The maximum number of retries. Defaults to 1.
+	Attempts *int32
+
+	// The timeout in duration for each retry attempt.
+	PerTryTimeout *string
+
+	// The conditions to retry the request on. Ex - ['5xx', 'connect-failure']. Defaults to '5xx'.
+	RetryOn []*string
+}
+
 // GatewayRouteTimeoutPolicy - Gateway route timeout policy
 type GatewayRouteTimeoutPolicy struct {
 	// The backend request timeout in duration for the route. Cannot be greater than the request timeout.
@@ -868,11 +1094,17 @@ type GatewayTLS struct {
 	// The resource id for the secret containing the TLS certificate and key for the gateway.
 	CertificateFrom *string
 
+	// Automatically issue the TLS certificate for the gateway hostname. Mutually exclusive with 'certificateFrom' and 'sslPassthrough'.
+	CertificateIssuer *GatewayCertificateIssuer
+
 	// TLS minimum protocol version (defaults to 1.2).
 	MinimumProtocolVersion *TLSMinVersion
 
 	// If true, gateway lets the https traffic sslPassthrough to the backend servers for decryption.
 	SSLPassthrough *bool
+
+	// READ-ONLY; The state of the automatically issued TLS certificate. Readonly
+	CertificateStatus *GatewayCertificateStatus
 }
 
 // GitAuthConfig - Authentication information used to access private Terraform modules from Git repository sources.
@@ -964,6 +1196,15 @@ type IdentitySettings struct {
 	Resource *string
 }
 
+// IngressProperties - The ingress implementation used to expose the gateways in the environment.
+type IngressProperties struct {
+	// REQUIRED; The kind of ingress implementation.
+	Kind *IngressKind
+
+	// The name of the GatewayClass used by the rendered Gateway resources. Required when kind is 'gatewayAPI'.
+	GatewayClassName *string
+}
+
 // KeyObjectProperties - Represents key object properties
 type KeyObjectProperties struct {
 	// REQUIRED; The name of the key
@@ -1644,3 +1885,39 @@ type VolumeResourceUpdate struct {
 	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
 	Type *string
 }
+
+// WorkloadProperties - Specifies the workload used to run the container
+type WorkloadProperties struct {
+	// REQUIRED; The kind of workload used to run the container
+	Kind *WorkloadKind
+
+	// The duration in seconds that a run may be active before it is terminated
+	ActiveDeadlineSeconds *int64
+
+	// The number of retries before a run is marked as failed
+	BackoffLimit *int32
+
+	// The number of successfully finished pods required for a run to complete
+	Completions *int32
+
+	// Specifies how concurrent runs of a scheduled job are handled
+	ConcurrencyPolicy *ConcurrencyPolicy
+
+	// The number of failed finished runs of a scheduled job to retain
+	FailedJobsHistoryLimit *int32
+
+	// The maximum number of pods that run in parallel for a run
+	Parallelism *int32
+
+	// The schedule in Cron format. Required when kind is 'cronJob'
+	Schedule *string
+
+	// The number of successful finished runs of a scheduled job to retain
+	SuccessfulJobsHistoryLimit *int32
+
+	// Suspends subsequent runs of a scheduled job
+	Suspend *bool
+
+	// The duration in seconds after which a finished run is cleaned up
+	TTLSecondsAfterFinished *int32
+}
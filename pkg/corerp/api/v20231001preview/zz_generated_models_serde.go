@@ -806,6 +806,7 @@ func (c ContainerProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "restartPolicy", c.RestartPolicy)
 	populate(objectMap, "runtimes", c.Runtimes)
 	populate(objectMap, "status", c.Status)
+	populate(objectMap, "workload", c.Workload)
 	return json.Marshal(objectMap)
 }
 
@@ -854,6 +855,9 @@ func (c *ContainerProperties) UnmarshalJSON(data []byte) error {
 		case "status":
 			err = unpopulate(val, "Status", &c.Status)
 			delete(rawMsg, key)
+		case "workload":
+			err = unpopulate(val, "Workload", &c.Workload)
+			delete(rawMsg, key)
 		}
 		if err != nil {
 			return fmt.Errorf("unmarshalling type %T: %v", c, err)
@@ -1070,6 +1074,7 @@ func (e EnvironmentProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "compute", e.Compute)
 	populate(objectMap, "extensions", e.Extensions)
+	populate(objectMap, "ingress", e.Ingress)
 	populate(objectMap, "providers", e.Providers)
 	populate(objectMap, "provisioningState", e.ProvisioningState)
 	populate(objectMap, "recipeConfig", e.RecipeConfig)
@@ -1093,6 +1098,9 @@ func (e *EnvironmentProperties) UnmarshalJSON(data []byte) error {
 		case "extensions":
 			e.Extensions, err = unmarshalExtensionClassificationArray(val)
 			delete(rawMsg, key)
+		case "ingress":
+			err = unpopulate(val, "Ingress", &e.Ingress)
+			delete(rawMsg, key)
 		case "providers":
 			err = unpopulate(val, "Providers", &e.Providers)
 			delete(rawMsg, key)
@@ -1711,6 +1719,181 @@ func (e *Extension) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type GatewayBasicAuthPolicy.
+func (g GatewayBasicAuthPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "secretFrom", g.SecretFrom)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayBasicAuthPolicy.
+func (g *GatewayBasicAuthPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "secretFrom":
+			err = unpopulate(val, "SecretFrom", &g.SecretFrom)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayCertificateIssuer.
+func (g GatewayCertificateIssuer) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "clusterIssuer", g.ClusterIssuer)
+	populate(objectMap, "kind", g.Kind)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayCertificateIssuer.
+func (g *GatewayCertificateIssuer) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "clusterIssuer":
+			err = unpopulate(val, "ClusterIssuer", &g.ClusterIssuer)
+			delete(rawMsg, key)
+		case "kind":
+			err = unpopulate(val, "Kind", &g.Kind)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayCertificateStatus.
+func (g GatewayCertificateStatus) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populateDateTimeRFC3339(objectMap, "notAfter", g.NotAfter)
+	populateDateTimeRFC3339(objectMap, "renewalTime", g.RenewalTime)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayCertificateStatus.
+func (g *GatewayCertificateStatus) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "notAfter":
+			err = unpopulateDateTimeRFC3339(val, "NotAfter", &g.NotAfter)
+			delete(rawMsg, key)
+		case "renewalTime":
+			err = unpopulateDateTimeRFC3339(val, "RenewalTime", &g.RenewalTime)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayCorsPolicy.
+func (g GatewayCorsPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "allowCredentials", g.AllowCredentials)
+	populate(objectMap, "allowHeaders", g.AllowHeaders)
+	populate(objectMap, "allowMethods", g.AllowMethods)
+	populate(objectMap, "allowOrigins", g.AllowOrigins)
+	populate(objectMap, "exposeHeaders", g.ExposeHeaders)
+	populate(objectMap, "maxAge", g.MaxAge)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayCorsPolicy.
+func (g *GatewayCorsPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "allowCredentials":
+			err = unpopulate(val, "AllowCredentials", &g.AllowCredentials)
+			delete(rawMsg, key)
+		case "allowHeaders":
+			err = unpopulate(val, "AllowHeaders", &g.AllowHeaders)
+			delete(rawMsg, key)
+		case "allowMethods":
+			err = unpopulate(val, "AllowMethods", &g.AllowMethods)
+			delete(rawMsg, key)
+		case "allowOrigins":
+			err = unpopulate(val, "AllowOrigins", &g.AllowOrigins)
+			delete(rawMsg, key)
+		case "exposeHeaders":
+			err = unpopulate(val, "ExposeHeaders", &g.ExposeHeaders)
+			delete(rawMsg, key)
+		case "maxAge":
+			err = unpopulate(val, "MaxAge", &g.MaxAge)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayExternalAuthPolicy.
+func (g GatewayExternalAuthPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "caCertificateFrom", g.CaCertificateFrom)
+	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "failOpen", g.FailOpen)
+	populate(objectMap, "timeout", g.Timeout)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayExternalAuthPolicy.
+func (g *GatewayExternalAuthPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "caCertificateFrom":
+			err = unpopulate(val, "CaCertificateFrom", &g.CaCertificateFrom)
+			delete(rawMsg, key)
+		case "destination":
+			err = unpopulate(val, "Destination", &g.Destination)
+			delete(rawMsg, key)
+		case "failOpen":
+			err = unpopulate(val, "FailOpen", &g.FailOpen)
+			delete(rawMsg, key)
+		case "timeout":
+			err = unpopulate(val, "Timeout", &g.Timeout)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GatewayHostname.
 func (g GatewayHostname) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1742,6 +1925,92 @@ func (g *GatewayHostname) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type GatewayJwtPolicy.
+func (g GatewayJwtPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "audiences", g.Audiences)
+	populate(objectMap, "caCertificateFrom", g.CaCertificateFrom)
+	populate(objectMap, "forwardToken", g.ForwardToken)
+	populate(objectMap, "issuer", g.Issuer)
+	populate(objectMap, "jwksUri", g.JwksURI)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayJwtPolicy.
+func (g *GatewayJwtPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "audiences":
+			err = unpopulate(val, "Audiences", &g.Audiences)
+			delete(rawMsg, key)
+		case "caCertificateFrom":
+			err = unpopulate(val, "CaCertificateFrom", &g.CaCertificateFrom)
+			delete(rawMsg, key)
+		case "forwardToken":
+			err = unpopulate(val, "ForwardToken", &g.ForwardToken)
+			delete(rawMsg, key)
+		case "issuer":
+			err = unpopulate(val, "Issuer", &g.Issuer)
+			delete(rawMsg, key)
+		case "jwksUri":
+			err = unpopulate(val, "JwksURI", &g.JwksURI)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayPolicies.
+func (g GatewayPolicies) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "basicAuth", g.BasicAuth)
+	populate(objectMap, "cors", g.Cors)
+	populate(objectMap, "externalAuth", g.ExternalAuth)
+	populate(objectMap, "jwt", g.Jwt)
+	populate(objectMap, "rateLimit", g.RateLimit)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayPolicies.
+func (g *GatewayPolicies) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "basicAuth":
+			err = unpopulate(val, "BasicAuth", &g.BasicAuth)
+			delete(rawMsg, key)
+		case "cors":
+			err = unpopulate(val, "Cors", &g.Cors)
+			delete(rawMsg, key)
+		case "externalAuth":
+			err = unpopulate(val, "ExternalAuth", &g.ExternalAuth)
+			delete(rawMsg, key)
+		case "jwt":
+			err = unpopulate(val, "Jwt", &g.Jwt)
+			delete(rawMsg, key)
+		case "rateLimit":
+			err = unpopulate(val, "RateLimit", &g.RateLimit)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GatewayProperties.
 func (g GatewayProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1749,6 +2018,7 @@ func (g GatewayProperties) MarshalJSON() ([]byte, error) {
 	populate(objectMap, "environment", g.Environment)
 	populate(objectMap, "hostname", g.Hostname)
 	populate(objectMap, "internal", g.Internal)
+	populate(objectMap, "policies", g.Policies)
 	populate(objectMap, "provisioningState", g.ProvisioningState)
 	populate(objectMap, "routes", g.Routes)
 	populate(objectMap, "status", g.Status)
@@ -1778,6 +2048,9 @@ func (g *GatewayProperties) UnmarshalJSON(data []byte) error {
 		case "internal":
 			err = unpopulate(val, "Internal", &g.Internal)
 			delete(rawMsg, key)
+		case "policies":
+			err = unpopulate(val, "Policies", &g.Policies)
+			delete(rawMsg, key)
 		case "provisioningState":
 			err = unpopulate(val, "ProvisioningState", &g.ProvisioningState)
 			delete(rawMsg, key)
@@ -1801,6 +2074,41 @@ func (g *GatewayProperties) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type GatewayRateLimitPolicy.
+func (g GatewayRateLimitPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "burst", g.Burst)
+	populate(objectMap, "requests", g.Requests)
+	populate(objectMap, "unit", g.Unit)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRateLimitPolicy.
+func (g *GatewayRateLimitPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "burst":
+			err = unpopulate(val, "Burst", &g.Burst)
+			delete(rawMsg, key)
+		case "requests":
+			err = unpopulate(val, "Requests", &g.Requests)
+			delete(rawMsg, key)
+		case "unit":
+			err = unpopulate(val, "Unit", &g.Unit)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GatewayResource.
 func (g GatewayResource) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -1930,9 +2238,17 @@ func (g *GatewayResourceUpdate) UnmarshalJSON(data []byte) error {
 func (g GatewayRoute) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "destinations", g.Destinations)
 	populate(objectMap, "enableWebsockets", g.EnableWebsockets)
+	populate(objectMap, "headers", g.Headers)
+	populate(objectMap, "methods", g.Methods)
 	populate(objectMap, "path", g.Path)
+	populate(objectMap, "policies", g.Policies)
+	populate(objectMap, "queryParameters", g.QueryParameters)
 	populate(objectMap, "replacePrefix", g.ReplacePrefix)
+	populate(objectMap, "requestHeaders", g.RequestHeaders)
+	populate(objectMap, "responseHeaders", g.ResponseHeaders)
+	populate(objectMap, "retryPolicy", g.RetryPolicy)
 	populate(objectMap, "timeoutPolicy", g.TimeoutPolicy)
 	return json.Marshal(objectMap)
 }
@@ -1949,15 +2265,39 @@ func (g *GatewayRoute) UnmarshalJSON(data []byte) error {
 		case "destination":
 			err = unpopulate(val, "Destination", &g.Destination)
 			delete(rawMsg, key)
+		case "destinations":
+			err = unpopulate(val, "Destinations", &g.Destinations)
+			delete(rawMsg, key)
 		case "enableWebsockets":
 			err = unpopulate(val, "EnableWebsockets", &g.EnableWebsockets)
 			delete(rawMsg, key)
+		case "headers":
+			err = unpopulate(val, "Headers", &g.Headers)
+			delete(rawMsg, key)
+		case "methods":
+			err = unpopulate(val, "Methods", &g.Methods)
+			delete(rawMsg, key)
 		case "path":
 			err = unpopulate(val, "Path", &g.Path)
 			delete(rawMsg, key)
+		case "policies":
+			err = unpopulate(val, "Policies", &g.Policies)
+			delete(rawMsg, key)
+		case "queryParameters":
+			err = unpopulate(val, "QueryParameters", &g.QueryParameters)
+			delete(rawMsg, key)
 		case "replacePrefix":
 			err = unpopulate(val, "ReplacePrefix", &g.ReplacePrefix)
 			delete(rawMsg, key)
+		case "requestHeaders":
+			err = unpopulate(val, "RequestHeaders", &g.RequestHeaders)
+			delete(rawMsg, key)
+		case "responseHeaders":
+			err = unpopulate(val, "ResponseHeaders", &g.ResponseHeaders)
+			delete(rawMsg, key)
+		case "retryPolicy":
+			err = unpopulate(val, "RetryPolicy", &g.RetryPolicy)
+			delete(rawMsg, key)
 		case "timeoutPolicy":
 			err = unpopulate(val, "TimeoutPolicy", &g.TimeoutPolicy)
 			delete(rawMsg, key)
@@ -1969,6 +2309,216 @@ func (g *GatewayRoute) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteDestination.
+func (g GatewayRouteDestination) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "destination", g.Destination)
+	populate(objectMap, "weight", g.Weight)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteDestination.
+func (g *GatewayRouteDestination) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "destination":
+			err = unpopulate(val, "Destination", &g.Destination)
+			delete(rawMsg, key)
+		case "weight":
+			err = unpopulate(val, "Weight", &g.Weight)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteHeaderMatch.
+func (g GatewayRouteHeaderMatch) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "contains", g.Contains)
+	populate(objectMap, "exact", g.Exact)
+	populate(objectMap, "name", g.Name)
+	populate(objectMap, "present", g.Present)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteHeaderMatch.
+func (g *GatewayRouteHeaderMatch) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "contains":
+			err = unpopulate(val, "Contains", &g.Contains)
+			delete(rawMsg, key)
+		case "exact":
+			err = unpopulate(val, "Exact", &g.Exact)
+			delete(rawMsg, key)
+		case "name":
+			err = unpopulate(val, "Name", &g.Name)
+			delete(rawMsg, key)
+		case "present":
+			err = unpopulate(val, "Present", &g.Present)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteHeadersPolicy.
+func (g GatewayRouteHeadersPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "remove", g.Remove)
+	populate(objectMap, "set", g.Set)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteHeadersPolicy.
+func (g *GatewayRouteHeadersPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "remove":
+			err = unpopulate(val, "Remove", &g.Remove)
+			delete(rawMsg, key)
+		case "set":
+			err = unpopulate(val, "Set", &g.Set)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRoutePolicies.
+func (g GatewayRoutePolicies) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "anonymous", g.Anonymous)
+	populate(objectMap, "jwt", g.Jwt)
+	populate(objectMap, "rateLimit", g.RateLimit)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRoutePolicies.
+func (g *GatewayRoutePolicies) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "anonymous":
+			err = unpopulate(val, "Anonymous", &g.Anonymous)
+			delete(rawMsg, key)
+		case "jwt":
+			err = unpopulate(val, "Jwt", &g.Jwt)
+			delete(rawMsg, key)
+		case "rateLimit":
+			err = unpopulate(val, "RateLimit", &g.RateLimit)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteQueryParameterMatch.
+func (g GatewayRouteQueryParameterMatch) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "contains", g.Contains)
+	populate(objectMap, "exact", g.Exact)
+	populate(objectMap, "name", g.Name)
+	populate(objectMap, "present", g.Present)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteQueryParameterMatch.
+func (g *GatewayRouteQueryParameterMatch) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "contains":
+			err = unpopulate(val, "Contains", &g.Contains)
+			delete(rawMsg, key)
+		case "exact":
+			err = unpopulate(val, "Exact", &g.Exact)
+			delete(rawMsg, key)
+		case "name":
+			err = unpopulate(val, "Name", &g.Name)
+			delete(rawMsg, key)
+		case "present":
+			err = unpopulate(val, "Present", &g.Present)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaller interface for type GatewayRouteRetryPolicy.
+func (g GatewayRouteRetryPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "attempts", g.Attempts)
+	populate(objectMap, "perTryTimeout", g.PerTryTimeout)
+	populate(objectMap, "retryOn", g.RetryOn)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type GatewayRouteRetryPolicy.
+func (g *GatewayRouteRetryPolicy) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", g, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "attempts":
+			err = unpopulate(val, "Attempts", &g.Attempts)
+			delete(rawMsg, key)
+		case "perTryTimeout":
+			err = unpopulate(val, "PerTryTimeout", &g.PerTryTimeout)
+			delete(rawMsg, key)
+		case "retryOn":
+			err = unpopulate(val, "RetryOn", &g.RetryOn)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", g, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type GatewayRouteTimeoutPolicy.
 func (g GatewayRouteTimeoutPolicy) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -2004,6 +2554,8 @@ func (g *GatewayRouteTimeoutPolicy) UnmarshalJSON(data []byte) error {
 func (g GatewayTLS) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
 	populate(objectMap, "certificateFrom", g.CertificateFrom)
+	populate(objectMap, "certificateIssuer", g.CertificateIssuer)
+	populate(objectMap, "certificateStatus", g.CertificateStatus)
 	populate(objectMap, "minimumProtocolVersion", g.MinimumProtocolVersion)
 	populate(objectMap, "sslPassthrough", g.SSLPassthrough)
 	return json.Marshal(objectMap)
@@ -2021,6 +2573,12 @@ func (g *GatewayTLS) UnmarshalJSON(data []byte) error {
 		case "certificateFrom":
 			err = unpopulate(val, "CertificateFrom", &g.CertificateFrom)
 			delete(rawMsg, key)
+		case "certificateIssuer":
+			err = unpopulate(val, "CertificateIssuer", &g.CertificateIssuer)
+			delete(rawMsg, key)
+		case "certificateStatus":
+			err = unpopulate(val, "CertificateStatus", &g.CertificateStatus)
+			delete(rawMsg, key)
 		case "minimumProtocolVersion":
 			err = unpopulate(val, "MinimumProtocolVersion", &g.MinimumProtocolVersion)
 			delete(rawMsg, key)
@@ -2230,6 +2788,37 @@ func (i *IdentitySettings) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type IngressProperties.
+func (i IngressProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "gatewayClassName", i.GatewayClassName)
+	populate(objectMap, "kind", i.Kind)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type IngressProperties.
+func (i *IngressProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", i, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "gatewayClassName":
+			err = unpopulate(val, "GatewayClassName", &i.GatewayClassName)
+			delete(rawMsg, key)
+		case "kind":
+			err = unpopulate(val, "Kind", &i.Kind)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", i, err)
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaller interface for type KeyObjectProperties.
 func (k KeyObjectProperties) MarshalJSON() ([]byte, error) {
 	objectMap := make(map[string]any)
@@ -3899,6 +4488,73 @@ func (v *VolumeResourceUpdate) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaller interface for type WorkloadProperties.
+func (w WorkloadProperties) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]any)
+	populate(objectMap, "activeDeadlineSeconds", w.ActiveDeadlineSeconds)
+	populate(objectMap, "backoffLimit", w.BackoffLimit)
+	populate(objectMap, "completions", w.Completions)
+	populate(objectMap, "concurrencyPolicy", w.ConcurrencyPolicy)
+	populate(objectMap, "failedJobsHistoryLimit", w.FailedJobsHistoryLimit)
+	populate(objectMap, "kind", w.Kind)
+	populate(objectMap, "parallelism", w.Parallelism)
+	populate(objectMap, "schedule", w.Schedule)
+	populate(objectMap, "successfulJobsHistoryLimit", w.SuccessfulJobsHistoryLimit)
+	populate(objectMap, "suspend", w.Suspend)
+	populate(objectMap, "ttlSecondsAfterFinished", w.TTLSecondsAfterFinished)
+	return json.Marshal(objectMap)
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface for type WorkloadProperties.
+func (w *WorkloadProperties) UnmarshalJSON(data []byte) error {
+	var rawMsg map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawMsg); err != nil {
+		return fmt.Errorf("unmarshalling type %T: %v", w, err)
+	}
+	for key, val := range rawMsg {
+		var err error
+		switch key {
+		case "activeDeadlineSeconds":
+			err = unpopulate(val, "ActiveDeadlineSeconds", &w.ActiveDeadlineSeconds)
+			delete(rawMsg, key)
+		case "backoffLimit":
+			err = unpopulate(val, "BackoffLimit", &w.BackoffLimit)
+			delete(rawMsg, key)
+		case "completions":
+			err = unpopulate(val, "Completions", &w.Completions)
+			delete(rawMsg, key)
+		case "concurrencyPolicy":
+			err = unpopulate(val, "ConcurrencyPolicy", &w.ConcurrencyPolicy)
+			delete(rawMsg, key)
+		case "failedJobsHistoryLimit":
+			err = unpopulate(val, "FailedJobsHistoryLimit", &w.FailedJobsHistoryLimit)
+			delete(rawMsg, key)
+		case "kind":
+			err = unpopulate(val, "Kind", &w.Kind)
+			delete(rawMsg, key)
+		case "parallelism":
+			err = unpopulate(val, "Parallelism", &w.Parallelism)
+			delete(rawMsg, key)
+		case "schedule":
+			err = unpopulate(val, "Schedule", &w.Schedule)
+			delete(rawMsg, key)
+		case "successfulJobsHistoryLimit":
+			err = unpopulate(val, "SuccessfulJobsHistoryLimit", &w.SuccessfulJobsHistoryLimit)
+			delete(rawMsg, key)
+		case "suspend":
+			err = unpopulate(val, "Suspend", &w.Suspend)
+			delete(rawMsg, key)
+		case "ttlSecondsAfterFinished":
+			err = unpopulate(val, "TTLSecondsAfterFinished", &w.TTLSecondsAfterFinished)
+			delete(rawMsg, key)
+		}
+		if err != nil {
+			return fmt.Errorf("unmarshalling type %T: %v", w, err)
+		}
+	}
+	return nil
+}
+
 func populate(m map[string]any, k string, v any) {
 	if v == nil {
 		return
@@ -29,6 +29,7 @@ import (
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 
 	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/handlers"
 	"github.com/radius-project/radius/pkg/corerp/model"
@@ -38,6 +39,7 @@ import (
 	dsrp_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
 	dynamicrp_dm "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
 	msg_dm "github.com/radius-project/radius/pkg/messagingrp/datamodel"
 	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
 	"github.com/radius-project/radius/pkg/portableresources"
@@ -59,8 +61,8 @@ type DeploymentProcessor interface {
 }
 
 // NewDeploymentProcessor creates a new instance of the DeploymentProcessor struct with the given parameters.
-func NewDeploymentProcessor(appmodel model.ApplicationModel, databaseClient database.Client, k8sClient controller_runtime.Client, k8sClientSet kubernetes.Interface) DeploymentProcessor {
-	return &deploymentProcessor{appmodel: appmodel, databaseClient: databaseClient, k8sClient: k8sClient, k8sClientSet: k8sClientSet}
+func NewDeploymentProcessor(appmodel model.ApplicationModel, databaseClient database.Client, k8sClient controller_runtime.Client, k8sClientSet kubernetes.Interface, secretProvider *secretprovider.SecretProvider) DeploymentProcessor {
+	return &deploymentProcessor{appmodel: appmodel, databaseClient: databaseClient, k8sClient: k8sClient, k8sClientSet: k8sClientSet, secretProvider: secretProvider}
 }
 
 var _ DeploymentProcessor = (*deploymentProcessor)(nil)
@@ -72,6 +74,8 @@ type deploymentProcessor struct {
 	k8sClient controller_runtime.Client
 	// k8sClientSet is the Kubernetes client.
 	k8sClientSet kubernetes.Interface
+	// secretProvider provides the secret store holding the recipe secrets of dynamic resources.
+	secretProvider *secretprovider.SecretProvider
 }
 
 type ResourceData struct {
@@ -425,6 +429,8 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		logger.V(ucplog.LevelDebug).Info("environment is a simulated environment.")
 	}
 
+	envOpts.Ingress = env.Properties.Ingress
+
 	// Get Environment KubernetesMetadata Info
 	if envExt := corerp_dm.FindExtension(env.Properties.Extensions, corerp_dm.KubernetesMetadata); envExt != nil && envExt.KubernetesMetadata != nil {
 		envOpts.KubernetesMetadata = envExt.KubernetesMetadata
@@ -454,7 +460,9 @@ func (dp *deploymentProcessor) getEnvOptions(ctx context.Context, env *corerp_dm
 		return envOpts, nil
 	}
 
-	if dp.k8sClient != nil {
+	// The public endpoint of a Gateway API implementation is only known once the Gateway is programmed, so the
+	// contour-envoy service is only used to find the public endpoint when gateways are rendered for Contour.
+	if dp.k8sClient != nil && env.Properties.GetIngressKind() == corerp_dm.IngressKindContour {
 		// Find the public endpoint of the cluster (External IP or hostname of the contour-envoy service)
 		var services corev1.ServiceList
 		err := dp.k8sClient.List(ctx, &services, &controller_runtime.ListOptions{Namespace: "radius-system"})
@@ -590,8 +598,33 @@ func (dp *deploymentProcessor) getResourceDataByID(ctx context.Context, resource
 
 		// At present, we combine secret data with computed values into bindings for UDT.
 		// Note: UDTs currently do not have full support for secrets.
-		return dp.buildResourceDependency(resourceID, obj.ResourceMetadata().ApplicationID(), obj, obj.OutputResources(), obj.GetComputedValues(), obj.GetSecrets(), portableresources.RecipeData{})
+		secretValues, err := dp.loadRecipeSecrets(ctx, resourceID)
+		if err != nil {
+			return ResourceData{}, fmt.Errorf(errMsg, resourceID.String(), err)
+		}
+		return dp.buildResourceDependency(resourceID, obj.ResourceMetadata().ApplicationID(), obj, obj.OutputResources(), obj.GetComputedValues(), secretValues, portableresources.RecipeData{})
+	}
+}
+
+// loadRecipeSecrets returns the secret outputs of the recipe of a dynamic resource. They are stored in the secret store
+// instead of the database.
+func (dp *deploymentProcessor) loadRecipeSecrets(ctx context.Context, resourceID resources.ID) (map[string]rpv1.SecretValueReference, error) {
+	client, err := dp.secretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
 	}
+
+	secrets, err := sensitive.LoadRecipeSecrets(ctx, client, resourceID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	secretValues := map[string]rpv1.SecretValueReference{}
+	for key, value := range secrets {
+		secretValues[key] = rpv1.SecretValueReference{Value: value}
+	}
+
+	return secretValues, nil
 }
 
 func (dp *deploymentProcessor) buildResourceDependency(resourceID resources.ID, applicationID string, resource v1.DataModelInterface, outputResources []rpv1.OutputResource, computedValues map[string]any, secretValues map[string]rpv1.SecretValueReference, recipeData portableresources.RecipeData) (ResourceData, error) {
@@ -26,6 +26,8 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/azure/clientv2"
 	"github.com/radius-project/radius/pkg/components/database"
+	secretinmemory "github.com/radius-project/radius/pkg/components/secret/inmemory"
+	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/corerp/handlers"
 	"github.com/radius-project/radius/pkg/corerp/model"
@@ -33,6 +35,7 @@ import (
 	"github.com/radius-project/radius/pkg/corerp/renderers/container"
 	dsrp_dm "github.com/radius-project/radius/pkg/datastoresrp/datamodel"
 	dynamicrp_dm "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
 	"github.com/radius-project/radius/pkg/portableresources"
 	pr_dm "github.com/radius-project/radius/pkg/portableresources/datamodel"
 	pr_renderers "github.com/radius-project/radius/pkg/portableresources/renderers"
@@ -329,7 +332,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render success", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -403,7 +406,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render success lowercase resourcetype", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getLowerCaseTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -453,7 +456,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render success uppercase resourcetype", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getUpperCaseTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -503,7 +506,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("verify render error", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -551,7 +554,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Resource not found in data store", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -566,7 +569,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Data store access error", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -580,7 +583,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Invalid resource type", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testInvalidResourceID := "/subscriptions/test-sub/resourceGroups/test-group/providers/Applications.foo/foo/foo"
 		testResource := getTestResource()
@@ -592,7 +595,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Invalid application id", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -614,7 +617,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Missing application id", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -635,7 +638,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Invalid application resource type", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -657,7 +660,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Missing output resource provider", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -708,7 +711,7 @@ func Test_Render(t *testing.T) {
 
 	t.Run("Unsupported output resource provider", func(t *testing.T) {
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -825,7 +828,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Verify deploy success", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -865,7 +868,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Verify deploy success with simulated env", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -883,7 +886,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Verify deploy failure", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -900,7 +903,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Output resource dependency missing local ID", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -918,7 +921,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Invalid output resource type", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -936,7 +939,7 @@ func Test_Deploy(t *testing.T) {
 	t.Run("Missing output resource identity", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		testRendererOutput := getTestRendererOutput()
@@ -962,7 +965,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete success", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -976,7 +979,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete failure", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -990,7 +993,7 @@ func Test_Delete(t *testing.T) {
 	t.Run("Verify delete with no output resources", func(t *testing.T) {
 		ctx := testcontext.New(t)
 		mocks := setup(t)
-		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+		dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, nil}
 
 		testResource := getTestResource()
 		resourceID := getTestResourceID(testResource.ID)
@@ -1006,7 +1009,7 @@ func Test_Delete(t *testing.T) {
 func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
 	ctx := testcontext.New(t)
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, nil, nil, nil}
+	dp := deploymentProcessor{mocks.model, nil, nil, nil, nil}
 
 	env := &datamodel.Environment{
 		BaseResource: v1.BaseResource{
@@ -1071,7 +1074,10 @@ func Test_getEnvOptions_PublicEndpointOverride(t *testing.T) {
 func Test_getResourceDataByID(t *testing.T) {
 	ctx := testcontext.New(t)
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil}
+	secretClient := &secretinmemory.Client{}
+	secretProvider := secretprovider.NewSecretProvider(secretprovider.SecretProviderOptions{})
+	secretProvider.SetClient(secretClient)
+	dp := deploymentProcessor{mocks.model, mocks.databaseClient, nil, nil, secretProvider}
 
 	t.Run("Get recipe data from connected mongoDB resources", func(t *testing.T) {
 		depId, _ := resources.ParseResource("/subscriptions/test-subscription/resourceGroups/test-resource-group/providers/Applications.Datastores/mongoDatabases/test-mongo")
@@ -1104,9 +1110,13 @@ func Test_getResourceDataByID(t *testing.T) {
 
 		mocks.databaseClient.EXPECT().Get(gomock.Any(), gomock.Any()).Times(1).Return(&mr, nil)
 
+		err := sensitive.SaveRecipeSecrets(ctx, secretClient, postgresResource.ID, map[string]string{"password": "s3cr3t"})
+		require.NoError(t, err)
+
 		resourceData, err := dp.getResourceDataByID(ctx, depId)
 		require.NoError(t, err)
 		require.Equal(t, resourceData.OutputResources, postgresResource.OutputResources())
+		require.Equal(t, map[string]rpv1.SecretValueReference{"password": {Value: "s3cr3t"}}, resourceData.SecretValues)
 
 	})
 }
@@ -1115,7 +1125,7 @@ func Test_fetchSecrets(t *testing.T) {
 	ctx := testcontext.New(t)
 
 	mocks := setup(t)
-	dp := deploymentProcessor{mocks.model, nil, nil, nil}
+	dp := deploymentProcessor{mocks.model, nil, nil, nil, nil}
 
 	t.Run("Get secrets from recipe data when resource has associated recipe", func(t *testing.T) {
 		mongoResource := buildMongoDBResourceDataWithRecipeAndSecrets()
@@ -79,6 +79,67 @@ type ContainerProperties struct {
 	Resources            []ResourceReference             `json:"resources,omitempty"`
 	ResourceProvisioning ContainerResourceProvisioning   `json:"resourceProvisioning,omitempty"`
 	RestartPolicy        string                          `json:"restartPolicy,omitempty"`
+	Workload             *WorkloadProperties             `json:"workload,omitempty"`
+}
+
+// GetWorkloadKind returns the kind of workload used to run the container, or deployment if the
+// workload is not specified.
+func (c ContainerProperties) GetWorkloadKind() WorkloadKind {
+	if c.Workload == nil || c.Workload.Kind == "" {
+		return WorkloadKindDeployment
+	}
+
+	return c.Workload.Kind
+}
+
+// WorkloadKind specifies the kind of workload used to run the container.
+type WorkloadKind string
+
+const (
+	// WorkloadKindDeployment runs the container as a long-running service.
+	WorkloadKindDeployment WorkloadKind = "deployment"
+
+	// WorkloadKindJob runs the container to completion once.
+	WorkloadKindJob WorkloadKind = "job"
+
+	// WorkloadKindCronJob runs the container to completion on a schedule.
+	WorkloadKindCronJob WorkloadKind = "cronJob"
+)
+
+// WorkloadProperties specifies the workload used to run the container.
+type WorkloadProperties struct {
+	// Kind is the kind of workload used to run the container.
+	Kind WorkloadKind `json:"kind,omitempty"`
+
+	// Schedule is the schedule in Cron format. Only used for cronJob workloads.
+	Schedule string `json:"schedule,omitempty"`
+
+	// Completions is the number of successfully finished pods required for a run to complete.
+	Completions *int32 `json:"completions,omitempty"`
+
+	// Parallelism is the maximum number of pods that run in parallel for a run.
+	Parallelism *int32 `json:"parallelism,omitempty"`
+
+	// BackoffLimit is the number of retries before a run is marked as failed.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// ActiveDeadlineSeconds is the duration in seconds that a run may be active before it is terminated.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// TTLSecondsAfterFinished is the duration in seconds after which a finished run is cleaned up.
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// ConcurrencyPolicy specifies how concurrent runs of a cronJob workload are handled.
+	ConcurrencyPolicy string `json:"concurrencyPolicy,omitempty"`
+
+	// Suspend suspends subsequent runs of a cronJob workload.
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// SuccessfulJobsHistoryLimit is the number of successful finished runs of a cronJob workload to retain.
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+
+	// FailedJobsHistoryLimit is the number of failed finished runs of a cronJob workload to retain.
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
 }
 
 // ContainerResourceProvisioning specifies how resources should be created for the container.
@@ -42,10 +42,40 @@ type EnvironmentProperties struct {
 	Recipes      map[string]map[string]EnvironmentRecipeProperties `json:"recipes,omitempty"`
 	Providers    Providers                                         `json:"providers,omitempty"`
 	RecipeConfig RecipeConfigProperties                            `json:"recipeConfig,omitempty"`
+	Ingress      *IngressProperties                                `json:"ingress,omitempty"`
 	Extensions   []Extension                                       `json:"extensions,omitempty"`
 	Simulated    bool                                              `json:"simulated,omitempty"`
 }
 
+// IngressKind represents the ingress implementation used to expose gateways.
+type IngressKind string
+
+const (
+	// IngressKindContour renders gateways as Contour HTTPProxy resources. This is the default.
+	IngressKindContour IngressKind = "contour"
+
+	// IngressKindGatewayAPI renders gateways as Kubernetes Gateway API resources.
+	IngressKindGatewayAPI IngressKind = "gatewayAPI"
+)
+
+// IngressProperties represents the ingress implementation of the environment.
+type IngressProperties struct {
+	// Kind is the ingress implementation.
+	Kind IngressKind `json:"kind"`
+
+	// GatewayClassName is the name of the GatewayClass used by the rendered Gateway resources.
+	GatewayClassName string `json:"gatewayClassName,omitempty"`
+}
+
+// GetIngressKind returns the ingress implementation of the environment, defaulting to Contour.
+func (e EnvironmentProperties) GetIngressKind() IngressKind {
+	if e.Ingress == nil || e.Ingress.Kind == "" {
+		return IngressKindContour
+	}
+
+	return e.Ingress.Kind
+}
+
 // EnvironmentRecipeProperties represents the properties of environment's recipe.
 type EnvironmentRecipeProperties struct {
 	TemplateKind    string         `json:"templateKind"`
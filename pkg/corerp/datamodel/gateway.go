@@ -21,7 +21,14 @@ import (
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 )
 
-const GatewayResourceType = "Applications.Core/gateways"
+const (
+	GatewayResourceType = "Applications.Core/gateways"
+
+	// GatewayCertificateNotAfter is the computed value that holds the expiry time of the automatically issued certificate.
+	GatewayCertificateNotAfter = "certificateNotAfter"
+	// GatewayCertificateRenewalTime is the computed value that holds the renewal time of the automatically issued certificate.
+	GatewayCertificateRenewalTime = "certificateRenewalTime"
+)
 
 // Gateway represents Gateway resource.
 type Gateway struct {
@@ -47,6 +54,14 @@ func (g *Gateway) ApplyDeploymentOutput(do rpv1.DeploymentOutput) error {
 	if url, ok := do.ComputedValues["url"].(string); ok {
 		g.Properties.URL = url
 	}
+	if g.Properties.TLS != nil && g.Properties.TLS.CertificateIssuer != nil {
+		notAfter, _ := do.ComputedValues[GatewayCertificateNotAfter].(string)
+		renewalTime, _ := do.ComputedValues[GatewayCertificateRenewalTime].(string)
+		g.Properties.TLS.CertificateStatus = &GatewayCertificateStatus{
+			NotAfter:    notAfter,
+			RenewalTime: renewalTime,
+		}
+	}
 	return nil
 }
 
@@ -66,17 +81,77 @@ type GatewayProperties struct {
 	Internal bool                       `json:"internal,omitempty"`
 	Hostname *GatewayPropertiesHostname `json:"hostname,omitempty"`
 	TLS      *GatewayPropertiesTLS      `json:"tls,omitempty"`
+	Policies *GatewayPolicies           `json:"policies,omitempty"`
 	Routes   []GatewayRoute             `json:"routes,omitempty"`
 	URL      string                     `json:"url,omitempty"`
 }
 
 // GatewayRoute represents the route attached to Gateway.
 type GatewayRoute struct {
-	Destination      string                     `json:"destination,omitempty"`
-	Path             string                     `json:"path,omitempty"`
-	ReplacePrefix    string                     `json:"replacePrefix,omitempty"`
-	EnableWebsockets bool                       `json:"enableWebsockets,omitempty"`
-	TimeoutPolicy    *GatewayRouteTimeoutPolicy `json:"timeoutPolicy,omitempty"`
+	Destination      string                            `json:"destination,omitempty"`
+	Path             string                            `json:"path,omitempty"`
+	ReplacePrefix    string                            `json:"replacePrefix,omitempty"`
+	EnableWebsockets bool                              `json:"enableWebsockets,omitempty"`
+	TimeoutPolicy    *GatewayRouteTimeoutPolicy        `json:"timeoutPolicy,omitempty"`
+	Destinations     []GatewayRouteDestination         `json:"destinations,omitempty"`
+	Headers          []GatewayRouteHeaderMatch         `json:"headers,omitempty"`
+	QueryParameters  []GatewayRouteQueryParameterMatch `json:"queryParameters,omitempty"`
+	Methods          []string                          `json:"methods,omitempty"`
+	RequestHeaders   *GatewayRouteHeadersPolicy        `json:"requestHeaders,omitempty"`
+	ResponseHeaders  *GatewayRouteHeadersPolicy        `json:"responseHeaders,omitempty"`
+	RetryPolicy      *GatewayRouteRetryPolicy          `json:"retryPolicy,omitempty"`
+	Policies         *GatewayRoutePolicies             `json:"policies,omitempty"`
+}
+
+// GetDestinations returns the weighted destinations of the route. A route with a single destination returns
+// that destination without a weight.
+func (r GatewayRoute) GetDestinations() []GatewayRouteDestination {
+	if len(r.Destinations) > 0 {
+		return r.Destinations
+	}
+
+	return []GatewayRouteDestination{{Destination: r.Destination}}
+}
+
+// HasRequestMatches returns true if the route matches requests on headers, query parameters or methods
+// in addition to the path.
+func (r GatewayRoute) HasRequestMatches() bool {
+	return len(r.Headers) > 0 || len(r.QueryParameters) > 0 || len(r.Methods) > 0
+}
+
+// GatewayRouteDestination represents a weighted destination of a GatewayRoute.
+type GatewayRouteDestination struct {
+	Destination string `json:"destination,omitempty"`
+	Weight      int32  `json:"weight,omitempty"`
+}
+
+// GatewayRouteHeaderMatch represents a condition on a request header of a GatewayRoute.
+type GatewayRouteHeaderMatch struct {
+	Name     string `json:"name,omitempty"`
+	Exact    string `json:"exact,omitempty"`
+	Contains string `json:"contains,omitempty"`
+	Present  bool   `json:"present,omitempty"`
+}
+
+// GatewayRouteQueryParameterMatch represents a condition on a query parameter of a GatewayRoute.
+type GatewayRouteQueryParameterMatch struct {
+	Name     string `json:"name,omitempty"`
+	Exact    string `json:"exact,omitempty"`
+	Contains string `json:"contains,omitempty"`
+	Present  bool   `json:"present,omitempty"`
+}
+
+// GatewayRouteHeadersPolicy represents the headers to set or remove on the requests or responses of a GatewayRoute.
+type GatewayRouteHeadersPolicy struct {
+	Set    map[string]string `json:"set,omitempty"`
+	Remove []string          `json:"remove,omitempty"`
+}
+
+// GatewayRouteRetryPolicy represents the retry policy for GatewayRoute.
+type GatewayRouteRetryPolicy struct {
+	Attempts      int32    `json:"attempts,omitempty"`
+	PerTryTimeout string   `json:"perTryTimeout,omitempty"`
+	RetryOn       []string `json:"retryOn,omitempty"`
 }
 
 // GatewayRouteTimeoutPolicy represents the timeout policy for GatewayRoute.
@@ -85,6 +160,75 @@ type GatewayRouteTimeoutPolicy struct {
 	BackendRequest string `json:"backendRequest,omitempty"`
 }
 
+// GatewayPolicies represents the authentication, rate limiting and CORS policies applied to all routes of a Gateway.
+type GatewayPolicies struct {
+	JWT          *GatewayJWTPolicy          `json:"jwt,omitempty"`
+	ExternalAuth *GatewayExternalAuthPolicy `json:"externalAuth,omitempty"`
+	BasicAuth    *GatewayBasicAuthPolicy    `json:"basicAuth,omitempty"`
+	RateLimit    *GatewayRateLimitPolicy    `json:"rateLimit,omitempty"`
+	CORS         *GatewayCORSPolicy         `json:"cors,omitempty"`
+}
+
+// GatewayRoutePolicies represents the authentication and rate limiting policies of a GatewayRoute. Route policies take
+// precedence over the policies of the Gateway.
+type GatewayRoutePolicies struct {
+	JWT       *GatewayJWTPolicy       `json:"jwt,omitempty"`
+	RateLimit *GatewayRateLimitPolicy `json:"rateLimit,omitempty"`
+	Anonymous bool                    `json:"anonymous,omitempty"`
+}
+
+// GatewayJWTPolicy represents the policy to verify the JSON Web Tokens of requests.
+type GatewayJWTPolicy struct {
+	Issuer            string   `json:"issuer,omitempty"`
+	Audiences         []string `json:"audiences,omitempty"`
+	JWKSURI           string   `json:"jwksUri,omitempty"`
+	CACertificateFrom string   `json:"caCertificateFrom,omitempty"`
+	ForwardToken      bool     `json:"forwardToken,omitempty"`
+}
+
+// GatewayExternalAuthPolicy represents the policy to authorize requests with an external authorization service.
+type GatewayExternalAuthPolicy struct {
+	Destination       string `json:"destination,omitempty"`
+	CACertificateFrom string `json:"caCertificateFrom,omitempty"`
+	Timeout           string `json:"timeout,omitempty"`
+	FailOpen          bool   `json:"failOpen,omitempty"`
+}
+
+// GatewayBasicAuthPolicy represents the policy to authenticate requests with HTTP basic authentication. The users and
+// their password hashes are read in htpasswd format from the '.htpasswd' key of the secret store.
+type GatewayBasicAuthPolicy struct {
+	SecretFrom string `json:"secretFrom,omitempty"`
+}
+
+// GatewayRateLimitUnit represents the unit of time of a rate limit.
+type GatewayRateLimitUnit string
+
+const (
+	// GatewayRateLimitUnitSecond limits the requests per second.
+	GatewayRateLimitUnitSecond GatewayRateLimitUnit = "second"
+	// GatewayRateLimitUnitMinute limits the requests per minute.
+	GatewayRateLimitUnitMinute GatewayRateLimitUnit = "minute"
+	// GatewayRateLimitUnitHour limits the requests per hour.
+	GatewayRateLimitUnitHour GatewayRateLimitUnit = "hour"
+)
+
+// GatewayRateLimitPolicy represents the policy to limit the rate of requests.
+type GatewayRateLimitPolicy struct {
+	Requests int32                `json:"requests,omitempty"`
+	Unit     GatewayRateLimitUnit `json:"unit,omitempty"`
+	Burst    int32                `json:"burst,omitempty"`
+}
+
+// GatewayCORSPolicy represents the cross-origin resource sharing policy of a Gateway.
+type GatewayCORSPolicy struct {
+	AllowOrigins     []string `json:"allowOrigins,omitempty"`
+	AllowMethods     []string `json:"allowMethods,omitempty"`
+	AllowHeaders     []string `json:"allowHeaders,omitempty"`
+	ExposeHeaders    []string `json:"exposeHeaders,omitempty"`
+	AllowCredentials bool     `json:"allowCredentials,omitempty"`
+	MaxAge           string   `json:"maxAge,omitempty"`
+}
+
 // GatewayPropertiesHostname - Declare hostname information for the Gateway.
 type GatewayPropertiesHostname struct {
 	FullyQualifiedHostname string `json:"fullyQualifiedHostname,omitempty"`
@@ -96,6 +240,37 @@ type GatewayPropertiesTLS struct {
 	SSLPassthrough         bool                      `json:"sslPassthrough,omitempty"`
 	MinimumProtocolVersion MinimumTLSProtocolVersion `json:"minimumProtocolVersion,omitempty"`
 	CertificateFrom        string                    `json:"certificateFrom,omitempty"`
+	CertificateIssuer      *GatewayCertificateIssuer `json:"certificateIssuer,omitempty"`
+	CertificateStatus      *GatewayCertificateStatus `json:"certificateStatus,omitempty"`
+}
+
+// HasCertificate returns true if the gateway terminates TLS, either with the certificate from a secret store or with an
+// automatically issued certificate.
+func (t *GatewayPropertiesTLS) HasCertificate() bool {
+	return t != nil && (t.CertificateFrom != "" || t.CertificateIssuer != nil)
+}
+
+// GatewayCertificateIssuerKind represents the kind of issuer of an automatically issued gateway certificate.
+type GatewayCertificateIssuerKind string
+
+const (
+	// GatewayCertificateIssuerKindACME issues the certificate from an ACME certificate authority through a cert-manager ClusterIssuer.
+	GatewayCertificateIssuerKindACME GatewayCertificateIssuerKind = "acme"
+	// GatewayCertificateIssuerKindSelfSigned issues the certificate from a self-signed certificate authority created for the gateway.
+	GatewayCertificateIssuerKindSelfSigned GatewayCertificateIssuerKind = "selfSigned"
+)
+
+// GatewayCertificateIssuer - Declare the issuer of the automatically issued TLS certificate of the Gateway.
+type GatewayCertificateIssuer struct {
+	Kind          GatewayCertificateIssuerKind `json:"kind,omitempty"`
+	ClusterIssuer string                       `json:"clusterIssuer,omitempty"`
+}
+
+// GatewayCertificateStatus - The expiry and renewal times of the automatically issued TLS certificate of the Gateway,
+// in RFC 3339 format.
+type GatewayCertificateStatus struct {
+	NotAfter    string `json:"notAfter,omitempty"`
+	RenewalTime string `json:"renewalTime,omitempty"`
 }
 
 // IsValid checks if the given MinimumTLSProtocolVersion is valid.
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -36,6 +37,7 @@ import (
 const (
 	manifestTargetProperty = "$.properties.runtimes.kubernetes.base"
 	podTargetProperty      = "$.properties.runtimes.kubernetes.pod"
+	workloadTargetProperty = "$.properties.workload"
 )
 
 // ValidateAndMutateRequest checks if the newResource has a user-defined identity and if so, returns a bad request
@@ -52,6 +54,10 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		newResource.Properties.Identity = oldResource.Properties.Identity
 	}
 
+	if err := validateWorkload(newResource); err != nil {
+		return rest.NewBadRequestARMResponse(v1.ErrorResponse{Error: err.(*v1.ErrorDetails)}), nil
+	}
+
 	runtimes := newResource.Properties.Runtimes
 	if runtimes != nil && runtimes.Kubernetes != nil {
 		if runtimes.Kubernetes.Base != "" {
@@ -88,6 +94,77 @@ func validatePodSpec(patch []byte) error {
 	return nil
 }
 
+// validateWorkload validates that the workload settings are consistent with the workload kind. Job and cronJob
+// workloads run to completion, so they cannot be scaled manually or restarted always.
+func validateWorkload(newResource *datamodel.ContainerResource) error {
+	properties := newResource.Properties
+	workload := properties.Workload
+	if workload == nil {
+		return nil
+	}
+
+	errDetails := []*v1.ErrorDetails{}
+	addError := func(message string) {
+		errDetails = append(errDetails, &v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  workloadTargetProperty,
+			Message: message,
+		})
+	}
+
+	kind := properties.GetWorkloadKind()
+	switch kind {
+	case datamodel.WorkloadKindDeployment:
+		if workload.Completions != nil || workload.Parallelism != nil ||
+			workload.BackoffLimit != nil || workload.ActiveDeadlineSeconds != nil || workload.TTLSecondsAfterFinished != nil {
+			addError("job settings are only allowed when workload kind is 'job' or 'cronJob'.")
+		}
+	case datamodel.WorkloadKindJob, datamodel.WorkloadKindCronJob:
+		if properties.RestartPolicy == string(corev1.RestartPolicyAlways) {
+			addError(fmt.Sprintf("restartPolicy 'Always' is not allowed when workload kind is '%s'.", kind))
+		}
+
+		if datamodel.FindExtension(properties.Extensions, datamodel.ManualScaling) != nil {
+			addError(fmt.Sprintf("manualScaling extension is not allowed when workload kind is '%s'.", kind))
+		}
+	default:
+		addError(fmt.Sprintf("workload kind '%s' is not supported. Supported kinds are 'deployment', 'job' and 'cronJob'.", kind))
+	}
+
+	if kind == datamodel.WorkloadKindCronJob {
+		if !isValidCronSchedule(workload.Schedule) {
+			addError(fmt.Sprintf("schedule '%s' is not a valid Cron schedule.", workload.Schedule))
+		}
+	} else if workload.Schedule != "" || workload.ConcurrencyPolicy != "" || workload.Suspend != nil ||
+		workload.SuccessfulJobsHistoryLimit != nil || workload.FailedJobsHistoryLimit != nil {
+		addError("schedule settings are only allowed when workload kind is 'cronJob'.")
+	}
+
+	if len(errDetails) == 1 {
+		return errDetails[0]
+	} else if len(errDetails) > 1 {
+		return &v1.ErrorDetails{
+			Code:    v1.CodeInvalidRequestContent,
+			Target:  workloadTargetProperty,
+			Message: "The workload includes invalid settings.",
+			Details: errDetails,
+		}
+	}
+
+	return nil
+}
+
+// isValidCronSchedule does a syntactic check of the Cron schedule. Kubernetes accepts either five fields or
+// one of the predefined macros such as @hourly.
+func isValidCronSchedule(schedule string) bool {
+	schedule = strings.TrimSpace(schedule)
+	if strings.HasPrefix(schedule, "@") {
+		return len(schedule) > 1
+	}
+
+	return len(strings.Fields(schedule)) == 5
+}
+
 func errMultipleResources(typeName string, num int) *v1.ErrorDetails {
 	return &v1.ErrorDetails{
 		Code:    v1.CodeInvalidRequestContent,
@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/k8sutil"
 	"github.com/stretchr/testify/require"
 )
@@ -191,6 +192,140 @@ func TestValidateAndMutateRequest_IdentityProperty(t *testing.T) {
 	}
 }
 
+func TestValidateAndMutateRequest_Workload(t *testing.T) {
+	requestTests := []struct {
+		desc       string
+		properties datamodel.ContainerProperties
+		errMessage string
+	}{
+		{
+			desc: "valid job",
+			properties: datamodel.ContainerProperties{
+				RestartPolicy: "OnFailure",
+				Workload: &datamodel.WorkloadProperties{
+					Kind:         datamodel.WorkloadKindJob,
+					Completions:  to.Ptr[int32](3),
+					BackoffLimit: to.Ptr[int32](2),
+				},
+			},
+		},
+		{
+			desc: "valid cronJob",
+			properties: datamodel.ContainerProperties{
+				Workload: &datamodel.WorkloadProperties{
+					Kind:              datamodel.WorkloadKindCronJob,
+					Schedule:          "0 * * * *",
+					ConcurrencyPolicy: "Forbid",
+				},
+			},
+		},
+		{
+			desc: "valid cronJob with macro",
+			properties: datamodel.ContainerProperties{
+				Workload: &datamodel.WorkloadProperties{
+					Kind:     datamodel.WorkloadKindCronJob,
+					Schedule: "@daily",
+				},
+			},
+		},
+		{
+			desc: "cronJob without schedule",
+			properties: datamodel.ContainerProperties{
+				Workload: &datamodel.WorkloadProperties{
+					Kind: datamodel.WorkloadKindCronJob,
+				},
+			},
+			errMessage: "schedule '' is not a valid Cron schedule.",
+		},
+		{
+			desc: "job with restart policy always",
+			properties: datamodel.ContainerProperties{
+				RestartPolicy: "Always",
+				Workload: &datamodel.WorkloadProperties{
+					Kind: datamodel.WorkloadKindJob,
+				},
+			},
+			errMessage: "restartPolicy 'Always' is not allowed when workload kind is 'job'.",
+		},
+		{
+			desc: "job with manual scaling",
+			properties: datamodel.ContainerProperties{
+				Extensions: []datamodel.Extension{
+					{
+						Kind:          datamodel.ManualScaling,
+						ManualScaling: &datamodel.ManualScalingExtension{Replicas: to.Ptr[int32](2)},
+					},
+				},
+				Workload: &datamodel.WorkloadProperties{
+					Kind: datamodel.WorkloadKindJob,
+				},
+			},
+			errMessage: "manualScaling extension is not allowed when workload kind is 'job'.",
+		},
+		{
+			desc: "job with schedule settings",
+			properties: datamodel.ContainerProperties{
+				Workload: &datamodel.WorkloadProperties{
+					Kind:              datamodel.WorkloadKindJob,
+					ConcurrencyPolicy: "Allow",
+				},
+			},
+			errMessage: "schedule settings are only allowed when workload kind is 'cronJob'.",
+		},
+		{
+			desc: "job with schedule",
+			properties: datamodel.ContainerProperties{
+				Workload: &datamodel.WorkloadProperties{
+					Kind:     datamodel.WorkloadKindJob,
+					Schedule: "0 * * * *",
+				},
+			},
+			errMessage: "schedule settings are only allowed when workload kind is 'cronJob'.",
+		},
+		{
+			desc: "unknown workload kind",
+			properties: datamodel.ContainerProperties{
+				Workload: &datamodel.WorkloadProperties{
+					Kind: "statefulSet",
+				},
+			},
+			errMessage: "workload kind 'statefulSet' is not supported. Supported kinds are 'deployment', 'job' and 'cronJob'.",
+		},
+		{
+			desc: "deployment with job settings",
+			properties: datamodel.ContainerProperties{
+				Workload: &datamodel.WorkloadProperties{
+					Kind:        datamodel.WorkloadKindDeployment,
+					Completions: to.Ptr[int32](1),
+				},
+			},
+			errMessage: "job settings are only allowed when workload kind is 'job' or 'cronJob'.",
+		},
+	}
+
+	for _, tc := range requestTests {
+		t.Run(tc.desc, func(t *testing.T) {
+			newResource := &datamodel.ContainerResource{Properties: tc.properties}
+			r, err := ValidateAndMutateRequest(context.Background(), newResource, nil, nil)
+			require.NoError(t, err)
+
+			if tc.errMessage == "" {
+				require.Nil(t, r)
+				return
+			}
+
+			expected := rest.NewBadRequestARMResponse(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Target:  workloadTargetProperty,
+					Message: tc.errMessage,
+				},
+			})
+			require.Equal(t, expected, r)
+		})
+	}
+}
+
 func TestValidateManifest(t *testing.T) {
 	fakeDeployment := fmt.Sprintf(k8sutil.FakeDeploymentTemplate, "magpie", "", "magpie")
 	fakeService := fmt.Sprintf(k8sutil.FakeServiceTemplate, "magpie", "")
@@ -18,15 +18,35 @@ package gateways
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
 
-// ValidateAndMutateRequest checks if the TLS configuration is valid and sets the TLS protocol version to 1.2 if it is not
-// specified. It returns a BadRequestResponse error if SSL Passthrough and TLS termination are both configured or if TLS
-// protocol version is set but certificateFrom is not.
+var (
+	// validRouteMethods are the HTTP methods that a gateway route can match on.
+	validRouteMethods = []string{
+		http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch,
+		http.MethodDelete, http.MethodConnect, http.MethodOptions, http.MethodTrace,
+	}
+
+	// validRetryOn are the conditions that a gateway route can retry a request on.
+	validRetryOn = []string{
+		"5xx", "gateway-error", "reset", "connect-failure", "retriable-4xx", "refused-stream",
+	}
+)
+
+// ValidateAndMutateRequest checks if the TLS configuration and the routes are valid and sets the TLS protocol version to
+// 1.2 if it is not specified. It returns a BadRequestResponse error if SSL Passthrough and TLS termination are both
+// configured, if the certificate issuer is invalid, if TLS protocol version is set without a certificate, or if a
+// route or a policy is invalid.
 func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *datamodel.Gateway, options *controller.Options) (rest.Response, error) {
 	if newResource.Properties.TLS != nil {
 		// If SSL Passthrough and TLS termination are both configured, then report an error
@@ -34,8 +54,23 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 			return rest.NewBadRequestResponse("Only one of $.properties.tls.certificateFrom and $.properties.tls.sslPassthrough can be specified at a time."), nil
 		}
 
-		// If TLS protocol version is set, then certificateFrom must be set
-		if newResource.Properties.TLS.MinimumProtocolVersion != "" && newResource.Properties.TLS.CertificateFrom == "" {
+		if issuer := newResource.Properties.TLS.CertificateIssuer; issuer != nil {
+			// The certificate is either issued automatically or read from a secret store
+			if newResource.Properties.TLS.SSLPassthrough || newResource.Properties.TLS.CertificateFrom != "" {
+				return rest.NewBadRequestResponse("Field $.properties.tls.certificateIssuer cannot be specified with $.properties.tls.certificateFrom or $.properties.tls.sslPassthrough."), nil
+			}
+
+			if issuer.Kind == datamodel.GatewayCertificateIssuerKindACME && issuer.ClusterIssuer == "" {
+				return rest.NewBadRequestResponse("Field $.properties.tls.certificateIssuer.clusterIssuer is required when $.properties.tls.certificateIssuer.kind is 'acme'."), nil
+			}
+
+			if issuer.Kind == datamodel.GatewayCertificateIssuerKindSelfSigned && issuer.ClusterIssuer != "" {
+				return rest.NewBadRequestResponse("Field $.properties.tls.certificateIssuer.clusterIssuer cannot be specified when $.properties.tls.certificateIssuer.kind is 'selfSigned'."), nil
+			}
+		}
+
+		// If TLS protocol version is set, then the gateway must terminate TLS
+		if newResource.Properties.TLS.MinimumProtocolVersion != "" && !newResource.Properties.TLS.HasCertificate() {
 			return rest.NewBadRequestResponse("Field $.properties.tls.certificateFrom is required when $.properties.tls.minimumProtocolVersion is set."), nil
 		}
 
@@ -45,5 +80,281 @@ func ValidateAndMutateRequest(ctx context.Context, newResource, oldResource *dat
 		}
 	}
 
+	sslPassthrough := newResource.Properties.TLS != nil && newResource.Properties.TLS.SSLPassthrough
+	terminatesTLS := newResource.Properties.TLS.HasCertificate()
+	if resp := validatePolicies(newResource.Properties.Policies, sslPassthrough, terminatesTLS); resp != nil {
+		return resp, nil
+	}
+
+	for i, route := range newResource.Properties.Routes {
+		path := fmt.Sprintf("$.properties.routes[%d]", i)
+		if resp := validateRoute(path, route, sslPassthrough); resp != nil {
+			return resp, nil
+		}
+		if resp := validateRoutePolicies(path+".policies", route.Policies, terminatesTLS); resp != nil {
+			return resp, nil
+		}
+	}
+
 	return nil, nil
 }
+
+// validateRoute validates the request matching, traffic splitting, header rewriting and retry properties of a route.
+func validateRoute(path string, route datamodel.GatewayRoute, sslPassthrough bool) rest.Response {
+	if sslPassthrough && (route.HasRequestMatches() || len(route.Destinations) > 0 || route.RequestHeaders != nil || route.ResponseHeaders != nil || route.RetryPolicy != nil || route.Policies != nil) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Only $.properties.routes[*].destination can be specified when $.properties.tls.sslPassthrough is set, found HTTP routing properties in %s.", path))
+	}
+
+	if route.Destination != "" && len(route.Destinations) > 0 {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Only one of %s.destination and %s.destinations can be specified at a time.", path, path))
+	}
+
+	for i, destination := range route.Destinations {
+		if destination.Destination == "" {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.destinations[%d].destination is required.", path, i))
+		}
+		if destination.Weight < 0 {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.destinations[%d].weight must not be negative.", path, i))
+		}
+	}
+
+	for i, header := range route.Headers {
+		if resp := validateMatch(fmt.Sprintf("%s.headers[%d]", path, i), header.Name, header.Exact, header.Contains, header.Present); resp != nil {
+			return resp
+		}
+	}
+
+	for i, parameter := range route.QueryParameters {
+		if resp := validateMatch(fmt.Sprintf("%s.queryParameters[%d]", path, i), parameter.Name, parameter.Exact, parameter.Contains, parameter.Present); resp != nil {
+			return resp
+		}
+	}
+
+	for i, method := range route.Methods {
+		if !slices.Contains(validRouteMethods, method) {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.methods[%d] must be one of %s, got '%s'.", path, i, strings.Join(validRouteMethods, ", "), method))
+		}
+	}
+
+	if resp := validateHeadersPolicy(path+".requestHeaders", route.RequestHeaders); resp != nil {
+		return resp
+	}
+
+	if resp := validateHeadersPolicy(path+".responseHeaders", route.ResponseHeaders); resp != nil {
+		return resp
+	}
+	if route.ResponseHeaders != nil {
+		for name := range route.ResponseHeaders.Set {
+			if strings.EqualFold(name, "host") {
+				return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.responseHeaders.set cannot set the Host header.", path))
+			}
+		}
+	}
+
+	if route.RetryPolicy != nil {
+		if route.RetryPolicy.Attempts < 0 {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.retryPolicy.attempts must not be negative.", path))
+		}
+
+		if route.RetryPolicy.PerTryTimeout != "" {
+			if _, err := time.ParseDuration(route.RetryPolicy.PerTryTimeout); err != nil {
+				return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.retryPolicy.perTryTimeout must be a valid duration, got '%s'.", path, route.RetryPolicy.PerTryTimeout))
+			}
+		}
+
+		for i, retryOn := range route.RetryPolicy.RetryOn {
+			if !slices.Contains(validRetryOn, retryOn) {
+				return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.retryPolicy.retryOn[%d] must be one of %s, got '%s'.", path, i, strings.Join(validRetryOn, ", "), retryOn))
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateMatch validates a header or query parameter condition, which must specify exactly one kind of match.
+func validateMatch(path string, name string, exact string, contains string, present bool) rest.Response {
+	if name == "" {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.name is required.", path))
+	}
+
+	count := 0
+	for _, set := range []bool{exact != "", contains != "", present} {
+		if set {
+			count++
+		}
+	}
+	if count != 1 {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Exactly one of %s.exact, %s.contains and %s.present must be specified.", path, path, path))
+	}
+
+	return nil
+}
+
+// validateHeadersPolicy validates that the header names of a headers policy are not empty.
+func validateHeadersPolicy(path string, policy *datamodel.GatewayRouteHeadersPolicy) rest.Response {
+	if policy == nil {
+		return nil
+	}
+
+	for name, value := range policy.Set {
+		if name == "" {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.set cannot contain an empty header name.", path))
+		}
+		if value == "" {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.set cannot contain an empty value for header '%s'.", path, name))
+		}
+	}
+
+	for i, name := range policy.Remove {
+		if name == "" {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.remove[%d] cannot be empty.", path, i))
+		}
+	}
+
+	return nil
+}
+
+// validatePolicies validates the policies of the gateway. Policies apply to HTTP requests, so they cannot be specified
+// when TLS is passed through, and authentication policies require the gateway to terminate TLS.
+func validatePolicies(policies *datamodel.GatewayPolicies, sslPassthrough bool, terminatesTLS bool) rest.Response {
+	if policies == nil {
+		return nil
+	}
+
+	if sslPassthrough {
+		return rest.NewBadRequestResponse("Field $.properties.policies cannot be specified when $.properties.tls.sslPassthrough is set.")
+	}
+
+	if resp := validateJWTPolicy("$.properties.policies.jwt", policies.JWT, terminatesTLS); resp != nil {
+		return resp
+	}
+
+	if auth := policies.ExternalAuth; auth != nil {
+		if !terminatesTLS {
+			return rest.NewBadRequestResponse("Field $.properties.policies.externalAuth requires the gateway to terminate TLS. Specify $.properties.tls.certificateFrom or $.properties.tls.certificateIssuer.")
+		}
+
+		u, err := url.Parse(auth.Destination)
+		if auth.Destination == "" || err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.policies.externalAuth.destination must be an 'http' or 'https' URL, got '%s'.", auth.Destination))
+		}
+
+		if auth.CACertificateFrom != "" && u.Scheme != "https" {
+			return rest.NewBadRequestResponse("Field $.properties.policies.externalAuth.caCertificateFrom can only be specified when $.properties.policies.externalAuth.destination is an 'https' URL.")
+		}
+
+		if resp := validateDuration("$.properties.policies.externalAuth.timeout", auth.Timeout); resp != nil {
+			return resp
+		}
+	}
+
+	if auth := policies.BasicAuth; auth != nil {
+		if !terminatesTLS {
+			return rest.NewBadRequestResponse("Field $.properties.policies.basicAuth requires the gateway to terminate TLS. Specify $.properties.tls.certificateFrom or $.properties.tls.certificateIssuer.")
+		}
+
+		if policies.JWT != nil || policies.ExternalAuth != nil {
+			return rest.NewBadRequestResponse("Field $.properties.policies.basicAuth cannot be specified with $.properties.policies.jwt or $.properties.policies.externalAuth.")
+		}
+
+		if auth.SecretFrom == "" {
+			return rest.NewBadRequestResponse("Field $.properties.policies.basicAuth.secretFrom is required.")
+		}
+	}
+
+	if resp := validateRateLimitPolicy("$.properties.policies.rateLimit", policies.RateLimit); resp != nil {
+		return resp
+	}
+
+	if cors := policies.CORS; cors != nil {
+		if len(cors.AllowOrigins) == 0 {
+			return rest.NewBadRequestResponse("Field $.properties.policies.cors.allowOrigins must contain at least one origin.")
+		}
+
+		if len(cors.AllowMethods) == 0 {
+			return rest.NewBadRequestResponse("Field $.properties.policies.cors.allowMethods must contain at least one method.")
+		}
+
+		for i, method := range cors.AllowMethods {
+			if !slices.Contains(validRouteMethods, method) {
+				return rest.NewBadRequestResponse(fmt.Sprintf("Field $.properties.policies.cors.allowMethods[%d] must be one of %s, got '%s'.", i, strings.Join(validRouteMethods, ", "), method))
+			}
+		}
+
+		if resp := validateDuration("$.properties.policies.cors.maxAge", cors.MaxAge); resp != nil {
+			return resp
+		}
+	}
+
+	return nil
+}
+
+// validateRoutePolicies validates the policies of a route.
+func validateRoutePolicies(path string, policies *datamodel.GatewayRoutePolicies, terminatesTLS bool) rest.Response {
+	if policies == nil {
+		return nil
+	}
+
+	if policies.Anonymous && policies.JWT != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.jwt cannot be specified when %s.anonymous is set.", path, path))
+	}
+
+	if resp := validateJWTPolicy(path+".jwt", policies.JWT, terminatesTLS); resp != nil {
+		return resp
+	}
+
+	return validateRateLimitPolicy(path+".rateLimit", policies.RateLimit)
+}
+
+// validateJWTPolicy validates a JWT policy. Tokens are only verified on requests received over TLS.
+func validateJWTPolicy(path string, policy *datamodel.GatewayJWTPolicy, terminatesTLS bool) rest.Response {
+	if policy == nil {
+		return nil
+	}
+
+	if !terminatesTLS {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field %s requires the gateway to terminate TLS. Specify $.properties.tls.certificateFrom or $.properties.tls.certificateIssuer.", path))
+	}
+
+	if policy.Issuer == "" {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.issuer is required.", path))
+	}
+
+	u, err := url.Parse(policy.JWKSURI)
+	if policy.JWKSURI == "" || err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.jwksUri must be an 'https' URL, got '%s'.", path, policy.JWKSURI))
+	}
+
+	return nil
+}
+
+// validateRateLimitPolicy validates a rate limiting policy.
+func validateRateLimitPolicy(path string, policy *datamodel.GatewayRateLimitPolicy) rest.Response {
+	if policy == nil {
+		return nil
+	}
+
+	if policy.Requests < 1 {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.requests must be at least 1.", path))
+	}
+
+	if policy.Burst < 0 {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field %s.burst must not be negative.", path))
+	}
+
+	return nil
+}
+
+// validateDuration validates that the given value, if set, is a valid duration.
+func validateDuration(path string, value string) rest.Response {
+	if value == "" {
+		return nil
+	}
+
+	if _, err := time.ParseDuration(value); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Field %s must be a valid duration, got '%s'.", path, value))
+	}
+
+	return nil
+}
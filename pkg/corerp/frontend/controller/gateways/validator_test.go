@@ -108,6 +108,247 @@ func TestValidateAndMutateRequest_Gateway(t *testing.T) {
 			},
 			resp: nil,
 		},
+		{
+			desc: "can issue certificate automatically",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						CertificateIssuer: &datamodel.GatewayCertificateIssuer{Kind: datamodel.GatewayCertificateIssuerKindACME, ClusterIssuer: "letsencrypt"},
+					},
+				},
+			},
+			mutatedResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						CertificateIssuer:      &datamodel.GatewayCertificateIssuer{Kind: datamodel.GatewayCertificateIssuerKindACME, ClusterIssuer: "letsencrypt"},
+						MinimumProtocolVersion: "1.2",
+					},
+				},
+			},
+			resp: nil,
+		},
+		{
+			desc: "cannot issue certificate automatically with certificateFrom",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						CertificateFrom:   "secretname",
+						CertificateIssuer: &datamodel.GatewayCertificateIssuer{Kind: datamodel.GatewayCertificateIssuerKindSelfSigned},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.tls.certificateIssuer cannot be specified with $.properties.tls.certificateFrom or $.properties.tls.sslPassthrough."),
+		},
+		{
+			desc: "acme issuer requires clusterIssuer",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						CertificateIssuer: &datamodel.GatewayCertificateIssuer{Kind: datamodel.GatewayCertificateIssuerKindACME},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.tls.certificateIssuer.clusterIssuer is required when $.properties.tls.certificateIssuer.kind is 'acme'."),
+		},
+		{
+			desc: "self-signed issuer does not accept clusterIssuer",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					TLS: &datamodel.GatewayPropertiesTLS{
+						CertificateIssuer: &datamodel.GatewayCertificateIssuer{Kind: datamodel.GatewayCertificateIssuerKindSelfSigned, ClusterIssuer: "letsencrypt"},
+					},
+				},
+			},
+			resp: rest.NewBadRequestResponse("Field $.properties.tls.certificateIssuer.clusterIssuer cannot be specified when $.properties.tls.certificateIssuer.kind is 'selfSigned'."),
+		},
+		{
+			desc: "valid route matching, traffic splitting, header rewriting and retries",
+			newResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{validRoute()},
+				},
+			},
+			mutatedResource: &datamodel.Gateway{
+				Properties: datamodel.GatewayProperties{
+					Routes: []datamodel.GatewayRoute{validRoute()},
+				},
+			},
+			resp: nil,
+		},
+		{
+			desc:        "cannot use route matching with SSL Passthrough",
+			newResource: gatewayWithRoute(true, func(r *datamodel.GatewayRoute) {}),
+			resp:        rest.NewBadRequestResponse("Only $.properties.routes[*].destination can be specified when $.properties.tls.sslPassthrough is set, found HTTP routing properties in $.properties.routes[0]."),
+		},
+		{
+			desc: "cannot specify both destination and destinations",
+			newResource: gatewayWithRoute(false, func(r *datamodel.GatewayRoute) {
+				r.Destination = "http://backend:3000"
+			}),
+			resp: rest.NewBadRequestResponse("Only one of $.properties.routes[0].destination and $.properties.routes[0].destinations can be specified at a time."),
+		},
+		{
+			desc: "destination weight must not be negative",
+			newResource: gatewayWithRoute(false, func(r *datamodel.GatewayRoute) {
+				r.Destinations[1].Weight = -1
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].destinations[1].weight must not be negative."),
+		},
+		{
+			desc: "header match requires a name",
+			newResource: gatewayWithRoute(false, func(r *datamodel.GatewayRoute) {
+				r.Headers[0].Name = ""
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].headers[0].name is required."),
+		},
+		{
+			desc: "query parameter match must specify exactly one match",
+			newResource: gatewayWithRoute(false, func(r *datamodel.GatewayRoute) {
+				r.QueryParameters[0].Present = true
+			}),
+			resp: rest.NewBadRequestResponse("Exactly one of $.properties.routes[0].queryParameters[0].exact, $.properties.routes[0].queryParameters[0].contains and $.properties.routes[0].queryParameters[0].present must be specified."),
+		},
+		{
+			desc: "invalid method",
+			newResource: gatewayWithRoute(false, func(r *datamodel.GatewayRoute) {
+				r.Methods = []string{"get"}
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].methods[0] must be one of GET, HEAD, POST, PUT, PATCH, DELETE, CONNECT, OPTIONS, TRACE, got 'get'."),
+		},
+		{
+			desc: "cannot set the Host response header",
+			newResource: gatewayWithRoute(false, func(r *datamodel.GatewayRoute) {
+				r.ResponseHeaders = &datamodel.GatewayRouteHeadersPolicy{Set: map[string]string{"Host": "example.com"}}
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].responseHeaders.set cannot set the Host header."),
+		},
+		{
+			desc: "invalid retry timeout",
+			newResource: gatewayWithRoute(false, func(r *datamodel.GatewayRoute) {
+				r.RetryPolicy.PerTryTimeout = "2 seconds"
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].retryPolicy.perTryTimeout must be a valid duration, got '2 seconds'."),
+		},
+		{
+			desc: "invalid retry condition",
+			newResource: gatewayWithRoute(false, func(r *datamodel.GatewayRoute) {
+				r.RetryPolicy.RetryOn = []string{"always"}
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].retryPolicy.retryOn[0] must be one of 5xx, gateway-error, reset, connect-failure, retriable-4xx, refused-stream, got 'always'."),
+		},
+		{
+			desc:            "valid gateway and route policies",
+			newResource:     gatewayWithPolicies(func(g *datamodel.Gateway) {}),
+			mutatedResource: gatewayWithPolicies(func(g *datamodel.Gateway) {}),
+			resp:            nil,
+		},
+		{
+			desc: "cannot use policies with SSL Passthrough",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.TLS = &datamodel.GatewayPropertiesTLS{SSLPassthrough: true}
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.policies cannot be specified when $.properties.tls.sslPassthrough is set."),
+		},
+		{
+			desc: "JWT policy requires TLS termination",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.TLS = nil
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.policies.jwt requires the gateway to terminate TLS. Specify $.properties.tls.certificateFrom or $.properties.tls.certificateIssuer."),
+		},
+		{
+			desc: "JWKS URI must use https",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Policies.JWT.JWKSURI = "http://login.contoso.com/keys"
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.policies.jwt.jwksUri must be an 'https' URL, got 'http://login.contoso.com/keys'."),
+		},
+		{
+			desc: "external authorization destination must be a URL",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Policies.ExternalAuth.Destination = "authserver"
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.policies.externalAuth.destination must be an 'http' or 'https' URL, got 'authserver'."),
+		},
+		{
+			desc: "external authorization CA certificate requires https",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Policies.ExternalAuth.CACertificateFrom = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/ca"
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.policies.externalAuth.caCertificateFrom can only be specified when $.properties.policies.externalAuth.destination is an 'https' URL."),
+		},
+		{
+			desc: "invalid external authorization timeout",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Policies.ExternalAuth.Timeout = "1 second"
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.policies.externalAuth.timeout must be a valid duration, got '1 second'."),
+		},
+		{
+			desc: "valid basic authentication policy",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Policies.JWT = nil
+				g.Properties.Policies.ExternalAuth = nil
+				g.Properties.Policies.BasicAuth = &datamodel.GatewayBasicAuthPolicy{SecretFrom: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/users"}
+			}),
+			mutatedResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Policies.JWT = nil
+				g.Properties.Policies.ExternalAuth = nil
+				g.Properties.Policies.BasicAuth = &datamodel.GatewayBasicAuthPolicy{SecretFrom: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/users"}
+			}),
+			resp: nil,
+		},
+		{
+			desc: "basic authentication cannot be combined with other authentication policies",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Policies.BasicAuth = &datamodel.GatewayBasicAuthPolicy{SecretFrom: "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/secretStores/users"}
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.policies.basicAuth cannot be specified with $.properties.policies.jwt or $.properties.policies.externalAuth."),
+		},
+		{
+			desc: "basic authentication requires a secret store",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Policies.JWT = nil
+				g.Properties.Policies.ExternalAuth = nil
+				g.Properties.Policies.BasicAuth = &datamodel.GatewayBasicAuthPolicy{}
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.policies.basicAuth.secretFrom is required."),
+		},
+		{
+			desc: "rate limit requires at least one request",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Policies.RateLimit.Requests = 0
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.policies.rateLimit.requests must be at least 1."),
+		},
+		{
+			desc: "CORS policy requires origins",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Policies.CORS.AllowOrigins = nil
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.policies.cors.allowOrigins must contain at least one origin."),
+		},
+		{
+			desc: "invalid CORS method",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Policies.CORS.AllowMethods = []string{"FETCH"}
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.policies.cors.allowMethods[0] must be one of GET, HEAD, POST, PUT, PATCH, DELETE, CONNECT, OPTIONS, TRACE, got 'FETCH'."),
+		},
+		{
+			desc: "anonymous route cannot require a JWT",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Routes[0].Policies.Anonymous = true
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].policies.jwt cannot be specified when $.properties.routes[0].policies.anonymous is set."),
+		},
+		{
+			desc: "invalid route rate limit burst",
+			newResource: gatewayWithPolicies(func(g *datamodel.Gateway) {
+				g.Properties.Routes[0].Policies.RateLimit.Burst = -1
+			}),
+			resp: rest.NewBadRequestResponse("Field $.properties.routes[0].policies.rateLimit.burst must not be negative."),
+		},
 	}
 
 	for _, tc := range requestTests {
@@ -124,3 +365,98 @@ func TestValidateAndMutateRequest_Gateway(t *testing.T) {
 		})
 	}
 }
+
+func validRoute() datamodel.GatewayRoute {
+	return datamodel.GatewayRoute{
+		Path: "/api",
+		Destinations: []datamodel.GatewayRouteDestination{
+			{Destination: "http://backend-v1:3000", Weight: 90},
+			{Destination: "http://backend-v2:3000", Weight: 10},
+		},
+		Headers: []datamodel.GatewayRouteHeaderMatch{
+			{Name: "x-canary", Exact: "true"},
+		},
+		QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{
+			{Name: "version", Contains: "beta"},
+		},
+		Methods: []string{"GET", "POST"},
+		RequestHeaders: &datamodel.GatewayRouteHeadersPolicy{
+			Set:    map[string]string{"x-forwarded-by": "radius"},
+			Remove: []string{"x-debug"},
+		},
+		RetryPolicy: &datamodel.GatewayRouteRetryPolicy{
+			Attempts:      3,
+			PerTryTimeout: "2s",
+			RetryOn:       []string{"5xx", "connect-failure"},
+		},
+	}
+}
+
+func gatewayWithRoute(sslPassthrough bool, mutate func(*datamodel.GatewayRoute)) *datamodel.Gateway {
+	route := validRoute()
+	mutate(&route)
+
+	gateway := &datamodel.Gateway{
+		Properties: datamodel.GatewayProperties{
+			Routes: []datamodel.GatewayRoute{route},
+		},
+	}
+	if sslPassthrough {
+		gateway.Properties.TLS = &datamodel.GatewayPropertiesTLS{SSLPassthrough: true}
+	}
+
+	return gateway
+}
+
+func gatewayWithPolicies(mutate func(*datamodel.Gateway)) *datamodel.Gateway {
+	gateway := &datamodel.Gateway{
+		Properties: datamodel.GatewayProperties{
+			TLS: &datamodel.GatewayPropertiesTLS{
+				MinimumProtocolVersion: datamodel.TLSMinVersion12,
+				CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+					Kind: datamodel.GatewayCertificateIssuerKindSelfSigned,
+				},
+			},
+			Policies: &datamodel.GatewayPolicies{
+				JWT: &datamodel.GatewayJWTPolicy{
+					Issuer:  "https://login.contoso.com",
+					JWKSURI: "https://login.contoso.com/keys",
+				},
+				ExternalAuth: &datamodel.GatewayExternalAuthPolicy{
+					Destination: "http://authserver:9001",
+					Timeout:     "500ms",
+				},
+				RateLimit: &datamodel.GatewayRateLimitPolicy{
+					Requests: 100,
+					Unit:     datamodel.GatewayRateLimitUnitSecond,
+				},
+				CORS: &datamodel.GatewayCORSPolicy{
+					AllowOrigins: []string{"https://www.contoso.com"},
+					AllowMethods: []string{"GET", "POST"},
+					MaxAge:       "10m",
+				},
+			},
+			Routes: []datamodel.GatewayRoute{
+				{
+					Destination: "http://backend:3000",
+					Path:        "/api",
+					Policies: &datamodel.GatewayRoutePolicies{
+						JWT: &datamodel.GatewayJWTPolicy{
+							Issuer:    "https://login.contoso.com",
+							JWKSURI:   "https://login.contoso.com/keys",
+							Audiences: []string{"api"},
+						},
+						RateLimit: &datamodel.GatewayRateLimitPolicy{
+							Requests: 10,
+							Unit:     datamodel.GatewayRateLimitUnitSecond,
+							Burst:    5,
+						},
+					},
+				},
+			},
+		},
+	}
+	mutate(gateway)
+
+	return gateway
+}
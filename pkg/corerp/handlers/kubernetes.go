@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
 	"time"
 
@@ -49,7 +50,7 @@ const (
 	DefaultCacheResyncInterval = time.Second * time.Duration(30)
 )
 
-// Create an interface for deployment waiter and http proxy waiter
+// Create an interface for deployment waiter, http proxy waiter and Gateway API waiter
 type ResourceWaiter interface {
 	addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error)
 	addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error)
@@ -62,6 +63,8 @@ func NewKubernetesHandler(client client.Client, clientSet k8s.Interface, discove
 		client:             client,
 		k8sDiscoveryClient: discoveryClient,
 		httpProxyWaiter:    NewHTTPProxyWaiter(dynamicClientSet),
+		gatewayAPIWaiter:   NewGatewayAPIWaiter(dynamicClientSet),
+		certificateWaiter:  NewCertificateWaiter(dynamicClientSet),
 		deploymentWaiter:   NewDeploymentWaiter(clientSet),
 	}
 }
@@ -71,6 +74,8 @@ type kubernetesHandler struct {
 	// k8sDiscoveryClient is the Kubernetes client to used for API version lookups on Kubernetes resources. Override this for testing.
 	k8sDiscoveryClient discovery.ServerResourcesInterface
 	httpProxyWaiter    ResourceWaiter
+	gatewayAPIWaiter   ResourceWaiter
+	certificateWaiter  ResourceWaiter
 	deploymentWaiter   ResourceWaiter
 }
 
@@ -137,6 +142,38 @@ func (handler *kubernetesHandler) Put(ctx context.Context, options *PutOptions)
 		}
 		logger.Info(fmt.Sprintf("HTTP Proxy %s in namespace %s is ready", item.GetName(), item.GetNamespace()))
 		return properties, nil
+	case "gateway", "httproute", "tlsroute":
+		if groupVersion.Group != kubernetes.GatewayAPIGroup {
+			return properties, nil
+		}
+
+		err = handler.gatewayAPIWaiter.waitUntilReady(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(fmt.Sprintf("%s %s in namespace %s is ready", item.GetKind(), item.GetName(), item.GetNamespace()))
+		return properties, nil
+	case "certificate":
+		if groupVersion.Group != kubernetes.CertManagerGroup {
+			return properties, nil
+		}
+
+		err = handler.certificateWaiter.waitUntilReady(ctx, &item)
+		if err != nil {
+			return nil, err
+		}
+
+		// Read the issued certificate to report its expiry and renewal times.
+		latest := &unstructured.Unstructured{}
+		latest.SetGroupVersionKind(item.GroupVersionKind())
+		err = handler.client.Get(ctx, client.ObjectKeyFromObject(&item), latest)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(properties, certificateProperties(latest))
+
+		logger.Info(fmt.Sprintf("Certificate %s in namespace %s is issued", item.GetName(), item.GetNamespace()))
+		return properties, nil
 	default:
 		// We do not monitor the other resource types.
 		return properties, nil
@@ -0,0 +1,177 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	MaxCertificateIssuanceTimeout = time.Minute * time.Duration(10)
+
+	// CertificateConditionReady is the condition type reported by cert-manager when the certificate is issued and valid.
+	CertificateConditionReady = "Ready"
+	// CertificateConditionIssuing is the condition type reported by cert-manager while the certificate is being issued.
+	CertificateConditionIssuing = "Issuing"
+	// CertificateReasonFailed is the reason reported by cert-manager when the issuance of a certificate failed.
+	CertificateReasonFailed = "Failed"
+
+	// CertificateNotAfterKey is the property key of the expiry time of an issued certificate.
+	CertificateNotAfterKey = "certificatenotafter"
+	// CertificateRenewalTimeKey is the property key of the renewal time of an issued certificate.
+	CertificateRenewalTimeKey = "certificaterenewaltime"
+)
+
+type certificateWaiter struct {
+	dynamicClientSet    dynamic.Interface
+	issuanceTimeout     time.Duration
+	cacheResyncInterval time.Duration
+}
+
+// NewCertificateWaiter returns a new instance of the waiter for cert-manager Certificate resources. The waiter monitors
+// the Certificate until cert-manager reports that the certificate was issued, or that the issuance failed.
+func NewCertificateWaiter(dynamicClientSet dynamic.Interface) *certificateWaiter {
+	return &certificateWaiter{
+		dynamicClientSet:    dynamicClientSet,
+		issuanceTimeout:     MaxCertificateIssuanceTimeout,
+		cacheResyncInterval: DefaultCacheResyncInterval,
+	}
+}
+
+func (handler *certificateWaiter) addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			handler.checkCertificateStatus(ctx, obj, item, doneCh)
+		},
+		UpdateFunc: func(_, newObj any) {
+			handler.checkCertificateStatus(ctx, newObj, item, doneCh)
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "failed to add event handler")
+	}
+}
+
+// addEventHandler is not implemented for certificateWaiter
+func (handler *certificateWaiter) addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+}
+
+func (handler *certificateWaiter) waitUntilReady(ctx context.Context, obj client.Object) error {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	doneCh := make(chan error, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, handler.issuanceTimeout)
+	// This ensures that the informer is stopped when this function is returned.
+	defer cancel()
+
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(handler.dynamicClientSet, handler.cacheResyncInterval, obj.GetNamespace(), nil)
+	informer := dynamicInformerFactory.ForResource(kubernetes.CertManagerCertificateGVR)
+	handler.addDynamicEventHandler(ctx, dynamicInformerFactory, informer.Informer(), obj, doneCh)
+
+	dynamicInformerFactory.Start(ctx.Done())
+	dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	select {
+	case <-ctx.Done():
+		// Get the final status
+		latest, err := informer.Lister().ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+		if err != nil {
+			return fmt.Errorf("certificate issuance timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		var status string
+		if u, ok := latest.(*unstructured.Unstructured); ok {
+			status = describeGatewayAPIConditions(gatewayAPIConditions(u))
+		}
+		return fmt.Errorf("certificate issuance timed out, name: %s, namespace %s, status: %s", obj.GetName(), obj.GetNamespace(), status)
+	case err := <-doneCh:
+		if err == nil {
+			logger.Info(fmt.Sprintf("Marking certificate %s in namespace %s as issued", obj.GetName(), obj.GetNamespace()))
+		}
+		return err
+	}
+}
+
+// checkCertificateStatus checks the status conditions of the Certificate and signals on doneCh when the certificate
+// was issued or the issuance failed. It returns true if the certificate was issued.
+func (handler *certificateWaiter) checkCertificateStatus(ctx context.Context, obj any, item client.Object, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("name", item.GetName(), "namespace", item.GetNamespace())
+
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok || u.GetName() != item.GetName() || u.GetNamespace() != item.GetNamespace() {
+		return false
+	}
+
+	ready, err := isCertificateReady(u)
+	if err != nil {
+		doneCh <- err
+		return false
+	}
+
+	if ready {
+		doneCh <- nil
+		return true
+	}
+
+	logger.Info(fmt.Sprintf("Certificate is not issued yet: %s", describeGatewayAPIConditions(gatewayAPIConditions(u))))
+	return false
+}
+
+// isCertificateReady evaluates the status conditions of a cert-manager Certificate. A certificate is ready when it is
+// Ready for the current generation. A failed issuance is reported as an error. cert-manager retries failed issuances
+// with a backoff, so redeploying the gateway waits for the next attempt.
+func isCertificateReady(obj *unstructured.Unstructured) (bool, error) {
+	conditions := currentConditions(obj, gatewayAPIConditions(obj))
+
+	if c := meta.FindStatusCondition(conditions, CertificateConditionIssuing); c != nil && c.Status == metav1.ConditionFalse && c.Reason == CertificateReasonFailed {
+		return false, fmt.Errorf("Certificate %s could not be issued. Reason: %s, Message: %s", obj.GetName(), c.Reason, c.Message)
+	}
+
+	c := meta.FindStatusCondition(conditions, CertificateConditionReady)
+	return c != nil && c.Status == metav1.ConditionTrue, nil
+}
+
+// certificateProperties returns the expiry and renewal times reported in the status of an issued Certificate.
+func certificateProperties(obj *unstructured.Unstructured) map[string]string {
+	properties := map[string]string{}
+	if notAfter, ok, _ := unstructured.NestedString(obj.Object, "status", "notAfter"); ok {
+		properties[CertificateNotAfterKey] = notAfter
+	}
+	if renewalTime, ok, _ := unstructured.NestedString(obj.Object, "status", "renewalTime"); ok {
+		properties[CertificateRenewalTimeKey] = renewalTime
+	}
+
+	return properties
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+)
+
+func makeCertificate(generation int64, status map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{
+		Object: map[string]any{
+			"metadata": map[string]any{
+				"name":       "test-gateway-tls",
+				"namespace":  "default",
+				"generation": generation,
+			},
+		},
+	}
+	obj.SetGroupVersionKind(kubernetes.CertManagerCertificateGVK)
+	if status != nil {
+		obj.Object["status"] = status
+	}
+
+	return obj
+}
+
+func TestIsCertificateReady(t *testing.T) {
+	tests := []struct {
+		name  string
+		obj   *unstructured.Unstructured
+		ready bool
+		err   string
+	}{
+		{
+			name: "certificate without status",
+			obj:  makeCertificate(1, nil),
+		},
+		{
+			name: "certificate issuing",
+			obj: makeCertificate(1, map[string]any{
+				"conditions": []any{
+					condition(CertificateConditionReady, "False", "DoesNotExist", 1),
+					condition(CertificateConditionIssuing, "True", "DoesNotExist", 1),
+				},
+			}),
+		},
+		{
+			name: "certificate issued",
+			obj: makeCertificate(1, map[string]any{
+				"conditions": []any{
+					condition(CertificateConditionReady, "True", "Ready", 1),
+				},
+			}),
+			ready: true,
+		},
+		{
+			name: "certificate issued for previous generation",
+			obj: makeCertificate(2, map[string]any{
+				"conditions": []any{
+					condition(CertificateConditionReady, "True", "Ready", 1),
+				},
+			}),
+		},
+		{
+			name: "certificate issuance failed",
+			obj: makeCertificate(1, map[string]any{
+				"conditions": []any{
+					condition(CertificateConditionReady, "False", "DoesNotExist", 1),
+					condition(CertificateConditionIssuing, "False", CertificateReasonFailed, 1),
+				},
+			}),
+			err: "Certificate test-gateway-tls could not be issued. Reason: Failed, Message: Failed message",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, err := isCertificateReady(tt.obj)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.ready, ready)
+		})
+	}
+}
+
+func TestCertificateProperties(t *testing.T) {
+	certificate := makeCertificate(1, map[string]any{
+		"notAfter":    "2024-01-01T00:00:00Z",
+		"renewalTime": "2023-12-02T00:00:00Z",
+	})
+
+	require.Equal(t, map[string]string{
+		CertificateNotAfterKey:    "2024-01-01T00:00:00Z",
+		CertificateRenewalTimeKey: "2023-12-02T00:00:00Z",
+	}, certificateProperties(certificate))
+	require.Empty(t, certificateProperties(makeCertificate(1, nil)))
+}
+
+func TestCertificateWaiter_WaitUntilReady(t *testing.T) {
+	certificate := makeCertificate(1, map[string]any{
+		"conditions": []any{
+			condition(CertificateConditionReady, "True", "Ready", 1),
+		},
+	})
+
+	fakeClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		kubernetes.CertManagerCertificateGVR: "CertificateList",
+	}, certificate)
+
+	waiter := NewCertificateWaiter(fakeClient)
+	waiter.issuanceTimeout = time.Second * 5
+
+	err := waiter.waitUntilReady(context.Background(), makeCertificate(1, nil))
+	require.NoError(t, err)
+}
+
+func TestCertificateWaiter_WaitUntilReady_Timeout(t *testing.T) {
+	certificate := makeCertificate(1, map[string]any{
+		"conditions": []any{
+			condition(CertificateConditionIssuing, "True", "DoesNotExist", 1),
+		},
+	})
+
+	fakeClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		kubernetes.CertManagerCertificateGVR: "CertificateList",
+	}, certificate)
+
+	waiter := NewCertificateWaiter(fakeClient)
+	waiter.issuanceTimeout = time.Second
+
+	err := waiter.waitUntilReady(context.Background(), makeCertificate(1, nil))
+	require.EqualError(t, err, "certificate issuance timed out, name: test-gateway-tls, namespace default, status: Issuing=True (reason: DoesNotExist, message: DoesNotExist message)")
+}
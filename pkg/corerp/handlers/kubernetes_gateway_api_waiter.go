@@ -0,0 +1,291 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	MaxGatewayAPIDeploymentTimeout = time.Minute * time.Duration(10)
+
+	// GatewayAPIConditionAccepted is the condition type reported by the implementation when it accepts a Gateway or route.
+	GatewayAPIConditionAccepted = "Accepted"
+	// GatewayAPIConditionProgrammed is the condition type reported when a Gateway is configured in the data plane.
+	GatewayAPIConditionProgrammed = "Programmed"
+	// GatewayAPIConditionResolvedRefs is the condition type reported when all references of a route are resolved.
+	GatewayAPIConditionResolvedRefs = "ResolvedRefs"
+	// GatewayAPIReasonInvalid is the reason reported when the Programmed condition of a Gateway can not be satisfied.
+	GatewayAPIReasonInvalid = "Invalid"
+)
+
+type gatewayAPIWaiter struct {
+	dynamicClientSet         dynamic.Interface
+	gatewayDeploymentTimeout time.Duration
+	cacheResyncInterval      time.Duration
+}
+
+// NewGatewayAPIWaiter returns a new instance of the waiter for Kubernetes Gateway API resources. The waiter
+// monitors Gateway, HTTPRoute and TLSRoute resources until the Gateway API implementation reports them as ready.
+func NewGatewayAPIWaiter(dynamicClientSet dynamic.Interface) *gatewayAPIWaiter {
+	return &gatewayAPIWaiter{
+		dynamicClientSet:         dynamicClientSet,
+		gatewayDeploymentTimeout: MaxGatewayAPIDeploymentTimeout,
+		cacheResyncInterval:      DefaultCacheResyncInterval,
+	}
+}
+
+func (handler *gatewayAPIWaiter) addDynamicEventHandler(ctx context.Context, informerFactory dynamicinformer.DynamicSharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			handler.checkGatewayAPIStatus(ctx, obj, item, doneCh)
+		},
+		UpdateFunc: func(_, newObj any) {
+			handler.checkGatewayAPIStatus(ctx, newObj, item, doneCh)
+		},
+	})
+
+	if err != nil {
+		logger.Error(err, "failed to add event handler")
+	}
+}
+
+// addEventHandler is not implemented for GatewayAPIWaiter
+func (handler *gatewayAPIWaiter) addEventHandler(ctx context.Context, informerFactory informers.SharedInformerFactory, informer cache.SharedIndexInformer, item client.Object, doneCh chan<- error) {
+}
+
+func (handler *gatewayAPIWaiter) waitUntilReady(ctx context.Context, obj client.Object) error {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", kind, "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	gvr, err := gatewayAPIResource(obj.GetObjectKind().GroupVersionKind())
+	if err != nil {
+		return err
+	}
+
+	doneCh := make(chan error, 1)
+
+	ctx, cancel := context.WithTimeout(ctx, handler.gatewayDeploymentTimeout)
+	// This ensures that the informer is stopped when this function is returned.
+	defer cancel()
+
+	// Create dynamic informer for the Gateway API resource
+	dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(handler.dynamicClientSet, handler.cacheResyncInterval, obj.GetNamespace(), nil)
+	informer := dynamicInformerFactory.ForResource(gvr)
+	handler.addDynamicEventHandler(ctx, dynamicInformerFactory, informer.Informer(), obj, doneCh)
+
+	// Start the informers
+	dynamicInformerFactory.Start(ctx.Done())
+
+	// Wait for the cache to be synced.
+	dynamicInformerFactory.WaitForCacheSync(ctx.Done())
+
+	select {
+	case <-ctx.Done():
+		// Get the final status
+		latest, err := informer.Lister().ByNamespace(obj.GetNamespace()).Get(obj.GetName())
+		if err != nil {
+			return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, error occurred while fetching latest status: %w", kind, obj.GetName(), obj.GetNamespace(), err)
+		}
+
+		var status string
+		if u, ok := latest.(*unstructured.Unstructured); ok {
+			status = describeGatewayAPIConditions(gatewayAPIConditions(u))
+		}
+		return fmt.Errorf("%s deployment timed out, name: %s, namespace %s, status: %s", kind, obj.GetName(), obj.GetNamespace(), status)
+	case err := <-doneCh:
+		if err == nil {
+			logger.Info(fmt.Sprintf("Marking %s %s in namespace %s as complete", kind, obj.GetName(), obj.GetNamespace()))
+		}
+		return err
+	}
+}
+
+// checkGatewayAPIStatus checks the status conditions of the given Gateway API resource and signals on doneCh
+// when the resource is ready or has failed. It returns true if the resource is ready.
+func (handler *gatewayAPIWaiter) checkGatewayAPIStatus(ctx context.Context, obj any, item client.Object, doneCh chan<- error) bool {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("name", item.GetName(), "namespace", item.GetNamespace())
+
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok || u.GetName() != item.GetName() || u.GetNamespace() != item.GetNamespace() {
+		return false
+	}
+
+	ready, err := isGatewayAPIResourceReady(u)
+	if err != nil {
+		doneCh <- err
+		return false
+	}
+
+	if ready {
+		doneCh <- nil
+		return true
+	}
+
+	logger.Info(fmt.Sprintf("%s is not ready yet: %s", u.GetKind(), describeGatewayAPIConditions(gatewayAPIConditions(u))))
+	return false
+}
+
+// isGatewayAPIResourceReady evaluates the status conditions of a Gateway, HTTPRoute or TLSRoute. Conditions
+// observed for an older generation of the resource are ignored.
+//
+// A Gateway is ready when it is Programmed. A route is ready when every parent Gateway has Accepted the route
+// and resolved its backend references.
+func isGatewayAPIResourceReady(obj *unstructured.Unstructured) (bool, error) {
+	if obj.GetKind() == kubernetes.GatewayAPIGatewayGVK.Kind {
+		conditions := currentConditions(obj, gatewayAPIConditions(obj))
+		if c := meta.FindStatusCondition(conditions, GatewayAPIConditionAccepted); c != nil && c.Status == metav1.ConditionFalse {
+			return false, fmt.Errorf("Gateway %s was not accepted. Reason: %s, Message: %s", obj.GetName(), c.Reason, c.Message)
+		}
+
+		c := meta.FindStatusCondition(conditions, GatewayAPIConditionProgrammed)
+		if c == nil {
+			return false, nil
+		}
+
+		if c.Status == metav1.ConditionFalse && c.Reason == GatewayAPIReasonInvalid {
+			return false, fmt.Errorf("Gateway %s could not be programmed. Reason: %s, Message: %s", obj.GetName(), c.Reason, c.Message)
+		}
+
+		return c.Status == metav1.ConditionTrue, nil
+	}
+
+	parents, _, err := unstructured.NestedSlice(obj.Object, "status", "parents")
+	if err != nil || len(parents) == 0 {
+		return false, nil
+	}
+
+	for _, parent := range parents {
+		p, ok := parent.(map[string]any)
+		if !ok {
+			return false, nil
+		}
+
+		conditions := currentConditions(obj, toConditions(p["conditions"]))
+		for _, conditionType := range []string{GatewayAPIConditionAccepted, GatewayAPIConditionResolvedRefs} {
+			c := meta.FindStatusCondition(conditions, conditionType)
+			if c == nil {
+				return false, nil
+			}
+
+			if c.Status == metav1.ConditionFalse {
+				return false, fmt.Errorf("%s %s is not ready. Condition: %s, Reason: %s, Message: %s", obj.GetKind(), obj.GetName(), c.Type, c.Reason, c.Message)
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// gatewayAPIResource returns the GroupVersionResource for the given Gateway API kind.
+func gatewayAPIResource(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
+	switch gvk.Kind {
+	case kubernetes.GatewayAPIGatewayGVK.Kind:
+		return gvk.GroupVersion().WithResource(kubernetes.GatewayAPIGatewayGVR.Resource), nil
+	case kubernetes.GatewayAPIHTTPRouteGVK.Kind:
+		return gvk.GroupVersion().WithResource(kubernetes.GatewayAPIHTTPRouteGVR.Resource), nil
+	case kubernetes.GatewayAPITLSRouteGVK.Kind:
+		return gvk.GroupVersion().WithResource(kubernetes.GatewayAPITLSRouteGVR.Resource), nil
+	default:
+		return schema.GroupVersionResource{}, fmt.Errorf("unsupported Gateway API kind: %s", gvk.Kind)
+	}
+}
+
+// gatewayAPIConditions returns the conditions of a Gateway or the conditions of all parents of a route.
+func gatewayAPIConditions(obj *unstructured.Unstructured) []metav1.Condition {
+	if conditions, ok, _ := unstructured.NestedSlice(obj.Object, "status", "conditions"); ok {
+		return toConditions(conditions)
+	}
+
+	result := []metav1.Condition{}
+	parents, _, _ := unstructured.NestedSlice(obj.Object, "status", "parents")
+	for _, parent := range parents {
+		if p, ok := parent.(map[string]any); ok {
+			result = append(result, toConditions(p["conditions"])...)
+		}
+	}
+
+	return result
+}
+
+func toConditions(raw any) []metav1.Condition {
+	items, ok := raw.([]any)
+	if !ok {
+		return nil
+	}
+
+	conditions := []metav1.Condition{}
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		c := metav1.Condition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &c); err != nil {
+			continue
+		}
+		conditions = append(conditions, c)
+	}
+
+	return conditions
+}
+
+// currentConditions filters out the conditions that were observed for an older generation of the object.
+func currentConditions(obj *unstructured.Unstructured, conditions []metav1.Condition) []metav1.Condition {
+	result := []metav1.Condition{}
+	for _, c := range conditions {
+		if c.ObservedGeneration != 0 && c.ObservedGeneration < obj.GetGeneration() {
+			continue
+		}
+		result = append(result, c)
+	}
+
+	return result
+}
+
+func describeGatewayAPIConditions(conditions []metav1.Condition) string {
+	if len(conditions) == 0 {
+		return "no status reported"
+	}
+
+	descriptions := []string{}
+	for _, c := range conditions {
+		descriptions = append(descriptions, fmt.Sprintf("%s=%s (reason: %s, message: %s)", c.Type, c.Status, c.Reason, c.Message))
+	}
+
+	return strings.Join(descriptions, ", ")
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+)
+
+func condition(conditionType string, status string, reason string, generation int64) map[string]any {
+	return map[string]any{
+		"type":               conditionType,
+		"status":             status,
+		"reason":             reason,
+		"message":            reason + " message",
+		"observedGeneration": generation,
+	}
+}
+
+func makeGatewayAPIObject(gvk schema.GroupVersionKind, generation int64, status map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{
+		Object: map[string]any{
+			"metadata": map[string]any{
+				"name":       "test-gateway",
+				"namespace":  "default",
+				"generation": generation,
+			},
+		},
+	}
+	obj.SetGroupVersionKind(gvk)
+	if status != nil {
+		obj.Object["status"] = status
+	}
+
+	return obj
+}
+
+func makeRouteStatus(conditions ...any) map[string]any {
+	return map[string]any{
+		"parents": []any{
+			map[string]any{
+				"parentRef":      map[string]any{"name": "test-gateway"},
+				"controllerName": "example.com/gateway-controller",
+				"conditions":     conditions,
+			},
+		},
+	}
+}
+
+func TestIsGatewayAPIResourceReady(t *testing.T) {
+	tests := []struct {
+		name  string
+		obj   *unstructured.Unstructured
+		ready bool
+		err   string
+	}{
+		{
+			name: "gateway without status",
+			obj:  makeGatewayAPIObject(kubernetes.GatewayAPIGatewayGVK, 1, nil),
+		},
+		{
+			name: "gateway programmed",
+			obj: makeGatewayAPIObject(kubernetes.GatewayAPIGatewayGVK, 1, map[string]any{
+				"conditions": []any{
+					condition(GatewayAPIConditionAccepted, "True", "Accepted", 1),
+					condition(GatewayAPIConditionProgrammed, "True", "Programmed", 1),
+				},
+			}),
+			ready: true,
+		},
+		{
+			name: "gateway programmed for previous generation",
+			obj: makeGatewayAPIObject(kubernetes.GatewayAPIGatewayGVK, 2, map[string]any{
+				"conditions": []any{
+					condition(GatewayAPIConditionProgrammed, "True", "Programmed", 1),
+				},
+			}),
+		},
+		{
+			name: "gateway pending",
+			obj: makeGatewayAPIObject(kubernetes.GatewayAPIGatewayGVK, 1, map[string]any{
+				"conditions": []any{
+					condition(GatewayAPIConditionProgrammed, "False", "AddressNotAssigned", 1),
+				},
+			}),
+		},
+		{
+			name: "gateway not accepted",
+			obj: makeGatewayAPIObject(kubernetes.GatewayAPIGatewayGVK, 1, map[string]any{
+				"conditions": []any{
+					condition(GatewayAPIConditionAccepted, "False", "InvalidParameters", 1),
+				},
+			}),
+			err: "Gateway test-gateway was not accepted. Reason: InvalidParameters, Message: InvalidParameters message",
+		},
+		{
+			name: "gateway invalid",
+			obj: makeGatewayAPIObject(kubernetes.GatewayAPIGatewayGVK, 1, map[string]any{
+				"conditions": []any{
+					condition(GatewayAPIConditionProgrammed, "False", GatewayAPIReasonInvalid, 1),
+				},
+			}),
+			err: "Gateway test-gateway could not be programmed. Reason: Invalid, Message: Invalid message",
+		},
+		{
+			name: "route without parents",
+			obj:  makeGatewayAPIObject(kubernetes.GatewayAPIHTTPRouteGVK, 1, map[string]any{}),
+		},
+		{
+			name: "route accepted",
+			obj: makeGatewayAPIObject(kubernetes.GatewayAPIHTTPRouteGVK, 1, makeRouteStatus(
+				condition(GatewayAPIConditionAccepted, "True", "Accepted", 1),
+				condition(GatewayAPIConditionResolvedRefs, "True", "ResolvedRefs", 1),
+			)),
+			ready: true,
+		},
+		{
+			name: "route refs not resolved yet",
+			obj: makeGatewayAPIObject(kubernetes.GatewayAPITLSRouteGVK, 1, makeRouteStatus(
+				condition(GatewayAPIConditionAccepted, "True", "Accepted", 1),
+			)),
+		},
+		{
+			name: "route backend not found",
+			obj: makeGatewayAPIObject(kubernetes.GatewayAPIHTTPRouteGVK, 1, makeRouteStatus(
+				condition(GatewayAPIConditionAccepted, "True", "Accepted", 1),
+				condition(GatewayAPIConditionResolvedRefs, "False", "BackendNotFound", 1),
+			)),
+			err: "HTTPRoute test-gateway is not ready. Condition: ResolvedRefs, Reason: BackendNotFound, Message: BackendNotFound message",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, err := isGatewayAPIResourceReady(tt.obj)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.ready, ready)
+		})
+	}
+}
+
+func TestGatewayAPIWaiter_WaitUntilReady(t *testing.T) {
+	gateway := makeGatewayAPIObject(kubernetes.GatewayAPIGatewayGVK, 1, map[string]any{
+		"conditions": []any{
+			condition(GatewayAPIConditionProgrammed, "True", "Programmed", 1),
+		},
+	})
+
+	fakeClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		kubernetes.GatewayAPIGatewayGVR: "GatewayList",
+	})
+
+	// The fake client guesses the resource name "gatewaies" for the Gateway kind, so the object is added with its resource.
+	err := fakeClient.Tracker().Create(kubernetes.GatewayAPIGatewayGVR, gateway, "default")
+	require.NoError(t, err)
+
+	waiter := NewGatewayAPIWaiter(fakeClient)
+	waiter.gatewayDeploymentTimeout = time.Second * 5
+
+	obj := makeGatewayAPIObject(kubernetes.GatewayAPIGatewayGVK, 1, nil)
+	err = waiter.waitUntilReady(context.Background(), obj)
+	require.NoError(t, err)
+}
+
+func TestGatewayAPIWaiter_WaitUntilReady_Timeout(t *testing.T) {
+	route := makeGatewayAPIObject(kubernetes.GatewayAPIHTTPRouteGVK, 1, makeRouteStatus(
+		condition(GatewayAPIConditionAccepted, "True", "Accepted", 1),
+	))
+
+	fakeClient := fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		kubernetes.GatewayAPIHTTPRouteGVR: "HTTPRouteList",
+	}, route)
+
+	waiter := NewGatewayAPIWaiter(fakeClient)
+	waiter.gatewayDeploymentTimeout = time.Second
+
+	obj := makeGatewayAPIObject(kubernetes.GatewayAPIHTTPRouteGVK, 1, nil)
+	err := waiter.waitUntilReady(context.Background(), obj)
+	require.EqualError(t, err, "HTTPRoute deployment timed out, name: test-gateway, namespace default, status: Accepted=True (reason: Accepted, message: Accepted message)")
+}
@@ -93,11 +93,13 @@ func NewApplicationModel(arm *armauth.ArmConfig, k8sClient client.Client, k8sCli
 			ResourceType: container.ResourceType,
 			Renderer: &mux.Renderer{
 				Inners: map[rpv1.EnvironmentComputeKind]renderers.Renderer{
-					rpv1.KubernetesComputeKind: &kubernetesmetadata.Renderer{
-						Inner: &manualscale.Renderer{
-							Inner: &daprextension.Renderer{
-								Inner: &container.Renderer{
-									RoleAssignmentMap: roleAssignmentMap,
+					rpv1.KubernetesComputeKind: &container.WorkloadRenderer{
+						Inner: &kubernetesmetadata.Renderer{
+							Inner: &manualscale.Renderer{
+								Inner: &daprextension.Renderer{
+									Inner: &container.Renderer{
+										RoleAssignmentMap: roleAssignmentMap,
+									},
 								},
 							},
 						},
@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// jobNameHashLength is the number of hex characters of the pod template hash appended to the job name.
+	jobNameHashLength = 10
+
+	// maxJobNameLength is the maximum length of a job name. Kubernetes copies the job name into the
+	// 'job-name' label of each pod, so it must be a valid label value.
+	maxJobNameLength = 63
+)
+
+// WorkloadRenderer is the renderers.Renderer implementation that converts the Deployment rendered for a container
+// into a Job or CronJob when the container specifies a job or cronJob workload.
+//
+// This renderer must wrap the other Kubernetes extension renderers so that extensions which modify the
+// rendered Deployment (labels, annotations, Dapr sidecar) are also applied to the Job or CronJob.
+type WorkloadRenderer struct {
+	Inner renderers.Renderer
+}
+
+// GetDependencyIDs gets the IDs of the resources that the given resource depends on.
+func (r *WorkloadRenderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	// Let the inner renderer do its work
+	return r.Inner.GetDependencyIDs(ctx, resource)
+}
+
+// Render lets the inner renderer render the container and then replaces the Deployment output resource with
+// a Job or CronJob based on the workload kind of the container.
+func (r *WorkloadRenderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	// Let the inner renderer do its work
+	output, err := r.Inner.Render(ctx, dm, options)
+	if err != nil {
+		return renderers.RendererOutput{}, err
+	}
+
+	resource, ok := dm.(*datamodel.ContainerResource)
+	if !ok {
+		return renderers.RendererOutput{}, v1.ErrInvalidModelConversion
+	}
+
+	kind := resource.Properties.GetWorkloadKind()
+	if kind == datamodel.WorkloadKindDeployment {
+		return output, nil
+	}
+
+	for i, ores := range output.Resources {
+		if ores.LocalID != rpv1.LocalIDDeployment || ores.CreateResource == nil {
+			continue
+		}
+
+		deployment, ok := ores.CreateResource.Data.(*appsv1.Deployment)
+		if !ok {
+			return renderers.RendererOutput{}, fmt.Errorf("found %s output resource with non-Deployment payload", rpv1.LocalIDDeployment)
+		}
+
+		var converted rpv1.OutputResource
+		switch kind {
+		case datamodel.WorkloadKindJob:
+			job, err := makeJob(deployment, resource.Properties.Workload)
+			if err != nil {
+				return renderers.RendererOutput{}, err
+			}
+			converted = rpv1.NewKubernetesOutputResource(rpv1.LocalIDJob, job, job.ObjectMeta)
+		case datamodel.WorkloadKindCronJob:
+			cronJob := makeCronJob(deployment, resource.Properties.Workload)
+			converted = rpv1.NewKubernetesOutputResource(rpv1.LocalIDCronJob, cronJob, cronJob.ObjectMeta)
+		default:
+			return renderers.RendererOutput{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("unsupported workload kind: %s", kind))
+		}
+
+		converted.CreateResource.Dependencies = ores.CreateResource.Dependencies
+		output.Resources[i] = converted
+	}
+
+	return output, nil
+}
+
+// makeJob creates a Job from the pod template of the rendered deployment.
+//
+// The pod template of a Job is immutable, so the name of the Job includes a hash of its spec. When the
+// container changes a new Job is created and the previous one is deleted as a stale output resource.
+func makeJob(deployment *appsv1.Deployment, workload *datamodel.WorkloadProperties) (*batchv1.Job, error) {
+	job := &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: *deployment.ObjectMeta.DeepCopy(),
+		Spec:       makeJobSpec(deployment, workload),
+	}
+
+	b, err := json.Marshal(job.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha1.Sum(b)
+	suffix := hex.EncodeToString(hash[:])[:jobNameHashLength]
+	name := job.Name
+	if len(name)+len(suffix)+1 > maxJobNameLength {
+		name = name[:maxJobNameLength-len(suffix)-1]
+	}
+	job.Name = name + "-" + suffix
+
+	return job, nil
+}
+
+// makeCronJob creates a CronJob from the pod template of the rendered deployment.
+func makeCronJob(deployment *appsv1.Deployment, workload *datamodel.WorkloadProperties) *batchv1.CronJob {
+	cronJob := &batchv1.CronJob{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CronJob",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: *deployment.ObjectMeta.DeepCopy(),
+		Spec: batchv1.CronJobSpec{
+			Schedule: workload.Schedule,
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: deployment.Spec.Template.Labels,
+				},
+				Spec: makeJobSpec(deployment, workload),
+			},
+			Suspend:                    workload.Suspend,
+			SuccessfulJobsHistoryLimit: workload.SuccessfulJobsHistoryLimit,
+			FailedJobsHistoryLimit:     workload.FailedJobsHistoryLimit,
+		},
+	}
+
+	if workload.ConcurrencyPolicy != "" {
+		cronJob.Spec.ConcurrencyPolicy = batchv1.ConcurrencyPolicy(workload.ConcurrencyPolicy)
+	}
+
+	return cronJob
+}
+
+func makeJobSpec(deployment *appsv1.Deployment, workload *datamodel.WorkloadProperties) batchv1.JobSpec {
+	template := *deployment.Spec.Template.DeepCopy()
+
+	// Pods of a Job must not be restarted always. If the user has not specified a restart policy, we
+	// retry failed containers in place.
+	if template.Spec.RestartPolicy == "" || template.Spec.RestartPolicy == corev1.RestartPolicyAlways {
+		template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+	}
+
+	return batchv1.JobSpec{
+		Template:                template,
+		Completions:             workload.Completions,
+		Parallelism:             workload.Parallelism,
+		BackoffLimit:            workload.BackoffLimit,
+		ActiveDeadlineSeconds:   workload.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished: workload.TTLSecondsAfterFinished,
+	}
+}
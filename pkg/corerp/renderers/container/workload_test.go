@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package container
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ renderers.Renderer = (*deploymentRenderer)(nil)
+
+// deploymentRenderer renders a minimal deployment for the container so the workload renderer can convert it.
+type deploymentRenderer struct {
+	name string
+}
+
+func (r *deploymentRenderer) GetDependencyIDs(ctx context.Context, resource v1.DataModelInterface) ([]resources.ID, []resources.ID, error) {
+	return nil, nil, nil
+}
+
+func (r *deploymentRenderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
+	resource := dm.(*datamodel.ContainerResource)
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.name,
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "test"},
+				},
+				Spec: corev1.PodSpec{
+					Containers:    []corev1.Container{{Name: r.name, Image: "test-image"}},
+					RestartPolicy: corev1.RestartPolicy(resource.Properties.RestartPolicy),
+				},
+			},
+		},
+	}
+
+	deploymentOutput := rpv1.NewKubernetesOutputResource(rpv1.LocalIDDeployment, deployment, deployment.ObjectMeta)
+	deploymentOutput.CreateResource.Dependencies = []string{rpv1.LocalIDServiceAccount}
+
+	serviceAccount := &corev1.ServiceAccount{
+		TypeMeta:   metav1.TypeMeta{Kind: "ServiceAccount", APIVersion: "v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: r.name, Namespace: "test-namespace"},
+	}
+
+	return renderers.RendererOutput{
+		Resources: []rpv1.OutputResource{
+			rpv1.NewKubernetesOutputResource(rpv1.LocalIDServiceAccount, serviceAccount, serviceAccount.ObjectMeta),
+			deploymentOutput,
+		},
+	}, nil
+}
+
+func makeWorkloadResource(workload *datamodel.WorkloadProperties, restartPolicy string) *datamodel.ContainerResource {
+	return &datamodel.ContainerResource{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{Name: "test-container"},
+		},
+		Properties: datamodel.ContainerProperties{
+			RestartPolicy: restartPolicy,
+			Workload:      workload,
+		},
+	}
+}
+
+func Test_WorkloadRenderer_Deployment(t *testing.T) {
+	renderer := &WorkloadRenderer{Inner: &deploymentRenderer{name: "test-container"}}
+
+	for _, workload := range []*datamodel.WorkloadProperties{nil, {Kind: datamodel.WorkloadKindDeployment}} {
+		output, err := renderer.Render(context.Background(), makeWorkloadResource(workload, ""), renderers.RenderOptions{})
+		require.NoError(t, err)
+		require.Len(t, output.Resources, 2)
+		require.Equal(t, rpv1.LocalIDDeployment, output.Resources[1].LocalID)
+		require.IsType(t, &appsv1.Deployment{}, output.Resources[1].CreateResource.Data)
+	}
+}
+
+func Test_WorkloadRenderer_Job(t *testing.T) {
+	renderer := &WorkloadRenderer{Inner: &deploymentRenderer{name: "test-container"}}
+	workload := &datamodel.WorkloadProperties{
+		Kind:                    datamodel.WorkloadKindJob,
+		Completions:             to.Ptr[int32](3),
+		Parallelism:             to.Ptr[int32](2),
+		BackoffLimit:            to.Ptr[int32](4),
+		ActiveDeadlineSeconds:   to.Ptr[int64](600),
+		TTLSecondsAfterFinished: to.Ptr[int32](60),
+	}
+
+	output, err := renderer.Render(context.Background(), makeWorkloadResource(workload, ""), renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	jobOutput := output.Resources[1]
+	require.Equal(t, rpv1.LocalIDJob, jobOutput.LocalID)
+	require.Equal(t, "batch/Job", jobOutput.GetResourceType().Type)
+	require.Equal(t, []string{rpv1.LocalIDServiceAccount}, jobOutput.CreateResource.Dependencies)
+
+	job, ok := jobOutput.CreateResource.Data.(*batchv1.Job)
+	require.True(t, ok)
+	require.True(t, strings.HasPrefix(job.Name, "test-container-"))
+	require.Len(t, job.Name, len("test-container-")+jobNameHashLength)
+	require.Equal(t, "test-namespace", job.Namespace)
+	require.Equal(t, map[string]string{"app": "test"}, job.Labels)
+	require.Equal(t, corev1.RestartPolicyOnFailure, job.Spec.Template.Spec.RestartPolicy)
+	require.Equal(t, "test-image", job.Spec.Template.Spec.Containers[0].Image)
+	require.Equal(t, workload.Completions, job.Spec.Completions)
+	require.Equal(t, workload.Parallelism, job.Spec.Parallelism)
+	require.Equal(t, workload.BackoffLimit, job.Spec.BackoffLimit)
+	require.Equal(t, workload.ActiveDeadlineSeconds, job.Spec.ActiveDeadlineSeconds)
+	require.Equal(t, workload.TTLSecondsAfterFinished, job.Spec.TTLSecondsAfterFinished)
+	require.Nil(t, job.Spec.Selector)
+
+	// Rendering the same container must produce the same job name, while a changed container must not.
+	again, err := renderer.Render(context.Background(), makeWorkloadResource(workload, ""), renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Equal(t, job.Name, again.Resources[1].CreateResource.Data.(*batchv1.Job).Name)
+
+	changed, err := renderer.Render(context.Background(), makeWorkloadResource(workload, "Never"), renderers.RenderOptions{})
+	require.NoError(t, err)
+	changedJob := changed.Resources[1].CreateResource.Data.(*batchv1.Job)
+	require.NotEqual(t, job.Name, changedJob.Name)
+	require.Equal(t, corev1.RestartPolicyNever, changedJob.Spec.Template.Spec.RestartPolicy)
+}
+
+func Test_WorkloadRenderer_Job_LongName(t *testing.T) {
+	name := strings.Repeat("a", 63)
+	renderer := &WorkloadRenderer{Inner: &deploymentRenderer{name: name}}
+
+	output, err := renderer.Render(context.Background(), makeWorkloadResource(&datamodel.WorkloadProperties{Kind: datamodel.WorkloadKindJob}, ""), renderers.RenderOptions{})
+	require.NoError(t, err)
+
+	job := output.Resources[1].CreateResource.Data.(*batchv1.Job)
+	require.Len(t, job.Name, maxJobNameLength)
+}
+
+func Test_WorkloadRenderer_CronJob(t *testing.T) {
+	renderer := &WorkloadRenderer{Inner: &deploymentRenderer{name: "test-container"}}
+	workload := &datamodel.WorkloadProperties{
+		Kind:                       datamodel.WorkloadKindCronJob,
+		Schedule:                   "*/5 * * * *",
+		BackoffLimit:               to.Ptr[int32](1),
+		ConcurrencyPolicy:          "Forbid",
+		Suspend:                    to.Ptr(false),
+		SuccessfulJobsHistoryLimit: to.Ptr[int32](2),
+		FailedJobsHistoryLimit:     to.Ptr[int32](1),
+	}
+
+	output, err := renderer.Render(context.Background(), makeWorkloadResource(workload, "Never"), renderers.RenderOptions{})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	cronJobOutput := output.Resources[1]
+	require.Equal(t, rpv1.LocalIDCronJob, cronJobOutput.LocalID)
+	require.Equal(t, "batch/CronJob", cronJobOutput.GetResourceType().Type)
+
+	cronJob, ok := cronJobOutput.CreateResource.Data.(*batchv1.CronJob)
+	require.True(t, ok)
+	require.Equal(t, "test-container", cronJob.Name)
+	require.Equal(t, "*/5 * * * *", cronJob.Spec.Schedule)
+	require.Equal(t, batchv1.ForbidConcurrent, cronJob.Spec.ConcurrencyPolicy)
+	require.Equal(t, workload.Suspend, cronJob.Spec.Suspend)
+	require.Equal(t, workload.SuccessfulJobsHistoryLimit, cronJob.Spec.SuccessfulJobsHistoryLimit)
+	require.Equal(t, workload.FailedJobsHistoryLimit, cronJob.Spec.FailedJobsHistoryLimit)
+	require.Equal(t, workload.BackoffLimit, cronJob.Spec.JobTemplate.Spec.BackoffLimit)
+	require.Equal(t, map[string]string{"app": "test"}, cronJob.Spec.JobTemplate.Labels)
+	require.Equal(t, corev1.RestartPolicyNever, cronJob.Spec.JobTemplate.Spec.Template.Spec.RestartPolicy)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"net"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+// MakeCertificateResources creates the cert-manager resources that issue the TLS certificate for the gateway hostname.
+//
+// An ACME certificate is requested from the ClusterIssuer configured on the gateway. A self-signed certificate is
+// issued by a certificate authority created for the gateway: a self-signed Issuer issues the CA certificate, and a CA
+// Issuer backed by that certificate issues the gateway certificate. cert-manager stores the issued certificate in the
+// secret returned by getIssuedCertificateSecretName and renews it before it expires.
+func MakeCertificateResources(options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string, hostname string) ([]rpv1.OutputResource, error) {
+	if hostname == "" || net.ParseIP(hostname) != nil {
+		return nil, v1.NewClientErrInvalidRequest("the gateway must have a DNS hostname to issue a TLS certificate automatically")
+	}
+
+	gatewayName := kubernetes.NormalizeResourceName(gateway.Name)
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		}
+	}
+
+	outputResources := []rpv1.OutputResource{}
+	issuerRef := map[string]any{
+		"group": kubernetes.CertManagerGroup,
+	}
+	var certificateDependencies []string
+
+	switch gateway.Properties.TLS.CertificateIssuer.Kind {
+	case datamodel.GatewayCertificateIssuerKindACME:
+		issuerRef["kind"] = "ClusterIssuer"
+		issuerRef["name"] = gateway.Properties.TLS.CertificateIssuer.ClusterIssuer
+	case datamodel.GatewayCertificateIssuerKindSelfSigned:
+		selfSignedIssuerMeta := objectMeta(gatewayName + "-selfsigned")
+		selfSignedIssuer := makeUnstructuredObject(kubernetes.CertManagerIssuerGVK, selfSignedIssuerMeta, map[string]any{
+			"selfSigned": map[string]any{},
+		})
+
+		caMeta := objectMeta(gatewayName + "-ca")
+		ca := makeUnstructuredObject(kubernetes.CertManagerCertificateGVK, caMeta, map[string]any{
+			"isCA":       true,
+			"commonName": caMeta.Name,
+			"secretName": caMeta.Name,
+			"privateKey": map[string]any{
+				"algorithm": "ECDSA",
+				"size":      int64(256),
+			},
+			"issuerRef": map[string]any{
+				"group": kubernetes.CertManagerGroup,
+				"kind":  kubernetes.CertManagerIssuerGVK.Kind,
+				"name":  selfSignedIssuerMeta.Name,
+			},
+		})
+		caResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDCertificateAuthority, ca, caMeta)
+		caResource.CreateResource.Dependencies = []string{rpv1.LocalIDCertificateIssuer}
+
+		caIssuerMeta := objectMeta(gatewayName + "-ca")
+		caIssuer := makeUnstructuredObject(kubernetes.CertManagerIssuerGVK, caIssuerMeta, map[string]any{
+			"ca": map[string]any{
+				"secretName": caMeta.Name,
+			},
+		})
+		caIssuerResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDCertificateAuthorityIssuer, caIssuer, caIssuerMeta)
+		caIssuerResource.CreateResource.Dependencies = []string{rpv1.LocalIDCertificateAuthority}
+
+		outputResources = append(outputResources,
+			rpv1.NewKubernetesOutputResource(rpv1.LocalIDCertificateIssuer, selfSignedIssuer, selfSignedIssuerMeta),
+			caResource,
+			caIssuerResource)
+
+		issuerRef["kind"] = kubernetes.CertManagerIssuerGVK.Kind
+		issuerRef["name"] = caIssuerMeta.Name
+		certificateDependencies = []string{rpv1.LocalIDCertificateAuthorityIssuer}
+	default:
+		return nil, v1.NewClientErrInvalidRequest("unsupported certificate issuer kind: " + string(gateway.Properties.TLS.CertificateIssuer.Kind))
+	}
+
+	certificateMeta := objectMeta(getIssuedCertificateSecretName(gateway))
+	certificate := makeUnstructuredObject(kubernetes.CertManagerCertificateGVK, certificateMeta, map[string]any{
+		"secretName": certificateMeta.Name,
+		"dnsNames":   []any{hostname},
+		"issuerRef":  issuerRef,
+	})
+	certificateResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDCertificate, certificate, certificateMeta)
+	certificateResource.CreateResource.Dependencies = certificateDependencies
+
+	return append(outputResources, certificateResource), nil
+}
+
+// getIssuedCertificateSecretName returns the name of the Kubernetes secret that holds the automatically issued
+// certificate of the gateway.
+func getIssuedCertificateSecretName(gateway *datamodel.Gateway) string {
+	return kubernetes.NormalizeResourceName(gateway.Name) + "-tls"
+}
+
+// makeCertificateComputedValues returns the computed values that report the expiry and renewal times of the
+// automatically issued certificate, as reported by cert-manager when the certificate is issued.
+func makeCertificateComputedValues() map[string]rpv1.ComputedValueReference {
+	return map[string]rpv1.ComputedValueReference{
+		datamodel.GatewayCertificateNotAfter: {
+			LocalID:           rpv1.LocalIDCertificate,
+			PropertyReference: handlers.CertificateNotAfterKey,
+		},
+		datamodel.GatewayCertificateRenewalTime: {
+			LocalID:           rpv1.LocalIDCertificate,
+			PropertyReference: handlers.CertificateRenewalTimeKey,
+		},
+	}
+}
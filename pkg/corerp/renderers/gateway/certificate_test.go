@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/handlers"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+func Test_Render_CertificateIssuer_ACME(t *testing.T) {
+	r := &Renderer{}
+
+	properties := makeGatewayAPITestProperties([]datamodel.GatewayRoute{{Destination: "http://A", Path: "/"}}, &datamodel.GatewayPropertiesTLS{
+		MinimumProtocolVersion: datamodel.TLSMinVersion12,
+		CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+			Kind:          datamodel.GatewayCertificateIssuerKindACME,
+			ClusterIssuer: "letsencrypt",
+		},
+	})
+	resource := makeResource(properties)
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+	require.Equal(t, rpv1.ComputedValueReference{
+		LocalID:           rpv1.LocalIDCertificate,
+		PropertyReference: handlers.CertificateNotAfterKey,
+	}, output.ComputedValues[datamodel.GatewayCertificateNotAfter])
+	require.Equal(t, rpv1.ComputedValueReference{
+		LocalID:           rpv1.LocalIDCertificate,
+		PropertyReference: handlers.CertificateRenewalTimeKey,
+	}, output.ComputedValues[datamodel.GatewayCertificateRenewalTime])
+
+	certificate := requireUnstructured(t, output.Resources[0], resources_kubernetes.ResourceTypeCertManagerCertificate)
+	require.Equal(t, rpv1.LocalIDCertificate, output.Resources[0].LocalID)
+	require.Empty(t, output.Resources[0].CreateResource.Dependencies)
+	require.Equal(t, "cert-manager.io/v1", certificate.GetAPIVersion())
+	require.Equal(t, resourceName+"-tls", certificate.GetName())
+	require.Equal(t, applicationName, certificate.GetNamespace())
+	require.Equal(t, map[string]any{
+		"secretName": resourceName + "-tls",
+		"dnsNames":   []any{expectedHostname},
+		"issuerRef": map[string]any{
+			"group": "cert-manager.io",
+			"kind":  "ClusterIssuer",
+			"name":  "letsencrypt",
+		},
+	}, certificate.Object["spec"])
+
+	require.Equal(t, rpv1.LocalIDGateway, output.Resources[1].LocalID)
+	require.Equal(t, []string{rpv1.LocalIDCertificate, "HttpProxy-A"}, output.Resources[1].CreateResource.Dependencies)
+	httpProxy, ok := output.Resources[1].CreateResource.Data.(*contourv1.HTTPProxy)
+	require.True(t, ok)
+	require.Equal(t, &contourv1.TLS{
+		SecretName:             fmt.Sprintf("%s/%s-tls", applicationName, resourceName),
+		MinimumProtocolVersion: string(datamodel.TLSMinVersion12),
+	}, httpProxy.Spec.VirtualHost.TLS)
+}
+
+func Test_Render_CertificateIssuer_SelfSigned_GatewayAPI(t *testing.T) {
+	r := &Renderer{}
+
+	properties := makeGatewayAPITestProperties([]datamodel.GatewayRoute{{Destination: "http://A", Path: "/"}}, &datamodel.GatewayPropertiesTLS{
+		CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+			Kind: datamodel.GatewayCertificateIssuerKindSelfSigned,
+		},
+	})
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 6)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	selfSignedIssuer := requireUnstructured(t, output.Resources[0], resources_kubernetes.ResourceTypeCertManagerIssuer)
+	require.Equal(t, rpv1.LocalIDCertificateIssuer, output.Resources[0].LocalID)
+	require.Equal(t, resourceName+"-selfsigned", selfSignedIssuer.GetName())
+	require.Equal(t, map[string]any{"selfSigned": map[string]any{}}, selfSignedIssuer.Object["spec"])
+
+	ca := requireUnstructured(t, output.Resources[1], resources_kubernetes.ResourceTypeCertManagerCertificate)
+	require.Equal(t, rpv1.LocalIDCertificateAuthority, output.Resources[1].LocalID)
+	require.Equal(t, []string{rpv1.LocalIDCertificateIssuer}, output.Resources[1].CreateResource.Dependencies)
+	require.Equal(t, map[string]any{
+		"isCA":       true,
+		"commonName": resourceName + "-ca",
+		"secretName": resourceName + "-ca",
+		"privateKey": map[string]any{"algorithm": "ECDSA", "size": int64(256)},
+		"issuerRef": map[string]any{
+			"group": "cert-manager.io",
+			"kind":  "Issuer",
+			"name":  resourceName + "-selfsigned",
+		},
+	}, ca.Object["spec"])
+
+	caIssuer := requireUnstructured(t, output.Resources[2], resources_kubernetes.ResourceTypeCertManagerIssuer)
+	require.Equal(t, rpv1.LocalIDCertificateAuthorityIssuer, output.Resources[2].LocalID)
+	require.Equal(t, []string{rpv1.LocalIDCertificateAuthority}, output.Resources[2].CreateResource.Dependencies)
+	require.Equal(t, map[string]any{"ca": map[string]any{"secretName": resourceName + "-ca"}}, caIssuer.Object["spec"])
+
+	certificate := requireUnstructured(t, output.Resources[3], resources_kubernetes.ResourceTypeCertManagerCertificate)
+	require.Equal(t, rpv1.LocalIDCertificate, output.Resources[3].LocalID)
+	require.Equal(t, []string{rpv1.LocalIDCertificateAuthorityIssuer}, output.Resources[3].CreateResource.Dependencies)
+	issuerRef, _, err := unstructured.NestedMap(certificate.Object, "spec", "issuerRef")
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"group": "cert-manager.io", "kind": "Issuer", "name": resourceName + "-ca"}, issuerRef)
+
+	gateway := requireUnstructured(t, output.Resources[4], resources_kubernetes.ResourceTypeGatewayAPIGateway)
+	require.Equal(t, []string{rpv1.LocalIDCertificate}, output.Resources[4].CreateResource.Dependencies)
+	listeners, _, err := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"mode": "Terminate",
+		"certificateRefs": []any{
+			map[string]any{"kind": "Secret", "name": resourceName + "-tls"},
+		},
+	}, listeners[0].(map[string]any)["tls"])
+}
+
+func Test_Render_CertificateIssuer_IPAddressHostname(t *testing.T) {
+	r := &Renderer{}
+
+	properties := makeGatewayAPITestProperties([]datamodel.GatewayRoute{{Destination: "http://A", Path: "/"}}, &datamodel.GatewayPropertiesTLS{
+		CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+			Kind: datamodel.GatewayCertificateIssuerKindSelfSigned,
+		},
+	})
+	resource := makeResource(properties)
+	environmentOptions := getEnvironmentOptions("10.0.0.1", "", "", true, false)
+
+	_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.Equal(t, v1.NewClientErrInvalidRequest("the gateway must have a DNS hostname to issue a TLS certificate automatically"), err)
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+const (
+	// basicAuthUsersKey is the key of the secret store that holds the users of the basic authentication policy. Envoy
+	// Gateway reads the users in htpasswd format from this key of the secret.
+	basicAuthUsersKey = ".htpasswd"
+
+	// gatewayAPIPolicyNotSupported is the error message for the policy settings that Envoy Gateway cannot express.
+	gatewayAPIPolicyNotSupported = "%s is not supported for gateways rendered with the Gateway API"
+)
+
+// gatewayAPIRoute is a Gateway API HTTPRoute rendered for the routes to a destination, which share the same policies.
+type gatewayAPIRoute struct {
+	// localID is the local ID of the HTTPRoute output resource.
+	localID string
+	// name is the name of the HTTPRoute.
+	name string
+	// routeName is the name of the routes, which is the hostname of their destination.
+	routeName string
+	// policies are the policies of the routes.
+	policies *datamodel.GatewayRoutePolicies
+}
+
+// makeEnvoyGatewayPolicies creates the Envoy Gateway SecurityPolicy and BackendTrafficPolicy resources that apply the
+// policies of the gateway and its routes. The Gateway API does not declare authentication, rate limiting or CORS, so
+// the policies require the GatewayClass of the environment to be implemented by Envoy Gateway.
+//
+// Envoy Gateway applies the most specific policy to a route, so the SecurityPolicy of a route repeats the policies of
+// the gateway that still apply to the route.
+func makeEnvoyGatewayPolicies(options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string, gatewayName string, routes []gatewayAPIRoute) ([]rpv1.OutputResource, error) {
+	policies := gateway.Properties.Policies
+	if policies == nil {
+		policies = &datamodel.GatewayPolicies{}
+	}
+
+	gatewayTarget := map[string]any{
+		"group": kubernetes.GatewayAPIGroup,
+		"kind":  kubernetes.GatewayAPIGatewayGVK.Kind,
+		"name":  gatewayName,
+	}
+
+	outputResources := []rpv1.OutputResource{}
+	if gateway.Properties.Policies != nil {
+		spec, err := makeSecurityPolicySpec(options, policies.CORS, policies.JWT, policies.ExternalAuth, policies.BasicAuth)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(spec) > 0 {
+			spec["targetRefs"] = []any{gatewayTarget}
+			outputResources = append(outputResources, makeEnvoyGatewayPolicy(options, gateway, applicationName, kubernetes.EnvoyGatewaySecurityPolicyGVK, rpv1.LocalIDSecurityPolicy, gatewayName, gateway.Name, spec, rpv1.LocalIDGateway))
+		}
+
+		if policies.RateLimit != nil {
+			spec, err := makeBackendTrafficPolicySpec("rateLimit", policies.RateLimit)
+			if err != nil {
+				return nil, err
+			}
+
+			spec["targetRefs"] = []any{gatewayTarget}
+			outputResources = append(outputResources, makeEnvoyGatewayPolicy(options, gateway, applicationName, kubernetes.EnvoyGatewayBackendTrafficPolicyGVK, rpv1.LocalIDBackendTrafficPolicy, gatewayName, gateway.Name, spec, rpv1.LocalIDGateway))
+		}
+	}
+
+	hasAuthentication := policies.JWT != nil || policies.ExternalAuth != nil || policies.BasicAuth != nil
+	for _, route := range routes {
+		if route.policies == nil {
+			continue
+		}
+
+		routeTarget := map[string]any{
+			"group": kubernetes.GatewayAPIGroup,
+			"kind":  kubernetes.GatewayAPIHTTPRouteGVK.Kind,
+			"name":  route.name,
+		}
+
+		if route.policies.JWT != nil || (route.policies.Anonymous && hasAuthentication) {
+			// Like the JWT provider of a route with Contour, the JWT policy of the route replaces the authentication
+			// of the gateway, while the external authorization of the gateway still applies.
+			jwt, externalAuth, basicAuth := policies.JWT, policies.ExternalAuth, policies.BasicAuth
+			if route.policies.JWT != nil {
+				jwt, basicAuth = route.policies.JWT, nil
+			}
+			if route.policies.Anonymous {
+				jwt, externalAuth, basicAuth = nil, nil, nil
+			}
+
+			spec, err := makeSecurityPolicySpec(options, policies.CORS, jwt, externalAuth, basicAuth)
+			if err != nil {
+				return nil, err
+			}
+
+			spec["targetRefs"] = []any{routeTarget}
+			localID := fmt.Sprintf("%s-%s", rpv1.LocalIDSecurityPolicy, route.routeName)
+			outputResources = append(outputResources, makeEnvoyGatewayPolicy(options, gateway, applicationName, kubernetes.EnvoyGatewaySecurityPolicyGVK, localID, route.name, route.routeName, spec, route.localID))
+		}
+
+		if route.policies.RateLimit != nil {
+			spec, err := makeBackendTrafficPolicySpec("rateLimit", route.policies.RateLimit)
+			if err != nil {
+				return nil, err
+			}
+
+			spec["targetRefs"] = []any{routeTarget}
+			localID := fmt.Sprintf("%s-%s", rpv1.LocalIDBackendTrafficPolicy, route.routeName)
+			outputResources = append(outputResources, makeEnvoyGatewayPolicy(options, gateway, applicationName, kubernetes.EnvoyGatewayBackendTrafficPolicyGVK, localID, route.name, route.routeName, spec, route.localID))
+		}
+	}
+
+	return outputResources, nil
+}
+
+// makeEnvoyGatewayPolicy creates the output resource of an Envoy Gateway policy, which depends on the resource that it
+// targets.
+func makeEnvoyGatewayPolicy(options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string, gvk schema.GroupVersionKind, localID string, name string, labelName string, spec map[string]any, targetLocalID string) rpv1.OutputResource {
+	objectMeta := metav1.ObjectMeta{
+		Name:        name,
+		Namespace:   options.Environment.Namespace,
+		Labels:      renderers.GetLabels(options, applicationName, labelName, gateway.ResourceTypeName()),
+		Annotations: renderers.GetAnnotations(options),
+	}
+
+	resource := rpv1.NewKubernetesOutputResource(localID, makeUnstructuredObject(gvk, objectMeta, spec), objectMeta)
+	resource.CreateResource.Dependencies = []string{targetLocalID}
+
+	return resource
+}
+
+// makeSecurityPolicySpec creates the spec of an Envoy Gateway SecurityPolicy with the given CORS and authentication
+// policies.
+func makeSecurityPolicySpec(options renderers.RenderOptions, cors *datamodel.GatewayCORSPolicy, jwt *datamodel.GatewayJWTPolicy, externalAuth *datamodel.GatewayExternalAuthPolicy, basicAuth *datamodel.GatewayBasicAuthPolicy) (map[string]any, error) {
+	spec := map[string]any{}
+
+	if cors != nil {
+		corsSpec := map[string]any{
+			"allowOrigins": toAnySlice(cors.AllowOrigins),
+			"allowMethods": toAnySlice(cors.AllowMethods),
+		}
+		if len(cors.AllowHeaders) > 0 {
+			corsSpec["allowHeaders"] = toAnySlice(cors.AllowHeaders)
+		}
+		if len(cors.ExposeHeaders) > 0 {
+			corsSpec["exposeHeaders"] = toAnySlice(cors.ExposeHeaders)
+		}
+		if cors.MaxAge != "" {
+			corsSpec["maxAge"] = cors.MaxAge
+		}
+		if cors.AllowCredentials {
+			corsSpec["allowCredentials"] = true
+		}
+		spec["cors"] = corsSpec
+	}
+
+	if jwt != nil {
+		if jwt.CACertificateFrom != "" {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf(gatewayAPIPolicyNotSupported, "jwt.caCertificateFrom"))
+		}
+		if jwt.ForwardToken {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf(gatewayAPIPolicyNotSupported, "jwt.forwardToken"))
+		}
+
+		provider := map[string]any{
+			"name":   gatewayJWTProviderName,
+			"issuer": jwt.Issuer,
+			"remoteJWKS": map[string]any{
+				"uri": jwt.JWKSURI,
+			},
+		}
+		if len(jwt.Audiences) > 0 {
+			provider["audiences"] = toAnySlice(jwt.Audiences)
+		}
+		spec["jwt"] = map[string]any{
+			"providers": []any{provider},
+		}
+	}
+
+	if externalAuth != nil {
+		if externalAuth.Timeout != "" {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf(gatewayAPIPolicyNotSupported, "externalAuth.timeout"))
+		}
+
+		scheme, hostname, port, err := parseURL(externalAuth.Destination)
+		if err != nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid external authorization destination %s: %s", externalAuth.Destination, err.Error()))
+		}
+		if scheme != "http" {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf(gatewayAPIPolicyNotSupported, "an 'https' externalAuth.destination"))
+		}
+
+		extAuth := map[string]any{
+			"grpc": map[string]any{
+				"backendRefs": []any{
+					map[string]any{
+						"name": kubernetes.NormalizeResourceName(hostname),
+						"port": int64(port),
+					},
+				},
+			},
+		}
+		if externalAuth.FailOpen {
+			extAuth["failOpen"] = true
+		}
+		spec["extAuth"] = extAuth
+	}
+
+	if basicAuth != nil {
+		secretNamespace, secretName, err := getSecretStoreSecret(basicAuth.SecretFrom, "basicAuth.secretFrom", options.Dependencies, datamodel.SecretTypeGeneric, basicAuthUsersKey)
+		if err != nil {
+			return nil, err
+		}
+
+		// A SecurityPolicy can only reference a secret in another namespace when that namespace allows it.
+		if secretNamespace != options.Environment.Namespace {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("basicAuth.secretFrom must reference a secretStore resource in the namespace %s", options.Environment.Namespace))
+		}
+
+		spec["basicAuth"] = map[string]any{
+			"users": map[string]any{
+				"kind": "Secret",
+				"name": secretName,
+			},
+		}
+	}
+
+	return spec, nil
+}
+
+// makeBackendTrafficPolicySpec creates the spec of an Envoy Gateway BackendTrafficPolicy that limits the rate of
+// requests handled by each Envoy instance.
+func makeBackendTrafficPolicySpec(property string, policy *datamodel.GatewayRateLimitPolicy) (map[string]any, error) {
+	if policy.Burst != 0 {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf(gatewayAPIPolicyNotSupported, property+".burst"))
+	}
+
+	var unit string
+	switch policy.Unit {
+	case datamodel.GatewayRateLimitUnitSecond:
+		unit = "Second"
+	case datamodel.GatewayRateLimitUnitMinute:
+		unit = "Minute"
+	case datamodel.GatewayRateLimitUnitHour:
+		unit = "Hour"
+	default:
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid %s.unit %s", property, policy.Unit))
+	}
+
+	return map[string]any{
+		"rateLimit": map[string]any{
+			"type": "Local",
+			"local": map[string]any{
+				"rules": []any{
+					map[string]any{
+						"limit": map[string]any{
+							"requests": int64(policy.Requests),
+							"unit":     unit,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func toAnySlice(values []string) []any {
+	converted := make([]any, len(values))
+	for i, value := range values {
+		converted[i] = value
+	}
+
+	return converted
+}
@@ -0,0 +1,575 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"reflect"
+	"regexp"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+)
+
+const (
+	// gatewayAPIListenerHTTP is the name of the listener for plain HTTP traffic.
+	gatewayAPIListenerHTTP = "http"
+	// gatewayAPIListenerHTTPS is the name of the listener that terminates TLS.
+	gatewayAPIListenerHTTPS = "https"
+	// gatewayAPIListenerTLS is the name of the listener that passes TLS traffic through to the route.
+	gatewayAPIListenerTLS = "tls"
+)
+
+// MakeGatewayAPIResources validates the Gateway resource and its dependencies, and creates a Kubernetes Gateway API
+// Gateway along with an HTTPRoute (or a TLSRoute when SSL passthrough is enabled) for each route destination.
+//
+// The Gateway uses the GatewayClass configured on the environment, so any Gateway API implementation (Envoy Gateway,
+// Istio, Cilium, ...) can serve the gateway. The routes depend on the Gateway so that they are accepted by the
+// implementation as soon as they are created. Policies are rendered as Envoy Gateway policies, so gateways with
+// policies require the GatewayClass to be implemented by Envoy Gateway.
+func MakeGatewayAPIResources(ctx context.Context, options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string, hostname string) ([]rpv1.OutputResource, error) {
+	if len(gateway.Properties.Routes) < 1 {
+		return nil, v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource")
+	}
+
+	if options.Environment.Ingress == nil || options.Environment.Ingress.GatewayClassName == "" {
+		return nil, v1.NewClientErrInvalidRequest("the environment must specify a gatewayClassName to render gateways with the Gateway API")
+	}
+
+	// Gateway API listeners only accept DNS names as hostnames.
+	if net.ParseIP(hostname) != nil {
+		hostname = ""
+	}
+
+	sslPassthrough := false
+	var certificateRef map[string]any
+	var referenceGrant *rpv1.OutputResource
+	if gateway.Properties.TLS != nil {
+		sslPassthrough = gateway.Properties.TLS.SSLPassthrough
+
+		if gateway.Properties.TLS.CertificateFrom != "" {
+			secretNamespace, secretName, err := getCertificateSecret(gateway, options.Dependencies)
+			if err != nil {
+				return nil, err
+			}
+
+			certificateRef = map[string]any{
+				"kind": "Secret",
+				"name": secretName,
+			}
+			if secretNamespace != options.Environment.Namespace {
+				certificateRef["namespace"] = secretNamespace
+
+				// A Gateway can only reference a secret in another namespace when that namespace allows it.
+				grant := makeReferenceGrant(options, gateway, applicationName, secretNamespace, secretName)
+				referenceGrant = &grant
+			}
+		} else if gateway.Properties.TLS.CertificateIssuer != nil {
+			certificateRef = map[string]any{
+				"kind": "Secret",
+				"name": getIssuedCertificateSecretName(gateway),
+			}
+		}
+	}
+
+	// If SSL Passthrough is enabled, then we can only have one route
+	if sslPassthrough && len(gateway.Properties.Routes) > 1 {
+		return nil, v1.NewClientErrInvalidRequest("cannot support multiple routes with sslPassthrough set to true")
+	}
+
+	// Policies apply to HTTP requests, which are not visible to the gateway when TLS is passed through.
+	if sslPassthrough && hasPolicies(gateway) {
+		return nil, v1.NewClientErrInvalidRequest("cannot support policies with sslPassthrough set to true")
+	}
+
+	var listener map[string]any
+	switch {
+	case sslPassthrough:
+		listener = map[string]any{
+			"name":     gatewayAPIListenerTLS,
+			"protocol": "TLS",
+			"port":     int64(443),
+			"tls": map[string]any{
+				"mode": "Passthrough",
+			},
+		}
+	case certificateRef != nil:
+		listener = map[string]any{
+			"name":     gatewayAPIListenerHTTPS,
+			"protocol": "HTTPS",
+			"port":     int64(443),
+			"tls": map[string]any{
+				"mode":            "Terminate",
+				"certificateRefs": []any{certificateRef},
+			},
+		}
+	default:
+		listener = map[string]any{
+			"name":     gatewayAPIListenerHTTP,
+			"protocol": "HTTP",
+			"port":     int64(80),
+		}
+	}
+	if hostname != "" {
+		listener["hostname"] = hostname
+	}
+
+	gatewayName := kubernetes.NormalizeResourceName(gateway.Name)
+	gatewayMeta := metav1.ObjectMeta{
+		Name:        gatewayName,
+		Namespace:   options.Environment.Namespace,
+		Labels:      renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName()),
+		Annotations: renderers.GetAnnotations(options),
+	}
+	gatewayObject := makeUnstructuredObject(kubernetes.GatewayAPIGatewayGVK, gatewayMeta, map[string]any{
+		"gatewayClassName": options.Environment.Ingress.GatewayClassName,
+		"listeners":        []any{listener},
+	})
+
+	gatewayResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDGateway, gatewayObject, gatewayMeta)
+	if gateway.Properties.TLS != nil && gateway.Properties.TLS.CertificateIssuer != nil {
+		// The secret referenced by the listener is created by cert-manager when the certificate is issued.
+		gatewayResource.CreateResource.Dependencies = []string{rpv1.LocalIDCertificate}
+	}
+
+	outputResources := []rpv1.OutputResource{gatewayResource}
+	if referenceGrant != nil {
+		gatewayResource.CreateResource.Dependencies = append(gatewayResource.CreateResource.Dependencies, rpv1.LocalIDReferenceGrant)
+		outputResources = []rpv1.OutputResource{gatewayResource, *referenceGrant}
+	}
+
+	parentRef := map[string]any{
+		"name":        gatewayName,
+		"sectionName": listener["name"],
+	}
+
+	// Routes with the same destination share a single route object, with one rule per Radius route.
+	routeObjects := map[string]*unstructured.Unstructured{}
+	httpRoutes := []gatewayAPIRoute{}
+	for _, route := range gateway.Properties.Routes {
+		if sslPassthrough && (route.Path != "" || route.ReplacePrefix != "") {
+			return nil, v1.NewClientErrInvalidRequest("cannot support `path` or `replacePrefix` in routes with sslPassthrough set to true")
+		}
+
+		routeName, err := getRouteName(&route)
+		if err != nil {
+			return nil, err
+		}
+		routeResourceName := kubernetes.NormalizeResourceName(routeName)
+
+		backendRefs, err := makeBackendRefs(&route, options.Dependencies, sslPassthrough)
+		if err != nil {
+			return nil, err
+		}
+
+		var localID string
+		var gvk schema.GroupVersionKind
+		var rule map[string]any
+		if sslPassthrough {
+			localID = fmt.Sprintf("%s-%s", rpv1.LocalIDTLSRoute, routeName)
+			gvk = kubernetes.GatewayAPITLSRouteGVK
+			rule = map[string]any{
+				"backendRefs": backendRefs,
+			}
+		} else {
+			localID = fmt.Sprintf("%s-%s", rpv1.LocalIDHttpRoute, routeName)
+			gvk = kubernetes.GatewayAPIHTTPRouteGVK
+			rule, err = makeHTTPRouteRule(&route, backendRefs)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if object, exists := routeObjects[localID]; exists {
+			// Route policies apply to the whole route object.
+			existing := httpRoutes[slices.IndexFunc(httpRoutes, func(r gatewayAPIRoute) bool { return r.localID == localID })]
+			if !reflect.DeepEqual(existing.policies, route.Policies) {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("routes to destination %s must declare the same policies", routeName))
+			}
+
+			rules, _, _ := unstructured.NestedSlice(object.Object, "spec", "rules")
+			if err := unstructured.SetNestedSlice(object.Object, append(rules, rule), "spec", "rules"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		spec := map[string]any{
+			"parentRefs": []any{parentRef},
+			"rules":      []any{rule},
+		}
+		if hostname != "" {
+			spec["hostnames"] = []any{hostname}
+		}
+
+		objectMeta := metav1.ObjectMeta{
+			Name:        routeResourceName,
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(options, applicationName, routeName, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		}
+
+		object := makeUnstructuredObject(gvk, objectMeta, spec)
+		routeObjects[localID] = object
+
+		routeResource := rpv1.NewKubernetesOutputResource(localID, object, objectMeta)
+		routeResource.CreateResource.Dependencies = []string{rpv1.LocalIDGateway}
+		outputResources = append(outputResources, routeResource)
+
+		httpRoutes = append(httpRoutes, gatewayAPIRoute{
+			localID:   localID,
+			name:      routeResourceName,
+			routeName: routeName,
+			policies:  route.Policies,
+		})
+	}
+
+	policyResources, err := makeEnvoyGatewayPolicies(options, gateway, applicationName, gatewayName, httpRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(outputResources, policyResources...), nil
+}
+
+// makeReferenceGrant creates the ReferenceGrant that allows the Gateway to reference the TLS certificate secret in
+// another namespace. The grant lives in the namespace of the secret and only allows access to that secret.
+func makeReferenceGrant(options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string, secretNamespace string, secretName string) rpv1.OutputResource {
+	objectMeta := metav1.ObjectMeta{
+		Name:        kubernetes.NormalizeResourceName(gateway.Name),
+		Namespace:   secretNamespace,
+		Labels:      renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName()),
+		Annotations: renderers.GetAnnotations(options),
+	}
+
+	object := makeUnstructuredObject(kubernetes.GatewayAPIReferenceGrantGVK, objectMeta, map[string]any{
+		"from": []any{
+			map[string]any{
+				"group":     kubernetes.GatewayAPIGroup,
+				"kind":      kubernetes.GatewayAPIGatewayGVK.Kind,
+				"namespace": options.Environment.Namespace,
+			},
+		},
+		"to": []any{
+			map[string]any{
+				"group": "",
+				"kind":  "Secret",
+				"name":  secretName,
+			},
+		},
+	})
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDReferenceGrant, object, objectMeta)
+}
+
+// makeHTTPRouteRule creates the HTTPRoute rule that matches the requests of the route and forwards them to the
+// given backends.
+func makeHTTPRouteRule(route *datamodel.GatewayRoute, backendRefs []any) (map[string]any, error) {
+	rule := map[string]any{
+		"matches":     makeHTTPRouteMatches(route),
+		"backendRefs": backendRefs,
+	}
+
+	filters := []any{}
+	if route.ReplacePrefix != "" {
+		filters = append(filters, map[string]any{
+			"type": "URLRewrite",
+			"urlRewrite": map[string]any{
+				"path": map[string]any{
+					"type":               "ReplacePrefixMatch",
+					"replacePrefixMatch": route.ReplacePrefix,
+				},
+			},
+		})
+	}
+	if route.RequestHeaders != nil {
+		filters = append(filters, map[string]any{
+			"type":                  "RequestHeaderModifier",
+			"requestHeaderModifier": makeHeaderModifier(route.RequestHeaders),
+		})
+	}
+	if route.ResponseHeaders != nil {
+		filters = append(filters, map[string]any{
+			"type":                   "ResponseHeaderModifier",
+			"responseHeaderModifier": makeHeaderModifier(route.ResponseHeaders),
+		})
+	}
+	if len(filters) > 0 {
+		rule["filters"] = filters
+	}
+
+	if route.TimeoutPolicy != nil {
+		if err := validateTimeoutPolicy(route.TimeoutPolicy); err != nil {
+			return nil, err
+		}
+
+		timeouts := map[string]any{
+			"request": route.TimeoutPolicy.Request,
+		}
+		if route.TimeoutPolicy.BackendRequest != "" {
+			timeouts["backendRequest"] = route.TimeoutPolicy.BackendRequest
+		}
+		rule["timeouts"] = timeouts
+	}
+
+	if route.RetryPolicy != nil {
+		rule["retry"] = makeHTTPRouteRetry(route.RetryPolicy)
+
+		// The Gateway API has no per-try timeout. The backend request timeout applies to each attempt instead.
+		if route.RetryPolicy.PerTryTimeout != "" {
+			timeouts, _ := rule["timeouts"].(map[string]any)
+			if timeouts == nil {
+				timeouts = map[string]any{}
+				rule["timeouts"] = timeouts
+			}
+
+			if backendRequest, ok := timeouts["backendRequest"]; ok && backendRequest != route.RetryPolicy.PerTryTimeout {
+				return nil, v1.NewClientErrInvalidRequest("retryPolicy.perTryTimeout and timeoutPolicy.backendRequest must be equal for gateways rendered with the Gateway API")
+			}
+			timeouts["backendRequest"] = route.RetryPolicy.PerTryTimeout
+		}
+	}
+
+	return rule, nil
+}
+
+// makeHTTPRouteRetry creates the retry configuration of an HTTPRoute rule.
+//
+// The Gateway API retries on status codes, and implementations retry on connection errors whenever retries are
+// configured, so the connection conditions ('reset', 'connect-failure', 'refused-stream') do not add any status code.
+// '5xx' is rendered as the 5xx codes that all Gateway API implementations must support.
+func makeHTTPRouteRetry(policy *datamodel.GatewayRouteRetryPolicy) map[string]any {
+	attempts := int64(policy.Attempts)
+	if attempts == 0 {
+		attempts = 1
+	}
+
+	retryOn := policy.RetryOn
+	if len(retryOn) == 0 {
+		retryOn = []string{"5xx"}
+	}
+
+	codes := []int64{}
+	for _, condition := range retryOn {
+		switch condition {
+		case "5xx":
+			codes = append(codes, 500, 502, 503, 504)
+		case "gateway-error":
+			codes = append(codes, 502, 503, 504)
+		case "retriable-4xx":
+			codes = append(codes, 409)
+		}
+	}
+	slices.Sort(codes)
+	codes = slices.Compact(codes)
+
+	retry := map[string]any{
+		"attempts": attempts,
+	}
+	if len(codes) > 0 {
+		retryCodes := []any{}
+		for _, code := range codes {
+			retryCodes = append(retryCodes, code)
+		}
+		retry["codes"] = retryCodes
+	}
+
+	return retry
+}
+
+// makeHTTPRouteMatches creates the HTTPRoute matches of the route. The conditions within a match are ANDed and the
+// matches are ORed, so a route that matches multiple methods creates one match per method.
+//
+// The Gateway API only supports exact and regular expression matches on headers and query parameters, so 'contains'
+// and 'present' conditions are rendered as regular expressions.
+func makeHTTPRouteMatches(route *datamodel.GatewayRoute) []any {
+	path := route.Path
+	if path == "" {
+		path = "/"
+	}
+
+	match := map[string]any{
+		"path": map[string]any{
+			"type":  "PathPrefix",
+			"value": path,
+		},
+	}
+
+	if len(route.Headers) > 0 {
+		headers := []any{}
+		for _, header := range route.Headers {
+			headers = append(headers, makeHTTPRouteValueMatch(header.Name, header.Exact, header.Contains, header.Present))
+		}
+		match["headers"] = headers
+	}
+
+	if len(route.QueryParameters) > 0 {
+		parameters := []any{}
+		for _, parameter := range route.QueryParameters {
+			parameters = append(parameters, makeHTTPRouteValueMatch(parameter.Name, parameter.Exact, parameter.Contains, parameter.Present))
+		}
+		match["queryParams"] = parameters
+	}
+
+	if len(route.Methods) == 0 {
+		return []any{match}
+	}
+
+	matches := []any{}
+	for _, method := range route.Methods {
+		methodMatch := maps.Clone(match)
+		methodMatch["method"] = method
+		matches = append(matches, methodMatch)
+	}
+
+	return matches
+}
+
+// makeHTTPRouteValueMatch creates an HTTPRoute header or query parameter match.
+func makeHTTPRouteValueMatch(name string, exact string, contains string, present bool) map[string]any {
+	switch {
+	case contains != "":
+		return map[string]any{
+			"type":  "RegularExpression",
+			"name":  name,
+			"value": ".*" + regexp.QuoteMeta(contains) + ".*",
+		}
+	case present:
+		return map[string]any{
+			"type":  "RegularExpression",
+			"name":  name,
+			"value": ".*",
+		}
+	default:
+		return map[string]any{
+			"type":  "Exact",
+			"name":  name,
+			"value": exact,
+		}
+	}
+}
+
+// makeHeaderModifier creates the configuration of an HTTPRoute RequestHeaderModifier or ResponseHeaderModifier filter.
+// The headers to set are sorted by name so that the rendered object is stable.
+func makeHeaderModifier(policy *datamodel.GatewayRouteHeadersPolicy) map[string]any {
+	modifier := map[string]any{}
+
+	if len(policy.Set) > 0 {
+		set := []any{}
+		for _, name := range slices.Sorted(maps.Keys(policy.Set)) {
+			set = append(set, map[string]any{
+				"name":  name,
+				"value": policy.Set[name],
+			})
+		}
+		modifier["set"] = set
+	}
+
+	if len(policy.Remove) > 0 {
+		remove := []any{}
+		for _, name := range policy.Remove {
+			remove = append(remove, name)
+		}
+		modifier["remove"] = remove
+	}
+
+	return modifier
+}
+
+// makeBackendRefs creates the backend references for the destinations of the route. The traffic is split between the
+// backends by weight when the route has multiple destinations.
+func makeBackendRefs(route *datamodel.GatewayRoute, dependencies map[string]renderers.RendererDependency, sslPassthrough bool) ([]any, error) {
+	// The Gateway API splits the traffic evenly when no weights are set, which matches Contour.
+	weighted := slices.ContainsFunc(route.Destinations, func(d datamodel.GatewayRouteDestination) bool { return d.Weight != 0 })
+
+	backendRefs := []any{}
+	for _, destination := range route.GetDestinations() {
+		name, err := getDestinationName(destination.Destination)
+		if err != nil {
+			return nil, err
+		}
+
+		port, err := getRoutePort(destination.Destination, dependencies, sslPassthrough)
+		if err != nil {
+			return nil, err
+		}
+
+		backendRef := map[string]any{
+			"name": kubernetes.NormalizeResourceName(name),
+			"port": int64(port),
+		}
+		if weighted {
+			backendRef["weight"] = int64(destination.Weight)
+		}
+		backendRefs = append(backendRefs, backendRef)
+	}
+
+	return backendRefs, nil
+}
+
+// makeUnstructuredObject creates an unstructured object with the given metadata and spec. The Gateway API and
+// cert-manager types are not part of the Kubernetes API, so their objects are rendered as unstructured objects.
+func makeUnstructuredObject(gvk schema.GroupVersionKind, objectMeta metav1.ObjectMeta, spec map[string]any) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": spec,
+		},
+	}
+	object.SetGroupVersionKind(gvk)
+	object.SetName(objectMeta.Name)
+	object.SetNamespace(objectMeta.Namespace)
+	object.SetLabels(objectMeta.Labels)
+	object.SetAnnotations(objectMeta.Annotations)
+
+	return object
+}
+
+// getRoutePort returns the port of the route destination. The port is parsed from the destination if it is a URL,
+// otherwise the port computed by the destination resource is used. Like the Contour TCP proxy, SSL passthrough
+// always forwards to the port computed by the destination resource.
+func getRoutePort(destination string, dependencies map[string]renderers.RendererDependency, sslPassthrough bool) (int32, error) {
+	if !sslPassthrough && isURL(destination) {
+		_, _, port, err := parseURL(destination)
+		if err != nil {
+			return 0, err
+		}
+		return port, nil
+	}
+
+	port := renderers.DefaultPort
+	if sslPassthrough {
+		port = renderers.DefaultSecurePort
+	}
+
+	routeProperties := dependencies[destination]
+	if routePort, ok := routeProperties.ComputedValues["port"].(float64); ok {
+		port = int32(routePort)
+	}
+
+	return port, nil
+}
@@ -0,0 +1,495 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+const testGatewayClassName = "envoy-gateway"
+
+func getGatewayAPIEnvironmentOptions(hostname, externalIP string, publicEndpointOverride bool) renderers.EnvironmentOptions {
+	environmentOptions := getEnvironmentOptions(hostname, externalIP, "", publicEndpointOverride, false)
+	environmentOptions.Ingress = &datamodel.IngressProperties{
+		Kind:             datamodel.IngressKindGatewayAPI,
+		GatewayClassName: testGatewayClassName,
+	}
+
+	return environmentOptions
+}
+
+func makeGatewayAPITestProperties(routes []datamodel.GatewayRoute, tls *datamodel.GatewayPropertiesTLS) datamodel.GatewayProperties {
+	return datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: routes,
+		TLS:    tls,
+	}
+}
+
+func requireUnstructured(t *testing.T, resource rpv1.OutputResource, expectedType string) *unstructured.Unstructured {
+	require.Equal(t, expectedType, resource.GetResourceType().Type)
+	object, ok := resource.CreateResource.Data.(*unstructured.Unstructured)
+	require.True(t, ok)
+
+	return object
+}
+
+func Test_Render_GatewayAPI_HTTPRoutes(t *testing.T) {
+	r := &Renderer{}
+
+	properties := makeGatewayAPITestProperties([]datamodel.GatewayRoute{
+		{
+			Destination: "http://A",
+			Path:        "/",
+		},
+		{
+			Destination:   "http://A",
+			Path:          "/api",
+			ReplacePrefix: "/",
+		},
+		{
+			Destination: "http://B:3000",
+			Path:        "/b",
+			TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{
+				Request:        "30s",
+				BackendRequest: "10s",
+			},
+		},
+	}, nil)
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "http://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	gateway := requireUnstructured(t, output.Resources[0], resources_kubernetes.ResourceTypeGatewayAPIGateway)
+	require.Equal(t, rpv1.LocalIDGateway, output.Resources[0].LocalID)
+	require.Equal(t, "gateway.networking.k8s.io/v1", gateway.GetAPIVersion())
+	require.Equal(t, resourceName, gateway.GetName())
+	require.Equal(t, applicationName, gateway.GetNamespace())
+	require.Equal(t, map[string]any{
+		"gatewayClassName": testGatewayClassName,
+		"listeners": []any{
+			map[string]any{
+				"name":     "http",
+				"protocol": "HTTP",
+				"port":     int64(80),
+				"hostname": expectedHostname,
+			},
+		},
+	}, gateway.Object["spec"])
+
+	routeA := requireUnstructured(t, output.Resources[1], resources_kubernetes.ResourceTypeGatewayAPIHTTPRoute)
+	require.Equal(t, "HttpRoute-A", output.Resources[1].LocalID)
+	require.Equal(t, []string{rpv1.LocalIDGateway}, output.Resources[1].CreateResource.Dependencies)
+	require.Equal(t, "a", routeA.GetName())
+	require.Equal(t, map[string]any{
+		"parentRefs": []any{map[string]any{"name": resourceName, "sectionName": "http"}},
+		"hostnames":  []any{expectedHostname},
+		"rules": []any{
+			map[string]any{
+				"matches":     []any{map[string]any{"path": map[string]any{"type": "PathPrefix", "value": "/"}}},
+				"backendRefs": []any{map[string]any{"name": "a", "port": int64(renderers.DefaultPort)}},
+			},
+			map[string]any{
+				"matches":     []any{map[string]any{"path": map[string]any{"type": "PathPrefix", "value": "/api"}}},
+				"backendRefs": []any{map[string]any{"name": "a", "port": int64(renderers.DefaultPort)}},
+				"filters": []any{
+					map[string]any{
+						"type": "URLRewrite",
+						"urlRewrite": map[string]any{
+							"path": map[string]any{"type": "ReplacePrefixMatch", "replacePrefixMatch": "/"},
+						},
+					},
+				},
+			},
+		},
+	}, routeA.Object["spec"])
+
+	routeB := requireUnstructured(t, output.Resources[2], resources_kubernetes.ResourceTypeGatewayAPIHTTPRoute)
+	require.Equal(t, "HttpRoute-B", output.Resources[2].LocalID)
+	rules, _, err := unstructured.NestedSlice(routeB.Object, "spec", "rules")
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		map[string]any{
+			"matches":     []any{map[string]any{"path": map[string]any{"type": "PathPrefix", "value": "/b"}}},
+			"backendRefs": []any{map[string]any{"name": "b", "port": int64(3000)}},
+			"timeouts":    map[string]any{"request": "30s", "backendRequest": "10s"},
+		},
+	}, rules)
+}
+
+func Test_Render_GatewayAPI_HTTPRoute_WithMatchingAndTrafficSplitting(t *testing.T) {
+	r := &Renderer{}
+
+	properties := makeGatewayAPITestProperties([]datamodel.GatewayRoute{
+		{
+			Path: "/api",
+			Destinations: []datamodel.GatewayRouteDestination{
+				{Destination: "http://A", Weight: 90},
+				{Destination: "http://B:3000", Weight: 10},
+			},
+			Headers: []datamodel.GatewayRouteHeaderMatch{
+				{Name: "x-canary", Exact: "true"},
+				{Name: "user-agent", Contains: "curl/8."},
+			},
+			QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{
+				{Name: "version", Present: true},
+			},
+			Methods: []string{"GET", "POST"},
+			RequestHeaders: &datamodel.GatewayRouteHeadersPolicy{
+				Set:    map[string]string{"x-b": "b", "x-a": "a"},
+				Remove: []string{"x-debug"},
+			},
+			ResponseHeaders: &datamodel.GatewayRouteHeadersPolicy{
+				Remove: []string{"server"},
+			},
+		},
+	}, nil)
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	route := requireUnstructured(t, output.Resources[1], resources_kubernetes.ResourceTypeGatewayAPIHTTPRoute)
+	require.Equal(t, "HttpRoute-A", output.Resources[1].LocalID)
+
+	match := func(method string) map[string]any {
+		return map[string]any{
+			"path": map[string]any{"type": "PathPrefix", "value": "/api"},
+			"headers": []any{
+				map[string]any{"type": "Exact", "name": "x-canary", "value": "true"},
+				map[string]any{"type": "RegularExpression", "name": "user-agent", "value": `.*curl/8\..*`},
+			},
+			"queryParams": []any{
+				map[string]any{"type": "RegularExpression", "name": "version", "value": ".*"},
+			},
+			"method": method,
+		}
+	}
+	rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		map[string]any{
+			"matches": []any{match("GET"), match("POST")},
+			"backendRefs": []any{
+				map[string]any{"name": "a", "port": int64(renderers.DefaultPort), "weight": int64(90)},
+				map[string]any{"name": "b", "port": int64(3000), "weight": int64(10)},
+			},
+			"filters": []any{
+				map[string]any{
+					"type": "RequestHeaderModifier",
+					"requestHeaderModifier": map[string]any{
+						"set": []any{
+							map[string]any{"name": "x-a", "value": "a"},
+							map[string]any{"name": "x-b", "value": "b"},
+						},
+						"remove": []any{"x-debug"},
+					},
+				},
+				map[string]any{
+					"type": "ResponseHeaderModifier",
+					"responseHeaderModifier": map[string]any{
+						"remove": []any{"server"},
+					},
+				},
+			},
+		},
+	}, rules)
+}
+
+func Test_Render_GatewayAPI_HTTPRoute_WithRetryPolicy(t *testing.T) {
+	r := &Renderer{}
+
+	properties := makeGatewayAPITestProperties([]datamodel.GatewayRoute{
+		{
+			Destination: "http://A",
+			Path:        "/",
+			RetryPolicy: &datamodel.GatewayRouteRetryPolicy{
+				Attempts:      3,
+				PerTryTimeout: "5s",
+				RetryOn:       []string{"gateway-error", "5xx", "connect-failure"},
+			},
+		},
+		{
+			Destination: "http://B",
+			Path:        "/b",
+			RetryPolicy: &datamodel.GatewayRouteRetryPolicy{},
+		},
+	}, nil)
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	expected := map[string]map[string]any{
+		"HttpRoute-A": {
+			"retry": map[string]any{
+				"attempts": int64(3),
+				"codes":    []any{int64(500), int64(502), int64(503), int64(504)},
+			},
+			"timeouts": map[string]any{"backendRequest": "5s"},
+		},
+		"HttpRoute-B": {
+			"retry": map[string]any{
+				"attempts": int64(1),
+				"codes":    []any{int64(500), int64(502), int64(503), int64(504)},
+			},
+		},
+	}
+	for _, resource := range output.Resources[1:] {
+		route := requireUnstructured(t, resource, resources_kubernetes.ResourceTypeGatewayAPIHTTPRoute)
+		rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+
+		rule := rules[0].(map[string]any)
+		require.Equal(t, expected[resource.LocalID]["retry"], rule["retry"])
+		require.Equal(t, expected[resource.LocalID]["timeouts"], rule["timeouts"])
+	}
+}
+
+func Test_Render_GatewayAPI_NoPublicEndpoint(t *testing.T) {
+	r := &Renderer{}
+
+	properties := makeGatewayAPITestProperties([]datamodel.GatewayRoute{{Destination: "http://A", Path: "/"}}, nil)
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", "", false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+	require.Equal(t, "unknown", output.ComputedValues["url"].Value)
+
+	gateway := requireUnstructured(t, output.Resources[0], resources_kubernetes.ResourceTypeGatewayAPIGateway)
+	listeners, _, err := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	require.NoError(t, err)
+	require.NotContains(t, listeners[0], "hostname")
+
+	route := requireUnstructured(t, output.Resources[1], resources_kubernetes.ResourceTypeGatewayAPIHTTPRoute)
+	require.NotContains(t, route.Object["spec"], "hostnames")
+}
+
+func Test_Render_GatewayAPI_TLSTermination(t *testing.T) {
+	r := &Renderer{}
+
+	secretStoreResourceId := makeSecretStoreResourceID("myapp-tls-secret")
+	properties := makeGatewayAPITestProperties([]datamodel.GatewayRoute{{Destination: "http://A", Path: "/"}}, &datamodel.GatewayPropertiesTLS{
+		CertificateFrom: secretStoreResourceId,
+	})
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, false)
+
+	dependencies := map[string]renderers.RendererDependency{
+		secretStoreResourceId: {
+			ResourceID: resources.MustParse(secretStoreResourceId),
+			Resource: &datamodel.SecretStore{
+				Properties: &datamodel.SecretStoreProperties{
+					Type: "certificate",
+					Data: map[string]*datamodel.SecretStoreDataValue{
+						"tls.crt": {Value: to.Ptr("test-crt")},
+						"tls.key": {Value: to.Ptr("test-key")},
+					},
+				},
+			},
+			OutputResources: map[string]resources.ID{
+				"Secret": resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", "other-namespace", "myapp-tls-secret"),
+			},
+		},
+	}
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 3)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+	require.Equal(t, []string{rpv1.LocalIDReferenceGrant}, output.Resources[0].CreateResource.Dependencies)
+
+	gateway := requireUnstructured(t, output.Resources[0], resources_kubernetes.ResourceTypeGatewayAPIGateway)
+	listeners, _, err := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		map[string]any{
+			"name":     "https",
+			"protocol": "HTTPS",
+			"port":     int64(443),
+			"hostname": expectedHostname,
+			"tls": map[string]any{
+				"mode": "Terminate",
+				"certificateRefs": []any{
+					map[string]any{"kind": "Secret", "name": "myapp-tls-secret", "namespace": "other-namespace"},
+				},
+			},
+		},
+	}, listeners)
+
+	grant := requireUnstructured(t, output.Resources[1], resources_kubernetes.ResourceTypeGatewayAPIReferenceGrant)
+	require.Equal(t, "other-namespace", grant.GetNamespace())
+	require.Equal(t, map[string]any{
+		"from": []any{
+			map[string]any{"group": "gateway.networking.k8s.io", "kind": "Gateway", "namespace": environmentOptions.Namespace},
+		},
+		"to": []any{
+			map[string]any{"group": "", "kind": "Secret", "name": "myapp-tls-secret"},
+		},
+	}, grant.Object["spec"])
+
+	route := requireUnstructured(t, output.Resources[2], resources_kubernetes.ResourceTypeGatewayAPIHTTPRoute)
+	parentRefs, _, err := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	require.NoError(t, err)
+	require.Equal(t, []any{map[string]any{"name": resourceName, "sectionName": "https"}}, parentRefs)
+}
+
+func Test_Render_GatewayAPI_SSLPassthrough(t *testing.T) {
+	r := &Renderer{}
+
+	properties := makeGatewayAPITestProperties([]datamodel.GatewayRoute{{Destination: "http://A"}}, &datamodel.GatewayPropertiesTLS{
+		SSLPassthrough: true,
+	})
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, false)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	gateway := requireUnstructured(t, output.Resources[0], resources_kubernetes.ResourceTypeGatewayAPIGateway)
+	listeners, _, err := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	require.NoError(t, err)
+	require.Equal(t, "TLS", listeners[0].(map[string]any)["protocol"])
+	require.Equal(t, map[string]any{"mode": "Passthrough"}, listeners[0].(map[string]any)["tls"])
+
+	route := requireUnstructured(t, output.Resources[1], resources_kubernetes.ResourceTypeGatewayAPITLSRoute)
+	require.Equal(t, "TLSRoute-A", output.Resources[1].LocalID)
+	require.Equal(t, "gateway.networking.k8s.io/v1alpha2", route.GetAPIVersion())
+	rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+	require.NoError(t, err)
+	require.Equal(t, []any{
+		map[string]any{
+			"backendRefs": []any{map[string]any{"name": "a", "port": int64(renderers.DefaultSecurePort)}},
+		},
+	}, rules)
+}
+
+func Test_Render_GatewayAPI_IPAddressHostname(t *testing.T) {
+	r := &Renderer{}
+
+	properties := makeGatewayAPITestProperties([]datamodel.GatewayRoute{{Destination: "http://A", Path: "/"}}, nil)
+	resource := makeResource(properties)
+	environmentOptions := getGatewayAPIEnvironmentOptions("10.0.0.1", "", true)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Equal(t, "http://10.0.0.1", output.ComputedValues["url"].Value)
+
+	gateway := requireUnstructured(t, output.Resources[0], resources_kubernetes.ResourceTypeGatewayAPIGateway)
+	listeners, _, err := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	require.NoError(t, err)
+	require.NotContains(t, listeners[0], "hostname")
+}
+
+func Test_Render_GatewayAPI_Fails(t *testing.T) {
+	tests := []struct {
+		name        string
+		properties  datamodel.GatewayProperties
+		environment func() renderers.EnvironmentOptions
+		err         error
+	}{
+		{
+			name:       "missing gatewayClassName",
+			properties: makeGatewayAPITestProperties([]datamodel.GatewayRoute{{Destination: "http://A", Path: "/"}}, nil),
+			environment: func() renderers.EnvironmentOptions {
+				options := getGatewayAPIEnvironmentOptions("", testExternalIP, false)
+				options.Ingress.GatewayClassName = ""
+				return options
+			},
+			err: v1.NewClientErrInvalidRequest("the environment must specify a gatewayClassName to render gateways with the Gateway API"),
+		},
+		{
+			name:       "no routes",
+			properties: makeGatewayAPITestProperties(nil, nil),
+			err:        v1.NewClientErrInvalidRequest("must have at least one route when declaring a Gateway resource"),
+		},
+		{
+			name: "ssl passthrough with multiple routes",
+			properties: makeGatewayAPITestProperties([]datamodel.GatewayRoute{{Destination: "http://A"}, {Destination: "http://B"}}, &datamodel.GatewayPropertiesTLS{
+				SSLPassthrough: true,
+			}),
+			err: v1.NewClientErrInvalidRequest("cannot support multiple routes with sslPassthrough set to true"),
+		},
+		{
+			name: "invalid timeout policy",
+			properties: makeGatewayAPITestProperties([]datamodel.GatewayRoute{
+				{
+					Destination:   "http://A",
+					Path:          "/",
+					TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Request: "10s", BackendRequest: "20s"},
+				},
+			}, nil),
+			err: v1.NewClientErrInvalidRequest("request timeout must be greater than or equal to backend request timeout"),
+		},
+		{
+			name: "per-try timeout different from backend request timeout",
+			properties: makeGatewayAPITestProperties([]datamodel.GatewayRoute{
+				{
+					Destination:   "http://A",
+					Path:          "/",
+					TimeoutPolicy: &datamodel.GatewayRouteTimeoutPolicy{Request: "30s", BackendRequest: "10s"},
+					RetryPolicy:   &datamodel.GatewayRouteRetryPolicy{Attempts: 3, PerTryTimeout: "5s"},
+				},
+			}, nil),
+			err: v1.NewClientErrInvalidRequest("retryPolicy.perTryTimeout and timeoutPolicy.backendRequest must be equal for gateways rendered with the Gateway API"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, false)
+			if tt.environment != nil {
+				environmentOptions = tt.environment()
+			}
+
+			r := &Renderer{}
+			_, err := r.Render(context.Background(), makeResource(tt.properties), renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
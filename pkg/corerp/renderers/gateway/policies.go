@@ -0,0 +1,318 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"net/url"
+
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	contourv1alpha1 "github.com/projectcontour/contour/apis/projectcontour/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+const (
+	// gatewayJWTProviderName is the name of the JWT provider created for the JWT policy of the gateway. The provider
+	// applies to all routes unless a route requires its own provider or is anonymous.
+	gatewayJWTProviderName = "gateway"
+
+	// extensionServiceKind is the kind of the Contour resource that declares the external authorization service.
+	extensionServiceKind = "ExtensionService"
+)
+
+// hasPolicies returns true if the gateway or any of its routes declares policies.
+func hasPolicies(gateway *datamodel.Gateway) bool {
+	if gateway.Properties.Policies != nil {
+		return true
+	}
+
+	for _, route := range gateway.Properties.Routes {
+		if route.Policies != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getPolicySecretStoreIDs returns the resource IDs of the secret stores referenced by the policies of the gateway
+// and its routes, which hold CA certificates and basic authentication users.
+func getPolicySecretStoreIDs(gateway *datamodel.Gateway) []string {
+	ids := []string{}
+	if policies := gateway.Properties.Policies; policies != nil {
+		if policies.JWT != nil && policies.JWT.CACertificateFrom != "" {
+			ids = append(ids, policies.JWT.CACertificateFrom)
+		}
+		if policies.ExternalAuth != nil && policies.ExternalAuth.CACertificateFrom != "" {
+			ids = append(ids, policies.ExternalAuth.CACertificateFrom)
+		}
+		if policies.BasicAuth != nil && policies.BasicAuth.SecretFrom != "" {
+			ids = append(ids, policies.BasicAuth.SecretFrom)
+		}
+	}
+
+	for _, route := range gateway.Properties.Routes {
+		if route.Policies != nil && route.Policies.JWT != nil && route.Policies.JWT.CACertificateFrom != "" {
+			ids = append(ids, route.Policies.JWT.CACertificateFrom)
+		}
+	}
+
+	return ids
+}
+
+// applyVirtualHostPolicies configures the policies of the gateway, and the JWT providers required by its routes, on
+// the virtual host of the root HTTPProxy.
+func applyVirtualHostPolicies(virtualHost *contourv1.VirtualHost, gateway *datamodel.Gateway, options renderers.RenderOptions) error {
+	policies := gateway.Properties.Policies
+	if policies != nil {
+		// Contour has no built-in basic authentication. It requires an external authorization service instead.
+		if policies.BasicAuth != nil {
+			return v1.NewClientErrInvalidRequest("basicAuth is not supported by the Contour ingress implementation. Use the Gateway API ingress implementation, or externalAuth with an authorization service that verifies basic credentials")
+		}
+
+		if policies.JWT != nil {
+			provider, err := makeJWTProvider(gatewayJWTProviderName, true, policies.JWT, options.Dependencies)
+			if err != nil {
+				return err
+			}
+			virtualHost.JWTProviders = append(virtualHost.JWTProviders, provider)
+		}
+
+		if policies.ExternalAuth != nil {
+			virtualHost.Authorization = &contourv1.AuthorizationServer{
+				ExtensionServiceRef: contourv1.ExtensionServiceReference{
+					APIVersion: contourv1alpha1.GroupVersion.String(),
+					Namespace:  options.Environment.Namespace,
+					Name:       getExternalAuthServiceName(gateway),
+				},
+				ResponseTimeout: policies.ExternalAuth.Timeout,
+				FailOpen:        policies.ExternalAuth.FailOpen,
+			}
+		}
+
+		virtualHost.RateLimitPolicy = makeRateLimitPolicy(policies.RateLimit)
+
+		if policies.CORS != nil {
+			virtualHost.CORSPolicy = &contourv1.CORSPolicy{
+				AllowCredentials: policies.CORS.AllowCredentials,
+				AllowOrigin:      policies.CORS.AllowOrigins,
+				AllowMethods:     toCORSHeaderValues(policies.CORS.AllowMethods),
+				AllowHeaders:     toCORSHeaderValues(policies.CORS.AllowHeaders),
+				ExposeHeaders:    toCORSHeaderValues(policies.CORS.ExposeHeaders),
+				MaxAge:           policies.CORS.MaxAge,
+			}
+		}
+	}
+
+	// Routes that require their own JWT provider reference it by name from the route of their HTTPProxy.
+	providers := map[string]bool{}
+	for _, route := range gateway.Properties.Routes {
+		if route.Policies == nil || route.Policies.JWT == nil {
+			continue
+		}
+
+		routeName, err := getRouteName(&route)
+		if err != nil {
+			return err
+		}
+
+		name := getRouteJWTProviderName(routeName)
+		if providers[name] {
+			continue
+		}
+		providers[name] = true
+
+		provider, err := makeJWTProvider(name, false, route.Policies.JWT, options.Dependencies)
+		if err != nil {
+			return err
+		}
+		virtualHost.JWTProviders = append(virtualHost.JWTProviders, provider)
+	}
+
+	return nil
+}
+
+// applyRoutePolicies configures the policies of a gateway route on the route of its HTTPProxy.
+func applyRoutePolicies(route *contourv1.Route, gatewayRoute *datamodel.GatewayRoute, gatewayPolicies *datamodel.GatewayPolicies) error {
+	policies := gatewayRoute.Policies
+	if policies == nil {
+		return nil
+	}
+
+	if policies.JWT != nil {
+		routeName, err := getRouteName(gatewayRoute)
+		if err != nil {
+			return err
+		}
+
+		route.JWTVerificationPolicy = &contourv1.JWTVerificationPolicy{
+			Require: getRouteJWTProviderName(routeName),
+		}
+	}
+
+	if policies.Anonymous && gatewayPolicies != nil {
+		if gatewayPolicies.JWT != nil {
+			route.JWTVerificationPolicy = &contourv1.JWTVerificationPolicy{Disabled: true}
+		}
+		if gatewayPolicies.ExternalAuth != nil {
+			route.AuthPolicy = &contourv1.AuthorizationPolicy{Disabled: true}
+		}
+	}
+
+	route.RateLimitPolicy = makeRateLimitPolicy(policies.RateLimit)
+
+	return nil
+}
+
+// MakeExternalAuthService creates the Contour ExtensionService that declares the external authorization service of
+// the gateway.
+func MakeExternalAuthService(options renderers.RenderOptions, gateway *datamodel.Gateway, applicationName string) (rpv1.OutputResource, error) {
+	policy := gateway.Properties.Policies.ExternalAuth
+	scheme, hostname, port, err := parseURL(policy.Destination)
+	if err != nil {
+		return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid external authorization destination %s: %s", policy.Destination, err.Error()))
+	}
+
+	// The authorization service uses gRPC, over TLS for https destinations.
+	protocol := "h2c"
+	var validation *contourv1.UpstreamValidation
+	if scheme == "https" {
+		protocol = "h2"
+
+		if policy.CACertificateFrom != "" {
+			validation, err = makeUpstreamValidation(policy.CACertificateFrom, "externalAuth.caCertificateFrom", hostname, options.Dependencies)
+			if err != nil {
+				return rpv1.OutputResource{}, err
+			}
+		}
+	}
+
+	extensionService := &contourv1alpha1.ExtensionService{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       extensionServiceKind,
+			APIVersion: contourv1alpha1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        getExternalAuthServiceName(gateway),
+			Namespace:   options.Environment.Namespace,
+			Labels:      renderers.GetLabels(options, applicationName, gateway.Name, gateway.ResourceTypeName()),
+			Annotations: renderers.GetAnnotations(options),
+		},
+		Spec: contourv1alpha1.ExtensionServiceSpec{
+			Services: []contourv1alpha1.ExtensionServiceTarget{
+				{
+					Name: kubernetes.NormalizeResourceName(hostname),
+					Port: int(port),
+				},
+			},
+			Protocol:           to.Ptr(protocol),
+			UpstreamValidation: validation,
+		},
+	}
+
+	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDExternalAuthService, extensionService, extensionService.ObjectMeta), nil
+}
+
+// makeJWTProvider creates a Contour JWT provider for the given JWT policy.
+func makeJWTProvider(name string, isDefault bool, policy *datamodel.GatewayJWTPolicy, dependencies map[string]renderers.RendererDependency) (contourv1.JWTProvider, error) {
+	provider := contourv1.JWTProvider{
+		Name:       name,
+		Default:    isDefault,
+		Issuer:     policy.Issuer,
+		Audiences:  policy.Audiences,
+		ForwardJWT: policy.ForwardToken,
+		RemoteJWKS: contourv1.RemoteJWKS{
+			URI: policy.JWKSURI,
+		},
+	}
+
+	if policy.CACertificateFrom != "" {
+		u, err := url.Parse(policy.JWKSURI)
+		if err != nil {
+			return contourv1.JWTProvider{}, v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid JWKS URI %s: %s", policy.JWKSURI, err.Error()))
+		}
+
+		validation, err := makeUpstreamValidation(policy.CACertificateFrom, "jwt.caCertificateFrom", u.Hostname(), dependencies)
+		if err != nil {
+			return contourv1.JWTProvider{}, err
+		}
+		provider.RemoteJWKS.UpstreamValidation = validation
+	}
+
+	return provider, nil
+}
+
+// makeUpstreamValidation creates the configuration to verify the TLS certificate of an upstream service with the CA
+// certificate held by the given secret store.
+func makeUpstreamValidation(secretStoreResourceId string, property string, subjectName string, dependencies map[string]renderers.RendererDependency) (*contourv1.UpstreamValidation, error) {
+	secretNamespace, secretName, err := getSecretStoreSecret(secretStoreResourceId, property, dependencies, datamodel.SecretTypeCert, "ca.crt")
+	if err != nil {
+		return nil, err
+	}
+
+	return &contourv1.UpstreamValidation{
+		CACertificate: fmt.Sprintf("%s/%s", secretNamespace, secretName),
+		SubjectName:   subjectName,
+		SubjectNames:  []string{subjectName},
+	}, nil
+}
+
+// makeRateLimitPolicy creates a Contour local rate limiting policy, which limits the requests handled by each
+// Envoy instance.
+func makeRateLimitPolicy(policy *datamodel.GatewayRateLimitPolicy) *contourv1.RateLimitPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &contourv1.RateLimitPolicy{
+		Local: &contourv1.LocalRateLimitPolicy{
+			Requests: uint32(policy.Requests),
+			Unit:     string(policy.Unit),
+			Burst:    uint32(policy.Burst),
+		},
+	}
+}
+
+func toCORSHeaderValues(values []string) []contourv1.CORSHeaderValue {
+	if len(values) == 0 {
+		return nil
+	}
+
+	headerValues := make([]contourv1.CORSHeaderValue, len(values))
+	for i, value := range values {
+		headerValues[i] = contourv1.CORSHeaderValue(value)
+	}
+
+	return headerValues
+}
+
+// getExternalAuthServiceName returns the name of the Contour ExtensionService of the gateway.
+func getExternalAuthServiceName(gateway *datamodel.Gateway) string {
+	return kubernetes.NormalizeResourceName(gateway.Name) + "-extauth"
+}
+
+// getRouteJWTProviderName returns the name of the JWT provider required by the routes to the given destination.
+func getRouteJWTProviderName(routeName string) string {
+	return "route-" + kubernetes.NormalizeResourceName(routeName)
+}
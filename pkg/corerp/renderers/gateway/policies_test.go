@@ -0,0 +1,475 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	contourv1 "github.com/projectcontour/contour/apis/projectcontour/v1"
+	contourv1alpha1 "github.com/projectcontour/contour/apis/projectcontour/v1alpha1"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/corerp/renderers"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+func makePoliciesTestProperties(caSecretStoreID string) datamodel.GatewayProperties {
+	return makeGatewayAPITestProperties([]datamodel.GatewayRoute{
+		{
+			Destination: "http://frontend",
+			Path:        "/",
+		},
+		{
+			Destination: "http://api",
+			Path:        "/api",
+			Policies: &datamodel.GatewayRoutePolicies{
+				JWT: &datamodel.GatewayJWTPolicy{
+					Issuer:            "https://login.contoso.com",
+					Audiences:         []string{"api"},
+					JWKSURI:           "https://login.contoso.com/keys",
+					CACertificateFrom: caSecretStoreID,
+				},
+				RateLimit: &datamodel.GatewayRateLimitPolicy{
+					Requests: 10,
+					Unit:     datamodel.GatewayRateLimitUnitSecond,
+					Burst:    5,
+				},
+			},
+		},
+		{
+			Destination: "http://health",
+			Path:        "/healthz",
+			Policies: &datamodel.GatewayRoutePolicies{
+				Anonymous: true,
+			},
+		},
+	}, &datamodel.GatewayPropertiesTLS{
+		MinimumProtocolVersion: datamodel.TLSMinVersion12,
+		CertificateIssuer: &datamodel.GatewayCertificateIssuer{
+			Kind:          datamodel.GatewayCertificateIssuerKindACME,
+			ClusterIssuer: "letsencrypt",
+		},
+	})
+}
+
+func makeGatewayPolicies() *datamodel.GatewayPolicies {
+	return &datamodel.GatewayPolicies{
+		JWT: &datamodel.GatewayJWTPolicy{
+			Issuer:       "https://login.contoso.com",
+			JWKSURI:      "https://login.contoso.com/keys",
+			ForwardToken: true,
+		},
+		ExternalAuth: &datamodel.GatewayExternalAuthPolicy{
+			Destination: "http://authserver:9001",
+			Timeout:     "500ms",
+			FailOpen:    true,
+		},
+		RateLimit: &datamodel.GatewayRateLimitPolicy{
+			Requests: 100,
+			Unit:     datamodel.GatewayRateLimitUnitMinute,
+		},
+		CORS: &datamodel.GatewayCORSPolicy{
+			AllowOrigins:     []string{"https://www.contoso.com"},
+			AllowMethods:     []string{"GET", "POST"},
+			AllowHeaders:     []string{"Authorization"},
+			AllowCredentials: true,
+			MaxAge:           "10m",
+		},
+	}
+}
+
+func makeCASecretStoreDependency(secretStoreID string) renderers.RendererDependency {
+	return renderers.RendererDependency{
+		ResourceID: resources.MustParse(secretStoreID),
+		Resource: &datamodel.SecretStore{
+			Properties: &datamodel.SecretStoreProperties{
+				Type: datamodel.SecretTypeCert,
+				Data: map[string]*datamodel.SecretStoreDataValue{
+					"ca.crt": {Value: to.Ptr("test-ca")},
+				},
+			},
+		},
+		OutputResources: map[string]resources.ID{
+			rpv1.LocalIDSecret: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", applicationName, "contoso-ca"),
+		},
+	}
+}
+
+func findOutputResource(t *testing.T, outputResources []rpv1.OutputResource, localID string) rpv1.OutputResource {
+	for _, resource := range outputResources {
+		if resource.LocalID == localID {
+			return resource
+		}
+	}
+
+	require.Failf(t, "output resource not found", "local ID: %s", localID)
+	return rpv1.OutputResource{}
+}
+
+func Test_GetDependencyIDs_Policies(t *testing.T) {
+	caSecretStoreID := makeSecretStoreResourceID("contoso-ca")
+	authCASecretStoreID := makeSecretStoreResourceID("authserver-ca")
+	properties := makePoliciesTestProperties(caSecretStoreID)
+	properties.Policies = makeGatewayPolicies()
+	properties.Policies.ExternalAuth.Destination = "https://authserver:9001"
+	properties.Policies.ExternalAuth.CACertificateFrom = authCASecretStoreID
+	resource := makeResource(properties)
+
+	radiusResourceIDs, azureResourceIDs, err := Renderer{}.GetDependencyIDs(testcontext.New(t), resource)
+	require.NoError(t, err)
+	require.Empty(t, azureResourceIDs)
+	require.ElementsMatch(t, []resources.ID{resources.MustParse(authCASecretStoreID), resources.MustParse(caSecretStoreID)}, radiusResourceIDs)
+}
+
+func Test_Render_Policies(t *testing.T) {
+	r := &Renderer{}
+
+	caSecretStoreID := makeSecretStoreResourceID("contoso-ca")
+	properties := makePoliciesTestProperties(caSecretStoreID)
+	properties.Policies = makeGatewayPolicies()
+	resource := makeResource(properties)
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	dependencies := map[string]renderers.RendererDependency{
+		caSecretStoreID: makeCASecretStoreDependency(caSecretStoreID),
+	}
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 6)
+
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+	require.Equal(t, "https://"+expectedHostname, output.ComputedValues["url"].Value)
+
+	// The external authorization service is declared with a Contour ExtensionService.
+	extensionServiceResource := findOutputResource(t, output.Resources, rpv1.LocalIDExternalAuthService)
+	require.Equal(t, resources_kubernetes.ResourceTypeContourExtensionService, extensionServiceResource.GetResourceType().Type)
+	extensionService, ok := extensionServiceResource.CreateResource.Data.(*contourv1alpha1.ExtensionService)
+	require.True(t, ok)
+	require.Equal(t, resourceName+"-extauth", extensionService.Name)
+	require.Equal(t, applicationName, extensionService.Namespace)
+	require.Equal(t, contourv1alpha1.ExtensionServiceSpec{
+		Services: []contourv1alpha1.ExtensionServiceTarget{{Name: "authserver", Port: 9001}},
+		Protocol: to.Ptr("h2c"),
+	}, extensionService.Spec)
+
+	// The gateway policies are configured on the virtual host of the root HTTPProxy.
+	gatewayResource := findOutputResource(t, output.Resources, rpv1.LocalIDGateway)
+	require.ElementsMatch(t, []string{rpv1.LocalIDCertificate, rpv1.LocalIDExternalAuthService, "HttpProxy-frontend", "HttpProxy-api", "HttpProxy-health"}, gatewayResource.CreateResource.Dependencies)
+	rootHTTPProxy, ok := gatewayResource.CreateResource.Data.(*contourv1.HTTPProxy)
+	require.True(t, ok)
+
+	virtualHost := rootHTTPProxy.Spec.VirtualHost
+	require.Equal(t, []contourv1.JWTProvider{
+		{
+			Name:       "gateway",
+			Default:    true,
+			Issuer:     "https://login.contoso.com",
+			ForwardJWT: true,
+			RemoteJWKS: contourv1.RemoteJWKS{URI: "https://login.contoso.com/keys"},
+		},
+		{
+			Name:      "route-api",
+			Issuer:    "https://login.contoso.com",
+			Audiences: []string{"api"},
+			RemoteJWKS: contourv1.RemoteJWKS{
+				URI: "https://login.contoso.com/keys",
+				UpstreamValidation: &contourv1.UpstreamValidation{
+					CACertificate: applicationName + "/contoso-ca",
+					SubjectName:   "login.contoso.com",
+					SubjectNames:  []string{"login.contoso.com"},
+				},
+			},
+		},
+	}, virtualHost.JWTProviders)
+	require.Equal(t, &contourv1.AuthorizationServer{
+		ExtensionServiceRef: contourv1.ExtensionServiceReference{
+			APIVersion: "projectcontour.io/v1alpha1",
+			Namespace:  applicationName,
+			Name:       resourceName + "-extauth",
+		},
+		ResponseTimeout: "500ms",
+		FailOpen:        true,
+	}, virtualHost.Authorization)
+	require.Equal(t, &contourv1.RateLimitPolicy{
+		Local: &contourv1.LocalRateLimitPolicy{Requests: 100, Unit: "minute"},
+	}, virtualHost.RateLimitPolicy)
+	require.Equal(t, &contourv1.CORSPolicy{
+		AllowCredentials: true,
+		AllowOrigin:      []string{"https://www.contoso.com"},
+		AllowMethods:     []contourv1.CORSHeaderValue{"GET", "POST"},
+		AllowHeaders:     []contourv1.CORSHeaderValue{"Authorization"},
+		MaxAge:           "10m",
+	}, virtualHost.CORSPolicy)
+
+	// The route policies are configured on the routes of the route HTTPProxies.
+	frontend := findOutputResource(t, output.Resources, "HttpProxy-frontend").CreateResource.Data.(*contourv1.HTTPProxy)
+	require.Nil(t, frontend.Spec.Routes[0].JWTVerificationPolicy)
+	require.Nil(t, frontend.Spec.Routes[0].AuthPolicy)
+	require.Nil(t, frontend.Spec.Routes[0].RateLimitPolicy)
+
+	api := findOutputResource(t, output.Resources, "HttpProxy-api").CreateResource.Data.(*contourv1.HTTPProxy)
+	require.Equal(t, &contourv1.JWTVerificationPolicy{Require: "route-api"}, api.Spec.Routes[0].JWTVerificationPolicy)
+	require.Nil(t, api.Spec.Routes[0].AuthPolicy)
+	require.Equal(t, &contourv1.RateLimitPolicy{
+		Local: &contourv1.LocalRateLimitPolicy{Requests: 10, Unit: "second", Burst: 5},
+	}, api.Spec.Routes[0].RateLimitPolicy)
+
+	health := findOutputResource(t, output.Resources, "HttpProxy-health").CreateResource.Data.(*contourv1.HTTPProxy)
+	require.Equal(t, &contourv1.JWTVerificationPolicy{Disabled: true}, health.Spec.Routes[0].JWTVerificationPolicy)
+	require.Equal(t, &contourv1.AuthorizationPolicy{Disabled: true}, health.Spec.Routes[0].AuthPolicy)
+}
+
+func Test_Render_GatewayAPI_Policies(t *testing.T) {
+	usersSecretStoreID := makeSecretStoreResourceID("users")
+	properties := makePoliciesTestProperties("")
+	properties.Routes[1].Policies.RateLimit.Burst = 0
+	properties.Policies = &datamodel.GatewayPolicies{
+		BasicAuth: &datamodel.GatewayBasicAuthPolicy{SecretFrom: usersSecretStoreID},
+		RateLimit: &datamodel.GatewayRateLimitPolicy{
+			Requests: 100,
+			Unit:     datamodel.GatewayRateLimitUnitMinute,
+		},
+		CORS: &datamodel.GatewayCORSPolicy{
+			AllowOrigins: []string{"https://www.contoso.com"},
+			AllowMethods: []string{"GET"},
+			MaxAge:       "10m",
+		},
+	}
+	resource := makeResource(properties)
+
+	dependencies := map[string]renderers.RendererDependency{
+		usersSecretStoreID: {
+			ResourceID: resources.MustParse(usersSecretStoreID),
+			Resource: &datamodel.SecretStore{
+				Properties: &datamodel.SecretStoreProperties{
+					Type: datamodel.SecretTypeGeneric,
+					Data: map[string]*datamodel.SecretStoreDataValue{".htpasswd": {Value: to.Ptr("user:{SHA}hash")}},
+				},
+			},
+			OutputResources: map[string]resources.ID{
+				rpv1.LocalIDSecret: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Secret", applicationName, "users"),
+			},
+		},
+	}
+	environmentOptions := getGatewayAPIEnvironmentOptions("", testExternalIP, false)
+
+	output, err := (&Renderer{}).Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+
+	cors := map[string]any{
+		"allowOrigins": []any{"https://www.contoso.com"},
+		"allowMethods": []any{"GET"},
+		"maxAge":       "10m",
+	}
+	gatewayTarget := []any{map[string]any{"group": "gateway.networking.k8s.io", "kind": "Gateway", "name": resourceName}}
+
+	securityPolicy := findOutputResource(t, output.Resources, rpv1.LocalIDSecurityPolicy)
+	require.Equal(t, []string{rpv1.LocalIDGateway}, securityPolicy.CreateResource.Dependencies)
+	require.Equal(t, map[string]any{
+		"targetRefs": gatewayTarget,
+		"cors":       cors,
+		"basicAuth": map[string]any{
+			"users": map[string]any{"kind": "Secret", "name": "users"},
+		},
+	}, requireUnstructured(t, securityPolicy, resources_kubernetes.ResourceTypeEnvoyGatewaySecurityPolicy).Object["spec"])
+
+	trafficPolicy := findOutputResource(t, output.Resources, rpv1.LocalIDBackendTrafficPolicy)
+	require.Equal(t, map[string]any{
+		"targetRefs": gatewayTarget,
+		"rateLimit": map[string]any{
+			"type": "Local",
+			"local": map[string]any{
+				"rules": []any{map[string]any{"limit": map[string]any{"requests": int64(100), "unit": "Minute"}}},
+			},
+		},
+	}, requireUnstructured(t, trafficPolicy, resources_kubernetes.ResourceTypeEnvoyGatewayBackendTrafficPolicy).Object["spec"])
+
+	// The route with its own JWT policy replaces the basic authentication of the gateway.
+	apiSecurityPolicy := findOutputResource(t, output.Resources, rpv1.LocalIDSecurityPolicy+"-api")
+	require.Equal(t, []string{rpv1.LocalIDHttpRoute + "-api"}, apiSecurityPolicy.CreateResource.Dependencies)
+	require.Equal(t, map[string]any{
+		"targetRefs": []any{map[string]any{"group": "gateway.networking.k8s.io", "kind": "HTTPRoute", "name": "api"}},
+		"cors":       cors,
+		"jwt": map[string]any{
+			"providers": []any{
+				map[string]any{
+					"name":       "gateway",
+					"issuer":     "https://login.contoso.com",
+					"audiences":  []any{"api"},
+					"remoteJWKS": map[string]any{"uri": "https://login.contoso.com/keys"},
+				},
+			},
+		},
+	}, requireUnstructured(t, apiSecurityPolicy, resources_kubernetes.ResourceTypeEnvoyGatewaySecurityPolicy).Object["spec"])
+
+	apiTrafficPolicy := findOutputResource(t, output.Resources, rpv1.LocalIDBackendTrafficPolicy+"-api")
+	require.Equal(t, map[string]any{
+		"targetRefs": []any{map[string]any{"group": "gateway.networking.k8s.io", "kind": "HTTPRoute", "name": "api"}},
+		"rateLimit": map[string]any{
+			"type": "Local",
+			"local": map[string]any{
+				"rules": []any{map[string]any{"limit": map[string]any{"requests": int64(10), "unit": "Second"}}},
+			},
+		},
+	}, requireUnstructured(t, apiTrafficPolicy, resources_kubernetes.ResourceTypeEnvoyGatewayBackendTrafficPolicy).Object["spec"])
+
+	// The anonymous route keeps the CORS policy of the gateway without authentication.
+	healthSecurityPolicy := findOutputResource(t, output.Resources, rpv1.LocalIDSecurityPolicy+"-health")
+	require.Equal(t, map[string]any{
+		"targetRefs": []any{map[string]any{"group": "gateway.networking.k8s.io", "kind": "HTTPRoute", "name": "health"}},
+		"cors":       cors,
+	}, requireUnstructured(t, healthSecurityPolicy, resources_kubernetes.ResourceTypeEnvoyGatewaySecurityPolicy).Object["spec"])
+
+	for _, resource := range output.Resources {
+		require.NotEqual(t, rpv1.LocalIDSecurityPolicy+"-frontend", resource.LocalID)
+	}
+}
+
+func Test_Render_Policies_ExternalAuthTLS(t *testing.T) {
+	r := &Renderer{}
+
+	caSecretStoreID := makeSecretStoreResourceID("authserver-ca")
+	properties := makeGatewayAPITestProperties([]datamodel.GatewayRoute{{Destination: "http://A", Path: "/"}}, &datamodel.GatewayPropertiesTLS{
+		CertificateIssuer: &datamodel.GatewayCertificateIssuer{Kind: datamodel.GatewayCertificateIssuerKindSelfSigned},
+	})
+	properties.Policies = &datamodel.GatewayPolicies{
+		ExternalAuth: &datamodel.GatewayExternalAuthPolicy{
+			Destination:       "https://authserver",
+			CACertificateFrom: caSecretStoreID,
+		},
+	}
+	resource := makeResource(properties)
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	dependencies := map[string]renderers.RendererDependency{
+		caSecretStoreID: makeCASecretStoreDependency(caSecretStoreID),
+	}
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: dependencies, Environment: environmentOptions})
+	require.NoError(t, err)
+
+	extensionService := findOutputResource(t, output.Resources, rpv1.LocalIDExternalAuthService).CreateResource.Data.(*contourv1alpha1.ExtensionService)
+	require.Equal(t, contourv1alpha1.ExtensionServiceSpec{
+		Services: []contourv1alpha1.ExtensionServiceTarget{{Name: "authserver", Port: 443}},
+		Protocol: to.Ptr("h2"),
+		UpstreamValidation: &contourv1.UpstreamValidation{
+			CACertificate: applicationName + "/contoso-ca",
+			SubjectName:   "authserver",
+			SubjectNames:  []string{"authserver"},
+		},
+	}, extensionService.Spec)
+}
+
+func Test_Render_Policies_Fails(t *testing.T) {
+	caSecretStoreID := makeSecretStoreResourceID("contoso-ca")
+
+	tests := []struct {
+		name         string
+		mutate       func(*datamodel.GatewayProperties)
+		dependencies map[string]renderers.RendererDependency
+		gatewayAPI   bool
+		err          error
+	}{
+		{
+			name:         "CA certificate secret store not found",
+			dependencies: map[string]renderers.RendererDependency{},
+			err:          v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, caSecretStoreID)),
+		},
+		{
+			name: "CA certificate secret store without ca.crt",
+			dependencies: map[string]renderers.RendererDependency{
+				caSecretStoreID: {
+					ResourceID: resources.MustParse(caSecretStoreID),
+					Resource: &datamodel.SecretStore{
+						Properties: &datamodel.SecretStoreProperties{
+							Type: datamodel.SecretTypeCert,
+							Data: map[string]*datamodel.SecretStoreDataValue{"tls.crt": {Value: to.Ptr("test-crt")}},
+						},
+					},
+				},
+			},
+			err: v1.NewClientErrInvalidRequest("jwt.caCertificateFrom must reference a secretStore resource with ca.crt"),
+		},
+		{
+			name: "routes to the same destination with different policies",
+			mutate: func(properties *datamodel.GatewayProperties) {
+				properties.Routes = append(properties.Routes, datamodel.GatewayRoute{Destination: "http://api", Path: "/internal"})
+			},
+			dependencies: map[string]renderers.RendererDependency{caSecretStoreID: makeCASecretStoreDependency(caSecretStoreID)},
+			err:          v1.NewClientErrInvalidRequest("routes to destination api must declare the same policies"),
+		},
+		{
+			name: "basic authentication with Contour",
+			mutate: func(properties *datamodel.GatewayProperties) {
+				properties.Policies = &datamodel.GatewayPolicies{
+					BasicAuth: &datamodel.GatewayBasicAuthPolicy{SecretFrom: makeSecretStoreResourceID("users")},
+				}
+			},
+			dependencies: map[string]renderers.RendererDependency{caSecretStoreID: makeCASecretStoreDependency(caSecretStoreID)},
+			err:          v1.NewClientErrInvalidRequest("basicAuth is not supported by the Contour ingress implementation. Use the Gateway API ingress implementation, or externalAuth with an authorization service that verifies basic credentials"),
+		},
+		{
+			name:         "JWT CA certificate with the Gateway API",
+			dependencies: map[string]renderers.RendererDependency{caSecretStoreID: makeCASecretStoreDependency(caSecretStoreID)},
+			gatewayAPI:   true,
+			err:          v1.NewClientErrInvalidRequest("jwt.caCertificateFrom is not supported for gateways rendered with the Gateway API"),
+		},
+		{
+			name: "rate limit burst with the Gateway API",
+			mutate: func(properties *datamodel.GatewayProperties) {
+				properties.Routes[1].Policies.JWT.CACertificateFrom = ""
+			},
+			dependencies: map[string]renderers.RendererDependency{},
+			gatewayAPI:   true,
+			err:          v1.NewClientErrInvalidRequest("rateLimit.burst is not supported for gateways rendered with the Gateway API"),
+		},
+		{
+			name: "routes to the same destination with different policies with the Gateway API",
+			mutate: func(properties *datamodel.GatewayProperties) {
+				properties.Routes = append(properties.Routes, datamodel.GatewayRoute{Destination: "http://api", Path: "/internal"})
+			},
+			dependencies: map[string]renderers.RendererDependency{},
+			gatewayAPI:   true,
+			err:          v1.NewClientErrInvalidRequest("routes to destination api must declare the same policies"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			properties := makePoliciesTestProperties(caSecretStoreID)
+			if tt.mutate != nil {
+				tt.mutate(&properties)
+			}
+			resource := makeResource(properties)
+
+			environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+			if tt.gatewayAPI {
+				environmentOptions = getGatewayAPIEnvironmentOptions("", testExternalIP, false)
+			}
+
+			_, err := (&Renderer{}).Render(context.Background(), resource, renderers.RenderOptions{Dependencies: tt.dependencies, Environment: environmentOptions})
+			require.Equal(t, tt.err, err)
+		})
+	}
+}
@@ -20,8 +20,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/url"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -39,13 +42,13 @@ import (
 )
 
 const secretStoreNotFound = "secretStore resource %s not found"
-const invalidSecretStoreResource = "certificateFrom must reference a secretStore resource"
+const invalidSecretStoreResource = "%s must reference a secretStore resource"
 
 type Renderer struct {
 }
 
-// GetDependencyIDs parses the gateway data model to get the secretStore resource ID
-// from the certificateFrom property, and returns them as two slices of resource IDs.
+// GetDependencyIDs parses the gateway data model to get the secretStore resource IDs
+// from the certificateFrom property and the policies, and returns them as two slices of resource IDs.
 func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface) (radiusResourceIDs []resources.ID, azureResourceIDs []resources.ID, err error) {
 	gateway, ok := dm.(*datamodel.Gateway)
 	if !ok {
@@ -63,11 +66,22 @@ func (r Renderer) GetDependencyIDs(ctx context.Context, dm v1.DataModelInterface
 		radiusResourceIDs = append(radiusResourceIDs, resourceID)
 	}
 
+	// Get secretStore resource IDs from the caCertificateFrom properties of the policies
+	for _, id := range getPolicySecretStoreIDs(gateway) {
+		resourceID, err := resources.ParseResource(id)
+		if err != nil {
+			return nil, nil, v1.NewClientErrInvalidRequest(err.Error())
+		}
+
+		radiusResourceIDs = append(radiusResourceIDs, resourceID)
+	}
+
 	return radiusResourceIDs, azureResourceIDs, nil
 }
 
 // Render creates a gateway object and http route objects based on the given parameters, and returns them along
-// with a computed value for the gateway's public endpoint.
+// with a computed value for the gateway's public endpoint. The objects are Contour HTTPProxy resources, unless the
+// environment uses the Gateway API ingress implementation.
 func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options renderers.RenderOptions) (renderers.RendererOutput, error) {
 	outputResources := []rpv1.OutputResource{}
 	gateway, ok := dm.(*datamodel.Gateway)
@@ -82,29 +96,66 @@ func (r Renderer) Render(ctx context.Context, dm v1.DataModelInterface, options
 	gatewayName := kubernetes.NormalizeResourceName(gateway.Name)
 	hostname, err := getHostname(*gateway, &gateway.Properties, applicationName, options.Environment.Gateway)
 
+	// gatewayHostname is the hostname matched by the Gateway API listener, which is only set for a public endpoint.
+	gatewayHostname := hostname
+
 	var publicEndpoint string
 	if errors.Is(err, &ErrNoPublicEndpoint{}) {
 		publicEndpoint = "unknown"
+		gatewayHostname = ""
 	} else if err != nil {
 		return renderers.RendererOutput{}, fmt.Errorf("getting hostname failed with error: %s", err)
 	} else {
-		isHttps := gateway.Properties.TLS != nil && (gateway.Properties.TLS.SSLPassthrough || gateway.Properties.TLS.CertificateFrom != "")
+		isHttps := gateway.Properties.TLS.HasCertificate() || (gateway.Properties.TLS != nil && gateway.Properties.TLS.SSLPassthrough)
 		publicEndpoint = getPublicEndpoint(hostname, options.Environment.Gateway.Port, isHttps)
 	}
 
+	computedValues := map[string]rpv1.ComputedValueReference{
+		"url": {
+			Value: publicEndpoint,
+		},
+	}
+
+	// Certificates issued automatically are requested for the public hostname of the gateway.
+	if gateway.Properties.TLS != nil && gateway.Properties.TLS.CertificateIssuer != nil {
+		certificateObjects, err := MakeCertificateResources(options, gateway, applicationName, gatewayHostname)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+
+		outputResources = append(outputResources, certificateObjects...)
+		maps.Copy(computedValues, makeCertificateComputedValues())
+	}
+
+	if options.Environment.Ingress != nil && options.Environment.Ingress.Kind == datamodel.IngressKindGatewayAPI {
+		gatewayAPIObjects, err := MakeGatewayAPIResources(ctx, options, gateway, applicationName, gatewayHostname)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
+
+		return renderers.RendererOutput{
+			Resources:      append(outputResources, gatewayAPIObjects...),
+			ComputedValues: computedValues,
+		}, nil
+	}
+
 	gatewayObject, err := MakeRootHTTPProxy(ctx, options, gateway, gateway.Name, applicationName, hostname)
 	if err != nil {
 		return renderers.RendererOutput{}, err
 	}
 
-	outputResources = append(outputResources, gatewayObject)
+	if gateway.Properties.Policies != nil && gateway.Properties.Policies.ExternalAuth != nil {
+		externalAuthService, err := MakeExternalAuthService(options, gateway, applicationName)
+		if err != nil {
+			return renderers.RendererOutput{}, err
+		}
 
-	computedValues := map[string]rpv1.ComputedValueReference{
-		"url": {
-			Value: publicEndpoint,
-		},
+		outputResources = append(outputResources, externalAuthService)
+		gatewayObject.CreateResource.Dependencies = append(gatewayObject.CreateResource.Dependencies, rpv1.LocalIDExternalAuthService)
 	}
 
+	outputResources = append(outputResources, gatewayObject)
+
 	httpProxyObjects, err := MakeRoutesHTTPProxies(ctx, options, *gateway, &gateway.Properties, gatewayName, gatewayObject, applicationName)
 	if err != nil {
 		return renderers.RendererOutput{}, err
@@ -135,55 +186,20 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		sslPassthrough = gateway.Properties.TLS.SSLPassthrough
 
 		if gateway.Properties.TLS.CertificateFrom != "" {
-			secretStoreResourceId := gateway.Properties.TLS.CertificateFrom
-			secretStoreResource, ok := dependencies[secretStoreResourceId]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
-			}
-
-			referencedResource := dependencies[secretStoreResourceId].Resource
-			if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource)
-			}
-
-			// Validate the secretStore resource: it must be of type certificate and have tls.crt and tls.key
-			secretStore, ok := referencedResource.(*datamodel.SecretStore)
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource)
-			}
-
-			if secretStore.Properties.Type != datamodel.SecretTypeCert {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with type certificate")
-			}
-
-			if secretStore.Properties.Data["tls.crt"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with tls.crt")
-			}
-
-			if secretStore.Properties.Data["tls.key"] == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(invalidSecretStoreResource + " with tls.key")
-			}
-
-			// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
-			if secretStoreResource.OutputResources == nil {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
-			}
-
-			secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
-			if !ok {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
-			}
-
-			secretName := secretResourceID.Name()
-			secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
-			if secretNamespace == "" {
-				return rpv1.OutputResource{}, v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+			secretNamespace, secretName, err := getCertificateSecret(gateway, dependencies)
+			if err != nil {
+				return rpv1.OutputResource{}, err
 			}
 
 			contourTLSConfig = &contourv1.TLS{
 				SecretName:             fmt.Sprintf("%s/%s", secretNamespace, secretName),
 				MinimumProtocolVersion: string(gateway.Properties.TLS.MinimumProtocolVersion),
 			}
+		} else if gateway.Properties.TLS.CertificateIssuer != nil {
+			contourTLSConfig = &contourv1.TLS{
+				SecretName:             fmt.Sprintf("%s/%s", options.Environment.Namespace, getIssuedCertificateSecretName(gateway)),
+				MinimumProtocolVersion: string(gateway.Properties.TLS.MinimumProtocolVersion),
+			}
 		}
 	}
 
@@ -210,14 +226,12 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 			prefix = "/"
 		}
 
-		includes = append(includes, contourv1.Include{
-			Name: routeResourceName,
-			Conditions: []contourv1.MatchCondition{
-				{
-					Prefix: prefix,
-				},
-			},
-		})
+		for _, conditions := range makeIncludeConditions(&route, prefix) {
+			includes = append(includes, contourv1.Include{
+				Name:       routeResourceName,
+				Conditions: conditions,
+			})
+		}
 	}
 
 	virtualHostname := hostname
@@ -232,6 +246,10 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		TLS:  contourTLSConfig,
 	}
 
+	if err := applyVirtualHostPolicies(virtualHost, gateway, options); err != nil {
+		return rpv1.OutputResource{}, err
+	}
+
 	var tcpProxy *contourv1.TCPProxy
 	if sslPassthrough {
 		virtualHost.TLS = &contourv1.TLS{
@@ -282,7 +300,13 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 		rootHTTPProxy.Spec.TCPProxy = tcpProxy
 	}
 
-	return rpv1.NewKubernetesOutputResource(rpv1.LocalIDGateway, rootHTTPProxy, rootHTTPProxy.ObjectMeta), nil
+	outputResource := rpv1.NewKubernetesOutputResource(rpv1.LocalIDGateway, rootHTTPProxy, rootHTTPProxy.ObjectMeta)
+	if gateway.Properties.TLS != nil && gateway.Properties.TLS.CertificateIssuer != nil {
+		// The secret referenced by the virtual host is created by cert-manager when the certificate is issued.
+		outputResource.CreateResource.Dependencies = []string{rpv1.LocalIDCertificate}
+	}
+
+	return outputResource, nil
 }
 
 // MakeRoutesHTTPProxies creates HTTPProxy objects for each route in the gateway and returns them as OutputResources. It returns
@@ -290,22 +314,12 @@ func MakeRootHTTPProxy(ctx context.Context, options renderers.RenderOptions, gat
 func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions, resource datamodel.Gateway, gateway *datamodel.GatewayProperties, gatewayName string, gatewayOutPutResource rpv1.OutputResource, applicationName string) ([]rpv1.OutputResource, error) {
 	dependencies := options.Dependencies
 	objects := make(map[string]*contourv1.HTTPProxy)
+	mergedRoutes := make(map[string]datamodel.GatewayRoute)
 
 	for _, route := range gateway.Routes {
-		port := renderers.DefaultPort
-
-		if isURL(route.Destination) {
-			_, _, urlPort, err := parseURL(route.Destination)
-			if err != nil {
-				return []rpv1.OutputResource{}, err
-			}
-			port = urlPort
-		} else {
-			routeProperties := dependencies[route.Destination]
-			routePort, ok := routeProperties.ComputedValues["port"].(float64)
-			if ok {
-				port = int32(routePort)
-			}
+		services, err := makeRouteServices(&route, dependencies)
+		if err != nil {
+			return []rpv1.OutputResource{}, err
 		}
 
 		routeName, err := getRouteName(&route)
@@ -331,32 +345,31 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 
 		var timeoutPolicy *contourv1.TimeoutPolicy
 		if route.TimeoutPolicy != nil {
-			// Parse the timeout policy request durations and compare them
-			requestDuration, err := time.ParseDuration(route.TimeoutPolicy.Request)
-			if err != nil {
-				return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("invalid request timeout duration")
-			}
-			var backendRequestDuration time.Duration
-			if route.TimeoutPolicy.BackendRequest != "" {
-				backendRequestDuration, err = time.ParseDuration(route.TimeoutPolicy.BackendRequest)
-				if err != nil {
-					return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("invalid backend request timeout duration")
-				}
-			} else {
-				// If the backend request timeout is not specified, default to the request timeout
-				backendRequestDuration = requestDuration
-			}
-			// Compare the 2 request durations and ensure that the request timeout is greater than the backend request timeout
-			if requestDuration < backendRequestDuration {
-				return []rpv1.OutputResource{}, v1.NewClientErrInvalidRequest("request timeout must be greater than or equal to backend request timeout")
+			if err := validateTimeoutPolicy(route.TimeoutPolicy); err != nil {
+				return []rpv1.OutputResource{}, err
 			}
 			timeoutPolicy = &contourv1.TimeoutPolicy{
 				Response: route.TimeoutPolicy.Request,
 			}
 		}
 
+		var retryPolicy *contourv1.RetryPolicy
+		if route.RetryPolicy != nil {
+			retryPolicy = &contourv1.RetryPolicy{
+				NumRetries:    int64(route.RetryPolicy.Attempts),
+				PerTryTimeout: route.RetryPolicy.PerTryTimeout,
+			}
+			for _, retryOn := range route.RetryPolicy.RetryOn {
+				retryPolicy.RetryOn = append(retryPolicy.RetryOn, contourv1.RetryOn(retryOn))
+			}
+		}
+
 		// If this route already exists, append to it
 		if object, exists := objects[localID]; exists {
+			if err := validateMergedRoute(mergedRoutes[localID], route); err != nil {
+				return []rpv1.OutputResource{}, err
+			}
+
 			if pathRewritePolicy != nil {
 			outer:
 				for i := range object.Spec.Routes {
@@ -389,21 +402,24 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 			Spec: contourv1.HTTPProxySpec{
 				Routes: []contourv1.Route{
 					{
-						Services: []contourv1.Service{
-							{
-								Name: routeResourceName,
-								Port: int(port),
-							},
-						},
-						PathRewritePolicy: pathRewritePolicy,
-						TimeoutPolicy:     timeoutPolicy,
-						EnableWebsockets:  route.EnableWebsockets,
+						Services:              services,
+						PathRewritePolicy:     pathRewritePolicy,
+						TimeoutPolicy:         timeoutPolicy,
+						RetryPolicy:           retryPolicy,
+						RequestHeadersPolicy:  makeHeadersPolicy(route.RequestHeaders),
+						ResponseHeadersPolicy: makeHeadersPolicy(route.ResponseHeaders),
+						EnableWebsockets:      route.EnableWebsockets,
 					},
 				},
 			},
 		}
 
+		if err := applyRoutePolicies(&httpProxyObject.Spec.Routes[0], &route, gateway.Policies); err != nil {
+			return []rpv1.OutputResource{}, err
+		}
+
 		objects[localID] = httpProxyObject
+		mergedRoutes[localID] = route
 
 		// Add the route as a dependency of the root http proxy to ensure that the route is created before the root http proxy
 		gatewayOutPutResource.CreateResource.Dependencies = append(gatewayOutPutResource.CreateResource.Dependencies, localID)
@@ -417,8 +433,225 @@ func MakeRoutesHTTPProxies(ctx context.Context, options renderers.RenderOptions,
 	return outputResources, nil
 }
 
+// makeIncludeConditions creates the conditions of the root HTTPProxy includes for the route. Contour evaluates the
+// conditions of an include as a logical AND, so a route that matches multiple methods creates one set of conditions
+// per method.
+func makeIncludeConditions(route *datamodel.GatewayRoute, prefix string) [][]contourv1.MatchCondition {
+	conditions := []contourv1.MatchCondition{
+		{
+			Prefix: prefix,
+		},
+	}
+
+	for _, header := range route.Headers {
+		conditions = append(conditions, contourv1.MatchCondition{
+			Header: &contourv1.HeaderMatchCondition{
+				Name:     header.Name,
+				Exact:    header.Exact,
+				Contains: header.Contains,
+				Present:  header.Present,
+			},
+		})
+	}
+
+	for _, parameter := range route.QueryParameters {
+		conditions = append(conditions, contourv1.MatchCondition{
+			QueryParameter: &contourv1.QueryParameterMatchCondition{
+				Name:     parameter.Name,
+				Exact:    parameter.Exact,
+				Contains: parameter.Contains,
+				Present:  parameter.Present,
+			},
+		})
+	}
+
+	if len(route.Methods) == 0 {
+		return [][]contourv1.MatchCondition{conditions}
+	}
+
+	result := [][]contourv1.MatchCondition{}
+	for _, method := range route.Methods {
+		// Envoy exposes the method of the request as the :method pseudo-header.
+		methodConditions := append(slices.Clone(conditions), contourv1.MatchCondition{
+			Header: &contourv1.HeaderMatchCondition{
+				Name:  ":method",
+				Exact: method,
+			},
+		})
+		result = append(result, methodConditions)
+	}
+
+	return result
+}
+
+// makeRouteServices creates the Contour services for the destinations of the route. The traffic is split between
+// the services by weight when the route has multiple destinations.
+func makeRouteServices(route *datamodel.GatewayRoute, dependencies map[string]renderers.RendererDependency) ([]contourv1.Service, error) {
+	services := []contourv1.Service{}
+	for _, destination := range route.GetDestinations() {
+		port, err := getRoutePort(destination.Destination, dependencies, false)
+		if err != nil {
+			return nil, err
+		}
+
+		name, err := getDestinationName(destination.Destination)
+		if err != nil {
+			return nil, err
+		}
+
+		services = append(services, contourv1.Service{
+			Name:   kubernetes.NormalizeResourceName(name),
+			Port:   int(port),
+			Weight: int64(destination.Weight),
+		})
+	}
+
+	return services, nil
+}
+
+// makeHeadersPolicy creates the Contour headers policy for the given route headers policy. The headers to set are
+// sorted by name so that the rendered object is stable.
+func makeHeadersPolicy(policy *datamodel.GatewayRouteHeadersPolicy) *contourv1.HeadersPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	headersPolicy := &contourv1.HeadersPolicy{
+		Remove: policy.Remove,
+	}
+	for _, name := range slices.Sorted(maps.Keys(policy.Set)) {
+		headersPolicy.Set = append(headersPolicy.Set, contourv1.HeaderValue{
+			Name:  name,
+			Value: policy.Set[name],
+		})
+	}
+
+	return headersPolicy
+}
+
+// getCertificateSecret validates the secretStore referenced by the certificateFrom property of the gateway and
+// returns the namespace and name of the Kubernetes secret that holds the certificate.
+func getCertificateSecret(gateway *datamodel.Gateway, dependencies map[string]renderers.RendererDependency) (string, string, error) {
+	return getSecretStoreSecret(gateway.Properties.TLS.CertificateFrom, "certificateFrom", dependencies, datamodel.SecretTypeCert, "tls.crt", "tls.key")
+}
+
+// getSecretStoreSecret validates that the secretStore with the given resource ID is of the given type and holds
+// the given keys, and returns the namespace and name of the Kubernetes secret that holds the secrets. The
+// property is the name of the gateway property that references the secretStore.
+func getSecretStoreSecret(secretStoreResourceId string, property string, dependencies map[string]renderers.RendererDependency, secretType datamodel.SecretType, keys ...string) (string, string, error) {
+	secretStoreResource, ok := dependencies[secretStoreResourceId]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+	}
+
+	invalidResource := fmt.Sprintf(invalidSecretStoreResource, property)
+	referencedResource := secretStoreResource.Resource
+	if !strings.EqualFold(referencedResource.ResourceTypeName(), datamodel.SecretStoreResourceType) {
+		return "", "", v1.NewClientErrInvalidRequest(invalidResource)
+	}
+
+	// Validate the secretStore resource: it must be of the given type and have the keys
+	secretStore, ok := referencedResource.(*datamodel.SecretStore)
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(invalidResource)
+	}
+
+	if secretStore.Properties.Type != secretType {
+		return "", "", v1.NewClientErrInvalidRequest(invalidResource + " with type " + string(secretType))
+	}
+
+	for _, key := range keys {
+		if secretStore.Properties.Data[key] == nil {
+			return "", "", v1.NewClientErrInvalidRequest(invalidResource + " with " + key)
+		}
+	}
+
+	// Get the name and namespace of the Kubernetes secret resource from the secretStore OutputResources
+	if secretStoreResource.OutputResources == nil {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+	}
+
+	secretResourceID, ok := secretStoreResource.OutputResources[rpv1.LocalIDSecret]
+	if !ok {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+	}
+
+	secretName := secretResourceID.Name()
+	secretNamespace := secretResourceID.FindScope(resources_kubernetes.ScopeNamespaces)
+	if secretNamespace == "" {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf(secretStoreNotFound, secretStoreResourceId))
+	}
+
+	return secretNamespace, secretName, nil
+}
+
+// validateTimeoutPolicy parses the request durations of the timeout policy and ensures that the request timeout
+// is greater than or equal to the backend request timeout.
+func validateTimeoutPolicy(policy *datamodel.GatewayRouteTimeoutPolicy) error {
+	requestDuration, err := time.ParseDuration(policy.Request)
+	if err != nil {
+		return v1.NewClientErrInvalidRequest("invalid request timeout duration")
+	}
+	var backendRequestDuration time.Duration
+	if policy.BackendRequest != "" {
+		backendRequestDuration, err = time.ParseDuration(policy.BackendRequest)
+		if err != nil {
+			return v1.NewClientErrInvalidRequest("invalid backend request timeout duration")
+		}
+	} else {
+		// If the backend request timeout is not specified, default to the request timeout
+		backendRequestDuration = requestDuration
+	}
+	// Compare the 2 request durations and ensure that the request timeout is greater than the backend request timeout
+	if requestDuration < backendRequestDuration {
+		return v1.NewClientErrInvalidRequest("request timeout must be greater than or equal to backend request timeout")
+	}
+
+	return nil
+}
+
+// validateMergedRoute ensures that routes sharing a destination, which are rendered as a single HTTPProxy route that
+// only differs by path rewrite, declare the same traffic splitting, headers, timeout, retry, websocket and policy
+// settings.
+func validateMergedRoute(existing datamodel.GatewayRoute, route datamodel.GatewayRoute) error {
+	fields := []struct {
+		name     string
+		existing any
+		route    any
+	}{
+		{"destinations", existing.GetDestinations(), route.GetDestinations()},
+		{"requestHeaders", existing.RequestHeaders, route.RequestHeaders},
+		{"responseHeaders", existing.ResponseHeaders, route.ResponseHeaders},
+		{"timeoutPolicy", existing.TimeoutPolicy, route.TimeoutPolicy},
+		{"retryPolicy", existing.RetryPolicy, route.RetryPolicy},
+		{"enableWebsockets", existing.EnableWebsockets, route.EnableWebsockets},
+		{"policies", existing.Policies, route.Policies},
+	}
+
+	for _, field := range fields {
+		if reflect.DeepEqual(field.existing, field.route) {
+			continue
+		}
+
+		routeName, err := getRouteName(&route)
+		if err != nil {
+			return err
+		}
+
+		return v1.NewClientErrInvalidRequest(fmt.Sprintf("routes to destination %s must declare the same %s", routeName, field.name))
+	}
+
+	return nil
+}
+
+// getRouteName returns the name of the route, which is the hostname of its first destination.
 func getRouteName(route *datamodel.GatewayRoute) (string, error) {
-	u, err := url.Parse(route.Destination)
+	return getDestinationName(route.GetDestinations()[0].Destination)
+}
+
+// getDestinationName returns the hostname of the route destination.
+func getDestinationName(destination string) (string, error) {
+	u, err := url.Parse(destination)
 	if err != nil {
 		return "", v1.NewClientErrInvalidRequest(err.Error())
 	}
@@ -1249,6 +1249,71 @@ func Test_Render_Route_WithMultiplePrefixRewrite(t *testing.T) {
 	validateContourHTTPRoute(t, output.Resources, "B", expectedHTTPRouteSpecB, "")
 }
 
+func Test_Render_Fails_MergedRoutesWithDifferentSettings(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(route *datamodel.GatewayRoute)
+		message string
+	}{
+		{
+			name: "destinations",
+			mutate: func(route *datamodel.GatewayRoute) {
+				route.Destination = ""
+				route.Destinations = []datamodel.GatewayRouteDestination{{Destination: "http://A", Weight: 50}, {Destination: "http://C", Weight: 50}}
+			},
+			message: "routes to destination A must declare the same destinations",
+		},
+		{
+			name: "request headers",
+			mutate: func(route *datamodel.GatewayRoute) {
+				route.RequestHeaders = &datamodel.GatewayRouteHeadersPolicy{Remove: []string{"x-debug"}}
+			},
+			message: "routes to destination A must declare the same requestHeaders",
+		},
+		{
+			name: "retry policy",
+			mutate: func(route *datamodel.GatewayRoute) {
+				route.RetryPolicy = &datamodel.GatewayRouteRetryPolicy{Attempts: 3}
+			},
+			message: "routes to destination A must declare the same retryPolicy",
+		},
+		{
+			name: "timeout policy",
+			mutate: func(route *datamodel.GatewayRoute) {
+				route.TimeoutPolicy = &datamodel.GatewayRouteTimeoutPolicy{Request: "10s"}
+			},
+			message: "routes to destination A must declare the same timeoutPolicy",
+		},
+		{
+			name: "websockets",
+			mutate: func(route *datamodel.GatewayRoute) {
+				route.EnableWebsockets = true
+			},
+			message: "routes to destination A must declare the same enableWebsockets",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Renderer{}
+
+			routeB := datamodel.GatewayRoute{Destination: "http://A", Path: "/b", ReplacePrefix: "/"}
+			tt.mutate(&routeB)
+			properties := datamodel.GatewayProperties{
+				BasicResourceProperties: rpv1.BasicResourceProperties{
+					Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+				},
+				Routes: []datamodel.GatewayRoute{{Destination: "http://A", Path: "/a"}, routeB},
+			}
+			resource := makeResource(properties)
+			environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+
+			_, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+			require.Equal(t, v1.NewClientErrInvalidRequest(tt.message), err)
+		})
+	}
+}
+
 func Test_Render_WithDependencies(t *testing.T) {
 	r := &Renderer{}
 
@@ -1607,6 +1672,94 @@ func Test_Render_With_TLSTermination(t *testing.T) {
 	validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
 }
 
+func Test_Render_Route_WithMatchingAndTrafficSplitting(t *testing.T) {
+	r := &Renderer{}
+
+	route := datamodel.GatewayRoute{
+		Path: "/api",
+		Destinations: []datamodel.GatewayRouteDestination{
+			{Destination: "http://A", Weight: 90},
+			{Destination: "http://B:3000", Weight: 10},
+		},
+		Headers: []datamodel.GatewayRouteHeaderMatch{
+			{Name: "x-canary", Exact: "true"},
+		},
+		QueryParameters: []datamodel.GatewayRouteQueryParameterMatch{
+			{Name: "version", Present: true},
+		},
+		Methods: []string{"GET", "POST"},
+		RequestHeaders: &datamodel.GatewayRouteHeadersPolicy{
+			Set:    map[string]string{"x-b": "b", "x-a": "a"},
+			Remove: []string{"x-debug"},
+		},
+		ResponseHeaders: &datamodel.GatewayRouteHeadersPolicy{
+			Remove: []string{"server"},
+		},
+		RetryPolicy: &datamodel.GatewayRouteRetryPolicy{
+			Attempts:      3,
+			PerTryTimeout: "2s",
+			RetryOn:       []string{"5xx", "connect-failure"},
+		},
+	}
+	properties := datamodel.GatewayProperties{
+		BasicResourceProperties: rpv1.BasicResourceProperties{
+			Application: "/subscriptions/test-sub-id/resourceGroups/test-rg/providers/Applications.Core/applications/test-application",
+		},
+		Routes: []datamodel.GatewayRoute{route},
+	}
+	resource := makeResource(properties)
+	environmentOptions := getEnvironmentOptions("", testExternalIP, "", false, false)
+	expectedHostname := fmt.Sprintf("%s.%s.%s.nip.io", resourceName, applicationName, testExternalIP)
+
+	output, err := r.Render(context.Background(), resource, renderers.RenderOptions{Dependencies: map[string]renderers.RendererDependency{}, Environment: environmentOptions})
+	require.NoError(t, err)
+	require.Len(t, output.Resources, 2)
+
+	conditions := func(method string) []contourv1.MatchCondition {
+		return []contourv1.MatchCondition{
+			{Prefix: "/api"},
+			{Header: &contourv1.HeaderMatchCondition{Name: "x-canary", Exact: "true"}},
+			{QueryParameter: &contourv1.QueryParameterMatchCondition{Name: "version", Present: true}},
+			{Header: &contourv1.HeaderMatchCondition{Name: ":method", Exact: method}},
+		}
+	}
+	expectedGatewaySpec := &contourv1.HTTPProxySpec{
+		VirtualHost: &contourv1.VirtualHost{
+			Fqdn: expectedHostname,
+		},
+		Includes: []contourv1.Include{
+			{Name: "a", Conditions: conditions("GET")},
+			{Name: "a", Conditions: conditions("POST")},
+		},
+	}
+
+	expectedHTTPRouteSpec := contourv1.HTTPProxySpec{
+		Routes: []contourv1.Route{
+			{
+				Services: []contourv1.Service{
+					{Name: "a", Port: int(renderers.DefaultPort), Weight: 90},
+					{Name: "b", Port: 3000, Weight: 10},
+				},
+				RetryPolicy: &contourv1.RetryPolicy{
+					NumRetries:    3,
+					PerTryTimeout: "2s",
+					RetryOn:       []contourv1.RetryOn{"5xx", "connect-failure"},
+				},
+				RequestHeadersPolicy: &contourv1.HeadersPolicy{
+					Set:    []contourv1.HeaderValue{{Name: "x-a", Value: "a"}, {Name: "x-b", Value: "b"}},
+					Remove: []string{"x-debug"},
+				},
+				ResponseHeadersPolicy: &contourv1.HeadersPolicy{
+					Remove: []string{"server"},
+				},
+			},
+		},
+	}
+
+	validateContourHTTPProxy(t, output.Resources, expectedGatewaySpec, "")
+	validateContourHTTPRoute(t, output.Resources, "A", expectedHTTPRouteSpec, "")
+}
+
 func Test_ParseURL(t *testing.T) {
 	const valid_url = "http://examplehost:80"
 	const invalid_url = "http://abc:def"
@@ -72,6 +72,8 @@ type EnvironmentOptions struct {
 	CloudProviders *datamodel.Providers
 	// Gateway represents the gateway options.
 	Gateway GatewayOptions
+	// Ingress represents the ingress implementation of the environment. Contour is used when it is not specified.
+	Ingress *datamodel.IngressProperties
 	// Identity represents identity of the environment.
 	Identity *rpv1.IdentitySettings
 	// KubernetesMetadata represents the Environment KubernetesMetadata extension.
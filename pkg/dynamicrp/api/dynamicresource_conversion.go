@@ -84,6 +84,11 @@ func (d *DynamicResource) ConvertFrom(src v1.DataModelInterface) error {
 		return fmt.Errorf("failed to unmarshal properties: %w", err)
 	}
 
+	// Secret outputs of the recipe are only returned by the listSecrets action.
+	if status, ok := properties["status"].(map[string]any); ok {
+		delete(status, "secrets")
+	}
+
 	d.ID = &dm.ID
 	d.Name = &dm.Name
 	d.Type = &dm.Type
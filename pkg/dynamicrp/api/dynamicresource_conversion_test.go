@@ -100,6 +100,25 @@ func Test_DynamicResource_ConvertDataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "dynamicresource-datamodel-secrets.json",
+			expected: &DynamicResource{
+				ID:       to.Ptr("/planes/radius/local/resourceGroups/test/providers/Applications.Test/testResources/testResource"),
+				Name:     to.Ptr("testResource"),
+				Type:     to.Ptr("Applications.Test/testResources"),
+				Location: to.Ptr("global"),
+				Tags:     map[string]*string{},
+				Properties: map[string]any{
+					"provisioningState": fromProvisioningStateDataModel(v1.ProvisioningStateSucceeded),
+					"host":              "example.com",
+					"status": map[string]any{
+						"computedValues": map[string]any{
+							"host": "example.com",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -24,7 +24,9 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend/processor"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/schema"
@@ -43,15 +45,17 @@ type DynamicResourceController struct {
 	ucp                 *v20231001preview.ClientFactory
 	engine              engine.Engine
 	configurationLoader configloader.ConfigurationLoader
+	secretProvider      *secretprovider.SecretProvider
 }
 
 // NewDynamicResourceController creates a new DynamicResourcePutController.
-func NewDynamicResourceController(opts ctrl.Options, ucp *v20231001preview.ClientFactory, engine engine.Engine, configurationLoader configloader.ConfigurationLoader) (ctrl.Controller, error) {
+func NewDynamicResourceController(opts ctrl.Options, ucp *v20231001preview.ClientFactory, engine engine.Engine, configurationLoader configloader.ConfigurationLoader, secretProvider *secretprovider.SecretProvider) (ctrl.Controller, error) {
 	return &DynamicResourceController{
 		BaseController:      ctrl.NewBaseAsyncController(opts),
 		ucp:                 ucp,
 		engine:              engine,
 		configurationLoader: configurationLoader,
+		secretProvider:      secretProvider,
 	}, nil
 }
 
@@ -73,8 +77,31 @@ func (c *DynamicResourceController) Run(ctx context.Context, request *ctrl.Reque
 		return ctrl.Result{}, fmt.Errorf("failed to create controller: %w", err)
 	}
 
-	return controller.Run(ctx, request)
+	result, err := controller.Run(ctx, request)
+	if err != nil || result.Requeue || result.ProvisioningState() != v1.ProvisioningStateSucceeded {
+		return result, err
+	}
+
+	// The sensitive properties and recipe secrets of a deleted resource are removed from the secret store.
+	operationType, _ := v1.ParseOperationType(request.OperationType)
+	if operationType.Method == v1.OperationDelete {
+		secretClient, err := c.secretProvider.GetClient(ctx)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		err = sensitive.Delete(ctx, secretClient, request.ResourceID)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete sensitive properties: %w", err)
+		}
+
+		err = sensitive.DeleteRecipeSecrets(ctx, secretClient, request.ResourceID)
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete recipe secrets: %w", err)
+		}
+	}
 
+	return result, nil
 }
 
 // selectController determines which controller to use based on the operation and resource capabilities
@@ -105,7 +132,11 @@ func (c *DynamicResourceController) selectController(ctx context.Context, reques
 		if hasCapability(resourceTypeDetails, datamodel.CapabilityManualResourceProvisioning) {
 			return NewInertPutController(options)
 		}
-		return NewRecipePutController(options, c.engine, c.configurationLoader)
+		secretClient, err := c.secretProvider.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return NewRecipePutController(options, c.engine, c.configurationLoader, secretClient)
 
 	default:
 		return nil, fmt.Errorf("unsupported operation type: %q", request.OperationType)
@@ -128,7 +159,7 @@ func (c *DynamicResourceController) validateRequestSchema(ctx context.Context, r
 		return fmt.Errorf("failed to access and validate resource data: %w", err)
 	}
 
-	schemaData, err := processor.GetSchemaForResourceType(ctx, c.ucp, request.ResourceID, request.APIVersion)
+	schemaData, err := processor.GetSchemaForResourceType(ctx, c.ucp, request.ResourceID, storedAPIVersion(resourceData, request.APIVersion))
 	if err != nil {
 		if errors.Is(err, processor.ErrNoSchemaFound) {
 			logger := ucplog.FromContextOrDiscard(ctx)
@@ -138,6 +169,12 @@ func (c *DynamicResourceController) validateRequestSchema(ctx context.Context, r
 		return fmt.Errorf("failed to get schema: %w", err)
 	}
 
+	// Sensitive properties are not stored in the database, but they are part of the resource that is validated.
+	err = c.mergeSensitiveProperties(ctx, request.ResourceID, resourceData)
+	if err != nil {
+		return err
+	}
+
 	err = schema.ValidateResourceAgainstSchema(ctx, resourceData, schemaData)
 	if err != nil {
 		return &v1.ErrClientRP{
@@ -211,6 +248,40 @@ func (c *DynamicResourceController) getResourceDataFromStorage(ctx context.Conte
 	return resourceMap, nil
 }
 
+// mergeSensitiveProperties merges the sensitive properties of the resource from the secret store into the resource data.
+func (c *DynamicResourceController) mergeSensitiveProperties(ctx context.Context, resourceID string, resourceData map[string]any) error {
+	secretClient, err := c.secretProvider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	values, err := sensitive.Load(ctx, secretClient, resourceID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch sensitive properties: %w", err)
+	} else if len(values) == 0 {
+		return nil
+	}
+
+	properties, ok := resourceData["properties"].(map[string]any)
+	if !ok {
+		properties = map[string]any{}
+		resourceData["properties"] = properties
+	}
+
+	sensitive.Merge(properties, values)
+	return nil
+}
+
+// storedAPIVersion returns the API version that the resource data is stored in. The resource is converted to the
+// storage API version of its resource type by the frontend, so this may differ from the API version of the request.
+func storedAPIVersion(resourceData map[string]any, requestAPIVersion string) string {
+	if apiVersion, ok := resourceData["updatedApiVersion"].(string); ok && apiVersion != "" {
+		return apiVersion
+	}
+
+	return requestAPIVersion
+}
+
 // hasCapability determines if a resource type has a specific capability.
 // It returns true when the given input capability string exists in the resource type's
 // capabilities list, false otherwise.
@@ -28,6 +28,7 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
@@ -41,13 +42,17 @@ const (
 	recipeResourceType = "Applications.Test/testRecipeResources"
 )
 
+func testSecretProvider() *secretprovider.SecretProvider {
+	return secretprovider.NewSecretProvider(secretprovider.SecretProviderOptions{Provider: secretprovider.TypeInMemorySecret})
+}
+
 func Test_DynamicResourceController_selectController(t *testing.T) {
 	setup := func() *DynamicResourceController {
 		ucp, err := testUCPClientFactory()
 		require.NoError(t, err)
 
 		// The recipe engine and configuration loader are not used in this test.
-		controller, err := NewDynamicResourceController(ctrl.Options{}, ucp, nil, nil)
+		controller, err := NewDynamicResourceController(ctrl.Options{}, ucp, nil, nil, testSecretProvider())
 		require.NoError(t, err)
 		return controller.(*DynamicResourceController)
 	}
@@ -216,7 +221,7 @@ func Test_DynamicResourceController_fetchResourceTypeDetails(t *testing.T) {
 	setup := func() *DynamicResourceController {
 		ucp, err := testUCPClientFactory()
 		require.NoError(t, err)
-		controller, err := NewDynamicResourceController(ctrl.Options{}, ucp, nil, nil)
+		controller, err := NewDynamicResourceController(ctrl.Options{}, ucp, nil, nil, testSecretProvider())
 		require.NoError(t, err)
 		return controller.(*DynamicResourceController)
 	}
@@ -272,7 +277,7 @@ func TestDynamicResourceController_validateRequestSchema(t *testing.T) {
 		ucp, err := testUCPClientFactory()
 		require.NoError(t, err)
 
-		controller, err := NewDynamicResourceController(ctrl.Options{}, ucp, nil, nil)
+		controller, err := NewDynamicResourceController(ctrl.Options{}, ucp, nil, nil, testSecretProvider())
 		require.NoError(t, err)
 		return controller.(*DynamicResourceController)
 	}
@@ -300,3 +305,9 @@ func TestDynamicResourceController_validateRequestSchema(t *testing.T) {
 		require.Contains(t, err.Error(), "invalid resource ID")
 	})
 }
+
+func Test_storedAPIVersion(t *testing.T) {
+	require.Equal(t, "2025-01-01", storedAPIVersion(map[string]any{"updatedApiVersion": "2025-01-01"}, "2024-01-01"))
+	require.Equal(t, "2024-01-01", storedAPIVersion(map[string]any{}, "2024-01-01"))
+	require.Equal(t, "2024-01-01", storedAPIVersion(nil, "2024-01-01"))
+}
@@ -18,12 +18,17 @@ package controller
 
 import (
 	"context"
+	"fmt"
 
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/components/secret"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend/processor"
 	recipecontroller "github.com/radius-project/radius/pkg/portableresources/backend/controller"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 )
 
 // RecipePutController is the async operation controller to perform PUT processing on "recipe" dynamic resources.
@@ -32,22 +37,52 @@ type RecipePutController struct {
 	opts                ctrl.Options
 	engine              engine.Engine
 	configurationLoader configloader.ConfigurationLoader
+	secretClient        secret.Client
 }
 
 // NewRecipePutController creates a new RecipePutController.
-func NewRecipePutController(opts ctrl.Options, engine engine.Engine, configurationLoader configloader.ConfigurationLoader) (ctrl.Controller, error) {
+func NewRecipePutController(opts ctrl.Options, engine engine.Engine, configurationLoader configloader.ConfigurationLoader, secretClient secret.Client) (ctrl.Controller, error) {
 	return &RecipePutController{
 		BaseController:      ctrl.NewBaseAsyncController(opts),
 		opts:                opts,
 		engine:              engine,
 		configurationLoader: configurationLoader,
+		secretClient:        secretClient,
 	}, nil
 }
 
 // Run processes PUT operations for dynamic resources deployed using recipes.
 // It creates and delegates the request to CreateOrUpdateResource controller to handle the operation.
+//
+// The sensitive properties of the resource are made available to the recipe, but are not stored in the database. The
+// secret outputs of the recipe are stored in the secret store instead of the database.
 func (c *RecipePutController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
-	putController, err := recipecontroller.NewCreateOrUpdateResource(c.opts, &processor.DynamicProcessor{}, c.engine, c.configurationLoader)
+	id, err := resources.ParseResource(request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// The sensitive properties are declared by the schema of the API version that the resource is stored in.
+	obj, err := c.DatabaseClient().Get(ctx, request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	resourceData, _ := obj.Data.(map[string]any)
+	paths, err := schema.GetSensitiveFieldPaths(ctx, c.UcpClient(), request.ResourceID, id.Type(), storedAPIVersion(resourceData, request.APIVersion))
+	if err != nil && !clientv2.Is404Error(err) {
+		return ctrl.Result{}, fmt.Errorf("failed to fetch the sensitive properties of resource type %q: %w", id.Type(), err)
+	}
+
+	opts := c.opts
+	opts.DatabaseClient = &sensitiveDatabaseClient{
+		Client:       c.opts.DatabaseClient,
+		secretClient: c.secretClient,
+		resourceID:   request.ResourceID,
+		paths:        paths,
+	}
+
+	putController, err := recipecontroller.NewCreateOrUpdateResource(opts, &processor.DynamicProcessor{}, c.engine, c.configurationLoader)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
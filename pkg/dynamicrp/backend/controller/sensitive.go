@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/secret"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
+)
+
+var _ database.Client = (*sensitiveDatabaseClient)(nil)
+
+// sensitiveDatabaseClient is a database client that restores the sensitive properties of a resource when it is read,
+// and removes them again when it is saved. The secret outputs of the recipe are moved from properties.status.secrets
+// to the secret store when the resource is saved.
+//
+// This allows the recipe engine to use the sensitive properties of the resource without storing them in the database.
+type sensitiveDatabaseClient struct {
+	database.Client
+
+	secretClient secret.Client
+	resourceID   string
+	paths        []string
+}
+
+// Get implements database.Client.
+func (c *sensitiveDatabaseClient) Get(ctx context.Context, id string, options ...database.GetOptions) (*database.Object, error) {
+	obj, err := c.Client.Get(ctx, id, options...)
+	if err != nil || !strings.EqualFold(id, c.resourceID) {
+		return obj, err
+	}
+
+	resource := &datamodel.DynamicResource{}
+	err = obj.As(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := sensitive.Load(ctx, c.secretClient, c.resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch sensitive properties: %w", err)
+	}
+
+	if resource.Properties == nil {
+		resource.Properties = map[string]any{}
+	}
+	sensitive.Merge(resource.Properties, values)
+
+	copy := *obj
+	copy.Data = resource
+	return &copy, nil
+}
+
+// Save implements database.Client.
+func (c *sensitiveDatabaseClient) Save(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+	if !strings.EqualFold(obj.ID, c.resourceID) {
+		return c.Client.Save(ctx, obj, options...)
+	}
+
+	// The caller may continue to use its copy of the resource, so the sensitive properties are removed from a copy.
+	bs, err := json.Marshal(obj.Data)
+	if err != nil {
+		return err
+	}
+
+	resource := &datamodel.DynamicResource{}
+	err = json.Unmarshal(bs, resource)
+	if err != nil {
+		return err
+	}
+
+	_ = sensitive.Extract(resource.Properties, c.paths)
+
+	err = c.saveRecipeSecrets(ctx, resource)
+	if err != nil {
+		return err
+	}
+
+	copy := *obj
+	copy.Data = resource
+	err = c.Client.Save(ctx, &copy, options...)
+	if err != nil {
+		return err
+	}
+
+	// Propagate the new ETag to the caller.
+	obj.ETag = copy.ETag
+	return nil
+}
+
+// saveRecipeSecrets moves the secret outputs of the recipe from the status of the resource to the secret store. The
+// secret outputs are left unchanged when the resource is saved without the outputs of a recipe.
+func (c *sensitiveDatabaseClient) saveRecipeSecrets(ctx context.Context, resource *datamodel.DynamicResource) error {
+	status, ok := resource.Properties["status"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	if _, ok := status["secrets"]; !ok {
+		return nil
+	}
+
+	values := map[string]string{}
+	for key, secret := range resource.GetSecrets() {
+		values[key] = secret.Value
+	}
+
+	err := sensitive.SaveRecipeSecrets(ctx, c.secretClient, c.resourceID, values)
+	if err != nil {
+		return fmt.Errorf("failed to save recipe secrets: %w", err)
+	}
+
+	delete(status, "secrets")
+	return nil
+}
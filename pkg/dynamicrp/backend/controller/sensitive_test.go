@@ -0,0 +1,139 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	secretinmemory "github.com/radius-project/radius/pkg/components/secret/inmemory"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SensitiveDatabaseClient(t *testing.T) {
+	ctx := testcontext.New(t)
+	resourceID := "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource"
+
+	databaseClient := inmemory.NewClient()
+	secretClient := &secretinmemory.Client{}
+
+	err := databaseClient.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: resourceID},
+		Data: map[string]any{
+			"id":         resourceID,
+			"properties": map[string]any{"host": "example.com"},
+		},
+	})
+	require.NoError(t, err)
+
+	err = sensitive.Save(ctx, secretClient, resourceID, map[string]any{"password": "s3cr3t"})
+	require.NoError(t, err)
+
+	client := &sensitiveDatabaseClient{
+		Client:       databaseClient,
+		secretClient: secretClient,
+		resourceID:   resourceID,
+		paths:        []string{"password"},
+	}
+
+	// Reading the resource restores the sensitive properties.
+	obj, err := client.Get(ctx, resourceID)
+	require.NoError(t, err)
+
+	resource := &datamodel.DynamicResource{}
+	require.NoError(t, obj.As(resource))
+	require.Equal(t, map[string]any{"host": "example.com", "password": "s3cr3t"}, resource.Properties)
+
+	// Saving the resource removes the sensitive properties, but does not modify the caller's copy.
+	resource.Properties["port"] = float64(5432)
+	update := &database.Object{Metadata: database.Metadata{ID: resourceID}, Data: resource}
+	err = client.Save(ctx, update, database.WithETag(obj.ETag))
+	require.NoError(t, err)
+	require.NotEmpty(t, update.ETag)
+	require.Equal(t, "s3cr3t", resource.Properties["password"])
+
+	obj, err = databaseClient.Get(ctx, resourceID)
+	require.NoError(t, err)
+
+	stored := &datamodel.DynamicResource{}
+	require.NoError(t, obj.As(stored))
+	require.Equal(t, map[string]any{"host": "example.com", "port": float64(5432)}, stored.Properties)
+}
+
+func Test_SensitiveDatabaseClient_RecipeSecrets(t *testing.T) {
+	ctx := testcontext.New(t)
+	resourceID := "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource"
+
+	databaseClient := inmemory.NewClient()
+	secretClient := &secretinmemory.Client{}
+
+	client := &sensitiveDatabaseClient{
+		Client:       databaseClient,
+		secretClient: secretClient,
+		resourceID:   resourceID,
+	}
+
+	resource := &datamodel.DynamicResource{
+		Properties: map[string]any{
+			"host": "example.com",
+		},
+	}
+	resource.ID = resourceID
+	err := resource.ApplyDeploymentOutput(rpv1.DeploymentOutput{
+		ComputedValues: map[string]any{"port": float64(5432)},
+		SecretValues:   map[string]rpv1.SecretValueReference{"password": {Value: "s3cr3t"}},
+	})
+	require.NoError(t, err)
+
+	// Saving the resource moves the recipe secrets to the secret store.
+	err = client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: resourceID}, Data: resource})
+	require.NoError(t, err)
+
+	obj, err := databaseClient.Get(ctx, resourceID)
+	require.NoError(t, err)
+
+	stored := &datamodel.DynamicResource{}
+	require.NoError(t, obj.As(stored))
+	require.Equal(t, map[string]any{"computedValues": map[string]any{"port": float64(5432)}}, stored.Properties["status"])
+
+	secrets, err := sensitive.LoadRecipeSecrets(ctx, secretClient, resourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"password": "s3cr3t"}, secrets)
+
+	// Saving the resource without the outputs of a recipe leaves the recipe secrets unchanged.
+	err = client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: resourceID}, Data: stored})
+	require.NoError(t, err)
+
+	secrets, err = sensitive.LoadRecipeSecrets(ctx, secretClient, resourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"password": "s3cr3t"}, secrets)
+
+	// A recipe without secret outputs removes the recipe secrets.
+	err = stored.ApplyDeploymentOutput(rpv1.DeploymentOutput{})
+	require.NoError(t, err)
+	err = client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: resourceID}, Data: stored})
+	require.NoError(t, err)
+
+	secrets, err = sensitive.LoadRecipeSecrets(ctx, secretClient, resourceID)
+	require.NoError(t, err)
+	require.Empty(t, secrets)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/resourceutil"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/schema"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"golang.org/x/exp/slices"
@@ -73,7 +74,7 @@ func (d *DynamicProcessor) Process(ctx context.Context, resource *datamodel.Dyna
 		return err
 	}
 
-	err = addOutputValuestoResourceProperties(ctx, options.UcpClient, resource, computedValues, secretValues)
+	err = addOutputValuestoResourceProperties(ctx, options.UcpClient, resource, computedValues)
 	if err != nil {
 		return err
 	}
@@ -81,9 +82,13 @@ func (d *DynamicProcessor) Process(ctx context.Context, resource *datamodel.Dyna
 	return nil
 }
 
-// addOutputValuestoResourceProperties adds the computed values and secret values to the resource properties.
-// It retrieves the schema of the resource type and filters out the values that are not part of the schema.
-func addOutputValuestoResourceProperties(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resource *datamodel.DynamicResource, computedValues map[string]any, secretValues map[string]rpv1.SecretValueReference) error {
+// addOutputValuestoResourceProperties adds the computed values to the resource properties.
+// It retrieves the schema of the resource type and only adds the values of the properties that are marked as read-only
+// in the schema. Properties that can be set by the client are never overwritten by the outputs of the recipe.
+//
+// Secret values are not added to the resource properties so they are not returned by GET and LIST. They are stored in
+// the secret store and available through the listSecrets action.
+func addOutputValuestoResourceProperties(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resource *datamodel.DynamicResource, computedValues map[string]any) error {
 
 	ID, err := resources.Parse(resource.ID)
 	if err != nil {
@@ -99,34 +104,22 @@ func addOutputValuestoResourceProperties(ctx context.Context, ucpClient *v202310
 		return err
 	}
 
-	// Filter out the basic properties from the resource properties
-	// This is to avoid overwriting the properties like application, environment etc when they are added as computed values or secret values.
+	// Filter out the basic properties from the read-only properties
+	// This is to avoid overwriting the properties like application, environment etc when they are added as computed values.
 	resourceProps := []string{}
-	schema := apiVersionResource.APIVersionResource.Properties.Schema
-	if schema != nil {
-		if properties, ok := schema["properties"].(map[string]any); ok {
-			for key := range properties {
-				if !slices.Contains(resourceutil.BasicProperties, key) {
-					resourceProps = append(resourceProps, key)
-				}
-			}
+	for _, key := range schema.GetReadOnlyProperties(apiVersionResource.APIVersionResource.Properties.Schema) {
+		if !slices.Contains(resourceutil.BasicProperties, key) {
+			resourceProps = append(resourceProps, key)
 		}
 	}
 
-	// Add the computed values to the resource properties if they are part of the schema.
+	// Add the computed values to the resource properties if they are read-only properties of the schema.
 	for key, value := range computedValues {
 		if slices.Contains(resourceProps, key) {
 			resource.Properties[key] = value
 		}
 	}
 
-	// Add the secret values to the resource properties if they are part of the schema.
-	for key, value := range secretValues {
-		if slices.Contains(resourceProps, key) {
-			resource.Properties[key] = value.Value
-		}
-	}
-
 	return nil
 }
 
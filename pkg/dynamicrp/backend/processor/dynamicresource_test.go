@@ -93,11 +93,14 @@ func Test_Process(t *testing.T) {
 		require.Equal(t, options.RecipeOutput.Values["host"], properties["host"])
 		require.Equal(t, options.RecipeOutput.Values["port"], properties["port"])
 		require.Equal(t, options.RecipeOutput.Values["database"], properties["database"])
-		require.Equal(t, options.RecipeOutput.Values["username"], properties["username"])
 
-		// password property is not defined in the schema but present as part of the recipe output.
-		// so, it is not added to the resource properties but instead available in  properties.status.computedValues and properties.status.secrets maps.
-		_, ok := properties["password"]
+		// username is defined in the schema but it is not read-only, so it is not populated from the recipe output.
+		_, ok := properties["username"]
+		require.False(t, ok)
+
+		// password property is defined in the schema but it is a secret output of the recipe.
+		// so, it is not added to the resource properties but instead available in properties.status.secrets map.
+		_, ok = properties["password"]
 		require.False(t, ok)
 
 		status, ok := properties["status"].(map[string]any)
@@ -206,12 +209,13 @@ func testUCPClientFactory() (*v20231001preview.ClientFactory, error) {
 					Properties: &v20231001preview.APIVersionProperties{
 						Schema: map[string]any{
 							"properties": map[string]any{
-								"environment": map[string]any{},
+								"environment": map[string]any{"readOnly": true},
 								"application": map[string]any{},
-								"host":        map[string]any{},
-								"database":    map[string]any{},
-								"port":        map[string]any{},
+								"host":        map[string]any{"readOnly": true},
+								"database":    map[string]any{"readOnly": true},
+								"port":        map[string]any{"readOnly": true},
 								"username":    map[string]any{},
+								"password":    map[string]any{"readOnly": true},
 							},
 						},
 					},
@@ -101,6 +101,6 @@ func (w *Service) registerControllers() error {
 	}
 
 	return w.Service.Controllers().RegisterDefault(func(opts ctrl.Options) (ctrl.Controller, error) {
-		return controller.NewDynamicResourceController(opts, ucp, w.recipes, w.options.Recipes.ConfigurationLoader)
+		return controller.NewDynamicResourceController(opts, ucp, w.recipes, w.options.Recipes.ConfigurationLoader, w.options.SecretProvider)
 	}, options)
 }
@@ -149,7 +149,8 @@ func (d *DynamicResource) ApplyDeploymentOutput(deploymentOutput rpv1.Deployment
 		status["outputResources"] = outputResources
 	}
 
-	// Store computed values and secrets as separate maps under status.
+	// Store computed values and secrets as separate maps under status. The secrets are moved to the secret store before
+	// the resource is saved, so they are always set to replace the secrets of the previous deployment.
 	computedValues := map[string]any{}
 	for key, value := range deploymentOutput.ComputedValues {
 		computedValues[key] = value
@@ -162,9 +163,7 @@ func (d *DynamicResource) ApplyDeploymentOutput(deploymentOutput rpv1.Deployment
 	for key, value := range deploymentOutput.SecretValues {
 		secrets[key] = value
 	}
-	if len(secrets) > 0 {
-		status["secrets"] = secrets
-	}
+	status["secrets"] = secrets
 
 	return nil
 }
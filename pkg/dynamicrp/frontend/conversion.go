@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/dynamicrp/versioning"
+)
+
+// makeConversionFilter returns an update filter that converts the resource to the storage API version of the resource
+// type, so that resources are stored in the same API version regardless of the API version of the request.
+//
+// The API version that the resource is stored in is recorded as the updated API version of the resource.
+func makeConversionFilter(versions *versioning.Converter) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		storageAPIVersion, err := versions.StorageAPIVersion(ctx, serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		properties, err := versions.Convert(ctx, serviceCtx.ResourceID.String(), newResource.Properties, serviceCtx.APIVersion, storageAPIVersion)
+		if err != nil {
+			return rest.NewBadRequestResponse(fmt.Sprintf("Failed to convert the resource to the storage API version: %v", err)), nil
+		}
+
+		newResource.Properties = properties
+		newResource.UpdatedAPIVersion = storageAPIVersion
+		return nil, nil
+	}
+}
+
+// makeResponseConverter returns a response converter that converts the resource from the API version it is stored in
+// to the API version of the request.
+func makeResponseConverter(ctx context.Context, versions *versioning.Converter) v1.ConvertToAPIModel[datamodel.DynamicResource] {
+	return func(resource *datamodel.DynamicResource, version string) (v1.VersionedModelInterface, error) {
+		properties, err := versions.Convert(ctx, resource.ID, resource.Properties, resource.UpdatedAPIVersion, version)
+		if err != nil {
+			return nil, err
+		}
+
+		copy := *resource
+		copy.Properties = properties
+		return converter.DynamicResourceDataModelToVersioned(&copy, version)
+	}
+}
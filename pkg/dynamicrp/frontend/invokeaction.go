@@ -0,0 +1,317 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
+	"github.com/radius-project/radius/pkg/dynamicrp/versioning"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ ctrl.Controller = (*InvokeAction)(nil)
+
+const (
+	// ListSecretsActionName is the name of the action that is available for every user-defined resource type and
+	// returns the sensitive properties and recipe secret outputs of the resource.
+	ListSecretsActionName = "listSecrets"
+)
+
+// InvokeAction is the controller implementation for the custom actions of user-defined resource types.
+//
+// Custom actions are declared by the resource type and invoked with a POST request to the action name following the
+// resource ID. For example: POST /planes/radius/local/resourceGroups/test-group/providers/Applications.Test/exampleResources/my-example/rotateKey
+//
+// The action is implemented by the handler declared by the resource type:
+//
+// - A recipe handler returns the outputs of the recipe that deployed the resource.
+// - A hook handler forwards the request to the hook endpoint whose address is an output of the recipe that deployed
+// the resource, and returns its response.
+// - A provider handler forwards the request to an external resource provider and returns its response.
+//
+// The listSecrets action is available for every resource type unless the resource type declares its own action with
+// that name.
+type InvokeAction struct {
+	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+
+	ucp            *v20231001preview.ClientFactory
+	httpClient     *http.Client
+	secretProvider *secretprovider.SecretProvider
+}
+
+// NewInvokeAction creates a new instance of InvokeAction.
+func NewInvokeAction(opts ctrl.Options, ucp *v20231001preview.ClientFactory, httpClient *http.Client, secretProvider *secretprovider.SecretProvider) (ctrl.Controller, error) {
+	return &InvokeAction{
+		Operation:      ctrl.NewOperation(opts, dynamicResourceOptions),
+		ucp:            ucp,
+		httpClient:     httpClient,
+		secretProvider: secretProvider,
+	}, nil
+}
+
+// Run invokes the custom action on the resource.
+func (c *InvokeAction) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The resource ID of a POST request does not include the action name, which is the last segment of the path.
+	resourceID := serviceCtx.ResourceID
+	actionName := chi.URLParam(req, "actionName")
+
+	resource, _, err := c.GetResource(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resource == nil {
+		return rest.NewNotFoundResponse(resourceID), nil
+	}
+
+	resourceType, err := c.getResourceType(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, action := findAction(resourceType, actionName)
+	if action == nil && strings.EqualFold(actionName, ListSecretsActionName) {
+		return c.listSecrets(ctx, resource)
+	} else if action == nil {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("The resource type %q does not support the action %q.", resourceID.Type(), actionName)), nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	if action.RequestSchema != nil {
+		var input any = map[string]any{}
+		if len(bytes.TrimSpace(body)) > 0 {
+			err = json.Unmarshal(body, &input)
+			if err != nil {
+				return rest.NewBadRequestResponse(fmt.Sprintf("The request body of action %q is not valid JSON: %v", name, err)), nil
+			}
+		}
+
+		err = schema.ValidateResourceAgainstSchema(ctx, map[string]any{"properties": input}, action.RequestSchema)
+		if err != nil {
+			return rest.NewBadRequestResponse(fmt.Sprintf("The request body of action %q is invalid: %v", name, err)), nil
+		}
+	}
+
+	switch *action.Handler.Kind {
+	case v20231001preview.ResourceTypeActionHandlerKindRecipe:
+		return c.invokeRecipeAction(ctx, resource, name, to.String(action.Handler.Output))
+	case v20231001preview.ResourceTypeActionHandlerKindHook:
+		return c.invokeHookAction(ctx, resource, name, to.String(action.Handler.Output), body)
+	case v20231001preview.ResourceTypeActionHandlerKindProvider:
+		return c.invokeProviderAction(ctx, to.String(action.Handler.Location), resourceID, name, serviceCtx.APIVersion, body)
+	default:
+		return nil, fmt.Errorf("action %q has an unsupported handler kind %q", name, *action.Handler.Kind)
+	}
+}
+
+// getResourceType fetches the resource type of the resource from UCP.
+func (c *InvokeAction) getResourceType(ctx context.Context, id resources.ID) (*v20231001preview.ResourceTypeResource, error) {
+	planeName := id.ScopeSegments()[0].Name
+	resourceTypeName := strings.TrimPrefix(id.Type(), id.ProviderNamespace()+resources.SegmentSeparator)
+	response, err := c.ucp.NewResourceTypesClient().Get(ctx, planeName, id.ProviderNamespace(), resourceTypeName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch resource type %q: %w", id.Type(), err)
+	}
+
+	return &response.ResourceTypeResource, nil
+}
+
+// findAction finds the action with the given name. Action names are case-insensitive like the rest of the resource ID.
+func findAction(resourceType *v20231001preview.ResourceTypeResource, actionName string) (string, *v20231001preview.ResourceTypeAction) {
+	if resourceType.Properties == nil {
+		return "", nil
+	}
+
+	for name, action := range resourceType.Properties.Actions {
+		if strings.EqualFold(name, actionName) && action != nil && action.Handler != nil && action.Handler.Kind != nil {
+			return name, action
+		}
+	}
+
+	return "", nil
+}
+
+// invokeRecipeAction returns the outputs of the recipe that deployed the resource as an object keyed by output name.
+// Secret outputs are only returned when output names them explicitly. If output is set, only that output is returned.
+func (c *InvokeAction) invokeRecipeAction(ctx context.Context, resource *datamodel.DynamicResource, actionName string, output string) (rest.Response, error) {
+	values := resource.GetComputedValues()
+	if output == "" {
+		return rest.NewOKResponse(values), nil
+	}
+
+	if value, ok := values[output]; ok {
+		return rest.NewOKResponse(map[string]any{output: value}), nil
+	}
+
+	secrets, err := c.loadRecipeSecrets(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	if value, ok := secrets[output]; ok {
+		return rest.NewOKResponse(map[string]any{output: value}), nil
+	}
+
+	return rest.NewConflictResponse(fmt.Sprintf("The action %q cannot be invoked because the recipe output %q is not available. Make sure the resource was deployed successfully by a recipe that returns this output.", actionName, output)), nil
+}
+
+// listSecrets returns the sensitive properties of the resource merged with the secret outputs of the recipe that
+// deployed the resource.
+func (c *InvokeAction) listSecrets(ctx context.Context, resource *datamodel.DynamicResource) (rest.Response, error) {
+	client, err := c.secretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := sensitive.Load(ctx, client, resource.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch sensitive properties: %w", err)
+	}
+
+	// The sensitive properties are stored in the storage API version of the resource type.
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	converter := versioning.NewConverter(c.ucp, c.httpClient, serviceCtx.ResourceID)
+	values, err = converter.Convert(ctx, resource.ID, values, resource.UpdatedAPIVersion, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := c.loadRecipeSecrets(ctx, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range secrets {
+		values[key] = value
+	}
+
+	return rest.NewOKResponse(values), nil
+}
+
+// loadRecipeSecrets returns the secret outputs of the recipe that deployed the resource from the secret store.
+func (c *InvokeAction) loadRecipeSecrets(ctx context.Context, resource *datamodel.DynamicResource) (map[string]string, error) {
+	client, err := c.secretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := sensitive.LoadRecipeSecrets(ctx, client, resource.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch recipe secrets: %w", err)
+	}
+
+	return secrets, nil
+}
+
+// invokeHookAction forwards the action to the hook endpoint whose address is the given output of the recipe that
+// deployed the resource, and returns its response as-is.
+func (c *InvokeAction) invokeHookAction(ctx context.Context, resource *datamodel.DynamicResource, actionName string, output string, body []byte) (rest.Response, error) {
+	location, _ := resource.GetComputedValues()[output].(string)
+	if location == "" {
+		return rest.NewConflictResponse(fmt.Sprintf("The action %q cannot be invoked because the recipe output %q is not available. Make sure the resource was deployed successfully by a recipe that returns the address of the hook in this output.", actionName, output)), nil
+	}
+
+	u, err := url.Parse(location)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return rest.NewConflictResponse(fmt.Sprintf("The action %q cannot be invoked because the recipe output %q is not an absolute http or https URL.", actionName, output)), nil
+	}
+
+	return c.forwardAction(ctx, u.String(), actionName, body)
+}
+
+// invokeProviderAction forwards the action to the external resource provider at the given location, and returns its
+// response as-is.
+func (c *InvokeAction) invokeProviderAction(ctx context.Context, location string, id resources.ID, actionName string, apiVersion string, body []byte) (rest.Response, error) {
+	u, err := url.Parse(strings.TrimSuffix(location, "/") + id.String() + resources.SegmentSeparator + actionName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the location of action %q: %w", actionName, err)
+	}
+	u.RawQuery = url.Values{"api-version": []string{apiVersion}}.Encode()
+
+	return c.forwardAction(ctx, u.String(), actionName, body)
+}
+
+// forwardAction sends the request body of the action to the given URL, and returns the response as-is.
+func (c *InvokeAction) forwardAction(ctx context.Context, actionURL string, actionName string, body []byte) (rest.Response, error) {
+	providerReq, err := http.NewRequestWithContext(ctx, http.MethodPost, actionURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	providerReq.Header.Set("Content-Type", "application/json")
+
+	providerResp, err := c.httpClient.Do(providerReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke action %q: %w", actionName, err)
+	}
+	defer providerResp.Body.Close()
+
+	responseBody, err := io.ReadAll(providerResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response of action %q: %w", actionName, err)
+	}
+
+	return &providerResponse{
+		StatusCode:  providerResp.StatusCode,
+		ContentType: providerResp.Header.Get("Content-Type"),
+		Body:        responseBody,
+	}, nil
+}
+
+// providerResponse is the response of an external resource provider to a custom action.
+type providerResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// Apply writes the response of the resource provider.
+func (r *providerResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	if r.ContentType != "" {
+		w.Header().Set("Content-Type", r.ContentType)
+	}
+	w.WriteHeader(r.StatusCode)
+
+	_, err := w.Write(r.Body)
+	if err != nil {
+		return fmt.Errorf("error writing response body: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ ctrl.Controller = (*MoveResources)(nil)
+
+// MoveResources is the controller implementation of the moveResources action of user-defined resource types.
+//
+// UCP saves the resources in the target resource group and then calls this action to commit the move. The
+// sensitive properties and the secret outputs of the recipes of the resources are stored in the secret store by
+// resource ID, so they are moved to the new IDs of the resources.
+type MoveResources struct {
+	ctrl.BaseController
+
+	secretProvider *secretprovider.SecretProvider
+}
+
+// NewMoveResources creates a new instance of MoveResources.
+func NewMoveResources(opts ctrl.Options, secretProvider *secretprovider.SecretProvider) (ctrl.Controller, error) {
+	return &MoveResources{BaseController: ctrl.NewBaseController(opts), secretProvider: secretProvider}, nil
+}
+
+// Run moves the secrets of the resources of the request to their new IDs.
+func (c *MoveResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	info, response, err := defaultoperation.ReadMoveRequest(req)
+	if response != nil || err != nil {
+		return response, err
+	}
+
+	target, err := resources.ParseScope(info.TargetResourceGroup)
+	if err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The target resource group %q is not a valid resource group id.", info.TargetResourceGroup)), nil
+	}
+
+	client, err := c.secretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range info.Resources {
+		id := resources.MustParse(resource)
+		targetID := resources.MakeUCPID(target.ScopeSegments(), id.TypeSegments(), nil)
+
+		err := sensitive.Move(ctx, client, id.String(), targetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to move the secrets of resource %q: %w", id.String(), err)
+		}
+	}
+
+	return rest.NewNoContentResponse(), nil
+}
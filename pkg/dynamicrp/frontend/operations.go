@@ -17,9 +17,11 @@ limitations under the License.
 package frontend
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
+	"github.com/go-chi/chi/v5"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
@@ -45,7 +47,7 @@ import (
 // Resource Type: Applications.Example/customService
 //
 // This code ensures that the controller will be provided with the correct resource type.
-func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.Options, factory func(opts controller.Options) (controller.Controller, error)) http.HandlerFunc {
+func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.Options, factory func(ctx context.Context, opts controller.Options) (controller.Controller, error)) http.HandlerFunc {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		id, err := resources.Parse(r.URL.Path)
 		if err != nil {
@@ -58,7 +60,20 @@ func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.O
 			return
 		}
 
-		operationType := v1.OperationType{Type: strings.ToUpper(id.Type()), Method: method}
+		operationType := v1.OperationType{Method: method}
+
+		// Custom actions are addressed by a path segment that follows the resource name. They operate on the resource.
+		//
+		// Each action is its own operation, e.g. APPLICATIONS.TEST/EXAMPLERESOURCES|LISTSECRETS, so that it can be
+		// authorized separately from the other actions of the resource type.
+		if method == v1.OperationPost {
+			id = id.Truncate()
+			if actionName := chi.URLParam(r, "actionName"); actionName != "" {
+				operationType.Method = v1.OperationMethod(strings.ToUpper(actionName))
+			}
+		}
+
+		operationType.Type = strings.ToUpper(id.Type())
 
 		// Copy the options and initalize them dynamically for this type.
 		opts := baseOptions
@@ -71,7 +86,7 @@ func dynamicOperationHandler(method v1.OperationMethod, baseOptions controller.O
 			opts.ResourceType = id.ProviderNamespace() + "/operationstatuses"
 		}
 
-		ctrl, err := factory(opts)
+		ctrl, err := factory(r.Context(), opts)
 		if err != nil {
 			result := rest.NewBadRequestResponse(err.Error())
 			err = result.Apply(r.Context(), w, r)
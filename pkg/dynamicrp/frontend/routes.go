@@ -17,6 +17,9 @@ limitations under the License.
 package frontend
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -24,12 +27,16 @@ import (
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/dynamicrp/versioning"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/validator"
 )
 
-func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Options) error {
+func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Options, ucp *v20231001preview.ClientFactory) error {
 	// Return ARM errors for invalid requests.
 	r.NotFound(validator.APINotFoundHandler())
 	r.MethodNotAllowed(validator.APIMethodNotAllowedHandler())
@@ -53,21 +60,41 @@ func (s *Service) registerRoutes(r *chi.Mux, controllerOptions controller.Option
 		r.Route("/providers/{providerNamespace}", func(r chi.Router) {
 
 			// Plane-scoped LIST operation
-			r.Get("/{resourceType}", dynamicOperationHandler(v1.OperationPlaneScopeList, controllerOptions, makeListResourceAtPlaneScopeController))
+			r.Get("/{resourceType}", dynamicOperationHandler(v1.OperationPlaneScopeList, controllerOptions, func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+				return makeListResourceAtPlaneScopeController(ctx, opts, ucp)
+			}))
 
 			// Async operation status/results
 			r.Route("/locations/{locationName}", func(r chi.Router) {
 				r.Get("/{or:operation[Rr]esults}/{operationID}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetOperationResultController))
+				r.Get("/{os:operation[Ss]tatuses}", dynamicOperationHandler(v1.OperationList, controllerOptions, makeListOperationStatusesController))
 				r.Get("/{os:operation[Ss]tatuses}/{operationID}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetOperationStatusController))
 			})
 		})
 
 		// Resource-group-scoped
 		r.Route("/{rg:resource[gG]roups}/{resourceGroupName}/providers/{providerNamespace}/{resourceType}", func(r chi.Router) {
-			r.Get("/", dynamicOperationHandler(v1.OperationList, controllerOptions, makeListResourceAtResourceGroupScopeController))
-			r.Get("/{resourceName}", dynamicOperationHandler(v1.OperationGet, controllerOptions, makeGetResourceController))
-			r.Put("/{resourceName}", dynamicOperationHandler(v1.OperationPut, controllerOptions, makePutResourceController))
+			// UCP validates and then commits moves of resources between resource groups with the resource provider. The
+			// name of the action takes the place of the resource type, e.g. POST .../providers/Applications.Test/moveResources.
+			r.Post("/", dynamicOperationHandler(v1.OperationPost, controllerOptions, func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+				return makeMoveResourcesController(ctx, opts, s.options.SecretProvider)
+			}))
+
+			r.Get("/", dynamicOperationHandler(v1.OperationList, controllerOptions, func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+				return makeListResourceAtResourceGroupScopeController(ctx, opts, ucp)
+			}))
+			r.Get("/{resourceName}", dynamicOperationHandler(v1.OperationGet, controllerOptions, func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+				return makeGetResourceController(ctx, opts, ucp)
+			}))
+			r.Put("/{resourceName}", dynamicOperationHandler(v1.OperationPut, controllerOptions, func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+				return makePutResourceController(ctx, opts, ucp, s.options.SecretProvider)
+			}))
 			r.Delete("/{resourceName}", dynamicOperationHandler(v1.OperationDelete, controllerOptions, makeDeleteResourceController))
+
+			// Custom actions declared by the resource type, and the built-in listSecrets action.
+			r.Post("/{resourceName}/{actionName}", dynamicOperationHandler(v1.OperationPost, controllerOptions, func(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+				return NewInvokeAction(opts, ucp, http.DefaultClient, s.options.SecretProvider)
+			}))
 		})
 	})
 
@@ -81,33 +108,74 @@ var dynamicResourceOptions = controller.ResourceOptions[datamodel.DynamicResourc
 	AsyncOperationTimeout:    time.Hour * 24,
 }
 
-func makeListResourceAtPlaneScopeController(opts controller.Options) (controller.Controller, error) {
+func makeListResourceAtPlaneScopeController(ctx context.Context, opts controller.Options, ucp *v20231001preview.ClientFactory) (controller.Controller, error) {
 	// At plane scope we list resources recursively to include all resource groups.
-	copy := dynamicResourceOptions
+	copy := makeVersionedResourceOptions(ctx, newConverter(ctx, ucp))
 	copy.ListRecursiveQuery = true
 	return defaultoperation.NewListResources(opts, copy)
 }
 
-func makeListResourceAtResourceGroupScopeController(opts controller.Options) (controller.Controller, error) {
-	return defaultoperation.NewListResources(opts, dynamicResourceOptions)
+func makeListResourceAtResourceGroupScopeController(ctx context.Context, opts controller.Options, ucp *v20231001preview.ClientFactory) (controller.Controller, error) {
+	return defaultoperation.NewListResources(opts, makeVersionedResourceOptions(ctx, newConverter(ctx, ucp)))
 }
 
-func makeGetResourceController(opts controller.Options) (controller.Controller, error) {
-	return defaultoperation.NewGetResource(opts, dynamicResourceOptions)
+func makeGetResourceController(ctx context.Context, opts controller.Options, ucp *v20231001preview.ClientFactory) (controller.Controller, error) {
+	return defaultoperation.NewGetResource(opts, makeVersionedResourceOptions(ctx, newConverter(ctx, ucp)))
 }
 
-func makePutResourceController(opts controller.Options) (controller.Controller, error) {
-	return defaultoperation.NewDefaultAsyncPut(opts, dynamicResourceOptions)
+func makePutResourceController(ctx context.Context, opts controller.Options, ucp *v20231001preview.ClientFactory, secretProvider *secretprovider.SecretProvider) (controller.Controller, error) {
+	versions := newConverter(ctx, ucp)
+	copy := makeVersionedResourceOptions(ctx, versions)
+
+	// The resource is converted to the storage API version before the sensitive properties are extracted, so the
+	// sensitive properties are stored in the storage API version as well.
+	copy.UpdateFilters = []controller.UpdateFilter[datamodel.DynamicResource]{
+		makeConversionFilter(versions),
+		makeSchemaFilter(ucp),
+		makeSensitivePropertiesFilter(ucp, secretProvider),
+	}
+	return defaultoperation.NewDefaultAsyncPut(opts, copy)
 }
 
-func makeDeleteResourceController(opts controller.Options) (controller.Controller, error) {
+func makeDeleteResourceController(ctx context.Context, opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewDefaultAsyncDelete(opts, dynamicResourceOptions)
 }
 
-func makeGetOperationResultController(opts controller.Options) (controller.Controller, error) {
+// makeMoveResourcesController returns the controller for the validateMoveResources or moveResources action, whose name
+// is the last segment of the resource type of the request.
+func makeMoveResourcesController(ctx context.Context, opts controller.Options, secretProvider *secretprovider.SecretProvider) (controller.Controller, error) {
+	_, action, _ := strings.Cut(opts.ResourceType, resources.SegmentSeparator)
+	switch {
+	case strings.EqualFold(action, v1.ValidateMoveResourcesActionName):
+		return defaultoperation.NewValidateMoveResources(opts)
+	case strings.EqualFold(action, v1.MoveResourcesActionName):
+		return NewMoveResources(opts, secretProvider)
+	default:
+		return nil, fmt.Errorf("the action %q is not supported", action)
+	}
+}
+
+func makeGetOperationResultController(ctx context.Context, opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewGetOperationResult(opts)
 }
 
-func makeGetOperationStatusController(opts controller.Options) (controller.Controller, error) {
+func makeGetOperationStatusController(ctx context.Context, opts controller.Options) (controller.Controller, error) {
 	return defaultoperation.NewGetOperationStatus(opts)
 }
+
+func makeListOperationStatusesController(ctx context.Context, opts controller.Options) (controller.Controller, error) {
+	return defaultoperation.NewListOperationStatuses(opts)
+}
+
+// makeVersionedResourceOptions returns the resource options for a request that returns resources. The resources are
+// converted from the API version they are stored in to the API version of the request.
+func makeVersionedResourceOptions(ctx context.Context, versions *versioning.Converter) controller.ResourceOptions[datamodel.DynamicResource] {
+	copy := dynamicResourceOptions
+	copy.ResponseConverter = makeResponseConverter(ctx, versions)
+	return copy
+}
+
+// newConverter creates the API version converter for the resource type of the request.
+func newConverter(ctx context.Context, ucp *v20231001preview.ClientFactory) *versioning.Converter {
+	return versioning.NewConverter(ucp, http.DefaultClient, v1.ARMRequestContextFromContext(ctx).ResourceID)
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// makeSchemaFilter returns an update filter that applies the schema of the resource type to the resource:
+//
+//   - Properties marked as readOnly cannot be set by the client. They are computed by the system, e.g. from the outputs
+//     of a recipe, and keep their existing values. A request that contains the existing value of a read-only property
+//     is accepted, so that the result of a GET can be sent back with PUT.
+//   - Properties that are missing from the request are set to the default value declared by the schema.
+func makeSchemaFilter(ucp *v20231001preview.ClientFactory) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		// The resource has already been converted to the API version it is stored in.
+		//
+		// A missing API version is reported when the resource is validated against the schema.
+		resourceSchema, err := schema.GetResourceTypeSchema(ctx, ucp, serviceCtx.ResourceID.String(), serviceCtx.ResourceID.Type(), newResource.UpdatedAPIVersion)
+		if err != nil && !clientv2.Is404Error(err) {
+			return nil, fmt.Errorf("failed to fetch the schema of resource type %q: %w", serviceCtx.ResourceID.Type(), err)
+		} else if resourceSchema == nil {
+			return nil, nil
+		}
+
+		if newResource.Properties == nil {
+			newResource.Properties = map[string]any{}
+		}
+
+		existing := map[string]any{}
+		if oldResource != nil {
+			existing, err = copyProperties(oldResource.Properties)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Requested values are removed from the properties and replaced by the computed values, which are only
+		// updated by the system.
+		computed := map[string]any{}
+		invalid := []string{}
+		for _, path := range schema.ExtractReadOnlyFieldPaths(resourceSchema, "") {
+			requested := sensitive.Extract(newResource.Properties, []string{path})
+			current := sensitive.Extract(existing, []string{path})
+			if len(requested) > 0 && !reflect.DeepEqual(requested, current) {
+				invalid = append(invalid, path)
+			}
+
+			sensitive.Merge(computed, current)
+		}
+
+		if len(invalid) > 0 {
+			return rest.NewBadRequestResponse(fmt.Sprintf("The following properties are read-only and cannot be set: %s", strings.Join(invalid, ", "))), nil
+		}
+
+		sensitive.Merge(newResource.Properties, computed)
+		schema.ApplyDefaults(newResource.Properties, resourceSchema)
+		return nil, nil
+	}
+}
+
+// copyProperties makes a copy of the properties by round-tripping them through JSON.
+func copyProperties(properties map[string]any) (map[string]any, error) {
+	result := map[string]any{}
+	if properties == nil {
+		return result, nil
+	}
+
+	bs, err := json.Marshal(properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal properties: %w", err)
+	}
+
+	err = json.Unmarshal(bs, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal properties: %w", err)
+	}
+
+	return result, nil
+}
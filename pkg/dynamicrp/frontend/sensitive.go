@@ -0,0 +1,69 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// makeSensitivePropertiesFilter returns an update filter that moves the properties marked as sensitive in the schema of
+// the resource type to the secret store, so they are neither stored in the database nor returned by GET and LIST.
+//
+// PUT replaces the resource, so sensitive properties that are omitted from the request are removed.
+func makeSensitivePropertiesFilter(ucp *v20231001preview.ClientFactory, secretProvider *secretprovider.SecretProvider) controller.UpdateFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, newResource *datamodel.DynamicResource, oldResource *datamodel.DynamicResource, options *controller.Options) (rest.Response, error) {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		resourceID := serviceCtx.ResourceID.String()
+
+		// The resource has already been converted to the API version it is stored in.
+		//
+		// A missing API version is reported when the resource is validated against the schema.
+		paths, err := schema.GetSensitiveFieldPaths(ctx, ucp, resourceID, serviceCtx.ResourceID.Type(), newResource.UpdatedAPIVersion)
+		if err != nil && !clientv2.Is404Error(err) {
+			return nil, fmt.Errorf("failed to fetch the sensitive properties of resource type %q: %w", serviceCtx.ResourceID.Type(), err)
+		}
+
+		// There's nothing to store, but the secret store may still hold values from a previous version of the resource.
+		if len(paths) == 0 && oldResource == nil {
+			return nil, nil
+		}
+
+		client, err := secretProvider.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		values := sensitive.Extract(newResource.Properties, paths)
+		err = sensitive.Save(ctx, client, resourceID, values)
+		if err != nil {
+			return nil, fmt.Errorf("failed to store sensitive properties: %w", err)
+		}
+
+		return nil, nil
+	}
+}
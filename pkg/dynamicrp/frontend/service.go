@@ -24,8 +24,11 @@ import (
 
 	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/servicecontext"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/dynamicrp"
 	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"github.com/go-chi/chi/v5"
@@ -70,7 +73,12 @@ func (s *Service) initialize(ctx context.Context) (*http.Server, error) {
 		ResourceType: "",  // Set dynamically
 	}
 
-	err = s.registerRoutes(r, controllerOptions)
+	ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(s.options.UCP))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create UCP client: %w", err)
+	}
+
+	err = s.registerRoutes(r, controllerOptions, ucp)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register routes: %w", err)
 	}
@@ -0,0 +1,315 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamic
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/dynamicrp/testhost"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/driver"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucptesthost "github.com/radius-project/radius/pkg/ucp/testhost"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// This test covers custom actions implemented by an external resource provider.
+func Test_Dynamic_Resource_Action_Provider(t *testing.T) {
+	type providerRequest struct {
+		Path       string
+		APIVersion string
+		Body       map[string]any
+	}
+	requests := []providerRequest{}
+
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		bs, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bs, &body))
+
+		requests = append(requests, providerRequest{Path: r.URL.Path, APIVersion: r.URL.Query().Get("api-version"), Body: body})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"keyVersion":2}`))
+	}))
+	defer provider.Close()
+
+	_, ucp := testhost.Start(t)
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createResourceTypeWithActions(ucp, inertResourceTypeName, []*string{to.Ptr(datamodel.CapabilityManualResourceProvisioning)}, map[string]*v20231001preview.ResourceTypeAction{
+		"rotateKey": {
+			RequestSchema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"keyName": map[string]any{"type": "string"},
+				},
+				"required": []any{"keyName"},
+			},
+			Handler: &v20231001preview.ResourceTypeActionHandler{
+				Kind:     to.Ptr(v20231001preview.ResourceTypeActionHandlerKindProvider),
+				Location: to.Ptr(provider.URL),
+			},
+		},
+	})
+	createAPIVersion(ucp, inertResourceTypeName, nil)
+	createLocation(ucp, inertResourceTypeName)
+	createResourceGroup(ucp)
+
+	// The resource does not exist yet.
+	response := ucp.MakeTypedRequest(http.MethodPost, testInertResourceID+"/rotateKey?api-version="+apiVersion, map[string]any{"keyName": "primary"})
+	response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+
+	response = ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, map[string]any{"properties": map[string]any{}})
+	response.WaitForOperationComplete(nil)
+
+	// The action is not declared by the resource type.
+	response = ucp.MakeTypedRequest(http.MethodPost, testInertResourceID+"/restart?api-version="+apiVersion, map[string]any{})
+	response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+
+	// The request body does not match the request schema.
+	response = ucp.MakeTypedRequest(http.MethodPost, testInertResourceID+"/rotateKey?api-version="+apiVersion, map[string]any{})
+	response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalid)
+	require.Empty(t, requests)
+
+	// Action names are case-insensitive.
+	response = ucp.MakeTypedRequest(http.MethodPost, testInertResourceID+"/rotatekey?api-version="+apiVersion, map[string]any{"keyName": "primary"})
+	response.EqualsValue(http.StatusOK, map[string]any{"keyVersion": float64(2)})
+
+	require.Equal(t, []providerRequest{
+		{
+			Path:       "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleInertResources/my-inert-example/rotateKey",
+			APIVersion: apiVersion,
+			Body:       map[string]any{"keyName": "primary"},
+		},
+	}, requests)
+}
+
+// This test covers custom actions that return the outputs of the recipe that deployed the resource.
+func Test_Dynamic_Resource_Action_Recipe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDriver := driver.NewMockDriver(ctrl)
+	mockConfigLoader := configloader.NewMockConfigurationLoader(ctrl)
+
+	_, ucp := testhost.Start(t, testhost.TestHostOptionFunc(func(options *dynamicrp.Options) {
+		options.Recipes.Drivers = map[string]func(options *dynamicrp.Options) (driver.Driver, error){
+			"test": func(options *dynamicrp.Options) (driver.Driver, error) {
+				return mockDriver, nil
+			},
+		}
+		options.Recipes.ConfigurationLoader = mockConfigLoader
+	}))
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createResourceTypeWithActions(ucp, recipeResourceTypeName, nil, map[string]*v20231001preview.ResourceTypeAction{
+		"getOutputs": {
+			Handler: &v20231001preview.ResourceTypeActionHandler{
+				Kind: to.Ptr(v20231001preview.ResourceTypeActionHandlerKindRecipe),
+			},
+		},
+		"getPassword": {
+			Handler: &v20231001preview.ResourceTypeActionHandler{
+				Kind:   to.Ptr(v20231001preview.ResourceTypeActionHandlerKindRecipe),
+				Output: to.Ptr("password"),
+			},
+		},
+		"getToken": {
+			Handler: &v20231001preview.ResourceTypeActionHandler{
+				Kind:   to.Ptr(v20231001preview.ResourceTypeActionHandlerKindRecipe),
+				Output: to.Ptr("token"),
+			},
+		},
+	})
+	createAPIVersion(ucp, recipeResourceTypeName, nil)
+	createLocation(ucp, recipeResourceTypeName)
+	createResourceGroup(ucp)
+
+	mockConfigLoader.EXPECT().
+		LoadRecipe(gomock.Any(), gomock.Any()).
+		Return(&recipes.EnvironmentDefinition{
+			Name:            "default",
+			Driver:          "test",
+			ResourceType:    "Applications.Test/exampleRecipeResources",
+			TemplatePath:    "test-path",
+			TemplateVersion: "test-version",
+		}, nil).
+		AnyTimes()
+	mockConfigLoader.EXPECT().
+		LoadConfiguration(gomock.Any(), gomock.Any()).
+		Return(&recipes.Configuration{}, nil).
+		AnyTimes()
+	mockDriver.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(&recipes.RecipeOutput{
+			Values: map[string]any{
+				"hostname": "example.com",
+			},
+			Secrets: map[string]any{
+				"password": "v3ryS3cr3t",
+			},
+			Status: &rpv1.RecipeStatus{
+				TemplateKind:    "test",
+				TemplatePath:    "test-path",
+				TemplateVersion: "test-version",
+			},
+		}, nil).
+		Times(1)
+
+	response := ucp.MakeTypedRequest(http.MethodPut, testRecipeResourceURL, map[string]any{"properties": map[string]any{}})
+	response.WaitForOperationComplete(nil)
+
+	// Secret outputs are only returned when the action names them.
+	response = ucp.MakeRequest(http.MethodPost, testRecipeResourceID+"/getOutputs?api-version="+apiVersion, nil)
+	response.EqualsValue(http.StatusOK, map[string]any{"hostname": "example.com"})
+
+	response = ucp.MakeRequest(http.MethodPost, testRecipeResourceID+"/getPassword?api-version="+apiVersion, nil)
+	response.EqualsValue(http.StatusOK, map[string]any{"password": "v3ryS3cr3t"})
+
+	// The recipe does not return this output.
+	response = ucp.MakeRequest(http.MethodPost, testRecipeResourceID+"/getToken?api-version="+apiVersion, nil)
+	response.EqualsErrorCode(http.StatusConflict, v1.CodeConflict)
+}
+
+// This test covers custom actions forwarded to a hook endpoint returned by the recipe that deployed the resource.
+func Test_Dynamic_Resource_Action_Hook(t *testing.T) {
+	type hookRequest struct {
+		Path string
+		Body map[string]any
+	}
+	requests := []hookRequest{}
+
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]any{}
+		bs, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(bs, &body))
+
+		requests = append(requests, hookRequest{Path: r.URL.Path, Body: body})
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"restarted":true}`))
+	}))
+	defer hook.Close()
+
+	ctrl := gomock.NewController(t)
+	mockDriver := driver.NewMockDriver(ctrl)
+	mockConfigLoader := configloader.NewMockConfigurationLoader(ctrl)
+
+	_, ucp := testhost.Start(t, testhost.TestHostOptionFunc(func(options *dynamicrp.Options) {
+		options.Recipes.Drivers = map[string]func(options *dynamicrp.Options) (driver.Driver, error){
+			"test": func(options *dynamicrp.Options) (driver.Driver, error) {
+				return mockDriver, nil
+			},
+		}
+		options.Recipes.ConfigurationLoader = mockConfigLoader
+	}))
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createResourceTypeWithActions(ucp, recipeResourceTypeName, nil, map[string]*v20231001preview.ResourceTypeAction{
+		"restart": {
+			Handler: &v20231001preview.ResourceTypeActionHandler{
+				Kind:   to.Ptr(v20231001preview.ResourceTypeActionHandlerKindHook),
+				Output: to.Ptr("restartUrl"),
+			},
+		},
+		"rotateKey": {
+			Handler: &v20231001preview.ResourceTypeActionHandler{
+				Kind:   to.Ptr(v20231001preview.ResourceTypeActionHandlerKindHook),
+				Output: to.Ptr("rotateKeyUrl"),
+			},
+		},
+	})
+	createAPIVersion(ucp, recipeResourceTypeName, nil)
+	createLocation(ucp, recipeResourceTypeName)
+	createResourceGroup(ucp)
+
+	mockConfigLoader.EXPECT().
+		LoadRecipe(gomock.Any(), gomock.Any()).
+		Return(&recipes.EnvironmentDefinition{
+			Name:            "default",
+			Driver:          "test",
+			ResourceType:    "Applications.Test/exampleRecipeResources",
+			TemplatePath:    "test-path",
+			TemplateVersion: "test-version",
+		}, nil).
+		AnyTimes()
+	mockConfigLoader.EXPECT().
+		LoadConfiguration(gomock.Any(), gomock.Any()).
+		Return(&recipes.Configuration{}, nil).
+		AnyTimes()
+	mockDriver.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(&recipes.RecipeOutput{
+			Values: map[string]any{
+				"restartUrl": hook.URL + "/hooks/restart",
+			},
+			Status: &rpv1.RecipeStatus{
+				TemplateKind:    "test",
+				TemplatePath:    "test-path",
+				TemplateVersion: "test-version",
+			},
+		}, nil).
+		Times(1)
+
+	response := ucp.MakeTypedRequest(http.MethodPut, testRecipeResourceURL, map[string]any{"properties": map[string]any{}})
+	response.WaitForOperationComplete(nil)
+
+	response = ucp.MakeTypedRequest(http.MethodPost, testRecipeResourceID+"/restart?api-version="+apiVersion, map[string]any{"force": true})
+	response.EqualsValue(http.StatusAccepted, map[string]any{"restarted": true})
+
+	require.Equal(t, []hookRequest{{Path: "/hooks/restart", Body: map[string]any{"force": true}}}, requests)
+
+	// The recipe does not return the address of this hook.
+	response = ucp.MakeRequest(http.MethodPost, testRecipeResourceID+"/rotateKey?api-version="+apiVersion, nil)
+	response.EqualsErrorCode(http.StatusConflict, v1.CodeConflict)
+}
+
+func createResourceTypeWithActions(server *ucptesthost.TestHost, resourceTypeName string, capabilities []*string, actions map[string]*v20231001preview.ResourceTypeAction) {
+	ctx := context.Background()
+
+	resourceType := v20231001preview.ResourceTypeResource{
+		Properties: &v20231001preview.ResourceTypeProperties{
+			Capabilities: capabilities,
+			Actions:      actions,
+		},
+	}
+
+	client := server.UCP().NewResourceTypesClient()
+	poller, err := client.BeginCreateOrUpdate(ctx, radiusPlaneName, resourceProviderNamespace, resourceTypeName, resourceType, nil)
+	require.NoError(server.T(), err)
+
+	_, err = poller.PollUntilDone(ctx, nil)
+	require.NoError(server.T(), err)
+}
@@ -24,6 +24,7 @@ import (
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/dynamicrp/sensitive"
 	"github.com/radius-project/radius/pkg/dynamicrp/testhost"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
@@ -195,6 +196,218 @@ func Test_Dynamic_Resource_Inert_Schema_Validation_Failure(t *testing.T) {
 	require.Contains(t, errorMap["message"].(string), "Schema validation failed", "Expected schema validation error message")
 }
 
+// This test covers properties marked as sensitive in the schema of the resource type.
+func Test_Dynamic_Resource_Sensitive_Properties(t *testing.T) {
+	dynamicrp, ucp := testhost.Start(t)
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createInertResourceType(ucp)
+
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"host": map[string]any{
+				"type": "string",
+			},
+			"password": map[string]any{
+				"type":               "string",
+				"x-radius-sensitive": true,
+			},
+		},
+		"required": []string{"host", "password"},
+	}
+
+	createAPIVersion(ucp, inertResourceTypeName, schema)
+	createLocation(ucp, inertResourceTypeName)
+	createResourceGroup(ucp)
+
+	resource := map[string]any{
+		"properties": map[string]any{
+			"host":     "example.com",
+			"password": "v3ryS3cr3t",
+		},
+	}
+
+	// The sensitive property is still validated against the schema.
+	response := ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, resource)
+	response.WaitForOperationComplete(nil)
+
+	expectedResource := map[string]any{
+		"id":       "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleInertResources/my-inert-example",
+		"location": "global",
+		"name":     "my-inert-example",
+		"properties": map[string]any{
+			"host":              "example.com",
+			"provisioningState": "Succeeded",
+		},
+		"type": "Applications.Test/exampleInertResources",
+	}
+
+	// GET and LIST do not return the sensitive property.
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsValue(http.StatusOK, expectedResource)
+
+	response = ucp.MakeRequest(http.MethodGet, "/planes/radius/testing/providers/Applications.Test/exampleInertResources"+"?api-version="+apiVersion, nil)
+	response.EqualsValue(http.StatusOK, map[string]any{"value": []any{expectedResource}})
+
+	response = ucp.MakeTypedRequest(http.MethodPost, testInertResourceID+"/listSecrets?api-version="+apiVersion, map[string]any{})
+	response.EqualsValue(http.StatusOK, map[string]any{"password": "v3ryS3cr3t"})
+
+	// The sensitive property is stored in the secret store.
+	secretClient, err := dynamicrp.Options().SecretProvider.GetClient(context.Background())
+	require.NoError(t, err)
+
+	values, err := sensitive.Load(context.Background(), secretClient, testInertResourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"password": "v3ryS3cr3t"}, values)
+
+	response = ucp.MakeRequest(http.MethodDelete, testInertResourceURL, nil)
+	response.WaitForOperationComplete(nil)
+
+	values, err = sensitive.Load(context.Background(), secretClient, testInertResourceID)
+	require.NoError(t, err)
+	require.Empty(t, values)
+}
+
+// This test covers moving a dynamic resource with a sensitive property to another resource group.
+func Test_Dynamic_Resource_Move(t *testing.T) {
+	dynamicrp, ucp := testhost.Start(t)
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createInertResourceType(ucp)
+
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"host": map[string]any{
+				"type": "string",
+			},
+			"password": map[string]any{
+				"type":               "string",
+				"x-radius-sensitive": true,
+			},
+		},
+	}
+
+	createAPIVersion(ucp, inertResourceTypeName, schema)
+	createLocation(ucp, inertResourceTypeName)
+	createResourceGroup(ucp)
+
+	_, err := ucp.UCP().NewResourceGroupsClient().CreateOrUpdate(context.Background(), radiusPlaneName, "target-group", v20231001preview.ResourceGroupResource{
+		Location:   to.Ptr(v1.LocationGlobal),
+		Properties: &v20231001preview.ResourceGroupProperties{},
+	}, nil)
+	require.NoError(t, err)
+
+	resource := map[string]any{
+		"properties": map[string]any{
+			"host":     "example.com",
+			"password": "v3ryS3cr3t",
+		},
+	}
+
+	response := ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, resource)
+	response.WaitForOperationComplete(nil)
+
+	move := map[string]any{
+		"resources":           []string{testInertResourceID},
+		"targetResourceGroup": testPlaneID + "/resourceGroups/target-group",
+	}
+	response = ucp.MakeTypedRequest(http.MethodPost, testResourceGroupID+"/moveResources?api-version="+v20231001preview.Version, move)
+	response.EqualsStatusCode(http.StatusAccepted)
+	response.WaitForOperationComplete(nil)
+
+	targetID := testPlaneID + "/resourceGroups/target-group/providers/" + resourceProviderNamespace + "/" + inertResourceTypeName + "/" + testInertResourceName
+
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsStatusCode(http.StatusNotFound)
+
+	response = ucp.MakeRequest(http.MethodGet, targetID+"?api-version="+apiVersion, nil)
+	response.EqualsStatusCode(http.StatusOK)
+
+	// The sensitive property is moved to the new ID of the resource.
+	response = ucp.MakeTypedRequest(http.MethodPost, targetID+"/listSecrets?api-version="+apiVersion, map[string]any{})
+	response.EqualsValue(http.StatusOK, map[string]any{"password": "v3ryS3cr3t"})
+
+	secretClient, err := dynamicrp.Options().SecretProvider.GetClient(context.Background())
+	require.NoError(t, err)
+
+	values, err := sensitive.Load(context.Background(), secretClient, testInertResourceID)
+	require.NoError(t, err)
+	require.Empty(t, values)
+}
+
+// This test covers default values and read-only properties declared by the schema of the resource type.
+func Test_Dynamic_Resource_Schema_Defaults_And_ReadOnly(t *testing.T) {
+	_, ucp := testhost.Start(t)
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createInertResourceType(ucp)
+
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{
+				"type":    "string",
+				"default": "small",
+			},
+			"endpoint": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+			},
+		},
+		"required": []string{"size", "endpoint"},
+	}
+
+	createAPIVersion(ucp, inertResourceTypeName, schema)
+	createLocation(ucp, inertResourceTypeName)
+	createResourceGroup(ucp)
+
+	// Read-only properties cannot be set by the client.
+	response := ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, map[string]any{
+		"properties": map[string]any{
+			"endpoint": "https://example.com",
+		},
+	})
+	response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalid)
+	require.Contains(t, response.Error.Error.Message, "The following properties are read-only and cannot be set: endpoint")
+
+	// Missing properties are set to their default value, and required read-only properties may be omitted.
+	response = ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, map[string]any{
+		"properties": map[string]any{},
+	})
+	response.WaitForOperationComplete(nil)
+
+	expectedResource := map[string]any{
+		"id":       "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleInertResources/my-inert-example",
+		"location": "global",
+		"name":     "my-inert-example",
+		"properties": map[string]any{
+			"size":              "small",
+			"provisioningState": "Succeeded",
+		},
+		"type": "Applications.Test/exampleInertResources",
+	}
+
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsValue(http.StatusOK, expectedResource)
+
+	// An explicit value is not overwritten by the default.
+	response = ucp.MakeTypedRequest(http.MethodPut, testInertResourceURL, map[string]any{
+		"properties": map[string]any{
+			"size": "large",
+		},
+	})
+	response.WaitForOperationComplete(nil)
+
+	expectedResource["properties"].(map[string]any)["size"] = "large"
+	response = ucp.MakeRequest(http.MethodGet, testInertResourceURL, nil)
+	response.EqualsValue(http.StatusOK, expectedResource)
+}
+
 func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockDriver := driver.NewMockDriver(ctrl)
@@ -221,6 +434,11 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 			"foo": map[string]any{
 				"type": "string",
 			},
+			// Read-only properties are populated from the outputs of the recipe.
+			"hostname": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+			},
 		},
 	}
 	// Setup a resource provider (Applications.Test/exampleRecipeResources)
@@ -296,6 +514,7 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 		"name":     "my-recipe-example",
 		"properties": map[string]any{
 			"foo":               "bar",
+			"hostname":          "example.com",
 			"provisioningState": "Succeeded",
 			"recipe": map[string]any{
 				"name":         "default",
@@ -306,11 +525,6 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 					"port":     float64(8080), // This is an artifact of the JSON unmarshal process. It's wierd but intended.
 					"hostname": "example.com",
 				},
-				"secrets": map[string]any{
-					"password": map[string]any{
-						"Value": "v3ryS3cr3t",
-					},
-				},
 				"outputResources": []any{
 					map[string]any{
 						"id":            "/planes/example/testing/providers/Test.Namespace/testResource/example",
@@ -347,6 +561,10 @@ func Test_Dynamic_Resource_Recipe_Lifecycle(t *testing.T) {
 	response = ucp.MakeRequest(http.MethodGet, "/planes/radius/testing/providers/Applications.Test/exampleRecipeResources"+"?api-version="+apiVersion, nil)
 	response.EqualsValue(200, expectedList)
 
+	// Secret outputs of the recipe are only returned by listSecrets.
+	response = ucp.MakeTypedRequest(http.MethodPost, testRecipeResourceID+"/listSecrets?api-version="+apiVersion, map[string]any{})
+	response.EqualsValue(http.StatusOK, map[string]any{"password": "v3ryS3cr3t"})
+
 	// Now lets delete the resource
 	response = ucp.MakeRequest(http.MethodDelete, testRecipeResourceURL, nil)
 	response.WaitForOperationComplete(nil)
@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dynamic
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/dynamicrp/testhost"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucptesthost "github.com/radius-project/radius/pkg/ucp/testhost"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	storageAPIVersion = "2025-01-01"
+)
+
+// Test_Dynamic_Resource_APIVersion_Conversion tests that resources are stored in the storage API version of the
+// resource type, and are converted to the API version of each request.
+func Test_Dynamic_Resource_APIVersion_Conversion(t *testing.T) {
+	_, ucp := testhost.Start(t)
+
+	createRadiusPlane(ucp)
+	createResourceProvider(ucp)
+	createVersionedResourceType(ucp)
+	createResourceGroup(ucp)
+
+	legacyURL := testInertResourceID + "?api-version=" + apiVersion
+	storageURL := testInertResourceID + "?api-version=" + storageAPIVersion
+
+	response := ucp.MakeTypedRequest(http.MethodPut, legacyURL, map[string]any{
+		"properties": map[string]any{
+			"hostname": "example.com",
+		},
+	})
+	response.WaitForOperationComplete(nil)
+
+	expectedResource := func(properties map[string]any) map[string]any {
+		properties["provisioningState"] = "Succeeded"
+		return map[string]any{
+			"id":         "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleInertResources/my-inert-example",
+			"location":   "global",
+			"name":       "my-inert-example",
+			"properties": properties,
+			"type":       "Applications.Test/exampleInertResources",
+		}
+	}
+
+	// The resource is stored in the storage API version.
+	response = ucp.MakeRequest(http.MethodGet, storageURL, nil)
+	response.EqualsValue(200, expectedResource(map[string]any{
+		"database": map[string]any{"host": "example.com", "port": float64(5432)},
+	}))
+
+	// The resource is converted back to the API version of the request.
+	legacyResource := expectedResource(map[string]any{"hostname": "example.com"})
+	response = ucp.MakeRequest(http.MethodGet, legacyURL, nil)
+	response.EqualsValue(200, legacyResource)
+
+	response = ucp.MakeRequest(http.MethodGet, "/planes/radius/testing/resourcegroups/test-group/providers/Applications.Test/exampleInertResources?api-version="+apiVersion, nil)
+	response.EqualsValue(200, map[string]any{"value": []any{legacyResource}})
+
+	// Updating the resource with the storage API version is visible in the other API version.
+	response = ucp.MakeTypedRequest(http.MethodPut, storageURL, map[string]any{
+		"properties": map[string]any{
+			"database": map[string]any{"host": "other.example.com", "port": float64(3306)},
+		},
+	})
+	response.WaitForOperationComplete(nil)
+
+	response = ucp.MakeRequest(http.MethodGet, legacyURL, nil)
+	response.EqualsValue(200, expectedResource(map[string]any{"hostname": "other.example.com"}))
+}
+
+func createVersionedResourceType(server *ucptesthost.TestHost) {
+	ctx := context.Background()
+
+	resourceType := v20231001preview.ResourceTypeResource{
+		Properties: &v20231001preview.ResourceTypeProperties{
+			Capabilities:      []*string{to.Ptr(datamodel.CapabilityManualResourceProvisioning)},
+			StorageAPIVersion: to.Ptr(storageAPIVersion),
+		},
+	}
+
+	poller, err := server.UCP().NewResourceTypesClient().BeginCreateOrUpdate(ctx, radiusPlaneName, resourceProviderNamespace, inertResourceTypeName, resourceType, nil)
+	require.NoError(server.T(), err)
+	_, err = poller.PollUntilDone(ctx, nil)
+	require.NoError(server.T(), err)
+
+	apiVersions := map[string]*v20231001preview.APIVersionProperties{
+		apiVersion: {
+			Conversion: &v20231001preview.APIVersionConversion{
+				Rules: []*v20231001preview.APIVersionConversionRule{
+					{Kind: to.Ptr(v20231001preview.APIVersionConversionRuleKindRename), From: to.Ptr("hostname"), To: to.Ptr("database.host")},
+					{Kind: to.Ptr(v20231001preview.APIVersionConversionRuleKindDefault), Path: to.Ptr("database.port"), Value: 5432},
+				},
+			},
+		},
+		storageAPIVersion: {},
+	}
+
+	for name, properties := range apiVersions {
+		poller, err := server.UCP().NewAPIVersionsClient().BeginCreateOrUpdate(ctx, radiusPlaneName, resourceProviderNamespace, inertResourceTypeName, name, v20231001preview.APIVersionResource{Properties: properties}, nil)
+		require.NoError(server.T(), err)
+		_, err = poller.PollUntilDone(ctx, nil)
+		require.NoError(server.T(), err)
+	}
+
+	location := v20231001preview.LocationResource{
+		Properties: &v20231001preview.LocationProperties{
+			ResourceTypes: map[string]*v20231001preview.LocationResourceType{
+				inertResourceTypeName: {
+					APIVersions: map[string]map[string]any{
+						apiVersion:        {},
+						storageAPIVersion: {},
+					},
+				},
+			},
+		},
+	}
+
+	locationPoller, err := server.UCP().NewLocationsClient().BeginCreateOrUpdate(ctx, radiusPlaneName, resourceProviderNamespace, locationName, location, nil)
+	require.NoError(server.T(), err)
+	_, err = locationPoller.PollUntilDone(ctx, nil)
+	require.NoError(server.T(), err)
+}
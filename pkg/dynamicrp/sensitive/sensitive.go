@@ -0,0 +1,285 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sensitive implements storage for the properties of dynamic resources that are marked as sensitive
+// (x-radius-sensitive) in the schema of the resource type.
+//
+// Sensitive properties are removed from the resource before it is saved to the database, and stored in the secret
+// store instead. The values are stored as a sparse copy of the resource properties that only contains the sensitive
+// fields, so that they can be merged back into the resource when they are needed.
+//
+// The secret outputs of the recipe that deployed a resource are stored in a separate secret, so that they are not
+// overwritten when the sensitive properties of the resource are updated.
+package sensitive
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/components/secret"
+)
+
+const (
+	// secretNamePrefix is the prefix of the names of secrets storing the sensitive properties of dynamic resources.
+	secretNamePrefix = "dynamicrp-"
+
+	// recipeSecretNamePrefix is the prefix of the names of secrets storing the secret outputs of the recipes of
+	// dynamic resources.
+	recipeSecretNamePrefix = "dynamicrp-recipe-"
+
+	// wildcard is the path segment that matches all elements of an array or all values of a map.
+	wildcard = "[*]"
+)
+
+// SecretName returns the name of the secret storing the sensitive properties of the resource.
+func SecretName(resourceID string) string {
+	return secretNamePrefix + hashResourceID(resourceID)
+}
+
+// RecipeSecretName returns the name of the secret storing the secret outputs of the recipe of the resource.
+func RecipeSecretName(resourceID string) string {
+	return recipeSecretNamePrefix + hashResourceID(resourceID)
+}
+
+// Load returns the sensitive properties of the resource from the secret store. An empty map is returned if the
+// resource has no sensitive properties.
+func Load(ctx context.Context, client secret.Client, resourceID string) (map[string]any, error) {
+	return load[any](ctx, client, SecretName(resourceID))
+}
+
+// Save stores the sensitive properties of the resource in the secret store. The secret is deleted if there are
+// no sensitive properties.
+func Save(ctx context.Context, client secret.Client, resourceID string, values map[string]any) error {
+	return save(ctx, client, SecretName(resourceID), values)
+}
+
+// Delete deletes the sensitive properties of the resource from the secret store.
+func Delete(ctx context.Context, client secret.Client, resourceID string) error {
+	return deleteSecret(ctx, client, SecretName(resourceID))
+}
+
+// LoadRecipeSecrets returns the secret outputs of the recipe of the resource from the secret store. An empty map is
+// returned if the recipe has no secret outputs.
+func LoadRecipeSecrets(ctx context.Context, client secret.Client, resourceID string) (map[string]string, error) {
+	return load[string](ctx, client, RecipeSecretName(resourceID))
+}
+
+// SaveRecipeSecrets stores the secret outputs of the recipe of the resource in the secret store. The secret is deleted
+// if there are no secret outputs.
+func SaveRecipeSecrets(ctx context.Context, client secret.Client, resourceID string, values map[string]string) error {
+	return save(ctx, client, RecipeSecretName(resourceID), values)
+}
+
+// DeleteRecipeSecrets deletes the secret outputs of the recipe of the resource from the secret store.
+func DeleteRecipeSecrets(ctx context.Context, client secret.Client, resourceID string) error {
+	return deleteSecret(ctx, client, RecipeSecretName(resourceID))
+}
+
+// Move moves the sensitive properties and the secret outputs of the recipe of a resource to the new ID of the resource,
+// when the resource is moved to another resource group. Moving a resource whose secrets were already moved does nothing.
+func Move(ctx context.Context, client secret.Client, resourceID string, targetID string) error {
+	if err := moveSecret(ctx, client, SecretName(resourceID), SecretName(targetID)); err != nil {
+		return err
+	}
+
+	return moveSecret(ctx, client, RecipeSecretName(resourceID), RecipeSecretName(targetID))
+}
+
+// hashResourceID returns a hash of the resource ID that is a valid part of a secret name.
+//
+// Resource IDs are case-insensitive and are not valid secret names, so names are derived from a hash of the ID.
+// The hash is truncated to keep the names within the 63 character limit of Kubernetes object names.
+func hashResourceID(resourceID string) string {
+	hash := sha256.Sum256([]byte(strings.ToLower(resourceID)))
+	return hex.EncodeToString(hash[:20])
+}
+
+func load[T any](ctx context.Context, client secret.Client, name string) (map[string]T, error) {
+	values, err := secret.GetSecret[map[string]T](ctx, client, name)
+	if errors.Is(err, &secret.ErrNotFound{}) {
+		return map[string]T{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if values == nil {
+		values = map[string]T{}
+	}
+
+	return values, nil
+}
+
+func save[T any](ctx context.Context, client secret.Client, name string, values map[string]T) error {
+	if len(values) == 0 {
+		return deleteSecret(ctx, client, name)
+	}
+
+	return secret.SaveSecret(ctx, client, name, values)
+}
+
+func moveSecret(ctx context.Context, client secret.Client, name string, target string) error {
+	values, err := secret.GetSecret[map[string]any](ctx, client, name)
+	if errors.Is(err, &secret.ErrNotFound{}) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := save(ctx, client, target, values); err != nil {
+		return err
+	}
+
+	return deleteSecret(ctx, client, name)
+}
+
+func deleteSecret(ctx context.Context, client secret.Client, name string) error {
+	err := client.Delete(ctx, name)
+	if errors.Is(err, &secret.ErrNotFound{}) {
+		return nil
+	}
+
+	return err
+}
+
+// Extract removes the fields at the given paths from the properties and returns them as a sparse copy of the
+// properties. Paths use the format returned by schema.ExtractSensitiveFieldPaths, e.g. "credentials.password" or
+// "users[*].password".
+func Extract(properties map[string]any, paths []string) map[string]any {
+	values := map[string]any{}
+	for _, path := range paths {
+		extracted, ok := extract(properties, splitPath(path))
+		if !ok {
+			continue
+		}
+
+		Merge(values, extracted.(map[string]any))
+	}
+
+	return values
+}
+
+// Merge merges the sensitive values returned by Extract back into the properties.
+func Merge(properties map[string]any, values map[string]any) {
+	for key, value := range values {
+		properties[key] = merge(properties[key], value)
+	}
+}
+
+// splitPath splits a path into its segments. A wildcard at the end of the path is dropped, because a sensitive
+// array or map is handled the same way as any other sensitive field.
+func splitPath(path string) []string {
+	segments := []string{}
+	for _, part := range strings.Split(path, ".") {
+		name, isWildcard := strings.CutSuffix(part, wildcard)
+		segments = append(segments, name)
+		if isWildcard {
+			segments = append(segments, wildcard)
+		}
+	}
+
+	for len(segments) > 0 && segments[len(segments)-1] == wildcard {
+		segments = segments[:len(segments)-1]
+	}
+
+	return segments
+}
+
+func extract(value any, segments []string) (any, bool) {
+	if len(segments) == 0 {
+		return nil, false
+	}
+
+	if segments[0] == wildcard {
+		switch v := value.(type) {
+		case []any:
+			result := make([]any, len(v))
+			found := false
+			for i, item := range v {
+				if extracted, ok := extract(item, segments[1:]); ok {
+					result[i] = extracted
+					found = true
+				}
+			}
+			return result, found
+
+		case map[string]any:
+			result := map[string]any{}
+			for key, item := range v {
+				if extracted, ok := extract(item, segments[1:]); ok {
+					result[key] = extracted
+				}
+			}
+			return result, len(result) > 0
+		}
+
+		return nil, false
+	}
+
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	child, ok := obj[segments[0]]
+	if !ok {
+		return nil, false
+	}
+
+	if len(segments) == 1 {
+		delete(obj, segments[0])
+		return map[string]any{segments[0]: child}, true
+	}
+
+	extracted, ok := extract(child, segments[1:])
+	if !ok {
+		return nil, false
+	}
+
+	return map[string]any{segments[0]: extracted}, true
+}
+
+func merge(dst any, src any) any {
+	switch s := src.(type) {
+	case map[string]any:
+		d, ok := dst.(map[string]any)
+		if !ok {
+			return s
+		}
+
+		for key, value := range s {
+			d[key] = merge(d[key], value)
+		}
+		return d
+
+	case []any:
+		d, ok := dst.([]any)
+		if !ok || len(d) != len(s) {
+			return s
+		}
+
+		for i, value := range s {
+			if value != nil {
+				d[i] = merge(d[i], value)
+			}
+		}
+		return d
+	}
+
+	return src
+}
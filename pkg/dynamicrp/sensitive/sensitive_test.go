@@ -0,0 +1,260 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sensitive
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/secret/inmemory"
+	"github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/exampleResources/my-example"
+
+func Test_SecretName(t *testing.T) {
+	name := SecretName(testResourceID)
+	require.True(t, kubernetes.IsValidObjectName(name))
+
+	// Resource IDs are case-insensitive.
+	require.Equal(t, name, SecretName("/planes/radius/local/resourcegroups/test-group/providers/applications.test/exampleresources/my-example"))
+	require.NotEqual(t, name, SecretName(testResourceID+"2"))
+
+	recipeName := RecipeSecretName(testResourceID)
+	require.True(t, kubernetes.IsValidObjectName(recipeName))
+	require.NotEqual(t, name, recipeName)
+}
+
+func Test_SaveLoadDelete(t *testing.T) {
+	ctx := context.Background()
+	client := &inmemory.Client{}
+
+	values, err := Load(ctx, client, testResourceID)
+	require.NoError(t, err)
+	require.Empty(t, values)
+
+	err = Save(ctx, client, testResourceID, map[string]any{"password": "s3cr3t"})
+	require.NoError(t, err)
+
+	values, err = Load(ctx, client, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"password": "s3cr3t"}, values)
+
+	// Saving no values deletes the secret.
+	err = Save(ctx, client, testResourceID, map[string]any{})
+	require.NoError(t, err)
+
+	values, err = Load(ctx, client, testResourceID)
+	require.NoError(t, err)
+	require.Empty(t, values)
+
+	// Deleting a missing secret is not an error.
+	err = Delete(ctx, client, testResourceID)
+	require.NoError(t, err)
+}
+
+func Test_SaveLoadDeleteRecipeSecrets(t *testing.T) {
+	ctx := context.Background()
+	client := &inmemory.Client{}
+
+	err := Save(ctx, client, testResourceID, map[string]any{"password": "s3cr3t"})
+	require.NoError(t, err)
+
+	values, err := LoadRecipeSecrets(ctx, client, testResourceID)
+	require.NoError(t, err)
+	require.Empty(t, values)
+
+	err = SaveRecipeSecrets(ctx, client, testResourceID, map[string]string{"connectionString": "Server=example.com"})
+	require.NoError(t, err)
+
+	values, err = LoadRecipeSecrets(ctx, client, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"connectionString": "Server=example.com"}, values)
+
+	// The sensitive properties are stored separately.
+	properties, err := Load(ctx, client, testResourceID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"password": "s3cr3t"}, properties)
+
+	// Saving no secret outputs deletes the secret.
+	err = SaveRecipeSecrets(ctx, client, testResourceID, map[string]string{})
+	require.NoError(t, err)
+
+	values, err = LoadRecipeSecrets(ctx, client, testResourceID)
+	require.NoError(t, err)
+	require.Empty(t, values)
+
+	err = DeleteRecipeSecrets(ctx, client, testResourceID)
+	require.NoError(t, err)
+}
+
+func Test_Move(t *testing.T) {
+	ctx := context.Background()
+	client := &inmemory.Client{}
+	targetID := "/planes/radius/local/resourceGroups/other-group/providers/Applications.Test/testResources/test"
+
+	err := Save(ctx, client, testResourceID, map[string]any{"password": "s3cr3t"})
+	require.NoError(t, err)
+
+	err = SaveRecipeSecrets(ctx, client, testResourceID, map[string]string{"connectionString": "Server=example.com"})
+	require.NoError(t, err)
+
+	err = Move(ctx, client, testResourceID, targetID)
+	require.NoError(t, err)
+
+	properties, err := Load(ctx, client, targetID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"password": "s3cr3t"}, properties)
+
+	values, err := LoadRecipeSecrets(ctx, client, targetID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"connectionString": "Server=example.com"}, values)
+
+	properties, err = Load(ctx, client, testResourceID)
+	require.NoError(t, err)
+	require.Empty(t, properties)
+
+	values, err = LoadRecipeSecrets(ctx, client, testResourceID)
+	require.NoError(t, err)
+	require.Empty(t, values)
+
+	// Moving again does not remove the moved secrets.
+	err = Move(ctx, client, testResourceID, targetID)
+	require.NoError(t, err)
+
+	properties, err = Load(ctx, client, targetID)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"password": "s3cr3t"}, properties)
+}
+
+func Test_ExtractAndMerge(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]any
+		paths      []string
+		redacted   map[string]any
+		values     map[string]any
+	}{
+		{
+			name:       "no paths",
+			properties: map[string]any{"host": "example.com"},
+			paths:      nil,
+			redacted:   map[string]any{"host": "example.com"},
+			values:     map[string]any{},
+		},
+		{
+			name:       "top-level field",
+			properties: map[string]any{"host": "example.com", "password": "s3cr3t"},
+			paths:      []string{"password"},
+			redacted:   map[string]any{"host": "example.com"},
+			values:     map[string]any{"password": "s3cr3t"},
+		},
+		{
+			name:       "missing field",
+			properties: map[string]any{"host": "example.com"},
+			paths:      []string{"password", "credentials.password"},
+			redacted:   map[string]any{"host": "example.com"},
+			values:     map[string]any{},
+		},
+		{
+			name: "nested fields",
+			properties: map[string]any{
+				"credentials": map[string]any{"username": "admin", "password": "s3cr3t", "token": "t0k3n"},
+			},
+			paths: []string{"credentials.password", "credentials.token"},
+			redacted: map[string]any{
+				"credentials": map[string]any{"username": "admin"},
+			},
+			values: map[string]any{
+				"credentials": map[string]any{"password": "s3cr3t", "token": "t0k3n"},
+			},
+		},
+		{
+			name: "array items",
+			properties: map[string]any{
+				"users": []any{
+					map[string]any{"name": "a", "password": "pa"},
+					map[string]any{"name": "b"},
+				},
+			},
+			paths: []string{"users[*].password"},
+			redacted: map[string]any{
+				"users": []any{
+					map[string]any{"name": "a"},
+					map[string]any{"name": "b"},
+				},
+			},
+			values: map[string]any{
+				"users": []any{map[string]any{"password": "pa"}, nil},
+			},
+		},
+		{
+			name: "map values",
+			properties: map[string]any{
+				"connections": map[string]any{
+					"db":    map[string]any{"host": "db", "password": "pdb"},
+					"cache": map[string]any{"host": "cache", "password": "pcache"},
+				},
+			},
+			paths: []string{"connections[*].password"},
+			redacted: map[string]any{
+				"connections": map[string]any{
+					"db":    map[string]any{"host": "db"},
+					"cache": map[string]any{"host": "cache"},
+				},
+			},
+			values: map[string]any{
+				"connections": map[string]any{
+					"db":    map[string]any{"password": "pdb"},
+					"cache": map[string]any{"password": "pcache"},
+				},
+			},
+		},
+		{
+			name:       "sensitive map",
+			properties: map[string]any{"env": map[string]any{"KEY": "value"}},
+			paths:      []string{"env[*]"},
+			redacted:   map[string]any{},
+			values:     map[string]any{"env": map[string]any{"KEY": "value"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := deepCopy(t, tt.properties)
+
+			values := Extract(tt.properties, tt.paths)
+			require.Equal(t, tt.redacted, tt.properties)
+			require.Equal(t, tt.values, values)
+
+			Merge(tt.properties, deepCopy(t, values))
+			require.Equal(t, original, tt.properties)
+		})
+	}
+}
+
+func deepCopy(t *testing.T, value map[string]any) map[string]any {
+	bs, err := json.Marshal(value)
+	require.NoError(t, err)
+
+	result := map[string]any{}
+	err = json.Unmarshal(bs, &result)
+	require.NoError(t, err)
+	return result
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/google/uuid"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
@@ -137,15 +138,26 @@ func StartWithOptions(t *testing.T, options *dynamicrp.Options) (*TestHost, *ucp
 	require.NoError(t, err, "failed to create server")
 
 	th := testhost.StartHost(t, host, baseURL)
-	return &TestHost{TestHost: th, options: options}, startUCP(t, baseURL, ucpPort)
+	return &TestHost{TestHost: th, options: options}, startUCP(t, baseURL, ucpPort, options.DatabaseProvider)
 }
 
-func startUCP(t *testing.T, dynamicRPURL string, ucpPort int) *ucptesthost.TestHost {
+func startUCP(t *testing.T, dynamicRPURL string, ucpPort int, databaseProvider *databaseprovider.DatabaseProvider) *ucptesthost.TestHost {
 	return ucptesthost.Start(t, ucptesthost.TestHostOptionFunc(func(options *ucp.Options) {
 		// Initialize UCP with its listening port
 		options.Config.Server.Port = ucpPort
 
 		// Intitialize UCP with the dynamic-rp URL
 		options.Config.Routing.DefaultDownstreamEndpoint = dynamicRPURL
+
+		// UCP and dynamic-rp share a database like they do when Radius is installed, so that UCP can move the
+		// resources of dynamic-rp.
+		databaseClient, err := databaseProvider.GetClient(context.Background())
+		require.NoError(t, err)
+
+		queueClient, err := options.QueueProvider.GetClient(context.Background())
+		require.NoError(t, err)
+
+		options.DatabaseProvider = databaseProvider
+		options.StatusManager = statusmanager.New(databaseClient, queueClient, options.Config.Environment.RoleLocation)
 	}))
 }
@@ -0,0 +1,166 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versioning
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// applyRules converts properties to the storage API version by applying the rules in order.
+//
+// - A 'rename' rule moves the property at 'from' to 'to'.
+// - A 'default' rule sets the property at 'path' to 'value' if it is missing.
+func applyRules(properties map[string]any, rules []*v20231001preview.APIVersionConversionRule) error {
+	for _, rule := range rules {
+		if rule == nil || rule.Kind == nil {
+			continue
+		}
+
+		switch *rule.Kind {
+		case v20231001preview.APIVersionConversionRuleKindRename:
+			err := moveProperty(properties, to.String(rule.From), to.String(rule.To))
+			if err != nil {
+				return err
+			}
+
+		case v20231001preview.APIVersionConversionRuleKindDefault:
+			if _, ok := getProperty(properties, to.String(rule.Path)); ok {
+				continue
+			}
+
+			err := setProperty(properties, to.String(rule.Path), rule.Value)
+			if err != nil {
+				return err
+			}
+
+		default:
+			return fmt.Errorf("unsupported conversion rule kind %q", *rule.Kind)
+		}
+	}
+
+	return nil
+}
+
+// revertRules converts properties from the storage API version by reverting the rules in reverse order.
+//
+// - A 'rename' rule moves the property at 'to' back to 'from'.
+// - A 'default' rule removes the property at 'path', because it does not exist in the API version.
+func revertRules(properties map[string]any, rules []*v20231001preview.APIVersionConversionRule) error {
+	for i := len(rules) - 1; i >= 0; i-- {
+		rule := rules[i]
+		if rule == nil || rule.Kind == nil {
+			continue
+		}
+
+		switch *rule.Kind {
+		case v20231001preview.APIVersionConversionRuleKindRename:
+			err := moveProperty(properties, to.String(rule.To), to.String(rule.From))
+			if err != nil {
+				return err
+			}
+
+		case v20231001preview.APIVersionConversionRuleKindDefault:
+			deleteProperty(properties, to.String(rule.Path))
+
+		default:
+			return fmt.Errorf("unsupported conversion rule kind %q", *rule.Kind)
+		}
+	}
+
+	return nil
+}
+
+// moveProperty moves the property at one path to another path. Nothing is done if the property is missing.
+func moveProperty(properties map[string]any, from string, to string) error {
+	value, ok := getProperty(properties, from)
+	if !ok {
+		return nil
+	}
+
+	deleteProperty(properties, from)
+	return setProperty(properties, to, value)
+}
+
+// getProperty returns the value of the property at the given dot-separated path.
+func getProperty(properties map[string]any, path string) (any, bool) {
+	segments := strings.Split(path, ".")
+
+	current := properties
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+
+	value, ok := current[segments[len(segments)-1]]
+	return value, ok
+}
+
+// setProperty sets the value of the property at the given dot-separated path, creating the parent objects that are
+// missing.
+func setProperty(properties map[string]any, path string, value any) error {
+	segments := strings.Split(path, ".")
+
+	current := properties
+	for i, segment := range segments[:len(segments)-1] {
+		existing, ok := current[segment]
+		if !ok {
+			next := map[string]any{}
+			current[segment] = next
+			current = next
+			continue
+		}
+
+		next, ok := existing.(map[string]any)
+		if !ok {
+			return fmt.Errorf("cannot set property %q because %q is not an object", path, strings.Join(segments[:i+1], "."))
+		}
+		current = next
+	}
+
+	current[segments[len(segments)-1]] = value
+	return nil
+}
+
+// deleteProperty removes the property at the given dot-separated path. Parent objects that become empty are removed
+// as well, so that moving a property back and forth does not leave empty objects behind.
+func deleteProperty(properties map[string]any, path string) {
+	deleteSegments(properties, strings.Split(path, "."))
+}
+
+func deleteSegments(current map[string]any, segments []string) {
+	if len(segments) == 1 {
+		delete(current, segments[0])
+		return
+	}
+
+	next, ok := current[segments[0]].(map[string]any)
+	if !ok {
+		return
+	}
+
+	deleteSegments(next, segments[1:])
+	if len(next) == 0 {
+		delete(current, segments[0])
+	}
+}
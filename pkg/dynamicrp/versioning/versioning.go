@@ -0,0 +1,269 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package versioning implements the conversion of dynamic resources between the API versions of a resource type.
+//
+// A resource type may declare a storage API version. Resources are always stored in the storage API version, and
+// every other API version may declare a conversion to the storage API version, either as a list of declarative rules
+// or as a webhook. Converting between two API versions that are not the storage API version goes through the storage
+// API version.
+//
+// API versions that don't declare a conversion are treated as identical to the storage API version, and resource
+// types that don't declare a storage API version are stored as-is.
+package versioning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// WebhookRequest is the request body sent to a conversion webhook.
+type WebhookRequest struct {
+	// ID is the resource ID of the resource being converted.
+	ID string `json:"id"`
+
+	// FromAPIVersion is the API version of the properties in the request.
+	FromAPIVersion string `json:"fromApiVersion"`
+
+	// ToAPIVersion is the API version that the properties should be converted to.
+	ToAPIVersion string `json:"toApiVersion"`
+
+	// Properties is the properties of the resource.
+	Properties map[string]any `json:"properties"`
+}
+
+// WebhookResponse is the response body returned by a conversion webhook.
+type WebhookResponse struct {
+	// Properties is the converted properties of the resource.
+	Properties map[string]any `json:"properties"`
+}
+
+// Converter converts the properties of dynamic resources between the API versions of a resource type.
+//
+// The conversions of the resource type are fetched from UCP the first time they are needed, so a Converter should be
+// created for each request.
+type Converter struct {
+	ucp        *v20231001preview.ClientFactory
+	httpClient *http.Client
+	id         resources.ID
+
+	loaded            bool
+	storageAPIVersion string
+	conversions       map[string]*v20231001preview.APIVersionConversion
+}
+
+// NewConverter creates a Converter for the resource type of the given resource or collection ID.
+func NewConverter(ucp *v20231001preview.ClientFactory, httpClient *http.Client, id resources.ID) *Converter {
+	return &Converter{ucp: ucp, httpClient: httpClient, id: id}
+}
+
+// StorageAPIVersion returns the API version that resources created or updated with the given API version are stored
+// in. This is the given API version if the resource type does not declare a storage API version.
+func (c *Converter) StorageAPIVersion(ctx context.Context, apiVersion string) (string, error) {
+	err := c.load(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if c.storageAPIVersion == "" {
+		return apiVersion, nil
+	}
+
+	return c.storageAPIVersion, nil
+}
+
+// Convert converts the properties of a resource from one API version to another. The properties are not modified.
+func (c *Converter) Convert(ctx context.Context, resourceID string, properties map[string]any, from string, to string) (map[string]any, error) {
+	if from == "" || to == "" || strings.EqualFold(from, to) {
+		return properties, nil
+	}
+
+	err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.storageAPIVersion == "" {
+		return properties, nil
+	}
+
+	result, err := deepCopy(properties)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(from, c.storageAPIVersion) {
+		result, err = c.convert(ctx, resourceID, result, from, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert from API version %q to %q: %w", from, c.storageAPIVersion, err)
+		}
+	}
+
+	if !strings.EqualFold(to, c.storageAPIVersion) {
+		result, err = c.convert(ctx, resourceID, result, to, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert from API version %q to %q: %w", c.storageAPIVersion, to, err)
+		}
+	}
+
+	return result, nil
+}
+
+// convert converts properties between the given API version and the storage API version using the conversion
+// declared by the API version.
+func (c *Converter) convert(ctx context.Context, resourceID string, properties map[string]any, apiVersion string, toStorage bool) (map[string]any, error) {
+	conversion := c.conversions[strings.ToLower(apiVersion)]
+	if conversion == nil {
+		return properties, nil
+	}
+
+	if conversion.Webhook != nil && conversion.Webhook.URL != nil {
+		request := WebhookRequest{ID: resourceID, FromAPIVersion: c.storageAPIVersion, ToAPIVersion: apiVersion, Properties: properties}
+		if toStorage {
+			request.FromAPIVersion, request.ToAPIVersion = apiVersion, c.storageAPIVersion
+		}
+
+		return c.callWebhook(ctx, *conversion.Webhook.URL, request)
+	}
+
+	if toStorage {
+		err := applyRules(properties, conversion.Rules)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err := revertRules(properties, conversion.Rules)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return properties, nil
+}
+
+func (c *Converter) callWebhook(ctx context.Context, url string, request WebhookRequest) (map[string]any, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call the conversion webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response of the conversion webhook: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("the conversion webhook returned status code %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	response := WebhookResponse{}
+	err = json.Unmarshal(data, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the response of the conversion webhook: %w", err)
+	}
+
+	if response.Properties == nil {
+		response.Properties = map[string]any{}
+	}
+
+	return response.Properties, nil
+}
+
+// load fetches the storage API version and the conversions of the resource type from UCP.
+func (c *Converter) load(ctx context.Context) error {
+	if c.loaded {
+		return nil
+	}
+
+	planeName := strings.TrimPrefix(c.id.PlaneNamespace(), "radius/")
+	resourceProvider, resourceTypeName, ok := strings.Cut(c.id.Type(), "/")
+	if !ok {
+		return fmt.Errorf("invalid resource type %q", c.id.Type())
+	}
+
+	response, err := c.ucp.NewResourceTypesClient().Get(ctx, planeName, resourceProvider, resourceTypeName, nil)
+	if clientv2.Is404Error(err) {
+		// Unregistered resource types are reported when the resource is validated.
+		c.loaded = true
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to fetch resource type %q: %w", c.id.Type(), err)
+	}
+
+	if response.Properties == nil || response.Properties.StorageAPIVersion == nil {
+		c.loaded = true
+		return nil
+	}
+
+	conversions := map[string]*v20231001preview.APIVersionConversion{}
+	pager := c.ucp.NewAPIVersionsClient().NewListPager(planeName, resourceProvider, resourceTypeName, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to fetch the API versions of resource type %q: %w", c.id.Type(), err)
+		}
+
+		for _, apiVersion := range page.Value {
+			if apiVersion == nil || apiVersion.Name == nil || apiVersion.Properties == nil || apiVersion.Properties.Conversion == nil {
+				continue
+			}
+
+			conversions[strings.ToLower(*apiVersion.Name)] = apiVersion.Properties.Conversion
+		}
+	}
+
+	c.storageAPIVersion = *response.Properties.StorageAPIVersion
+	c.conversions = conversions
+	c.loaded = true
+	return nil
+}
+
+// deepCopy makes a copy of the properties by round-tripping them through JSON.
+func deepCopy(properties map[string]any) (map[string]any, error) {
+	bs, err := json.Marshal(properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal properties: %w", err)
+	}
+
+	result := map[string]any{}
+	err = json.Unmarshal(bs, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal properties: %w", err)
+	}
+
+	return result, nil
+}
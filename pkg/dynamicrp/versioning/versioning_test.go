@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package versioning
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	ucpfake "github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Test.Resource/testResources/test-resource"
+)
+
+func newTestUCPClient(t *testing.T, storageAPIVersion *string, apiVersions map[string]*v20231001preview.APIVersionConversion) *v20231001preview.ClientFactory {
+	serverFactory := ucpfake.ServerFactory{
+		ResourceTypesServer: ucpfake.ResourceTypesServer{
+			Get: func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetResponse], errResp azfake.ErrorResponder) {
+				if resourceProviderName != "Test.Resource" || resourceTypeName != "testResources" {
+					errResp.SetResponseError(http.StatusNotFound, "NotFound")
+					return
+				}
+
+				resp.SetResponse(http.StatusOK, v20231001preview.ResourceTypesClientGetResponse{
+					ResourceTypeResource: v20231001preview.ResourceTypeResource{
+						Name:       to.Ptr(resourceTypeName),
+						Properties: &v20231001preview.ResourceTypeProperties{StorageAPIVersion: storageAPIVersion},
+					},
+				}, nil)
+				return
+			},
+		},
+		APIVersionsServer: ucpfake.APIVersionsServer{
+			NewListPager: func(planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+				value := []*v20231001preview.APIVersionResource{}
+				for name, conversion := range apiVersions {
+					value = append(value, &v20231001preview.APIVersionResource{
+						Name:       to.Ptr(name),
+						Properties: &v20231001preview.APIVersionProperties{Conversion: conversion},
+					})
+				}
+
+				resp.AddPage(http.StatusOK, v20231001preview.APIVersionsClientListResponse{
+					APIVersionResourceListResult: v20231001preview.APIVersionResourceListResult{Value: value},
+				}, nil)
+				return
+			},
+		},
+	}
+
+	clientFactory, err := v20231001preview.NewClientFactory(&azfake.TokenCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{Transport: ucpfake.NewServerFactoryTransport(&serverFactory)},
+	})
+	require.NoError(t, err)
+	return clientFactory
+}
+
+func Test_Converter_Rules(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	ucp := newTestUCPClient(t, to.Ptr("2025-01-01"), map[string]*v20231001preview.APIVersionConversion{
+		"2024-01-01": {
+			Rules: []*v20231001preview.APIVersionConversionRule{
+				{Kind: to.Ptr(v20231001preview.APIVersionConversionRuleKindRename), From: to.Ptr("hostname"), To: to.Ptr("database.host")},
+				{Kind: to.Ptr(v20231001preview.APIVersionConversionRuleKindDefault), Path: to.Ptr("database.port"), Value: float64(5432)},
+			},
+		},
+		"2025-01-01": nil,
+	})
+
+	converter := NewConverter(ucp, http.DefaultClient, resources.MustParse(testResourceID))
+
+	storageAPIVersion, err := converter.StorageAPIVersion(ctx, "2024-01-01")
+	require.NoError(t, err)
+	require.Equal(t, "2025-01-01", storageAPIVersion)
+
+	legacy := map[string]any{"hostname": "example.com", "environment": "env"}
+
+	stored, err := converter.Convert(ctx, testResourceID, legacy, "2024-01-01", "2025-01-01")
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"database": map[string]any{"host": "example.com", "port": float64(5432)}, "environment": "env"}, stored)
+
+	// The input is not modified.
+	require.Equal(t, map[string]any{"hostname": "example.com", "environment": "env"}, legacy)
+
+	// An explicit value is not overwritten by a default.
+	stored, err = converter.Convert(ctx, testResourceID, map[string]any{"hostname": "example.com", "database": map[string]any{"port": float64(3306)}}, "2024-01-01", "2025-01-01")
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"database": map[string]any{"host": "example.com", "port": float64(3306)}}, stored)
+
+	// Converting back removes the defaulted property and the objects that become empty.
+	converted, err := converter.Convert(ctx, testResourceID, map[string]any{"database": map[string]any{"host": "example.com", "port": float64(5432)}, "environment": "env"}, "2025-01-01", "2024-01-01")
+	require.NoError(t, err)
+	require.Equal(t, legacy, converted)
+
+	// API versions without a conversion are identical to the storage API version.
+	converted, err = converter.Convert(ctx, testResourceID, stored, "2025-01-01", "2025-06-01")
+	require.NoError(t, err)
+	require.Equal(t, stored, converted)
+
+	// A property that cannot be set is reported.
+	_, err = converter.Convert(ctx, testResourceID, map[string]any{"hostname": "example.com", "database": "postgres"}, "2024-01-01", "2025-01-01")
+	require.ErrorContains(t, err, `cannot set property "database.host" because "database" is not an object`)
+}
+
+func Test_Converter_Webhook(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	requests := []WebhookRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := WebhookRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		requests = append(requests, request)
+
+		if request.ToAPIVersion == "2025-01-01" {
+			_ = json.NewEncoder(w).Encode(WebhookResponse{Properties: map[string]any{"host": request.Properties["hostname"]}})
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(WebhookResponse{Properties: map[string]any{"hostname": request.Properties["host"]}})
+	}))
+	defer server.Close()
+
+	ucp := newTestUCPClient(t, to.Ptr("2025-01-01"), map[string]*v20231001preview.APIVersionConversion{
+		"2024-01-01": {Webhook: &v20231001preview.APIVersionConversionWebhook{URL: to.Ptr(server.URL)}},
+	})
+
+	converter := NewConverter(ucp, server.Client(), resources.MustParse(testResourceID))
+
+	stored, err := converter.Convert(ctx, testResourceID, map[string]any{"hostname": "example.com"}, "2024-01-01", "2025-01-01")
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"host": "example.com"}, stored)
+
+	converted, err := converter.Convert(ctx, testResourceID, stored, "2025-01-01", "2024-01-01")
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"hostname": "example.com"}, converted)
+
+	expected := []WebhookRequest{
+		{ID: testResourceID, FromAPIVersion: "2024-01-01", ToAPIVersion: "2025-01-01", Properties: map[string]any{"hostname": "example.com"}},
+		{ID: testResourceID, FromAPIVersion: "2025-01-01", ToAPIVersion: "2024-01-01", Properties: map[string]any{"host": "example.com"}},
+	}
+	require.Equal(t, expected, requests)
+}
+
+func Test_Converter_WebhookError(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unsupported", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	ucp := newTestUCPClient(t, to.Ptr("2025-01-01"), map[string]*v20231001preview.APIVersionConversion{
+		"2024-01-01": {Webhook: &v20231001preview.APIVersionConversionWebhook{URL: to.Ptr(server.URL)}},
+	})
+
+	converter := NewConverter(ucp, server.Client(), resources.MustParse(testResourceID))
+
+	_, err := converter.Convert(ctx, testResourceID, map[string]any{"hostname": "example.com"}, "2024-01-01", "2025-01-01")
+	require.ErrorContains(t, err, "the conversion webhook returned status code 400: unsupported")
+}
+
+func Test_Converter_NoStorageAPIVersion(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	ucp := newTestUCPClient(t, nil, nil)
+	converter := NewConverter(ucp, http.DefaultClient, resources.MustParse(testResourceID))
+
+	storageAPIVersion, err := converter.StorageAPIVersion(ctx, "2024-01-01")
+	require.NoError(t, err)
+	require.Equal(t, "2024-01-01", storageAPIVersion)
+
+	properties := map[string]any{"hostname": "example.com"}
+	converted, err := converter.Convert(ctx, testResourceID, properties, "2024-01-01", "2025-01-01")
+	require.NoError(t, err)
+	require.Equal(t, properties, converted)
+}
+
+func Test_Converter_UnregisteredResourceType(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	ucp := newTestUCPClient(t, to.Ptr("2025-01-01"), nil)
+	converter := NewConverter(ucp, http.DefaultClient, resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Test.Resource/otherResources/test-resource"))
+
+	storageAPIVersion, err := converter.StorageAPIVersion(ctx, "2024-01-01")
+	require.NoError(t, err)
+	require.Equal(t, "2024-01-01", storageAPIVersion)
+}
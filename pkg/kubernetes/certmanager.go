@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+// CertManagerGroup is the API group of cert-manager.
+//
+// Radius renders cert-manager resources as unstructured objects, so the group, versions and kinds of the
+// resources are declared here rather than imported from the cert-manager module.
+const CertManagerGroup = "cert-manager.io"
+
+var (
+	// CertManagerCertificateGVK is the GroupVersionKind of a cert-manager Certificate.
+	CertManagerCertificateGVK = schema.GroupVersionKind{Group: CertManagerGroup, Version: "v1", Kind: resources_kubernetes.KindCertManagerCertificate}
+	// CertManagerIssuerGVK is the GroupVersionKind of a cert-manager Issuer.
+	CertManagerIssuerGVK = schema.GroupVersionKind{Group: CertManagerGroup, Version: "v1", Kind: resources_kubernetes.KindCertManagerIssuer}
+
+	// CertManagerCertificateGVR is the GroupVersionResource of a cert-manager Certificate.
+	CertManagerCertificateGVR = CertManagerCertificateGVK.GroupVersion().WithResource("certificates")
+	// CertManagerIssuerGVR is the GroupVersionResource of a cert-manager Issuer.
+	CertManagerIssuerGVR = CertManagerIssuerGVK.GroupVersion().WithResource("issuers")
+)
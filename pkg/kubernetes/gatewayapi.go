@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
+)
+
+// GatewayAPIGroup is the API group of the Kubernetes Gateway API.
+//
+// Radius renders Gateway API resources as unstructured objects, so the group, versions and kinds of the
+// resources are declared here rather than imported from the Gateway API module.
+const GatewayAPIGroup = "gateway.networking.k8s.io"
+
+// EnvoyGatewayGroup is the API group of the Envoy Gateway extensions to the Gateway API. The authentication, rate
+// limiting and CORS policies of gateways are rendered as Envoy Gateway policies, because the Gateway API does not
+// declare them.
+const EnvoyGatewayGroup = "gateway.envoyproxy.io"
+
+var (
+	// GatewayAPIGatewayGVK is the GroupVersionKind of a Gateway API Gateway.
+	GatewayAPIGatewayGVK = schema.GroupVersionKind{Group: GatewayAPIGroup, Version: "v1", Kind: resources_kubernetes.KindGatewayAPIGateway}
+	// GatewayAPIHTTPRouteGVK is the GroupVersionKind of a Gateway API HTTPRoute.
+	GatewayAPIHTTPRouteGVK = schema.GroupVersionKind{Group: GatewayAPIGroup, Version: "v1", Kind: resources_kubernetes.KindGatewayAPIHTTPRoute}
+	// GatewayAPITLSRouteGVK is the GroupVersionKind of a Gateway API TLSRoute. TLSRoute is only available in the
+	// experimental channel of the Gateway API.
+	GatewayAPITLSRouteGVK = schema.GroupVersionKind{Group: GatewayAPIGroup, Version: "v1alpha2", Kind: resources_kubernetes.KindGatewayAPITLSRoute}
+	// GatewayAPIReferenceGrantGVK is the GroupVersionKind of a Gateway API ReferenceGrant.
+	GatewayAPIReferenceGrantGVK = schema.GroupVersionKind{Group: GatewayAPIGroup, Version: "v1beta1", Kind: resources_kubernetes.KindGatewayAPIReferenceGrant}
+
+	// EnvoyGatewaySecurityPolicyGVK is the GroupVersionKind of an Envoy Gateway SecurityPolicy.
+	EnvoyGatewaySecurityPolicyGVK = schema.GroupVersionKind{Group: EnvoyGatewayGroup, Version: "v1alpha1", Kind: resources_kubernetes.KindEnvoyGatewaySecurityPolicy}
+	// EnvoyGatewayBackendTrafficPolicyGVK is the GroupVersionKind of an Envoy Gateway BackendTrafficPolicy.
+	EnvoyGatewayBackendTrafficPolicyGVK = schema.GroupVersionKind{Group: EnvoyGatewayGroup, Version: "v1alpha1", Kind: resources_kubernetes.KindEnvoyGatewayBackendTrafficPolicy}
+
+	// GatewayAPIGatewayGVR is the GroupVersionResource of a Gateway API Gateway.
+	GatewayAPIGatewayGVR = GatewayAPIGatewayGVK.GroupVersion().WithResource("gateways")
+	// GatewayAPIHTTPRouteGVR is the GroupVersionResource of a Gateway API HTTPRoute.
+	GatewayAPIHTTPRouteGVR = GatewayAPIHTTPRouteGVK.GroupVersion().WithResource("httproutes")
+	// GatewayAPITLSRouteGVR is the GroupVersionResource of a Gateway API TLSRoute.
+	GatewayAPITLSRouteGVR = GatewayAPITLSRouteGVK.GroupVersion().WithResource("tlsroutes")
+)
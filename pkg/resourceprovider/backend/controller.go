@@ -0,0 +1,113 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/resourceprovider"
+)
+
+// PutController is the async operation controller to perform PUT processing on the resources of an external
+// resource provider.
+type PutController struct {
+	ctrl.BaseController
+	handler resourceprovider.ResourceHandler
+}
+
+// NewPutController creates a new PutController that provisions resources with the given handler.
+func NewPutController(opts ctrl.Options, handler resourceprovider.ResourceHandler) (ctrl.Controller, error) {
+	return &PutController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		handler:        handler,
+	}, nil
+}
+
+// Run provisions the resource with the handler and stores the changes that the handler made to the resource.
+func (c *PutController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	obj, err := c.DatabaseClient().Get(ctx, request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	resource := &resourceprovider.Resource{}
+	err = obj.As(resource)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = c.handler.Put(ctx, resource)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	update := &database.Object{
+		Metadata: database.Metadata{
+			ID: request.ResourceID,
+		},
+		Data: resource,
+	}
+	err = c.DatabaseClient().Save(ctx, update, database.WithETag(obj.ETag))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// DeleteController is the async operation controller to perform DELETE processing on the resources of an external
+// resource provider.
+type DeleteController struct {
+	ctrl.BaseController
+	handler resourceprovider.ResourceHandler
+}
+
+// NewDeleteController creates a new DeleteController that deletes resources with the given handler.
+func NewDeleteController(opts ctrl.Options, handler resourceprovider.ResourceHandler) (ctrl.Controller, error) {
+	return &DeleteController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		handler:        handler,
+	}, nil
+}
+
+// Run deletes the resource with the handler and then removes the resource from the database.
+func (c *DeleteController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	obj, err := c.DatabaseClient().Get(ctx, request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	resource := &resourceprovider.Resource{}
+	err = obj.As(resource)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = c.handler.Delete(ctx, resource)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = c.DatabaseClient().Delete(ctx, request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
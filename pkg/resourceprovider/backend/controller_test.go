@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/resourceprovider"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testResourceID = "/planes/radius/local/resourceGroups/test-group/providers/Test.Resources/widgets/my-widget"
+)
+
+func setupDatabase(t *testing.T) *database.MockClient {
+	databaseClient := database.NewMockClient(gomock.NewController(t))
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(&database.Object{
+			Metadata: database.Metadata{ID: testResourceID, ETag: "etag"},
+			Data:     map[string]any{"id": testResourceID, "properties": map[string]any{"size": "small"}},
+		}, nil).
+		Times(1)
+
+	return databaseClient
+}
+
+func Test_PutController_Run(t *testing.T) {
+	databaseClient := setupDatabase(t)
+	handler := resourceprovider.ResourceHandlerFuncs{
+		PutFunc: func(ctx context.Context, resource *resourceprovider.Resource) error {
+			resource.Properties["endpoint"] = "https://example.com"
+			return nil
+		},
+	}
+
+	controller, err := NewPutController(ctrl.Options{DatabaseClient: databaseClient}, handler)
+	require.NoError(t, err)
+
+	// The changes made by the handler are saved.
+	databaseClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, options ...database.SaveOptions) error {
+			resource := obj.Data.(*resourceprovider.Resource)
+			require.Equal(t, map[string]any{"size": "small", "endpoint": "https://example.com"}, resource.Properties)
+			return nil
+		}).
+		Times(1)
+
+	result, err := controller.Run(testcontext.New(t), &ctrl.Request{ResourceID: testResourceID})
+	require.NoError(t, err)
+	require.Equal(t, ctrl.Result{}, result)
+}
+
+func Test_PutController_Run_HandlerError(t *testing.T) {
+	databaseClient := setupDatabase(t)
+	handler := resourceprovider.ResourceHandlerFuncs{
+		PutFunc: func(ctx context.Context, resource *resourceprovider.Resource) error {
+			return errors.New("failed to provision")
+		},
+	}
+
+	controller, err := NewPutController(ctrl.Options{DatabaseClient: databaseClient}, handler)
+	require.NoError(t, err)
+
+	_, err = controller.Run(testcontext.New(t), &ctrl.Request{ResourceID: testResourceID})
+	require.EqualError(t, err, "failed to provision")
+}
+
+func Test_DeleteController_Run(t *testing.T) {
+	databaseClient := setupDatabase(t)
+
+	deleted := []string{}
+	handler := resourceprovider.ResourceHandlerFuncs{
+		DeleteFunc: func(ctx context.Context, resource *resourceprovider.Resource) error {
+			deleted = append(deleted, resource.ID)
+			return nil
+		},
+	}
+
+	controller, err := NewDeleteController(ctrl.Options{DatabaseClient: databaseClient}, handler)
+	require.NoError(t, err)
+
+	databaseClient.EXPECT().Delete(gomock.Any(), testResourceID).Return(nil).Times(1)
+
+	result, err := controller.Run(testcontext.New(t), &ctrl.Request{ResourceID: testResourceID})
+	require.NoError(t, err)
+	require.Equal(t, ctrl.Result{}, result)
+	require.Equal(t, []string{testResourceID}, deleted)
+}
+
+func Test_DeleteController_Run_HandlerError(t *testing.T) {
+	databaseClient := setupDatabase(t)
+	handler := resourceprovider.ResourceHandlerFuncs{
+		DeleteFunc: func(ctx context.Context, resource *resourceprovider.Resource) error {
+			return errors.New("failed to delete")
+		},
+	}
+
+	controller, err := NewDeleteController(ctrl.Options{DatabaseClient: databaseClient}, handler)
+	require.NoError(t, err)
+
+	// The resource is not removed from the database.
+	_, err = controller.Run(testcontext.New(t), &ctrl.Request{ResourceID: testResourceID})
+	require.EqualError(t, err, "failed to delete")
+}
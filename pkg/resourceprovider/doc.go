@@ -0,0 +1,30 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resourceprovider is an SDK for building external resource providers.
+//
+// A resource provider manifest can set the location of a resource provider to the address of an external server,
+// in which case UCP proxies the requests for the resource types of the resource provider to that server. This package
+// implements the ARM-RPC contract that UCP expects from such a server:
+//
+//   - PUT, GET, LIST and DELETE of resources, stored in the database of the resource provider.
+//   - Asynchronous PUT and DELETE operations, with operation statuses and results.
+//   - Validation of resources against the schema of the resource type that is registered in UCP.
+//
+// The implementation of a resource provider only provides a ResourceHandler for each resource type, which is called
+// by the asynchronous operation worker to provision and delete resources. The server package hosts the resource
+// provider, and the testhost package runs it together with an in-process instance of UCP for testing.
+package resourceprovider
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+	"github.com/radius-project/radius/pkg/resourceprovider"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// NewSchemaFilter returns an update filter that validates the resource against the schema that is registered in UCP
+// for the resource type and the API version of the request. Properties that are missing from the request are set to
+// the default value declared by the schema before the resource is validated.
+//
+// Requests for API versions that are not registered are rejected.
+func NewSchemaFilter(ucp *v20231001preview.ClientFactory) controller.UpdateFilter[resourceprovider.Resource] {
+	return func(ctx context.Context, newResource *resourceprovider.Resource, oldResource *resourceprovider.Resource, options *controller.Options) (rest.Response, error) {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		resourceSchema, err := schema.GetResourceTypeSchema(ctx, ucp, serviceCtx.ResourceID.String(), serviceCtx.ResourceID.Type(), serviceCtx.APIVersion)
+		if clientv2.Is404Error(err) {
+			return rest.NewBadRequestResponse(fmt.Sprintf("API version %q of resource type %q is not registered", serviceCtx.APIVersion, serviceCtx.ResourceID.Type())), nil
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to fetch the schema of resource type %q: %w", serviceCtx.ResourceID.Type(), err)
+		}
+
+		// The API version does not declare a schema, so the properties are not validated.
+		if resourceSchema == nil {
+			return nil, nil
+		}
+
+		if newResource.Properties == nil {
+			newResource.Properties = map[string]any{}
+		}
+		schema.ApplyDefaults(newResource.Properties, resourceSchema)
+
+		err = schema.ValidateResourceAgainstSchema(ctx, map[string]any{"properties": newResource.Properties}, resourceSchema)
+		if err != nil {
+			return rest.NewBadRequestARMResponse(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeInvalidRequestContent,
+					Message: fmt.Sprintf("Schema validation failed: %v", err),
+					Target:  serviceCtx.ResourceID.String(),
+				},
+			}), nil
+		}
+
+		return nil, nil
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/radius-project/radius/pkg/armrpc/builder"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	"github.com/radius-project/radius/pkg/resourceprovider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Service implements the hosting.Service interface for the API of an external resource provider.
+type Service struct {
+	options *resourceprovider.Options
+	builder builder.Builder
+}
+
+// NewService creates a new service to run the API of an external resource provider. The API handlers are
+// registered from the builder.
+func NewService(options *resourceprovider.Options, builder builder.Builder) *Service {
+	return &Service{
+		options: options,
+		builder: builder,
+	}
+}
+
+// Name gets this service name.
+func (s *Service) Name() string {
+	return s.builder.Namespace() + " api"
+}
+
+func (s *Service) initialize(ctx context.Context) (*http.Server, error) {
+	databaseClient, err := s.options.DatabaseProvider.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database client: %w", err)
+	}
+
+	controllerOptions := controller.Options{
+		Address:        s.options.Config.Server.Address(),
+		PathBase:       s.options.Config.Server.PathBase,
+		DatabaseClient: databaseClient,
+		StatusManager:  s.options.StatusManager,
+	}
+
+	return server.New(ctx, server.Options{
+		ServiceName: s.builder.Namespace(),
+		Location:    s.options.Config.Environment.RoleLocation,
+		Address:     s.options.Config.Server.Address(),
+		PathBase:    s.options.Config.Server.PathBase,
+		Configure: func(r chi.Router) error {
+			return s.builder.ApplyAPIHandlers(ctx, r, controllerOptions)
+		},
+	})
+}
+
+// Run sets up a server to listen on a given address, and shuts it down when the context is done. It returns an
+// error if the server fails to start or stops unexpectedly.
+func (s *Service) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	server, err := s.initialize(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Handle shutdown based on the context
+	go func() {
+		<-ctx.Done()
+		// We don't care about shutdown errors
+		_ = server.Shutdown(ctx)
+	}()
+
+	logger.Info(fmt.Sprintf("listening on: '%s'...", server.Addr))
+	err = server.ListenAndServe()
+	if err == http.ErrServerClosed {
+		// We expect this, safe to ignore.
+		logger.Info("Server stopped...")
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	logger.Info("Server stopped...")
+	return nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integrationtest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/resourceprovider"
+	"github.com/radius-project/radius/pkg/resourceprovider/testhost"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	apiVersion      = "2025-01-01-preview"
+	testResourceID  = testhost.ResourceGroupID + "/providers/Test.Resources/widgets/my-widget"
+	testResourceURL = testResourceID + "?api-version=" + apiVersion
+)
+
+// widgetHandler is a resource handler that populates the endpoint of widgets and records the deleted widgets.
+type widgetHandler struct {
+	mutex   sync.Mutex
+	deleted []string
+}
+
+func (h *widgetHandler) Put(ctx context.Context, resource *resourceprovider.Resource) error {
+	if fail, ok := resource.Properties["fail"].(bool); ok && fail {
+		return errors.New("the widget could not be provisioned")
+	}
+
+	resource.Properties["endpoint"] = "https://" + resource.Name + ".example.com"
+	return nil
+}
+
+func (h *widgetHandler) Delete(ctx context.Context, resource *resourceprovider.Resource) error {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.deleted = append(h.deleted, resource.ID)
+	return nil
+}
+
+func Test_ResourceProvider_Lifecycle(t *testing.T) {
+	handler := &widgetHandler{}
+	provider := &resourceprovider.ResourceProvider{
+		Namespace: "Test.Resources",
+		ResourceTypes: map[string]resourceprovider.ResourceHandler{
+			"widgets": handler,
+		},
+	}
+
+	_, ucp := testhost.Start(t, provider, "testdata/manifest.yaml")
+
+	// Requests are proxied by UCP to the resource provider.
+	response := ucp.MakeTypedRequest(http.MethodPut, testResourceURL, map[string]any{
+		"properties": map[string]any{},
+	})
+	response.EqualsStatusCode(http.StatusCreated)
+	response.WaitForOperationComplete(nil)
+
+	// The default of the schema is applied, and the handler populates the endpoint.
+	expectedResource := map[string]any{
+		"id":       "/planes/radius/local/resourcegroups/test-group/providers/Test.Resources/widgets/my-widget",
+		"location": "global",
+		"name":     "my-widget",
+		"properties": map[string]any{
+			"size":              "small",
+			"endpoint":          "https://my-widget.example.com",
+			"provisioningState": "Succeeded",
+		},
+		"type": "Test.Resources/widgets",
+	}
+
+	response = ucp.MakeRequest(http.MethodGet, testResourceURL, nil)
+	response.EqualsValue(http.StatusOK, expectedResource)
+
+	response = ucp.MakeRequest(http.MethodGet, testhost.ResourceGroupID+"/providers/Test.Resources/widgets?api-version="+apiVersion, nil)
+	response.EqualsValue(http.StatusOK, map[string]any{"value": []any{expectedResource}})
+
+	response = ucp.MakeRequest(http.MethodDelete, testResourceURL, nil)
+	response.WaitForOperationComplete(nil)
+
+	response = ucp.MakeRequest(http.MethodGet, testResourceURL, nil)
+	response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+
+	require.Equal(t, []string{expectedResource["id"].(string)}, handler.deleted)
+}
+
+func Test_ResourceProvider_SchemaValidation(t *testing.T) {
+	provider := &resourceprovider.ResourceProvider{
+		Namespace: "Test.Resources",
+		ResourceTypes: map[string]resourceprovider.ResourceHandler{
+			"widgets": &widgetHandler{},
+		},
+	}
+
+	_, ucp := testhost.Start(t, provider, "testdata/manifest.yaml")
+
+	response := ucp.MakeTypedRequest(http.MethodPut, testResourceURL, map[string]any{
+		"properties": map[string]any{
+			"size": "huge",
+		},
+	})
+	response.EqualsErrorCode(http.StatusBadRequest, v1.CodeInvalidRequestContent)
+	require.Contains(t, response.Error.Error.Message, "Schema validation failed")
+
+	// The resource was not created.
+	response = ucp.MakeRequest(http.MethodGet, testResourceURL, nil)
+	response.EqualsErrorCode(http.StatusNotFound, v1.CodeNotFound)
+}
+
+func Test_ResourceProvider_HandlerFailure(t *testing.T) {
+	provider := &resourceprovider.ResourceProvider{
+		Namespace: "Test.Resources",
+		ResourceTypes: map[string]resourceprovider.ResourceHandler{
+			"widgets": &widgetHandler{},
+		},
+	}
+
+	_, ucp := testhost.Start(t, provider, "testdata/manifest.yaml")
+
+	response := ucp.MakeTypedRequest(http.MethodPut, testResourceURL, map[string]any{
+		"properties": map[string]any{
+			"fail": true,
+		},
+	})
+	response.WaitForOperationComplete(nil)
+
+	// The error returned by the handler is reported in the operation status.
+	response = ucp.MakeRequest(http.MethodGet, response.Raw.Header.Get("Azure-AsyncOperation"), nil)
+
+	operationStatus := v1.AsyncOperationStatus{}
+	response.ReadAs(&operationStatus)
+	require.Equal(t, v1.ProvisioningStateFailed, operationStatus.Status)
+	require.NotNil(t, operationStatus.Error)
+	require.Equal(t, v1.CodeInternal, operationStatus.Error.Code)
+	require.Contains(t, operationStatus.Error.Message, "the widget could not be provisioned")
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceprovider
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
+	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
+	"github.com/radius-project/radius/pkg/sdk"
+	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
+)
+
+// Options holds the configuration options and shared services for the server of an external resource provider.
+//
+// For testability, all fields on this struct MUST be constructed from the NewOptions function without any
+// additional initialization required.
+type Options struct {
+	// Config is the configuration for the server.
+	Config *Config
+
+	// DatabaseProvider provides access to the database.
+	DatabaseProvider *databaseprovider.DatabaseProvider
+
+	// KubernetesProvider provides access to the Kubernetes clients.
+	KubernetesProvider *kubernetesclientprovider.KubernetesClientProvider
+
+	// QueueProvider provides access to the message queue client.
+	QueueProvider *queueprovider.QueueProvider
+
+	// StatusManager implements operations on async operation statuses.
+	StatusManager statusmanager.StatusManager
+
+	// UCP is the connection to UCP
+	UCP sdk.Connection
+}
+
+// NewOptions creates a new Options instance from the given configuration.
+func NewOptions(ctx context.Context, config *Config) (*Options, error) {
+	var err error
+	options := Options{
+		Config: config,
+	}
+
+	options.QueueProvider = queueprovider.New(config.Queue)
+	options.DatabaseProvider = databaseprovider.FromOptions(config.Database)
+
+	databaseClient, err := options.DatabaseProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queueClient, err := options.QueueProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	options.StatusManager = statusmanager.New(databaseClient, queueClient, config.Environment.RoleLocation)
+
+	options.KubernetesProvider, err = kubernetesclientprovider.FromOptions(config.Kubernetes)
+	if err != nil {
+		return nil, err
+	}
+
+	options.UCP, err = ucpconfig.NewConnectionFromUCPConfig(&config.UCP, options.KubernetesProvider.Config())
+	if err != nil {
+		return nil, err
+	}
+
+	return &options, nil
+}
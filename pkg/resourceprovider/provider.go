@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceprovider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+)
+
+// Resource is the data model of the resources of an external resource provider. The properties of the resource are
+// defined by the schema of the resource type.
+type Resource = datamodel.DynamicResource
+
+// ResourceHandler implements the provisioning of the resources of a resource type.
+//
+// The handler is called by the asynchronous operation worker after the resource has been validated and stored. An
+// error returned by the handler fails the operation, and the error message is reported in the operation status.
+// Return a *v1.ErrClientRP to report an error code other than "Internal".
+type ResourceHandler interface {
+	// Put provisions the resource. Changes made to the properties of the resource, e.g. to populate read-only
+	// properties, are stored when Put returns successfully.
+	Put(ctx context.Context, resource *Resource) error
+
+	// Delete deletes the resource. The resource is removed from the database when Delete returns successfully.
+	Delete(ctx context.Context, resource *Resource) error
+}
+
+// ResourceHandlerFuncs implements ResourceHandler with functions. A nil function is a no-op.
+type ResourceHandlerFuncs struct {
+	// PutFunc is called by Put.
+	PutFunc func(ctx context.Context, resource *Resource) error
+
+	// DeleteFunc is called by Delete.
+	DeleteFunc func(ctx context.Context, resource *Resource) error
+}
+
+// Put implements ResourceHandler.
+func (h ResourceHandlerFuncs) Put(ctx context.Context, resource *Resource) error {
+	if h.PutFunc == nil {
+		return nil
+	}
+
+	return h.PutFunc(ctx, resource)
+}
+
+// Delete implements ResourceHandler.
+func (h ResourceHandlerFuncs) Delete(ctx context.Context, resource *Resource) error {
+	if h.DeleteFunc == nil {
+		return nil
+	}
+
+	return h.DeleteFunc(ctx, resource)
+}
+
+// ResourceProvider describes the resource types implemented by an external resource provider.
+type ResourceProvider struct {
+	// Namespace is the namespace of the resource provider, e.g. "MyCompany.Resources". It must match the namespace of
+	// the resource provider manifest.
+	Namespace string
+
+	// ResourceTypes maps the name of each resource type, without the namespace (e.g. "widgets"), to the handler that
+	// provisions its resources.
+	ResourceTypes map[string]ResourceHandler
+}
+
+// Validate validates that required fields are set on the resource provider.
+func (p *ResourceProvider) Validate() error {
+	var err error
+	if p.Namespace == "" {
+		err = errors.Join(err, errors.New(".Namespace is required"))
+	}
+	if len(p.ResourceTypes) == 0 {
+		err = errors.Join(err, errors.New(".ResourceTypes must contain at least one resource type"))
+	}
+	for name, handler := range p.ResourceTypes {
+		if handler == nil {
+			err = errors.Join(err, errors.New(".ResourceTypes["+name+"] must not be nil"))
+		}
+	}
+
+	return err
+}
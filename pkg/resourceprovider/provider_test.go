@@ -0,0 +1,40 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceprovider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResourceProvider_Validate(t *testing.T) {
+	valid := &ResourceProvider{
+		Namespace:     "Test.Resources",
+		ResourceTypes: map[string]ResourceHandler{"widgets": ResourceHandlerFuncs{}},
+	}
+	require.NoError(t, valid.Validate())
+
+	invalid := &ResourceProvider{
+		ResourceTypes: map[string]ResourceHandler{"widgets": nil},
+	}
+	err := invalid.Validate()
+	require.ErrorContains(t, err, ".Namespace is required")
+	require.ErrorContains(t, err, ".ResourceTypes[widgets] must not be nil")
+
+	require.ErrorContains(t, (&ResourceProvider{Namespace: "Test.Resources"}).Validate(), ".ResourceTypes must contain at least one resource type")
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	asyncctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/armrpc/builder"
+	apictrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/resourceprovider"
+	"github.com/radius-project/radius/pkg/resourceprovider/backend"
+	"github.com/radius-project/radius/pkg/resourceprovider/frontend"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// newNamespace builds the namespace of the resource provider. Each resource type supports the ARM-RPC operations:
+// LIST, GET, asynchronous PUT and asynchronous DELETE.
+func newNamespace(provider *resourceprovider.ResourceProvider, ucp *v20231001preview.ClientFactory) *builder.Namespace {
+	namespace := builder.NewNamespace(provider.Namespace)
+
+	for name, handler := range provider.ResourceTypes {
+		namespace.AddResource(name, &builder.ResourceOption[*resourceprovider.Resource, resourceprovider.Resource]{
+			RequestConverter:  converter.DynamicResourceDataModelFromVersioned,
+			ResponseConverter: converter.DynamicResourceDataModelToVersioned,
+
+			Put: builder.Operation[resourceprovider.Resource]{
+				UpdateFilters: []apictrl.UpdateFilter[resourceprovider.Resource]{
+					frontend.NewSchemaFilter(ucp),
+				},
+				AsyncJobController: func(opts asyncctrl.Options) (asyncctrl.Controller, error) {
+					return backend.NewPutController(opts, handler)
+				},
+			},
+			Patch: builder.Operation[resourceprovider.Resource]{
+				// PATCH is not part of the contract for resource types defined by a manifest.
+				Disabled: true,
+			},
+			Delete: builder.Operation[resourceprovider.Resource]{
+				AsyncJobController: func(opts asyncctrl.Options) (asyncctrl.Controller, error) {
+					return backend.NewDeleteController(opts, handler)
+				},
+			},
+		})
+	}
+
+	return namespace
+}
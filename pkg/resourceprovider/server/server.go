@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/hosting"
+	"github.com/radius-project/radius/pkg/resourceprovider"
+	"github.com/radius-project/radius/pkg/resourceprovider/backend"
+	"github.com/radius-project/radius/pkg/resourceprovider/frontend"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// NewServer initializes a host for the given external resource provider based on the provided options.
+func NewServer(options *resourceprovider.Options, provider *resourceprovider.ResourceProvider) (*hosting.Host, error) {
+	err := provider.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid resource provider: %w", err)
+	}
+
+	ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(options.UCP))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create UCP client: %w", err)
+	}
+
+	builder := newNamespace(provider, ucp).GenerateBuilder()
+
+	return &hosting.Host{
+		Services: []hosting.Service{
+			frontend.NewService(options, builder),
+			backend.NewService(options, builder, ucp),
+		},
+	}, nil
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testhost
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
+	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
+	"github.com/radius-project/radius/pkg/components/testhost"
+	"github.com/radius-project/radius/pkg/resourceprovider"
+	"github.com/radius-project/radius/pkg/resourceprovider/server"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/config"
+	ucptesthost "github.com/radius-project/radius/pkg/ucp/testhost"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// PlaneName is the name of the radius plane that the resource provider is registered in.
+	PlaneName = "local"
+
+	// ResourceGroupName is the name of the resource group created by the test host.
+	ResourceGroupName = "test-group"
+
+	// ResourceGroupID is the ID of the resource group created by the test host.
+	ResourceGroupID = "/planes/radius/" + PlaneName + "/resourceGroups/" + ResourceGroupName
+)
+
+// TestHostOption supports configuring the resource provider test host.
+type TestHostOption interface {
+	// Apply applies the option to the resource provider options.
+	Apply(options *resourceprovider.Options)
+}
+
+// TestHostOptionFunc is a function that implements the TestHostOption interface.
+type TestHostOptionFunc func(options *resourceprovider.Options)
+
+// Apply applies the function to the resource provider options.
+func (f TestHostOptionFunc) Apply(options *resourceprovider.Options) {
+	f(options)
+}
+
+// TestHost provides a test host for an external resource provider.
+type TestHost struct {
+	*testhost.TestHost
+	options *resourceprovider.Options
+}
+
+// Options provides access to the options of the resource provider server.
+func (th *TestHost) Options() *resourceprovider.Options {
+	return th.options
+}
+
+// Start starts the resource provider test host and an instance of UCP, and registers the resource provider in UCP
+// using the resource provider manifest at the given path. The location of the manifest is replaced with the address
+// of the test host.
+//
+// The test host also creates the resource group ResourceGroupID, so that tests can create resources right away.
+func Start(t *testing.T, provider *resourceprovider.ResourceProvider, manifestPath string, opts ...TestHostOption) (*TestHost, *ucptesthost.TestHost) {
+	config := &resourceprovider.Config{
+		Database: databaseprovider.Options{
+			Provider: databaseprovider.TypeInMemory,
+		},
+		Environment: hostoptions.EnvironmentOptions{
+			Name:         "test",
+			RoleLocation: v1.LocationGlobal,
+		},
+		Kubernetes: kubernetesclientprovider.Options{
+			Kind: kubernetesclientprovider.KindNone,
+		},
+		Queue: queueprovider.QueueProviderOptions{
+			Provider: queueprovider.TypeInmemory,
+			Name:     strings.ToLower(provider.Namespace),
+		},
+		Server: hostoptions.ServerOptions{
+			// Initialized dynamically when the server is started.
+		},
+		UCP: config.UCPOptions{
+			Kind: config.UCPConnectionKindDirect,
+			Direct: &config.UCPDirectConnectionOptions{
+				Endpoint: "http://localhost:65000", // Initialized dynamically when the server is started.
+			},
+		},
+	}
+
+	options, err := resourceprovider.NewOptions(context.Background(), config)
+	require.NoError(t, err)
+
+	for _, opt := range opts {
+		opt.Apply(options)
+	}
+
+	return StartWithOptions(t, options, provider, manifestPath)
+}
+
+// StartWithOptions uses the provided options to start the resource provider test host and an instance of UCP, and
+// registers the resource provider in UCP using the resource provider manifest at the given path.
+//
+// Manually configuring the server information other than the port is not supported.
+func StartWithOptions(t *testing.T, options *resourceprovider.Options, provider *resourceprovider.ResourceProvider, manifestPath string) (*TestHost, *ucptesthost.TestHost) {
+	resourceProvider, err := manifest.ReadFile(manifestPath)
+	require.NoError(t, err, "failed to read resource provider manifest")
+	require.Equal(t, provider.Namespace, resourceProvider.Namespace, "the namespace of the manifest does not match the resource provider")
+
+	options.Config.Server.Host = "localhost"
+	options.Config.Server.PathBase = "/" + uuid.New().String()
+	if options.Config.Server.Port == 0 {
+		options.Config.Server.Port = testhost.AllocateFreePort(t)
+	}
+
+	// Allocate a port for UCP.
+	ucpPort := testhost.AllocateFreePort(t)
+	options.Config.UCP.Kind = config.UCPConnectionKindDirect
+	options.Config.UCP.Direct = &config.UCPDirectConnectionOptions{Endpoint: fmt.Sprintf("http://localhost:%d", ucpPort)}
+
+	options.UCP, err = sdk.NewDirectConnection(options.Config.UCP.Direct.Endpoint)
+	require.NoError(t, err)
+
+	baseURL := fmt.Sprintf(
+		"http://%s%s",
+		options.Config.Server.Address(),
+		options.Config.Server.PathBase)
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	host, err := server.NewServer(options, provider)
+	require.NoError(t, err, "failed to create server")
+
+	th := testhost.StartHost(t, host, baseURL)
+	ucpHost := ucptesthost.Start(t, ucptesthost.TestHostOptionFunc(func(options *ucp.Options) {
+		// Initialize UCP with its listening port
+		options.Config.Server.Port = ucpPort
+	}))
+
+	// Route the requests for the resource provider to the test host.
+	resourceProvider.Location = map[string]string{v1.LocationGlobal: baseURL}
+	register(ucpHost, *resourceProvider)
+
+	return &TestHost{TestHost: th, options: options}, ucpHost
+}
+
+// register creates the radius plane and the resource group used by tests, and registers the resource provider.
+func register(ucpHost *ucptesthost.TestHost, resourceProvider manifest.ResourceProvider) {
+	ctx := context.Background()
+	clientFactory := ucpHost.UCP()
+
+	plane := v20231001preview.RadiusPlaneResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Properties: &v20231001preview.RadiusPlaneResourceProperties{
+			// Note: this is a workaround. Properties is marked as a required field in
+			// the API. Without passing *something* here the body will be rejected.
+			ProvisioningState: to.Ptr(v20231001preview.ProvisioningStateSucceeded),
+			ResourceProviders: map[string]*string{},
+		},
+	}
+
+	poller, err := clientFactory.NewRadiusPlanesClient().BeginCreateOrUpdate(ctx, PlaneName, plane, nil)
+	require.NoError(ucpHost.T(), err)
+	_, err = poller.PollUntilDone(ctx, nil)
+	require.NoError(ucpHost.T(), err)
+
+	err = manifest.RegisterResourceProvider(ctx, clientFactory, PlaneName, resourceProvider, ucpHost.T().Logf)
+	require.NoError(ucpHost.T(), err, "failed to register resource provider")
+
+	resourceGroup := v20231001preview.ResourceGroupResource{
+		Location:   to.Ptr(v1.LocationGlobal),
+		Properties: &v20231001preview.ResourceGroupProperties{},
+	}
+
+	_, err = clientFactory.NewResourceGroupsClient().CreateOrUpdate(ctx, PlaneName, ResourceGroupName, resourceGroup, nil)
+	require.NoError(ucpHost.T(), err)
+}
@@ -52,6 +52,8 @@ const (
 	LocalIDDaprSecretStoreAzureKeyVault   = "DaprSecretStoreAzureKeyVault"
 	LocalIDDaprPubSubBrokerKafka          = "DaprPubSubBrokerKafka"
 	LocalIDDeployment                     = "Deployment"
+	LocalIDJob                            = "Job"
+	LocalIDCronJob                        = "CronJob"
 	LocalIDGateway                        = "Gateway"
 	LocalIDHttpProxy                      = "HttpProxy"
 	LocalIDKeyVault                       = "KeyVault"
@@ -73,6 +75,15 @@ const (
 	LocalIDAzureApplicationGateway        = "AzureApplicationGateway"
 	LocalIDAzureNetworkSecurityGroup      = "AzureNetworkSecurityGroup"
 	LocalIDHttpRoute                      = "HttpRoute"
+	LocalIDTLSRoute                       = "TLSRoute"
+	LocalIDReferenceGrant                 = "ReferenceGrant"
+	LocalIDCertificate                    = "Certificate"
+	LocalIDCertificateIssuer              = "CertificateIssuer"
+	LocalIDCertificateAuthority           = "CertificateAuthority"
+	LocalIDCertificateAuthorityIssuer     = "CertificateAuthorityIssuer"
+	LocalIDExternalAuthService            = "ExternalAuthService"
+	LocalIDSecurityPolicy                 = "SecurityPolicy"
+	LocalIDBackendTrafficPolicy           = "BackendTrafficPolicy"
 	LocalIDAzureAppGWNetworkSecurityGroup = "AzureAppGWNetworkSecurityGroup"
 
 	// Obsolete when we remove AppModelV1
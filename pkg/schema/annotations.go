@@ -38,6 +38,18 @@ import (
 //   - []string: Paths to sensitive fields, or empty slice if none found
 //   - error: Any error encountered while fetching the schema
 func GetSensitiveFieldPaths(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resourceID string, resourceType string, apiVersion string) ([]string, error) {
+	schema, err := GetResourceTypeSchema(ctx, ucpClient, resourceID, resourceType, apiVersion)
+	if err != nil || schema == nil {
+		return nil, err
+	}
+
+	// Extract paths to fields with x-radius-sensitive annotation
+	return ExtractSensitiveFieldPaths(schema, ""), nil
+}
+
+// GetResourceTypeSchema fetches the schema of an API version of a resource type. The resource ID is used to determine
+// the plane of the resource type. Returns nil if the API version does not declare a schema.
+func GetResourceTypeSchema(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resourceID string, resourceType string, apiVersion string) (map[string]any, error) {
 	if ucpClient == nil {
 		return nil, nil
 	}
@@ -59,13 +71,11 @@ func GetSensitiveFieldPaths(ctx context.Context, ucpClient *v20231001preview.Cli
 		return nil, err
 	}
 
-	schema := apiVersionResource.APIVersionResource.Properties.Schema
-	if schema == nil {
+	if apiVersionResource.APIVersionResource.Properties == nil {
 		return nil, nil
 	}
 
-	// Extract paths to fields with x-radius-sensitive annotation
-	return ExtractSensitiveFieldPaths(schema, ""), nil
+	return apiVersionResource.APIVersionResource.Properties.Schema, nil
 }
 
 // ExtractSensitiveFieldPaths recursively walks the schema and returns paths to fields marked with x-radius-sensitive.
@@ -73,6 +83,12 @@ func GetSensitiveFieldPaths(ctx context.Context, ucpClient *v20231001preview.Cli
 // Supports object properties, array items, and additionalProperties (maps).
 // If a field is marked sensitive, its nested properties are not checked since the entire field is considered sensitive.
 func ExtractSensitiveFieldPaths(schema map[string]any, prefix string) []string {
+	return extractFieldPaths(schema, prefix, annotationRadiusSensitive)
+}
+
+// extractFieldPaths recursively walks the schema and returns paths to fields where the given boolean keyword is true.
+// If a field is marked, its nested properties are not checked since the keyword applies to the entire field.
+func extractFieldPaths(schema map[string]any, prefix string, keyword string) []string {
 	var paths []string
 
 	properties, ok := schema["properties"].(map[string]any)
@@ -94,9 +110,9 @@ func ExtractSensitiveFieldPaths(schema map[string]any, prefix string) []string {
 			fullPath = prefix + "." + fieldName
 		}
 
-		// Check if this field has the x-radius-sensitive annotation
-		// If sensitive, add the path and skip nested properties since the entire field is sensitive
-		if isSensitive, ok := fieldSchemaMap[annotationRadiusSensitive].(bool); ok && isSensitive {
+		// Check if this field is marked
+		// If marked, add the path and skip nested properties since the keyword applies to the entire field
+		if marked, ok := fieldSchemaMap[keyword].(bool); ok && marked {
 			paths = append(paths, fullPath)
 			continue
 		}
@@ -104,7 +120,7 @@ func ExtractSensitiveFieldPaths(schema map[string]any, prefix string) []string {
 		// Recursively check nested objects
 		if nestedProps, ok := fieldSchemaMap["properties"].(map[string]any); ok {
 			nestedSchema := map[string]any{"properties": nestedProps}
-			nestedPaths := ExtractSensitiveFieldPaths(nestedSchema, fullPath)
+			nestedPaths := extractFieldPaths(nestedSchema, fullPath, keyword)
 			paths = append(paths, nestedPaths...)
 		}
 
@@ -113,15 +129,15 @@ func ExtractSensitiveFieldPaths(schema map[string]any, prefix string) []string {
 		if items, ok := fieldSchemaMap["items"].(map[string]any); ok {
 			arrayItemPath := fullPath + "[*]"
 
-			// Check if items themselves are marked sensitive
-			// If sensitive, add the path and skip nested properties
-			if isSensitive, ok := items[annotationRadiusSensitive].(bool); ok && isSensitive {
+			// Check if items themselves are marked
+			// If marked, add the path and skip nested properties
+			if marked, ok := items[keyword].(bool); ok && marked {
 				paths = append(paths, arrayItemPath)
 			} else {
 				// Recursively check nested properties within array items
 				if itemProps, ok := items["properties"].(map[string]any); ok {
 					itemSchema := map[string]any{"properties": itemProps}
-					nestedPaths := ExtractSensitiveFieldPaths(itemSchema, arrayItemPath)
+					nestedPaths := extractFieldPaths(itemSchema, arrayItemPath, keyword)
 					paths = append(paths, nestedPaths...)
 				}
 			}
@@ -132,15 +148,15 @@ func ExtractSensitiveFieldPaths(schema map[string]any, prefix string) []string {
 		if additionalProps, ok := fieldSchemaMap["additionalProperties"].(map[string]any); ok {
 			mapValuePath := fullPath + "[*]"
 
-			// Check if additionalProperties values are marked sensitive
-			// If sensitive, add the path and skip nested properties
-			if isSensitive, ok := additionalProps[annotationRadiusSensitive].(bool); ok && isSensitive {
+			// Check if additionalProperties values are marked
+			// If marked, add the path and skip nested properties
+			if marked, ok := additionalProps[keyword].(bool); ok && marked {
 				paths = append(paths, mapValuePath)
 			} else {
 				// Recursively check nested properties within additionalProperties
 				if addProps, ok := additionalProps["properties"].(map[string]any); ok {
 					addPropsSchema := map[string]any{"properties": addProps}
-					nestedPaths := ExtractSensitiveFieldPaths(addPropsSchema, mapValuePath)
+					nestedPaths := extractFieldPaths(addPropsSchema, mapValuePath, keyword)
 					paths = append(paths, nestedPaths...)
 				}
 			}
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+const (
+	keywordDefault  = "default"
+	keywordReadOnly = "readOnly"
+)
+
+// ApplyDefaults sets the properties that are missing from the resource properties to the default values declared by
+// the schema. Defaults are applied recursively to the nested objects, array items and map values that are present,
+// including objects that were themselves set from a default.
+//
+// Read-only properties are not defaulted because they are populated by the system rather than by the client.
+func ApplyDefaults(properties map[string]any, schema map[string]any) {
+	if properties == nil || schema == nil {
+		return
+	}
+
+	applyDefaults(properties, schema)
+}
+
+func applyDefaults(value any, schema map[string]any) {
+	switch v := value.(type) {
+	case map[string]any:
+		declared, _ := schema["properties"].(map[string]any)
+		for name, propertySchema := range declared {
+			propertySchemaMap, ok := propertySchema.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			if _, exists := v[name]; !exists && !isReadOnly(propertySchemaMap) {
+				if defaultValue, ok := propertySchemaMap[keywordDefault]; ok {
+					v[name] = copyValue(defaultValue)
+				}
+			}
+
+			if child, exists := v[name]; exists {
+				applyDefaults(child, propertySchemaMap)
+			}
+		}
+
+		if additionalProperties, ok := schema["additionalProperties"].(map[string]any); ok {
+			for name, child := range v {
+				if _, ok := declared[name]; !ok {
+					applyDefaults(child, additionalProperties)
+				}
+			}
+		}
+
+	case []any:
+		if items, ok := schema["items"].(map[string]any); ok {
+			for _, item := range v {
+				applyDefaults(item, items)
+			}
+		}
+	}
+}
+
+func isReadOnly(schema map[string]any) bool {
+	readOnly, ok := schema[keywordReadOnly].(bool)
+	return ok && readOnly
+}
+
+// copyValue makes a deep copy of a JSON value, so that default values are not shared between resources.
+func copyValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for key, item := range v {
+			result[key] = copyValue(item)
+		}
+		return result
+
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = copyValue(item)
+		}
+		return result
+
+	default:
+		return v
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyDefaults(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"size":     map[string]any{"type": "string", "default": "S"},
+			"replicas": map[string]any{"type": "integer", "default": 1},
+			"host":     map[string]any{"type": "string", "readOnly": true, "default": "localhost"},
+			"database": map[string]any{
+				"type":    "object",
+				"default": map[string]any{},
+				"properties": map[string]any{
+					"port": map[string]any{"type": "integer", "default": 5432},
+				},
+			},
+			"endpoints": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"scheme": map[string]any{"type": "string", "default": "https"},
+					},
+				},
+			},
+			"volumes": map[string]any{
+				"type": "object",
+				"additionalProperties": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"readOnly": map[string]any{"type": "boolean", "default": false},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		properties map[string]any
+		expected   map[string]any
+	}{
+		{
+			name:       "missing properties are defaulted",
+			properties: map[string]any{},
+			expected: map[string]any{
+				"size":     "S",
+				"replicas": 1,
+				"database": map[string]any{"port": 5432},
+			},
+		},
+		{
+			name: "explicit values are preserved",
+			properties: map[string]any{
+				"size":     "L",
+				"replicas": 0,
+				"database": map[string]any{"port": 3306},
+			},
+			expected: map[string]any{
+				"size":     "L",
+				"replicas": 0,
+				"database": map[string]any{"port": 3306},
+			},
+		},
+		{
+			name: "nested array items and map values are defaulted",
+			properties: map[string]any{
+				"endpoints": []any{map[string]any{}, map[string]any{"scheme": "http"}},
+				"volumes":   map[string]any{"data": map[string]any{}},
+			},
+			expected: map[string]any{
+				"size":      "S",
+				"replicas":  1,
+				"database":  map[string]any{"port": 5432},
+				"endpoints": []any{map[string]any{"scheme": "https"}, map[string]any{"scheme": "http"}},
+				"volumes":   map[string]any{"data": map[string]any{"readOnly": false}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ApplyDefaults(tt.properties, schema)
+			require.Equal(t, tt.expected, tt.properties)
+		})
+	}
+
+	t.Run("defaults are not shared", func(t *testing.T) {
+		properties := map[string]any{}
+		ApplyDefaults(properties, schema)
+
+		properties["database"].(map[string]any)["port"] = 1
+		require.Equal(t, map[string]any{}, schema["properties"].(map[string]any)["database"].(map[string]any)["default"])
+	})
+
+	t.Run("nil schema", func(t *testing.T) {
+		properties := map[string]any{"size": "L"}
+		ApplyDefaults(properties, nil)
+		require.Equal(t, map[string]any{"size": "L"}, properties)
+	})
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"strings"
+)
+
+// ExtractReadOnlyFieldPaths recursively walks the schema and returns paths to fields marked with readOnly.
+// Paths use the same format as ExtractSensitiveFieldPaths, e.g., "status.host" or "endpoints[*].url".
+//
+// Read-only fields are computed by the system, e.g. from the outputs of a recipe, and cannot be set by clients.
+func ExtractReadOnlyFieldPaths(schema map[string]any, prefix string) []string {
+	return extractFieldPaths(schema, prefix, keywordReadOnly)
+}
+
+// GetReadOnlyProperties returns the names of the top-level properties of the schema that are marked with readOnly.
+func GetReadOnlyProperties(schema map[string]any) []string {
+	names := []string{}
+	for _, path := range ExtractReadOnlyFieldPaths(schema, "") {
+		if !strings.ContainsAny(path, ".[") {
+			names = append(names, path)
+		}
+	}
+
+	return names
+}
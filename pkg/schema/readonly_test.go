@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractReadOnlyFieldPaths(t *testing.T) {
+	schema := map[string]any{
+		"properties": map[string]any{
+			"name": map[string]any{"type": "string"},
+			"host": map[string]any{"type": "string", "readOnly": true},
+			"connection": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"port": map[string]any{"type": "integer", "readOnly": true},
+				},
+			},
+			"endpoints": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"url": map[string]any{"type": "string", "readOnly": true},
+					},
+				},
+			},
+		},
+	}
+
+	require.ElementsMatch(t, []string{"host", "connection.port", "endpoints[*].url"}, ExtractReadOnlyFieldPaths(schema, ""))
+	require.Equal(t, []string{"host"}, GetReadOnlyProperties(schema))
+	require.Empty(t, ExtractReadOnlyFieldPaths(map[string]any{}, ""))
+}
@@ -802,7 +802,10 @@ func ValidateResourceAgainstSchema(ctx context.Context, resourceData map[string]
 		return fmt.Errorf("resource data missing 'properties' field")
 	}
 
-	if err := schemaRef.Value.VisitJSON(propertiesData); err != nil {
+	// The data is validated as a request, so that required read-only properties may be missing until they are
+	// computed by the system. Read-only properties that are present are not rejected here: they may have been
+	// computed by a previous deployment of the resource.
+	if err := schemaRef.Value.VisitJSON(propertiesData, openapi3.VisitAsRequest(), openapi3.DisableReadOnlyValidation()); err != nil {
 		// Try to extract structured error information
 		if openAPIErr, ok := err.(*openapi3.SchemaError); ok {
 
@@ -2162,6 +2162,22 @@ func TestValidateResourceAgainstSchema(t *testing.T) {
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "property \"age\" is missing")
 	})
+	t.Run("required read-only property may be missing or present", func(t *testing.T) {
+		schema := map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"name": map[string]any{"type": "string"},
+				"host": map[string]any{"type": "string", "readOnly": true},
+			},
+			"required": []any{"name", "host"},
+		}
+
+		err := ValidateResourceAgainstSchema(ctx, map[string]any{"properties": map[string]any{"name": "test"}}, schema)
+		require.NoError(t, err)
+
+		err = ValidateResourceAgainstSchema(ctx, map[string]any{"properties": map[string]any{"name": "test", "host": "example.com"}}, schema)
+		require.NoError(t, err)
+	})
 }
 
 func TestValidator_checkSensitiveAnnotation(t *testing.T) {
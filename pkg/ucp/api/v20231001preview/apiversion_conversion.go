@@ -17,6 +17,10 @@ limitations under the License.
 package v20231001preview
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -39,8 +43,14 @@ func (src *APIVersionResource) ConvertTo() (v1.DataModelInterface, error) {
 		},
 	}
 
+	conversion, err := toConversionDataModel(src.Properties.Conversion)
+	if err != nil {
+		return nil, err
+	}
+
 	dst.Properties = datamodel.APIVersionProperties{
-		Schema: src.Properties.Schema,
+		Schema:     src.Properties.Schema,
+		Conversion: conversion,
 	}
 
 	return dst, nil
@@ -62,7 +72,105 @@ func (dst *APIVersionResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &APIVersionProperties{
 		ProvisioningState: to.Ptr(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
 		Schema:            dm.Properties.Schema,
+		Conversion:        fromConversionDataModel(dm.Properties.Conversion),
 	}
 
 	return nil
 }
+
+func toConversionDataModel(conversion *APIVersionConversion) (*datamodel.APIVersionConversion, error) {
+	if conversion == nil || (len(conversion.Rules) == 0 && conversion.Webhook == nil) {
+		return nil, nil
+	}
+
+	if len(conversion.Rules) > 0 && conversion.Webhook != nil {
+		return nil, v1.NewClientErrInvalidRequest("conversion must specify either rules or a webhook, but not both")
+	}
+
+	if conversion.Webhook != nil {
+		u, err := url.Parse(to.String(conversion.Webhook.URL))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, v1.NewClientErrInvalidRequest("conversion webhook must specify an absolute http or https url")
+		}
+
+		return &datamodel.APIVersionConversion{Webhook: &datamodel.APIVersionConversionWebhook{URL: u.String()}}, nil
+	}
+
+	result := &datamodel.APIVersionConversion{}
+	for i, rule := range conversion.Rules {
+		if rule == nil || rule.Kind == nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("conversion rule %d must specify a kind", i))
+		}
+
+		dm := datamodel.APIVersionConversionRule{
+			Kind:  datamodel.APIVersionConversionRuleKind(*rule.Kind),
+			From:  to.String(rule.From),
+			To:    to.String(rule.To),
+			Path:  to.String(rule.Path),
+			Value: rule.Value,
+		}
+
+		switch dm.Kind {
+		case datamodel.APIVersionConversionRuleKindRename:
+			if !isValidPropertyPath(dm.From) || !isValidPropertyPath(dm.To) || dm.Path != "" || dm.Value != nil {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("conversion rule %d is a rename rule and must specify only 'from' and 'to' property paths", i))
+			}
+		case datamodel.APIVersionConversionRuleKindDefault:
+			if !isValidPropertyPath(dm.Path) || dm.From != "" || dm.To != "" {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("conversion rule %d is a default rule and must specify only a 'path' property path and a 'value'", i))
+			}
+		default:
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("conversion rule %d has an unsupported kind %q. Supported kinds: %s, %s", i, dm.Kind, datamodel.APIVersionConversionRuleKindRename, datamodel.APIVersionConversionRuleKindDefault))
+		}
+
+		result.Rules = append(result.Rules, dm)
+	}
+
+	return result, nil
+}
+
+// isValidPropertyPath returns true if the path is a dot-separated list of property names, for example "database.host".
+func isValidPropertyPath(path string) bool {
+	if path == "" {
+		return false
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		if segment == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func fromConversionDataModel(conversion *datamodel.APIVersionConversion) *APIVersionConversion {
+	if conversion == nil {
+		return nil
+	}
+
+	result := &APIVersionConversion{}
+	if conversion.Webhook != nil {
+		result.Webhook = &APIVersionConversionWebhook{URL: to.Ptr(conversion.Webhook.URL)}
+	}
+
+	for _, rule := range conversion.Rules {
+		versioned := &APIVersionConversionRule{
+			Kind:  to.Ptr(APIVersionConversionRuleKind(rule.Kind)),
+			Value: rule.Value,
+		}
+		if rule.From != "" {
+			versioned.From = to.Ptr(rule.From)
+		}
+		if rule.To != "" {
+			versioned.To = to.Ptr(rule.To)
+		}
+		if rule.Path != "" {
+			versioned.Path = to.Ptr(rule.Path)
+		}
+
+		result.Rules = append(result.Rules, versioned)
+	}
+
+	return result
+}
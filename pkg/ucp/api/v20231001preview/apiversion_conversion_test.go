@@ -50,6 +50,29 @@ func Test_APIVersion_VersionedToDataModel(t *testing.T) {
 				Properties: datamodel.APIVersionProperties{},
 			},
 		},
+		{
+			filename: "apiversion_resource_conversion.json",
+			expected: &datamodel.APIVersion{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2024-01-01",
+						Name: "2024-01-01",
+						Type: datamodel.APIVersionResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.APIVersionProperties{
+					Conversion: &datamodel.APIVersionConversion{
+						Rules: []datamodel.APIVersionConversionRule{
+							{Kind: datamodel.APIVersionConversionRuleKindRename, From: "hostname", To: "database.host"},
+							{Kind: datamodel.APIVersionConversionRuleKindDefault, Path: "database.port", Value: float64(5432)},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -88,6 +111,20 @@ func Test_APIVersion_DataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "apiversion_datamodel_conversion.json",
+			expected: &APIVersionResource{
+				ID:   to.Ptr("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2024-01-01"),
+				Type: to.Ptr(datamodel.APIVersionResourceType),
+				Name: to.Ptr("2024-01-01"),
+				Properties: &APIVersionProperties{
+					ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+					Conversion: &APIVersionConversion{
+						Webhook: &APIVersionConversionWebhook{URL: to.Ptr("https://convert.example.com")},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -110,3 +147,52 @@ func Test_APIVersion_DataModelToVersioned(t *testing.T) {
 		})
 	}
 }
+
+func Test_toConversionDataModel_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		conversion  *APIVersionConversion
+		expectedErr error
+	}{
+		{
+			name: "rules and webhook",
+			conversion: &APIVersionConversion{
+				Rules:   []*APIVersionConversionRule{{Kind: to.Ptr(APIVersionConversionRuleKindRename), From: to.Ptr("a"), To: to.Ptr("b")}},
+				Webhook: &APIVersionConversionWebhook{URL: to.Ptr("https://convert.example.com")},
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("conversion must specify either rules or a webhook, but not both"),
+		},
+		{
+			name:        "relative webhook url",
+			conversion:  &APIVersionConversion{Webhook: &APIVersionConversionWebhook{URL: to.Ptr("/convert")}},
+			expectedErr: v1.NewClientErrInvalidRequest("conversion webhook must specify an absolute http or https url"),
+		},
+		{
+			name:        "missing kind",
+			conversion:  &APIVersionConversion{Rules: []*APIVersionConversionRule{{From: to.Ptr("a"), To: to.Ptr("b")}}},
+			expectedErr: v1.NewClientErrInvalidRequest("conversion rule 0 must specify a kind"),
+		},
+		{
+			name:        "unsupported kind",
+			conversion:  &APIVersionConversion{Rules: []*APIVersionConversionRule{{Kind: to.Ptr(APIVersionConversionRuleKind("split"))}}},
+			expectedErr: v1.NewClientErrInvalidRequest("conversion rule 0 has an unsupported kind \"split\". Supported kinds: rename, default"),
+		},
+		{
+			name:        "rename without to",
+			conversion:  &APIVersionConversion{Rules: []*APIVersionConversionRule{{Kind: to.Ptr(APIVersionConversionRuleKindRename), From: to.Ptr("a")}}},
+			expectedErr: v1.NewClientErrInvalidRequest("conversion rule 0 is a rename rule and must specify only 'from' and 'to' property paths"),
+		},
+		{
+			name:        "default with invalid path",
+			conversion:  &APIVersionConversion{Rules: []*APIVersionConversionRule{{Kind: to.Ptr(APIVersionConversionRuleKindDefault), Path: to.Ptr("a..b"), Value: 1}}},
+			expectedErr: v1.NewClientErrInvalidRequest("conversion rule 0 is a default rule and must specify only a 'path' property path and a 'value'"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := toConversionDataModel(tt.conversion)
+			require.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
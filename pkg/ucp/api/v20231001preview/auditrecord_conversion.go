@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"errors"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned AuditRecordResource resource to version-agnostic datamodel.
+//
+// NOTE: AuditRecordResource is READONLY. There is no conversion from versioned to datamodel.
+func (src *AuditRecordResource) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, errors.New("the AuditRecordResource is READONLY. There is no conversion from versioned to datamodel")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned AuditRecordResource resource.
+func (dst *AuditRecordResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.AuditRecord)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(dm.ID)
+	dst.Name = to.Ptr(dm.Name)
+	dst.Type = to.Ptr(dm.Type)
+
+	dst.Properties = &AuditRecordProperties{
+		Timestamp:       to.Ptr(dm.Properties.Timestamp),
+		PrincipalName:   to.Ptr(dm.Properties.PrincipalName),
+		PrincipalGroups: to.SliceOfPtrs(dm.Properties.PrincipalGroups...),
+		Method:          to.Ptr(dm.Properties.Method),
+		ResourceID:      to.Ptr(dm.Properties.ResourceID),
+		APIVersion:      to.Ptr(dm.Properties.APIVersion),
+		CorrelationID:   to.Ptr(dm.Properties.CorrelationID),
+		OperationID:     to.Ptr(dm.Properties.OperationID),
+		StatusCode:      to.Ptr(int32(dm.Properties.StatusCode)),
+		Result:          to.Ptr(AuditResult(dm.Properties.Result)),
+		RequestDigest:   to.Ptr(dm.Properties.RequestDigest),
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// Note: AuditRecordResource is READONLY. There is no conversion from versioned to datamodel.
+
+func Test_AuditRecord_DataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("auditrecord_datamodel.json")
+	data := &datamodel.AuditRecord{}
+	err := json.Unmarshal(rawPayload, data)
+	require.NoError(t, err)
+
+	versioned := &AuditRecordResource{}
+	err = versioned.ConvertFrom(data)
+	require.NoError(t, err)
+
+	expected := &AuditRecordResource{
+		ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/System.Audit/records/9c6a1a5e-5f0f-4d3b-9f0e-6f6f2a0d8f51"),
+		Name: to.Ptr("9c6a1a5e-5f0f-4d3b-9f0e-6f6f2a0d8f51"),
+		Type: to.Ptr(datamodel.AuditRecordResourceType),
+		Properties: &AuditRecordProperties{
+			Timestamp:       to.Ptr(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+			PrincipalName:   to.Ptr("alice"),
+			PrincipalGroups: []*string{to.Ptr("admins")},
+			Method:          to.Ptr("PUT"),
+			ResourceID:      to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"),
+			APIVersion:      to.Ptr("2023-10-01-preview"),
+			CorrelationID:   to.Ptr("a3f3c3b0-3b0e-4d7e-8d3e-0b4e6a9f1c2d"),
+			OperationID:     to.Ptr("1f0b6f44-2b8e-4c1c-8b5e-2f0d7a6f4c3e"),
+			StatusCode:      to.Ptr(int32(201)),
+			Result:          to.Ptr(AuditResultSucceeded),
+			RequestDigest:   to.Ptr("sha256:de1876e8a7424574cd817bc9046a4d22449e85c5d2ff7ec27d92d5a059a9ab5f"),
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
+
+func Test_AuditRecord_VersionedToDataModel(t *testing.T) {
+	versioned := &AuditRecordResource{}
+	_, err := versioned.ConvertTo()
+	require.Error(t, err)
+}
@@ -20,6 +20,10 @@ import (
 
 // ResourceGroupsServer is a fake server for instances of the v20231001preview.ResourceGroupsClient type.
 type ResourceGroupsServer struct {
+	// BeginMoveResources is the fake for method ResourceGroupsClient.BeginMoveResources
+	// HTTP status codes to indicate success: http.StatusAccepted
+	BeginMoveResources func(ctx context.Context, planeName string, resourceGroupName string, body v20231001preview.ResourcesMoveInfo, options *v20231001preview.ResourceGroupsClientBeginMoveResourcesOptions) (resp azfake.PollerResponder[v20231001preview.ResourceGroupsClientMoveResourcesResponse], errResp azfake.ErrorResponder)
+
 	// CreateOrUpdate is the fake for method ResourceGroupsClient.CreateOrUpdate
 	// HTTP status codes to indicate success: http.StatusOK, http.StatusCreated
 	CreateOrUpdate func(ctx context.Context, planeName string, resourceGroupName string, resource v20231001preview.ResourceGroupResource, options *v20231001preview.ResourceGroupsClientCreateOrUpdateOptions) (resp azfake.Responder[v20231001preview.ResourceGroupsClientCreateOrUpdateResponse], errResp azfake.ErrorResponder)
@@ -39,6 +43,10 @@ type ResourceGroupsServer struct {
 	// Update is the fake for method ResourceGroupsClient.Update
 	// HTTP status codes to indicate success: http.StatusOK
 	Update func(ctx context.Context, planeName string, resourceGroupName string, properties v20231001preview.ResourceGroupResourceTagsUpdate, options *v20231001preview.ResourceGroupsClientUpdateOptions) (resp azfake.Responder[v20231001preview.ResourceGroupsClientUpdateResponse], errResp azfake.ErrorResponder)
+
+	// ValidateMoveResources is the fake for method ResourceGroupsClient.ValidateMoveResources
+	// HTTP status codes to indicate success: http.StatusNoContent
+	ValidateMoveResources func(ctx context.Context, planeName string, resourceGroupName string, body v20231001preview.ResourcesMoveInfo, options *v20231001preview.ResourceGroupsClientValidateMoveResourcesOptions) (resp azfake.Responder[v20231001preview.ResourceGroupsClientValidateMoveResourcesResponse], errResp azfake.ErrorResponder)
 }
 
 // NewResourceGroupsServerTransport creates a new instance of ResourceGroupsServerTransport with the provided implementation.
@@ -46,16 +54,18 @@ type ResourceGroupsServer struct {
 // azcore.ClientOptions.Transporter field in the client's constructor parameters.
 func NewResourceGroupsServerTransport(srv *ResourceGroupsServer) *ResourceGroupsServerTransport {
 	return &ResourceGroupsServerTransport{
-		srv:          srv,
-		newListPager: newTracker[azfake.PagerResponder[v20231001preview.ResourceGroupsClientListResponse]](),
+		srv:                srv,
+		beginMoveResources: newTracker[azfake.PollerResponder[v20231001preview.ResourceGroupsClientMoveResourcesResponse]](),
+		newListPager:       newTracker[azfake.PagerResponder[v20231001preview.ResourceGroupsClientListResponse]](),
 	}
 }
 
 // ResourceGroupsServerTransport connects instances of v20231001preview.ResourceGroupsClient to instances of ResourceGroupsServer.
 // Don't use this type directly, use NewResourceGroupsServerTransport instead.
 type ResourceGroupsServerTransport struct {
-	srv          *ResourceGroupsServer
-	newListPager *tracker[azfake.PagerResponder[v20231001preview.ResourceGroupsClientListResponse]]
+	srv                *ResourceGroupsServer
+	beginMoveResources *tracker[azfake.PollerResponder[v20231001preview.ResourceGroupsClientMoveResourcesResponse]]
+	newListPager       *tracker[azfake.PagerResponder[v20231001preview.ResourceGroupsClientListResponse]]
 }
 
 // Do implements the policy.Transporter interface for ResourceGroupsServerTransport.
@@ -81,6 +91,8 @@ func (r *ResourceGroupsServerTransport) dispatchToMethodFake(req *http.Request,
 		}
 		if !intercepted {
 			switch method {
+			case "ResourceGroupsClient.BeginMoveResources":
+				res.resp, res.err = r.dispatchBeginMoveResources(req)
 			case "ResourceGroupsClient.CreateOrUpdate":
 				res.resp, res.err = r.dispatchCreateOrUpdate(req)
 			case "ResourceGroupsClient.Delete":
@@ -91,6 +103,8 @@ func (r *ResourceGroupsServerTransport) dispatchToMethodFake(req *http.Request,
 				res.resp, res.err = r.dispatchNewListPager(req)
 			case "ResourceGroupsClient.Update":
 				res.resp, res.err = r.dispatchUpdate(req)
+			case "ResourceGroupsClient.ValidateMoveResources":
+				res.resp, res.err = r.dispatchValidateMoveResources(req)
 			default:
 				res.err = fmt.Errorf("unhandled API %s", method)
 			}
@@ -110,6 +124,54 @@ func (r *ResourceGroupsServerTransport) dispatchToMethodFake(req *http.Request,
 	}
 }
 
+func (r *ResourceGroupsServerTransport) dispatchBeginMoveResources(req *http.Request) (*http.Response, error) {
+	if r.srv.BeginMoveResources == nil {
+		return nil, &nonRetriableError{errors.New("fake for method BeginMoveResources not implemented")}
+	}
+	beginMoveResources := r.beginMoveResources.get(req)
+	if beginMoveResources == nil {
+		const regexStr = `/planes/radius/(?P<planeName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourcegroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/moveResources`
+		regex := regexp.MustCompile(regexStr)
+		matches := regex.FindStringSubmatch(req.URL.EscapedPath())
+		if len(matches) < 3 {
+			return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
+		}
+		body, err := server.UnmarshalRequestAsJSON[v20231001preview.ResourcesMoveInfo](req)
+		if err != nil {
+			return nil, err
+		}
+		planeNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("planeName")])
+		if err != nil {
+			return nil, err
+		}
+		resourceGroupNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("resourceGroupName")])
+		if err != nil {
+			return nil, err
+		}
+		respr, errRespr := r.srv.BeginMoveResources(req.Context(), planeNameParam, resourceGroupNameParam, body, nil)
+		if respErr := server.GetError(errRespr, req); respErr != nil {
+			return nil, respErr
+		}
+		beginMoveResources = &respr
+		r.beginMoveResources.add(req, beginMoveResources)
+	}
+
+	resp, err := server.PollerResponderNext(beginMoveResources, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if !contains([]int{http.StatusAccepted}, resp.StatusCode) {
+		r.beginMoveResources.remove(req)
+		return nil, &nonRetriableError{fmt.Errorf("unexpected status code %d. acceptable values are http.StatusAccepted", resp.StatusCode)}
+	}
+	if !server.PollerResponderMore(beginMoveResources) {
+		r.beginMoveResources.remove(req)
+	}
+
+	return resp, nil
+}
+
 func (r *ResourceGroupsServerTransport) dispatchCreateOrUpdate(req *http.Request) (*http.Response, error) {
 	if r.srv.CreateOrUpdate == nil {
 		return nil, &nonRetriableError{errors.New("fake for method CreateOrUpdate not implemented")}
@@ -287,6 +349,43 @@ func (r *ResourceGroupsServerTransport) dispatchUpdate(req *http.Request) (*http
 	return resp, nil
 }
 
+func (r *ResourceGroupsServerTransport) dispatchValidateMoveResources(req *http.Request) (*http.Response, error) {
+	if r.srv.ValidateMoveResources == nil {
+		return nil, &nonRetriableError{errors.New("fake for method ValidateMoveResources not implemented")}
+	}
+	const regexStr = `/planes/radius/(?P<planeName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourcegroups/(?P<resourceGroupName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/validateMoveResources`
+	regex := regexp.MustCompile(regexStr)
+	matches := regex.FindStringSubmatch(req.URL.EscapedPath())
+	if len(matches) < 3 {
+		return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
+	}
+	body, err := server.UnmarshalRequestAsJSON[v20231001preview.ResourcesMoveInfo](req)
+	if err != nil {
+		return nil, err
+	}
+	planeNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("planeName")])
+	if err != nil {
+		return nil, err
+	}
+	resourceGroupNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("resourceGroupName")])
+	if err != nil {
+		return nil, err
+	}
+	respr, errRespr := r.srv.ValidateMoveResources(req.Context(), planeNameParam, resourceGroupNameParam, body, nil)
+	if respErr := server.GetError(errRespr, req); respErr != nil {
+		return nil, respErr
+	}
+	respContent := server.GetResponseContent(respr)
+	if !contains([]int{http.StatusNoContent}, respContent.HTTPStatus) {
+		return nil, &nonRetriableError{fmt.Errorf("unexpected status code %d. acceptable values are http.StatusNoContent", respContent.HTTPStatus)}
+	}
+	resp, err := server.NewResponse(respContent, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // set this to conditionally intercept incoming requests to ResourceGroupsServerTransport
 var resourceGroupsServerTransportInterceptor interface {
 	// Do returns true if the server transport should use the returned response/error
@@ -18,6 +18,9 @@ type ServerFactory struct {
 	// APIVersionsServer contains the fakes for client APIVersionsClient
 	APIVersionsServer APIVersionsServer
 
+	// AuditRecordsServer contains the fakes for client AuditRecordsClient
+	AuditRecordsServer AuditRecordsServer
+
 	// AwsCredentialsServer contains the fakes for client AwsCredentialsClient
 	AwsCredentialsServer AwsCredentialsServer
 
@@ -33,6 +36,9 @@ type ServerFactory struct {
 	// LocationsServer contains the fakes for client LocationsClient
 	LocationsServer LocationsServer
 
+	// LocksServer contains the fakes for client LocksClient
+	LocksServer LocksServer
+
 	// PlanesServer contains the fakes for client PlanesClient
 	PlanesServer PlanesServer
 
@@ -45,11 +51,20 @@ type ServerFactory struct {
 	// ResourceProvidersServer contains the fakes for client ResourceProvidersClient
 	ResourceProvidersServer ResourceProvidersServer
 
+	// ResourceQueryServer contains the fakes for client ResourceQueryClient
+	ResourceQueryServer ResourceQueryServer
+
 	// ResourceTypesServer contains the fakes for client ResourceTypesClient
 	ResourceTypesServer ResourceTypesServer
 
 	// ResourcesServer contains the fakes for client ResourcesClient
 	ResourcesServer ResourcesServer
+
+	// RoleAssignmentsServer contains the fakes for client RoleAssignmentsClient
+	RoleAssignmentsServer RoleAssignmentsServer
+
+	// RoleDefinitionsServer contains the fakes for client RoleDefinitionsClient
+	RoleDefinitionsServer RoleDefinitionsServer
 }
 
 // NewServerFactoryTransport creates a new instance of ServerFactoryTransport with the provided implementation.
@@ -67,17 +82,22 @@ type ServerFactoryTransport struct {
 	srv                       *ServerFactory
 	trMu                      sync.Mutex
 	trAPIVersionsServer       *APIVersionsServerTransport
+	trAuditRecordsServer      *AuditRecordsServerTransport
 	trAwsCredentialsServer    *AwsCredentialsServerTransport
 	trAwsPlanesServer         *AwsPlanesServerTransport
 	trAzureCredentialsServer  *AzureCredentialsServerTransport
 	trAzurePlanesServer       *AzurePlanesServerTransport
 	trLocationsServer         *LocationsServerTransport
+	trLocksServer             *LocksServerTransport
 	trPlanesServer            *PlanesServerTransport
 	trRadiusPlanesServer      *RadiusPlanesServerTransport
 	trResourceGroupsServer    *ResourceGroupsServerTransport
 	trResourceProvidersServer *ResourceProvidersServerTransport
+	trResourceQueryServer     *ResourceQueryServerTransport
 	trResourceTypesServer     *ResourceTypesServerTransport
 	trResourcesServer         *ResourcesServerTransport
+	trRoleAssignmentsServer   *RoleAssignmentsServerTransport
+	trRoleDefinitionsServer   *RoleDefinitionsServerTransport
 }
 
 // Do implements the policy.Transporter interface for ServerFactoryTransport.
@@ -96,6 +116,9 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "APIVersionsClient":
 		initServer(s, &s.trAPIVersionsServer, func() *APIVersionsServerTransport { return NewAPIVersionsServerTransport(&s.srv.APIVersionsServer) })
 		resp, err = s.trAPIVersionsServer.Do(req)
+	case "AuditRecordsClient":
+		initServer(s, &s.trAuditRecordsServer, func() *AuditRecordsServerTransport { return NewAuditRecordsServerTransport(&s.srv.AuditRecordsServer) })
+		resp, err = s.trAuditRecordsServer.Do(req)
 	case "AwsCredentialsClient":
 		initServer(s, &s.trAwsCredentialsServer, func() *AwsCredentialsServerTransport {
 			return NewAwsCredentialsServerTransport(&s.srv.AwsCredentialsServer)
@@ -115,6 +138,9 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "LocationsClient":
 		initServer(s, &s.trLocationsServer, func() *LocationsServerTransport { return NewLocationsServerTransport(&s.srv.LocationsServer) })
 		resp, err = s.trLocationsServer.Do(req)
+	case "LocksClient":
+		initServer(s, &s.trLocksServer, func() *LocksServerTransport { return NewLocksServerTransport(&s.srv.LocksServer) })
+		resp, err = s.trLocksServer.Do(req)
 	case "PlanesClient":
 		initServer(s, &s.trPlanesServer, func() *PlanesServerTransport { return NewPlanesServerTransport(&s.srv.PlanesServer) })
 		resp, err = s.trPlanesServer.Do(req)
@@ -131,6 +157,11 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 			return NewResourceProvidersServerTransport(&s.srv.ResourceProvidersServer)
 		})
 		resp, err = s.trResourceProvidersServer.Do(req)
+	case "ResourceQueryClient":
+		initServer(s, &s.trResourceQueryServer, func() *ResourceQueryServerTransport {
+			return NewResourceQueryServerTransport(&s.srv.ResourceQueryServer)
+		})
+		resp, err = s.trResourceQueryServer.Do(req)
 	case "ResourceTypesClient":
 		initServer(s, &s.trResourceTypesServer, func() *ResourceTypesServerTransport {
 			return NewResourceTypesServerTransport(&s.srv.ResourceTypesServer)
@@ -139,6 +170,16 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "ResourcesClient":
 		initServer(s, &s.trResourcesServer, func() *ResourcesServerTransport { return NewResourcesServerTransport(&s.srv.ResourcesServer) })
 		resp, err = s.trResourcesServer.Do(req)
+	case "RoleAssignmentsClient":
+		initServer(s, &s.trRoleAssignmentsServer, func() *RoleAssignmentsServerTransport {
+			return NewRoleAssignmentsServerTransport(&s.srv.RoleAssignmentsServer)
+		})
+		resp, err = s.trRoleAssignmentsServer.Do(req)
+	case "RoleDefinitionsClient":
+		initServer(s, &s.trRoleDefinitionsServer, func() *RoleDefinitionsServerTransport {
+			return NewRoleDefinitionsServerTransport(&s.srv.RoleDefinitionsServer)
+		})
+		resp, err = s.trRoleDefinitionsServer.Do(req)
 	default:
 		err = fmt.Errorf("unhandled client %s", client)
 	}
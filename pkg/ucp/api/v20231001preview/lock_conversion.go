@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ConvertTo converts from the versioned LockResource resource to version-agnostic datamodel.
+func (src *LockResource) ConvertTo() (v1.DataModelInterface, error) {
+	if src.Properties == nil || src.Properties.Level == nil {
+		return nil, v1.NewClientErrInvalidRequest("lock must specify a level")
+	}
+
+	level, err := toLockLevelDataModel(*src.Properties.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := &datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: datamodel.LockResourceType,
+
+				// NOTE: this is a proxy resource. It does not have a location or tags.
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.LockProperties{
+			Level: level,
+			Notes: to.String(src.Properties.Notes),
+		},
+	}
+
+	if src.Properties.ResourceID != nil && *src.Properties.ResourceID != "" {
+		id, err := resources.Parse(*src.Properties.ResourceID)
+		if err != nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("lock resource id %q is not a valid resource id", *src.Properties.ResourceID))
+		}
+
+		dst.Properties.ResourceID = id.String()
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned LockResource resource.
+func (dst *LockResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.Lock)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(dm.ID)
+	dst.Name = to.Ptr(dm.Name)
+	dst.Type = to.Ptr(dm.Type)
+
+	dst.Properties = &LockProperties{
+		ProvisioningState: to.Ptr(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
+		Level:             to.Ptr(LockLevel(dm.Properties.Level)),
+	}
+
+	if dm.Properties.ResourceID != "" {
+		dst.Properties.ResourceID = to.Ptr(dm.Properties.ResourceID)
+	}
+	if dm.Properties.Notes != "" {
+		dst.Properties.Notes = to.Ptr(dm.Properties.Notes)
+	}
+
+	return nil
+}
+
+func toLockLevelDataModel(level LockLevel) (datamodel.LockLevel, error) {
+	for _, possible := range PossibleLockLevelValues() {
+		if strings.EqualFold(string(level), string(possible)) {
+			return datamodel.LockLevel(possible), nil
+		}
+	}
+
+	return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("lock level %q is not supported. Supported levels: %s, %s", level, LockLevelCanNotDelete, LockLevelReadOnly))
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Lock_VersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("lock_resource.json")
+	versioned := &LockResource{}
+	err := json.Unmarshal(rawPayload, versioned)
+	require.NoError(t, err)
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.Lock{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/resourceGroups/test-group/providers/System.Resources/locks/protect-env",
+				Name: "protect-env",
+				Type: datamodel.LockResourceType,
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.LockProperties{
+			Level:      datamodel.LockLevelCanNotDelete,
+			ResourceID: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/production",
+			Notes:      "Production must not be deleted.",
+		},
+	}
+	require.Equal(t, expected, dm)
+}
+
+func Test_Lock_VersionedToDataModel_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		properties  *LockProperties
+		expectedErr error
+	}{
+		{
+			name:        "missing properties",
+			properties:  nil,
+			expectedErr: v1.NewClientErrInvalidRequest("lock must specify a level"),
+		},
+		{
+			name:        "missing level",
+			properties:  &LockProperties{},
+			expectedErr: v1.NewClientErrInvalidRequest("lock must specify a level"),
+		},
+		{
+			name:        "unsupported level",
+			properties:  &LockProperties{Level: to.Ptr(LockLevel("NoAccess"))},
+			expectedErr: v1.NewClientErrInvalidRequest("lock level \"NoAccess\" is not supported. Supported levels: CanNotDelete, ReadOnly"),
+		},
+		{
+			name:        "invalid resource id",
+			properties:  &LockProperties{Level: to.Ptr(LockLevelReadOnly), ResourceID: to.Ptr("not-an-id")},
+			expectedErr: v1.NewClientErrInvalidRequest("lock resource id \"not-an-id\" is not a valid resource id"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versioned := &LockResource{Properties: tt.properties}
+			_, err := versioned.ConvertTo()
+			require.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func Test_Lock_DataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("lock_datamodel.json")
+	data := &datamodel.Lock{}
+	err := json.Unmarshal(rawPayload, data)
+	require.NoError(t, err)
+
+	versioned := &LockResource{}
+	err = versioned.ConvertFrom(data)
+	require.NoError(t, err)
+
+	expected := &LockResource{
+		ID:   to.Ptr("/planes/radius/local/resourceGroups/test-group/providers/System.Resources/locks/freeze"),
+		Name: to.Ptr("freeze"),
+		Type: to.Ptr(datamodel.LockResourceType),
+		Properties: &LockProperties{
+			ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+			Level:             to.Ptr(LockLevelReadOnly),
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
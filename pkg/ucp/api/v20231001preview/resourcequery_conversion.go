@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"errors"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned ResourceQueryRequest to version-agnostic datamodel.
+func (src *ResourceQueryRequest) ConvertTo() (v1.DataModelInterface, error) {
+	return &datamodel.ResourceQuery{
+		Scopes:    to.StringArray(src.Scopes),
+		Filter:    to.String(src.Filter),
+		Select:    to.StringArray(src.Select),
+		Top:       int(to.Int32(src.Top)),
+		SkipToken: to.String(src.SkipToken),
+	}, nil
+}
+
+// ConvertFrom returns an error as the ResourceQueryRequest is only sent by clients.
+func (dst *ResourceQueryRequest) ConvertFrom(src v1.DataModelInterface) error {
+	return errors.New("converting a version-agnostic resource query to ResourceQueryRequest is not supported")
+}
+
+// ConvertTo returns an error as the ResourceQueryResponse is only returned by the server.
+func (src *ResourceQueryResponse) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, errors.New("converting ResourceQueryResponse to a version-agnostic object is not supported")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned ResourceQueryResponse.
+func (dst *ResourceQueryResponse) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.ResourceQueryResult)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.Count = to.Ptr(int64(dm.Count))
+	dst.Data = dm.Data
+	if dst.Data == nil {
+		dst.Data = []map[string]any{}
+	}
+
+	dst.SkipToken = nil
+	if dm.SkipToken != "" {
+		dst.SkipToken = to.Ptr(dm.SkipToken)
+	}
+
+	return nil
+}
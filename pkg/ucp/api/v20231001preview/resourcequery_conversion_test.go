@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResourceQueryRequest_VersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("resourcequery_request.json")
+	versioned := &ResourceQueryRequest{}
+	err := json.Unmarshal(rawPayload, versioned)
+	require.NoError(t, err)
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.ResourceQuery{
+		Scopes:    []string{"/planes/radius/local/resourceGroups/prod", "/planes/radius/local/resourceGroups/dev"},
+		Filter:    "type == 'Applications.Datastores/redisCaches' and tags.team =~ 'payments'",
+		Select:    []string{"id", "properties.environment"},
+		Top:       50,
+		SkipToken: "50",
+	}
+	require.Equal(t, expected, dm)
+}
+
+func Test_ResourceQueryRequest_DataModelToVersioned(t *testing.T) {
+	versioned := &ResourceQueryRequest{}
+	err := versioned.ConvertFrom(&datamodel.ResourceQuery{})
+	require.Error(t, err)
+}
+
+func Test_ResourceQueryResponse_DataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("resourcequeryresult_datamodel.json")
+	dm := &datamodel.ResourceQueryResult{}
+	err := json.Unmarshal(rawPayload, dm)
+	require.NoError(t, err)
+
+	versioned := &ResourceQueryResponse{}
+	err = versioned.ConvertFrom(dm)
+	require.NoError(t, err)
+
+	expected := &ResourceQueryResponse{
+		Count: to.Ptr(int64(1)),
+		Data: []map[string]any{
+			{
+				"id":                     "/planes/radius/local/resourceGroups/prod/providers/Applications.Datastores/redisCaches/cache",
+				"properties.environment": "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod",
+			},
+		},
+	}
+	require.Equal(t, expected, versioned)
+
+	dm.SkipToken = "1"
+	err = versioned.ConvertFrom(dm)
+	require.NoError(t, err)
+	require.Equal(t, to.Ptr("1"), versioned.SkipToken)
+}
+
+func Test_ResourceQueryResponse_VersionedToDataModel(t *testing.T) {
+	versioned := &ResourceQueryResponse{}
+	_, err := versioned.ConvertTo()
+	require.Error(t, err)
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"errors"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned ResourcesMoveInfo to version-agnostic datamodel.
+func (src *ResourcesMoveInfo) ConvertTo() (v1.DataModelInterface, error) {
+	return &datamodel.ResourcesMoveInfo{
+		Resources:           to.StringArray(src.Resources),
+		TargetResourceGroup: to.String(src.TargetResourceGroup),
+	}, nil
+}
+
+// ConvertFrom returns an error as the ResourcesMoveInfo is only sent by clients.
+func (dst *ResourcesMoveInfo) ConvertFrom(src v1.DataModelInterface) error {
+	return errors.New("converting a version-agnostic resources move info to ResourcesMoveInfo is not supported")
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResourcesMoveInfo_VersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("resourcesmoveinfo.json")
+	versioned := &ResourcesMoveInfo{}
+	err := json.Unmarshal(rawPayload, versioned)
+	require.NoError(t, err)
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.ResourcesMoveInfo{
+		Resources: []string{
+			"/planes/radius/local/resourceGroups/dev/providers/Applications.Core/applications/app",
+			"/planes/radius/local/resourceGroups/dev/providers/Applications.Core/containers/frontend",
+		},
+		TargetResourceGroup: "/planes/radius/local/resourceGroups/prod",
+	}
+	require.Equal(t, expected, dm)
+}
+
+func Test_ResourcesMoveInfo_DataModelToVersioned(t *testing.T) {
+	versioned := &ResourcesMoveInfo{}
+	err := versioned.ConvertFrom(&datamodel.ResourcesMoveInfo{})
+	require.Error(t, err)
+}
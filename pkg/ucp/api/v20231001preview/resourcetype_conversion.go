@@ -18,6 +18,8 @@ package v20231001preview
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
@@ -54,10 +56,17 @@ func (src *ResourceTypeResource) ConvertTo() (v1.DataModelInterface, error) {
 	dst.Properties = datamodel.ResourceTypeProperties{
 		Capabilities:      capabilities,
 		DefaultAPIVersion: src.Properties.DefaultAPIVersion,
+		StorageAPIVersion: src.Properties.StorageAPIVersion,
 	}
 
 	dst.Properties.Description = src.Properties.Description
 
+	actions, err := toActionsDataModel(src.Properties.Actions)
+	if err != nil {
+		return nil, err
+	}
+	dst.Properties.Actions = actions
+
 	return dst, nil
 }
 
@@ -79,6 +88,8 @@ func (dst *ResourceTypeResource) ConvertFrom(src v1.DataModelInterface) error {
 		Capabilities:      to.SliceOfPtrs(dm.Properties.Capabilities...),
 		DefaultAPIVersion: dm.Properties.DefaultAPIVersion,
 		Description:       dm.Properties.Description,
+		Actions:           fromActionsDataModel(dm.Properties.Actions),
+		StorageAPIVersion: dm.Properties.StorageAPIVersion,
 	}
 
 	return nil
@@ -95,3 +106,93 @@ func validateCapability(input *string) error {
 
 	return v1.NewClientErrInvalidRequest(fmt.Sprintf("capability %q is not recognized. Supported capabilities: %s", *input, datamodel.CapabilityManualResourceProvisioning))
 }
+
+// actionNamePattern is the pattern for the names of custom actions. Action names are used as a segment of the
+// request URL.
+var actionNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
+func toActionsDataModel(actions map[string]*ResourceTypeAction) (map[string]datamodel.ResourceTypeAction, error) {
+	if len(actions) == 0 {
+		return nil, nil
+	}
+
+	result := map[string]datamodel.ResourceTypeAction{}
+	for name, action := range actions {
+		if !actionNamePattern.MatchString(name) {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("action name %q is invalid. Action names must start with a letter and contain only letters and digits", name))
+		}
+
+		if action == nil || action.Handler == nil || action.Handler.Kind == nil {
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q must specify a handler kind", name))
+		}
+
+		handler := datamodel.ResourceTypeActionHandler{
+			Kind:     datamodel.ResourceTypeActionHandlerKind(*action.Handler.Kind),
+			Output:   to.String(action.Handler.Output),
+			Location: to.String(action.Handler.Location),
+		}
+
+		switch handler.Kind {
+		case datamodel.ResourceTypeActionHandlerKindRecipe:
+			if handler.Location != "" {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q uses a recipe handler and cannot specify a location", name))
+			}
+		case datamodel.ResourceTypeActionHandlerKindHook:
+			if handler.Output == "" {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q uses a hook handler and must specify the recipe output that contains the address of the hook", name))
+			}
+
+			if handler.Location != "" {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q uses a hook handler and cannot specify a location", name))
+			}
+		case datamodel.ResourceTypeActionHandlerKindProvider:
+			if handler.Output != "" {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q uses a provider handler and cannot specify a recipe output", name))
+			}
+
+			u, err := url.Parse(handler.Location)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q uses a provider handler and must specify an absolute http or https location", name))
+			}
+		default:
+			return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("action %q has an unsupported handler kind %q. Supported kinds: %s, %s, %s", name, handler.Kind, datamodel.ResourceTypeActionHandlerKindRecipe, datamodel.ResourceTypeActionHandlerKindHook, datamodel.ResourceTypeActionHandlerKindProvider))
+		}
+
+		result[name] = datamodel.ResourceTypeAction{
+			Description:    action.Description,
+			RequestSchema:  action.RequestSchema,
+			ResponseSchema: action.ResponseSchema,
+			Handler:        handler,
+		}
+	}
+
+	return result, nil
+}
+
+func fromActionsDataModel(actions map[string]datamodel.ResourceTypeAction) map[string]*ResourceTypeAction {
+	if len(actions) == 0 {
+		return nil
+	}
+
+	result := map[string]*ResourceTypeAction{}
+	for name, action := range actions {
+		handler := &ResourceTypeActionHandler{
+			Kind: to.Ptr(ResourceTypeActionHandlerKind(action.Handler.Kind)),
+		}
+		if action.Handler.Output != "" {
+			handler.Output = to.Ptr(action.Handler.Output)
+		}
+		if action.Handler.Location != "" {
+			handler.Location = to.Ptr(action.Handler.Location)
+		}
+
+		result[name] = &ResourceTypeAction{
+			Description:    action.Description,
+			RequestSchema:  action.RequestSchema,
+			ResponseSchema: action.ResponseSchema,
+			Handler:        handler,
+		}
+	}
+
+	return result
+}
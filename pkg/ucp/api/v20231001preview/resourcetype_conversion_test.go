@@ -50,6 +50,49 @@ func Test_ResourceType_VersionedToDataModel(t *testing.T) {
 				Properties: datamodel.ResourceTypeProperties{
 					Capabilities:      []string{},
 					DefaultAPIVersion: to.Ptr("2025-01-01"),
+					StorageAPIVersion: to.Ptr("2025-01-01"),
+				},
+			},
+		},
+		{
+			filename: "resourcetype_resource_actions.json",
+			expected: &datamodel.ResourceType{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources",
+						Name: "testResources",
+						Type: datamodel.ResourceTypeResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.ResourceTypeProperties{
+					Capabilities:      []string{},
+					DefaultAPIVersion: to.Ptr("2025-01-01"),
+					Actions: map[string]datamodel.ResourceTypeAction{
+						"listSecrets": {
+							Description:    to.Ptr("Lists the secrets of the resource."),
+							ResponseSchema: map[string]any{"type": "object"},
+							Handler: datamodel.ResourceTypeActionHandler{
+								Kind:   datamodel.ResourceTypeActionHandlerKindRecipe,
+								Output: "secrets",
+							},
+						},
+						"restart": {
+							Handler: datamodel.ResourceTypeActionHandler{
+								Kind:   datamodel.ResourceTypeActionHandlerKindHook,
+								Output: "restartUrl",
+							},
+						},
+						"rotateKey": {
+							RequestSchema: map[string]any{"type": "object"},
+							Handler: datamodel.ResourceTypeActionHandler{
+								Kind:     datamodel.ResourceTypeActionHandlerKindProvider,
+								Location: "http://keys.example.com",
+							},
+						},
+					},
 				},
 			},
 		},
@@ -90,6 +133,43 @@ func Test_ResourceType_DataModelToVersioned(t *testing.T) {
 					ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
 					Capabilities:      []*string{},
 					DefaultAPIVersion: to.Ptr("2025-01-01"),
+					StorageAPIVersion: to.Ptr("2025-01-01"),
+				},
+			},
+		},
+		{
+			filename: "resourcetype_datamodel_actions.json",
+			expected: &ResourceTypeResource{
+				ID:   to.Ptr("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources"),
+				Type: to.Ptr(datamodel.ResourceTypeResourceType),
+				Name: to.Ptr("testResources"),
+				Properties: &ResourceTypeProperties{
+					ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+					Capabilities:      []*string{},
+					DefaultAPIVersion: to.Ptr("2025-01-01"),
+					Actions: map[string]*ResourceTypeAction{
+						"listSecrets": {
+							Description:    to.Ptr("Lists the secrets of the resource."),
+							ResponseSchema: map[string]any{"type": "object"},
+							Handler: &ResourceTypeActionHandler{
+								Kind:   to.Ptr(ResourceTypeActionHandlerKindRecipe),
+								Output: to.Ptr("secrets"),
+							},
+						},
+						"restart": {
+							Handler: &ResourceTypeActionHandler{
+								Kind:   to.Ptr(ResourceTypeActionHandlerKindHook),
+								Output: to.Ptr("restartUrl"),
+							},
+						},
+						"rotateKey": {
+							RequestSchema: map[string]any{"type": "object"},
+							Handler: &ResourceTypeActionHandler{
+								Kind:     to.Ptr(ResourceTypeActionHandlerKindProvider),
+								Location: to.Ptr("http://keys.example.com"),
+							},
+						},
+					},
 				},
 			},
 		},
@@ -150,3 +230,75 @@ func Test_validateCapability(t *testing.T) {
 		})
 	}
 }
+
+func Test_toActionsDataModel_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		actions     map[string]*ResourceTypeAction
+		expectedErr error
+	}{
+		{
+			name: "invalid name",
+			actions: map[string]*ResourceTypeAction{
+				"rotate-key": {Handler: &ResourceTypeActionHandler{Kind: to.Ptr(ResourceTypeActionHandlerKindRecipe)}},
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("action name \"rotate-key\" is invalid. Action names must start with a letter and contain only letters and digits"),
+		},
+		{
+			name: "missing handler",
+			actions: map[string]*ResourceTypeAction{
+				"rotateKey": {},
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("action \"rotateKey\" must specify a handler kind"),
+		},
+		{
+			name: "unsupported kind",
+			actions: map[string]*ResourceTypeAction{
+				"rotateKey": {Handler: &ResourceTypeActionHandler{Kind: to.Ptr(ResourceTypeActionHandlerKind("function"))}},
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("action \"rotateKey\" has an unsupported handler kind \"function\". Supported kinds: recipe, hook, provider"),
+		},
+		{
+			name: "hook handler without output",
+			actions: map[string]*ResourceTypeAction{
+				"restart": {Handler: &ResourceTypeActionHandler{Kind: to.Ptr(ResourceTypeActionHandlerKindHook)}},
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("action \"restart\" uses a hook handler and must specify the recipe output that contains the address of the hook"),
+		},
+		{
+			name: "hook handler with location",
+			actions: map[string]*ResourceTypeAction{
+				"restart": {Handler: &ResourceTypeActionHandler{Kind: to.Ptr(ResourceTypeActionHandlerKindHook), Output: to.Ptr("restartUrl"), Location: to.Ptr("http://keys.example.com")}},
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("action \"restart\" uses a hook handler and cannot specify a location"),
+		},
+		{
+			name: "recipe handler with location",
+			actions: map[string]*ResourceTypeAction{
+				"rotateKey": {Handler: &ResourceTypeActionHandler{Kind: to.Ptr(ResourceTypeActionHandlerKindRecipe), Location: to.Ptr("http://keys.example.com")}},
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("action \"rotateKey\" uses a recipe handler and cannot specify a location"),
+		},
+		{
+			name: "provider handler without location",
+			actions: map[string]*ResourceTypeAction{
+				"rotateKey": {Handler: &ResourceTypeActionHandler{Kind: to.Ptr(ResourceTypeActionHandlerKindProvider)}},
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("action \"rotateKey\" uses a provider handler and must specify an absolute http or https location"),
+		},
+		{
+			name: "provider handler with output",
+			actions: map[string]*ResourceTypeAction{
+				"rotateKey": {Handler: &ResourceTypeActionHandler{Kind: to.Ptr(ResourceTypeActionHandlerKindProvider), Location: to.Ptr("http://keys.example.com"), Output: to.Ptr("key")}},
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("action \"rotateKey\" uses a provider handler and cannot specify a recipe output"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := toActionsDataModel(tt.actions)
+			require.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
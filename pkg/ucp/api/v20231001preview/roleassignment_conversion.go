@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v20231001preview
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ConvertTo converts from the versioned RoleAssignmentResource resource to version-agnostic datamodel.
+func (src *RoleAssignmentResource) ConvertTo() (v1.DataModelInterface, error) {
+	if src.Properties == nil {
+		return nil, v1.NewClientErrInvalidRequest("role assignment must specify properties")
+	}
+
+	if to.String(src.Properties.PrincipalID) == "" {
+		return nil, v1.NewClientErrInvalidRequest("role assignment must specify a principalId")
+	}
+
+	if src.Properties.PrincipalType == nil {
+		return nil, v1.NewClientErrInvalidRequest("role assignment must specify a principalType")
+	}
+
+	principalType, err := toPrincipalTypeDataModel(*src.Properties.PrincipalType)
+	if err != nil {
+		return nil, err
+	}
+
+	roleDefinitionID, err := resources.ParseResource(to.String(src.Properties.RoleDefinitionID))
+	if err != nil || !strings.EqualFold(roleDefinitionID.Type(), datamodel.RoleDefinitionResourceType) {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("role definition id %q is not a valid role definition id", to.String(src.Properties.RoleDefinitionID)))
+	}
+
+	scope, err := resources.Parse(to.String(src.Properties.Scope))
+	if err != nil || len(scope.ScopeSegments()) == 0 {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("role assignment scope %q is not a valid scope", to.String(src.Properties.Scope)))
+	}
+
+	dst := &datamodel.RoleAssignment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: datamodel.RoleAssignmentResourceType,
+
+				// NOTE: this is a proxy resource. It does not have a location or tags.
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.RoleAssignmentProperties{
+			PrincipalID:      *src.Properties.PrincipalID,
+			PrincipalType:    principalType,
+			RoleDefinitionID: roleDefinitionID.String(),
+			Scope:            scope.String(),
+		},
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned RoleAssignmentResource resource.
+func (dst *RoleAssignmentResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.RoleAssignment)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(dm.ID)
+	dst.Name = to.Ptr(dm.Name)
+	dst.Type = to.Ptr(dm.Type)
+
+	dst.Properties = &RoleAssignmentProperties{
+		ProvisioningState: to.Ptr(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
+		PrincipalID:       to.Ptr(dm.Properties.PrincipalID),
+		PrincipalType:     to.Ptr(PrincipalType(dm.Properties.PrincipalType)),
+		RoleDefinitionID:  to.Ptr(dm.Properties.RoleDefinitionID),
+		Scope:             to.Ptr(dm.Properties.Scope),
+	}
+
+	return nil
+}
+
+func toPrincipalTypeDataModel(principalType PrincipalType) (datamodel.PrincipalType, error) {
+	for _, possible := range PossiblePrincipalTypeValues() {
+		if strings.EqualFold(string(principalType), string(possible)) {
+			return datamodel.PrincipalType(possible), nil
+		}
+	}
+
+	return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("principal type %q is not supported. Supported types: %s, %s", principalType, PrincipalTypeGroup, PrincipalTypeUser))
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RoleAssignment_VersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roleassignment_resource.json")
+	versioned := &RoleAssignmentResource{}
+	err := json.Unmarshal(rawPayload, versioned)
+	require.NoError(t, err)
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.RoleAssignment{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/providers/System.Authorization/roleAssignments/dev-team-contributor",
+				Name: "dev-team-contributor",
+				Type: datamodel.RoleAssignmentResourceType,
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.RoleAssignmentProperties{
+			PrincipalID:      "dev-team",
+			PrincipalType:    datamodel.PrincipalTypeGroup,
+			RoleDefinitionID: "/planes/radius/local/providers/System.Authorization/roleDefinitions/Contributor",
+			Scope:            "/planes/radius/local/resourceGroups/dev",
+		},
+	}
+	require.Equal(t, expected, dm)
+}
+
+func Test_RoleAssignment_VersionedToDataModel_Invalid(t *testing.T) {
+	valid := func() *RoleAssignmentProperties {
+		return &RoleAssignmentProperties{
+			PrincipalID:      to.Ptr("alice"),
+			PrincipalType:    to.Ptr(PrincipalTypeUser),
+			RoleDefinitionID: to.Ptr("/planes/radius/local/providers/System.Authorization/roleDefinitions/Reader"),
+			Scope:            to.Ptr("/planes/radius/local"),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(p *RoleAssignmentProperties) *RoleAssignmentProperties
+		expectedErr error
+	}{
+		{
+			name:        "missing properties",
+			modify:      func(p *RoleAssignmentProperties) *RoleAssignmentProperties { return nil },
+			expectedErr: v1.NewClientErrInvalidRequest("role assignment must specify properties"),
+		},
+		{
+			name: "missing principal id",
+			modify: func(p *RoleAssignmentProperties) *RoleAssignmentProperties {
+				p.PrincipalID = nil
+				return p
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("role assignment must specify a principalId"),
+		},
+		{
+			name: "missing principal type",
+			modify: func(p *RoleAssignmentProperties) *RoleAssignmentProperties {
+				p.PrincipalType = nil
+				return p
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("role assignment must specify a principalType"),
+		},
+		{
+			name: "unsupported principal type",
+			modify: func(p *RoleAssignmentProperties) *RoleAssignmentProperties {
+				p.PrincipalType = to.Ptr(PrincipalType("ServicePrincipal"))
+				return p
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("principal type \"ServicePrincipal\" is not supported. Supported types: Group, User"),
+		},
+		{
+			name: "role definition id of wrong type",
+			modify: func(p *RoleAssignmentProperties) *RoleAssignmentProperties {
+				p.RoleDefinitionID = to.Ptr("/planes/radius/local/resourceGroups/dev/providers/Applications.Core/environments/env")
+				return p
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("role definition id \"/planes/radius/local/resourceGroups/dev/providers/Applications.Core/environments/env\" is not a valid role definition id"),
+		},
+		{
+			name: "invalid scope",
+			modify: func(p *RoleAssignmentProperties) *RoleAssignmentProperties {
+				p.Scope = to.Ptr("not-a-scope")
+				return p
+			},
+			expectedErr: v1.NewClientErrInvalidRequest("role assignment scope \"not-a-scope\" is not a valid scope"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versioned := &RoleAssignmentResource{Properties: tt.modify(valid())}
+			_, err := versioned.ConvertTo()
+			require.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func Test_RoleAssignment_DataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roleassignment_datamodel.json")
+	data := &datamodel.RoleAssignment{}
+	err := json.Unmarshal(rawPayload, data)
+	require.NoError(t, err)
+
+	versioned := &RoleAssignmentResource{}
+	err = versioned.ConvertFrom(data)
+	require.NoError(t, err)
+
+	expected := &RoleAssignmentResource{
+		ID:   to.Ptr("/planes/radius/local/providers/System.Authorization/roleAssignments/alice-reader"),
+		Name: to.Ptr("alice-reader"),
+		Type: to.Ptr(datamodel.RoleAssignmentResourceType),
+		Properties: &RoleAssignmentProperties{
+			ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+			PrincipalID:       to.Ptr("alice@example.com"),
+			PrincipalType:     to.Ptr(PrincipalTypeUser),
+			RoleDefinitionID:  to.Ptr("/planes/radius/local/providers/System.Authorization/roleDefinitions/Reader"),
+			Scope:             to.Ptr("/planes/radius/local"),
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
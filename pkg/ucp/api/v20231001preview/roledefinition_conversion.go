@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v20231001preview
+
+import (
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned RoleDefinitionResource resource to version-agnostic datamodel.
+func (src *RoleDefinitionResource) ConvertTo() (v1.DataModelInterface, error) {
+	if src.Properties == nil || len(src.Properties.Actions) == 0 {
+		return nil, v1.NewClientErrInvalidRequest("role definition must specify at least one action")
+	}
+
+	dst := &datamodel.RoleDefinition{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   to.String(src.ID),
+				Name: to.String(src.Name),
+				Type: datamodel.RoleDefinitionResourceType,
+
+				// NOTE: this is a proxy resource. It does not have a location or tags.
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.RoleDefinitionProperties{
+			Description: to.String(src.Properties.Description),
+			Actions:     to.StringArray(src.Properties.Actions),
+			NotActions:  to.StringArray(src.Properties.NotActions),
+		},
+	}
+
+	return dst, nil
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned RoleDefinitionResource resource.
+func (dst *RoleDefinitionResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.RoleDefinition)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = to.Ptr(dm.ID)
+	dst.Name = to.Ptr(dm.Name)
+	dst.Type = to.Ptr(dm.Type)
+
+	dst.Properties = &RoleDefinitionProperties{
+		ProvisioningState: to.Ptr(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
+		Actions:           to.ArrayofStringPtrs(dm.Properties.Actions),
+	}
+
+	if dm.Properties.Description != "" {
+		dst.Properties.Description = to.Ptr(dm.Properties.Description)
+	}
+	if len(dm.Properties.NotActions) > 0 {
+		dst.Properties.NotActions = to.ArrayofStringPtrs(dm.Properties.NotActions)
+	}
+
+	return nil
+}
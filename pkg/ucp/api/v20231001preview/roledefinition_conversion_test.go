@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package v20231001preview
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RoleDefinition_VersionedToDataModel(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roledefinition_resource.json")
+	versioned := &RoleDefinitionResource{}
+	err := json.Unmarshal(rawPayload, versioned)
+	require.NoError(t, err)
+
+	dm, err := versioned.ConvertTo()
+	require.NoError(t, err)
+
+	expected := &datamodel.RoleDefinition{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   "/planes/radius/local/providers/System.Authorization/roleDefinitions/env-operator",
+				Name: "env-operator",
+				Type: datamodel.RoleDefinitionResourceType,
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: datamodel.RoleDefinitionProperties{
+			Description: "Can read everything and manage environments.",
+			Actions:     []string{"*/read", "Applications.Core/environments/*"},
+			NotActions:  []string{"Applications.Core/environments/delete"},
+		},
+	}
+	require.Equal(t, expected, dm)
+}
+
+func Test_RoleDefinition_VersionedToDataModel_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		properties  *RoleDefinitionProperties
+		expectedErr error
+	}{
+		{
+			name:        "missing properties",
+			properties:  nil,
+			expectedErr: v1.NewClientErrInvalidRequest("role definition must specify at least one action"),
+		},
+		{
+			name:        "missing actions",
+			properties:  &RoleDefinitionProperties{Description: to.Ptr("empty")},
+			expectedErr: v1.NewClientErrInvalidRequest("role definition must specify at least one action"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versioned := &RoleDefinitionResource{Properties: tt.properties}
+			_, err := versioned.ConvertTo()
+			require.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func Test_RoleDefinition_DataModelToVersioned(t *testing.T) {
+	rawPayload := testutil.ReadFixture("roledefinition_datamodel.json")
+	data := &datamodel.RoleDefinition{}
+	err := json.Unmarshal(rawPayload, data)
+	require.NoError(t, err)
+
+	versioned := &RoleDefinitionResource{}
+	err = versioned.ConvertFrom(data)
+	require.NoError(t, err)
+
+	expected := &RoleDefinitionResource{
+		ID:   to.Ptr("/planes/radius/local/providers/System.Authorization/roleDefinitions/viewer"),
+		Name: to.Ptr("viewer"),
+		Type: to.Ptr(datamodel.RoleDefinitionResourceType),
+		Properties: &RoleDefinitionProperties{
+			ProvisioningState: to.Ptr(ProvisioningStateSucceeded),
+			Actions:           []*string{to.Ptr("*/read")},
+		},
+	}
+	require.Equal(t, expected, versioned)
+}
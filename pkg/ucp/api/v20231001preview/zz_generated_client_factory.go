@@ -36,6 +36,13 @@ func (c *ClientFactory) NewAPIVersionsClient() *APIVersionsClient {
 	}
 }
 
+// NewAuditRecordsClient creates a new instance of AuditRecordsClient.
+func (c *ClientFactory) NewAuditRecordsClient() *AuditRecordsClient {
+	return &AuditRecordsClient{
+		internal: c.internal,
+	}
+}
+
 // NewAwsCredentialsClient creates a new instance of AwsCredentialsClient.
 func (c *ClientFactory) NewAwsCredentialsClient() *AwsCredentialsClient {
 	return &AwsCredentialsClient{
@@ -71,6 +78,13 @@ func (c *ClientFactory) NewLocationsClient() *LocationsClient {
 	}
 }
 
+// NewLocksClient creates a new instance of LocksClient.
+func (c *ClientFactory) NewLocksClient() *LocksClient {
+	return &LocksClient{
+		internal: c.internal,
+	}
+}
+
 // NewPlanesClient creates a new instance of PlanesClient.
 func (c *ClientFactory) NewPlanesClient() *PlanesClient {
 	return &PlanesClient{
@@ -99,6 +113,13 @@ func (c *ClientFactory) NewResourceProvidersClient() *ResourceProvidersClient {
 	}
 }
 
+// NewResourceQueryClient creates a new instance of ResourceQueryClient.
+func (c *ClientFactory) NewResourceQueryClient() *ResourceQueryClient {
+	return &ResourceQueryClient{
+		internal: c.internal,
+	}
+}
+
 // NewResourceTypesClient creates a new instance of ResourceTypesClient.
 func (c *ClientFactory) NewResourceTypesClient() *ResourceTypesClient {
 	return &ResourceTypesClient{
@@ -112,3 +133,17 @@ func (c *ClientFactory) NewResourcesClient() *ResourcesClient {
 		internal: c.internal,
 	}
 }
+
+// NewRoleAssignmentsClient creates a new instance of RoleAssignmentsClient.
+func (c *ClientFactory) NewRoleAssignmentsClient() *RoleAssignmentsClient {
+	return &RoleAssignmentsClient{
+		internal: c.internal,
+	}
+}
+
+// NewRoleDefinitionsClient creates a new instance of RoleDefinitionsClient.
+func (c *ClientFactory) NewRoleDefinitionsClient() *RoleDefinitionsClient {
+	return &RoleDefinitionsClient{
+		internal: c.internal,
+	}
+}
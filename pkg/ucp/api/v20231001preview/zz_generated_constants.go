@@ -22,6 +22,47 @@ func PossibleAWSCredentialKindValues() []AWSCredentialKind {
 	}
 }
 
+// APIVersionConversionRuleKind - The kind of a conversion rule.
+type APIVersionConversionRuleKind string
+
+const (
+	// APIVersionConversionRuleKindDefault - The property at 'path' only exists in the storage API version. It is set to 'value'
+	// when it is not set, and removed when converting to this API version.
+	APIVersionConversionRuleKindDefault APIVersionConversionRuleKind = "default"
+	// APIVersionConversionRuleKindRename - The property is renamed or moved. The property at 'from' in this API version is stored
+	// at 'to' in the storage API version.
+	APIVersionConversionRuleKindRename APIVersionConversionRuleKind = "rename"
+)
+
+// PossibleAPIVersionConversionRuleKindValues returns the possible values for the APIVersionConversionRuleKind const type.
+func PossibleAPIVersionConversionRuleKindValues() []APIVersionConversionRuleKind {
+	return []APIVersionConversionRuleKind{
+		APIVersionConversionRuleKindDefault,
+		APIVersionConversionRuleKindRename,
+	}
+}
+
+// AuditResult - The result of an audited operation.
+type AuditResult string
+
+const (
+	// AuditResultAccepted - The operation was accepted and will complete asynchronously.
+	AuditResultAccepted AuditResult = "Accepted"
+	// AuditResultFailed - The operation failed.
+	AuditResultFailed AuditResult = "Failed"
+	// AuditResultSucceeded - The operation completed successfully.
+	AuditResultSucceeded AuditResult = "Succeeded"
+)
+
+// PossibleAuditResultValues returns the possible values for the AuditResult const type.
+func PossibleAuditResultValues() []AuditResult {
+	return []AuditResult{
+		AuditResultAccepted,
+		AuditResultFailed,
+		AuditResultSucceeded,
+	}
+}
+
 // AzureCredentialKind - Azure credential kinds supported.
 type AzureCredentialKind string
 
@@ -77,6 +118,42 @@ func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	}
 }
 
+// LockLevel - The level of a management lock.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete - Authorized users can read and modify the locked resources, but can't delete them.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+	// LockLevelReadOnly - Authorized users can read the locked resources, but can't modify or delete them.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// PossibleLockLevelValues returns the possible values for the LockLevel const type.
+func PossibleLockLevelValues() []LockLevel {
+	return []LockLevel{
+		LockLevelCanNotDelete,
+		LockLevelReadOnly,
+	}
+}
+
+// PrincipalType - The type of the principal of a role assignment.
+type PrincipalType string
+
+const (
+	// PrincipalTypeGroup - A group of users, such as a Kubernetes group or a group claim of an OIDC token.
+	PrincipalTypeGroup PrincipalType = "Group"
+	// PrincipalTypeUser - A user, such as a Kubernetes service account or the subject of an OIDC token.
+	PrincipalTypeUser PrincipalType = "User"
+)
+
+// PossiblePrincipalTypeValues returns the possible values for the PrincipalType const type.
+func PossiblePrincipalTypeValues() []PrincipalType {
+	return []PrincipalType{
+		PrincipalTypeGroup,
+		PrincipalTypeUser,
+	}
+}
+
 // ProvisioningState - Provisioning state of the resource at the time the operation was called
 type ProvisioningState string
 
@@ -112,3 +189,24 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 		ProvisioningStateUpdating,
 	}
 }
+
+// ResourceTypeActionHandlerKind - The kind of handler that implements a custom action.
+type ResourceTypeActionHandlerKind string
+
+const (
+	// ResourceTypeActionHandlerKindHook - The action is forwarded to the hook endpoint whose address is returned by the recipe that deployed the resource.
+	ResourceTypeActionHandlerKindHook ResourceTypeActionHandlerKind = "hook"
+	// ResourceTypeActionHandlerKindProvider - The action is forwarded to an external resource provider.
+	ResourceTypeActionHandlerKindProvider ResourceTypeActionHandlerKind = "provider"
+	// ResourceTypeActionHandlerKindRecipe - The action returns the outputs of the recipe that deployed the resource. Secret outputs are only returned when named by the handler.
+	ResourceTypeActionHandlerKindRecipe ResourceTypeActionHandlerKind = "recipe"
+)
+
+// PossibleResourceTypeActionHandlerKindValues returns the possible values for the ResourceTypeActionHandlerKind const type.
+func PossibleResourceTypeActionHandlerKindValues() []ResourceTypeActionHandlerKind {
+	return []ResourceTypeActionHandlerKind{
+		ResourceTypeActionHandlerKindHook,
+		ResourceTypeActionHandlerKindProvider,
+		ResourceTypeActionHandlerKindRecipe,
+	}
+}
@@ -6,8 +6,46 @@ package v20231001preview
 
 import "time"
 
+// APIVersionConversion - The conversion between an API version and the storage API version of a resource type. Either rules
+// or a webhook can be specified.
+type APIVersionConversion struct {
+	// The rules that convert a resource from this API version to the storage API version. The rules are applied in reverse order
+	// to convert a resource from the storage API version to this API version.
+	Rules []*APIVersionConversionRule
+
+	// The webhook that converts resources between this API version and the storage API version.
+	Webhook *APIVersionConversionWebhook
+}
+
+// APIVersionConversionRule - A declarative rule that converts a resource from an API version to the storage API version.
+type APIVersionConversionRule struct {
+	// REQUIRED; The kind of rule.
+	Kind *APIVersionConversionRuleKind
+
+	// The path of the property in this API version for a rename rule, for example 'database.host'.
+	From *string
+
+	// The path of the property in the storage API version for a default rule.
+	Path *string
+
+	// The path of the property in the storage API version for a rename rule.
+	To *string
+
+	// The value of the property for a default rule.
+	Value any
+}
+
+// APIVersionConversionWebhook - A webhook that converts resources between API versions.
+type APIVersionConversionWebhook struct {
+	// REQUIRED; The URL of the webhook.
+	URL *string
+}
+
 // APIVersionProperties - The properties of an API version.
 type APIVersionProperties struct {
+	// The conversion between this API version and the storage API version of the resource type.
+	Conversion *APIVersionConversion
+
 	// Schema is the schema for the resource type.
 	Schema map[string]any
 
@@ -43,6 +81,69 @@ type APIVersionResourceListResult struct {
 	NextLink *string
 }
 
+// AuditRecordProperties - The properties of an audit record.
+type AuditRecordProperties struct {
+	// READ-ONLY; The HTTP method of the operation.
+	Method *string
+
+	// READ-ONLY; The name of the principal that performed the operation.
+	PrincipalName *string
+
+	// READ-ONLY; The ID of the resource or scope the operation targets.
+	ResourceID *string
+
+	// READ-ONLY; The result of the operation.
+	Result *AuditResult
+
+	// READ-ONLY; The HTTP status code of the response.
+	StatusCode *int32
+
+	// READ-ONLY; The time the operation was received.
+	Timestamp *time.Time
+
+	// READ-ONLY; The API version of the operation.
+	APIVersion *string
+
+	// READ-ONLY; The correlation ID sent by the caller.
+	CorrelationID *string
+
+	// READ-ONLY; The ID assigned to the operation by UCP.
+	OperationID *string
+
+	// READ-ONLY; The groups of the principal that performed the operation.
+	PrincipalGroups []*string
+
+	// READ-ONLY; The SHA-256 digest of the request body, in the form 'sha256:<hex>'.
+	RequestDigest *string
+}
+
+// AuditRecordResource - An audit record of a mutating operation performed on the control plane.
+type AuditRecordResource struct {
+	// The resource-specific properties for this resource.
+	Properties *AuditRecordProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// AuditRecordResourceListResult - The response of a AuditRecordResource list operation.
+type AuditRecordResourceListResult struct {
+	// REQUIRED; The AuditRecordResource items on this page
+	Value []*AuditRecordResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // AwsAccessKeyCredentialProperties - AWS credential properties for Access Key
 type AwsAccessKeyCredentialProperties struct {
 	// REQUIRED; Access key ID for AWS identity
@@ -537,6 +638,50 @@ type LocationResourceType struct {
 	APIVersions map[string]map[string]any
 }
 
+// LockProperties - The properties of a management lock.
+type LockProperties struct {
+	// REQUIRED; The level of the lock.
+	Level *LockLevel
+
+	// Notes about the lock, for example why it was created.
+	Notes *string
+
+	// The ID of the resource the lock applies to. The lock applies to the resource and its child resources. A lock on an application
+	// or environment also applies to the resources in it. When not set, the lock applies to the resource group and every resource
+	// in it.
+	ResourceID *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// LockResource - A management lock that prevents resources in a resource group from being modified or deleted.
+type LockResource struct {
+	// The resource-specific properties for this resource.
+	Properties *LockProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// LockResourceListResult - The response of a LockResource list operation.
+type LockResourceListResult struct {
+	// REQUIRED; The LockResource items on this page
+	Value []*LockResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // PagedResourceProviderSummary - Paged collection of ResourceProviderSummary items
 type PagedResourceProviderSummary struct {
 	// REQUIRED; The ResourceProviderSummary items on this page
@@ -743,8 +888,69 @@ type ResourceProviderSummaryResourceType struct {
 	Description *string
 }
 
+// ResourceQueryRequest - A query for the resources in one or more scopes.
+type ResourceQueryRequest struct {
+	// The filter expression that resources must match. An empty filter matches every resource.
+	Filter *string
+
+	// The IDs of the planes, resource groups or resources to query. Defaults to the plane.
+	Scopes []*string
+
+	// The property paths to return for each resource. Defaults to the id, name, type, location, tags, systemData and properties
+	// of the resource.
+	Select []*string
+
+	// The token returned by a previous query to continue from.
+	SkipToken *string
+
+	// The maximum number of resources to return. Defaults to 100.
+	Top *int32
+}
+
+// ResourceQueryResponse - A page of the results of a resource query.
+type ResourceQueryResponse struct {
+	// REQUIRED; The number of resources on this page.
+	Count *int64
+
+	// REQUIRED; The matching resources on this page, ordered by resource ID.
+	Data []map[string]any
+
+	// The token to query the next page. It is not set on the last page.
+	SkipToken *string
+}
+
+// ResourceTypeAction - A custom action that can be invoked on resources of a resource type with a POST request.
+type ResourceTypeAction struct {
+	// REQUIRED; The handler that implements the action.
+	Handler *ResourceTypeActionHandler
+
+	// Description of the action.
+	Description *string
+
+	// The schema of the request body of the action.
+	RequestSchema map[string]any
+
+	// The schema of the response body of the action.
+	ResponseSchema map[string]any
+}
+
+// ResourceTypeActionHandler - The handler that implements a custom action.
+type ResourceTypeActionHandler struct {
+	// REQUIRED; The kind of handler.
+	Kind *ResourceTypeActionHandlerKind
+
+	// The address of the resource provider that implements a provider handler.
+	Location *string
+
+	// The name of the recipe output returned by a recipe handler, or of the recipe output that contains the address of the hook endpoint for a hook handler. The recipe outputs that are not secrets are returned by a recipe handler when not set.
+	Output *string
+}
+
 // ResourceTypeProperties - The properties of a resource type.
 type ResourceTypeProperties struct {
+	// The custom actions supported by resources of the resource type, keyed by action name.
+	Actions map[string]*ResourceTypeAction
+
 	// The resource type capabilities.
 	Capabilities []*string
 
@@ -754,6 +960,10 @@ type ResourceTypeProperties struct {
 	// Description of the resource type.
 	Description *string
 
+	// The API version used to store resources of the resource type. Resources are converted to and from the storage API version
+	// using the conversions declared by the other API versions. Resources are stored as-is when not set.
+	StorageAPIVersion *string
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -791,6 +1001,105 @@ type ResourceTypeSummaryResultAPIVersion struct {
 	Schema map[string]any
 }
 
+// ResourcesMoveInfo - The resources to move to another resource group.
+type ResourcesMoveInfo struct {
+	// REQUIRED; The IDs of the resources to move. The resources must be top-level resources in the resource group.
+	Resources []*string
+
+	// REQUIRED; The ID of the resource group to move the resources to. The resource group must be in the same plane.
+	TargetResourceGroup *string
+}
+
+// RoleAssignmentProperties - The properties of a role assignment.
+type RoleAssignmentProperties struct {
+	// REQUIRED; The name of the principal the role is assigned to, for example a Kubernetes user or service account name, or
+	// the subject of an OIDC token.
+	PrincipalID *string
+
+	// REQUIRED; The type of the principal the role is assigned to.
+	PrincipalType *PrincipalType
+
+	// REQUIRED; The ID of the role definition to assign. Built-in roles are referenced by their name, for example /planes/radius/local/providers/System.Authorization/roleDefinitions/Reader.
+	RoleDefinitionID *string
+
+	// REQUIRED; The ID of the scope the role is assigned at. The role applies to the scope and every resource in it. The scope
+	// can be a plane, a resource group or a resource.
+	Scope *string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// RoleAssignmentResource - A role assignment grants a principal the permissions of a role definition at a scope.
+type RoleAssignmentResource struct {
+	// The resource-specific properties for this resource.
+	Properties *RoleAssignmentProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// RoleAssignmentResourceListResult - The response of a RoleAssignmentResource list operation.
+type RoleAssignmentResourceListResult struct {
+	// REQUIRED; The RoleAssignmentResource items on this page
+	Value []*RoleAssignmentResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// RoleDefinitionProperties - The properties of a role definition.
+type RoleDefinitionProperties struct {
+	// REQUIRED; The actions allowed by the role. Actions have the format {resourceType}/{operation}, for example Applications.Core/containers/write.
+	// Wildcards (*) are supported.
+	Actions []*string
+
+	// The description of the role.
+	Description *string
+
+	// The actions excluded from the allowed actions. Wildcards (*) are supported.
+	NotActions []*string
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// RoleDefinitionResource - A role definition defines the actions that a principal is allowed to perform.
+type RoleDefinitionResource struct {
+	// The resource-specific properties for this resource.
+	Properties *RoleDefinitionProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// RoleDefinitionResourceListResult - The response of a RoleDefinitionResource list operation.
+type RoleDefinitionResourceListResult struct {
+	// REQUIRED; The RoleDefinitionResource items on this page
+	Value []*RoleDefinitionResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
 // SystemData - Metadata pertaining to creation and last modification of the resource.
 type SystemData struct {
 	// The timestamp of resource creation (UTC).
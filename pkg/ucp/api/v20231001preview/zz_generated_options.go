@@ -4,6 +4,8 @@
 
 package v20231001preview
 
+import "time"
+
 // APIVersionsClientBeginCreateOrUpdateOptions contains the optional parameters for the APIVersionsClient.BeginCreateOrUpdate
 // method.
 type APIVersionsClientBeginCreateOrUpdateOptions struct {
@@ -27,6 +29,18 @@ type APIVersionsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// AuditRecordsClientListOptions contains the optional parameters for the AuditRecordsClient.NewListPager method.
+type AuditRecordsClientListOptions struct {
+	// The latest time of the audit records to return, in RFC 3339 format.
+	EndTime *time.Time
+
+	// The ID of the plane, resource group or resource to list audit records for. Defaults to the plane.
+	Scope *string
+
+	// The earliest time of the audit records to return, in RFC 3339 format.
+	StartTime *time.Time
+}
+
 // AwsCredentialsClientCreateOrUpdateOptions contains the optional parameters for the AwsCredentialsClient.CreateOrUpdate
 // method.
 type AwsCredentialsClientCreateOrUpdateOptions struct {
@@ -160,6 +174,26 @@ type LocationsClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// LocksClientCreateOrUpdateOptions contains the optional parameters for the LocksClient.CreateOrUpdate method.
+type LocksClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientDeleteOptions contains the optional parameters for the LocksClient.Delete method.
+type LocksClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientGetOptions contains the optional parameters for the LocksClient.Get method.
+type LocksClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// LocksClientListOptions contains the optional parameters for the LocksClient.NewListPager method.
+type LocksClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
 // PlanesClientListPlanesOptions contains the optional parameters for the PlanesClient.NewListPlanesPager method.
 type PlanesClientListPlanesOptions struct {
 	// placeholder for future optional parameters
@@ -194,6 +228,13 @@ type RadiusPlanesClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ResourceGroupsClientBeginMoveResourcesOptions contains the optional parameters for the ResourceGroupsClient.BeginMoveResources
+// method.
+type ResourceGroupsClientBeginMoveResourcesOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
 // ResourceGroupsClientCreateOrUpdateOptions contains the optional parameters for the ResourceGroupsClient.CreateOrUpdate
 // method.
 type ResourceGroupsClientCreateOrUpdateOptions struct {
@@ -220,6 +261,12 @@ type ResourceGroupsClientUpdateOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ResourceGroupsClientValidateMoveResourcesOptions contains the optional parameters for the ResourceGroupsClient.ValidateMoveResources
+// method.
+type ResourceGroupsClientValidateMoveResourcesOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ResourceProvidersClientBeginCreateOrUpdateOptions contains the optional parameters for the ResourceProvidersClient.BeginCreateOrUpdate
 // method.
 type ResourceProvidersClientBeginCreateOrUpdateOptions struct {
@@ -256,6 +303,11 @@ type ResourceProvidersClientListProviderSummariesOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ResourceQueryClientQueryOptions contains the optional parameters for the ResourceQueryClient.Query method.
+type ResourceQueryClientQueryOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ResourceTypesClientBeginCreateOrUpdateOptions contains the optional parameters for the ResourceTypesClient.BeginCreateOrUpdate
 // method.
 type ResourceTypesClientBeginCreateOrUpdateOptions struct {
@@ -283,3 +335,43 @@ type ResourceTypesClientListOptions struct {
 type ResourcesClientListOptions struct {
 	// placeholder for future optional parameters
 }
+
+// RoleAssignmentsClientCreateOrUpdateOptions contains the optional parameters for the RoleAssignmentsClient.CreateOrUpdate method.
+type RoleAssignmentsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientDeleteOptions contains the optional parameters for the RoleAssignmentsClient.Delete method.
+type RoleAssignmentsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientGetOptions contains the optional parameters for the RoleAssignmentsClient.Get method.
+type RoleAssignmentsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleAssignmentsClientListOptions contains the optional parameters for the RoleAssignmentsClient.NewListPager method.
+type RoleAssignmentsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientCreateOrUpdateOptions contains the optional parameters for the RoleDefinitionsClient.CreateOrUpdate method.
+type RoleDefinitionsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientDeleteOptions contains the optional parameters for the RoleDefinitionsClient.Delete method.
+type RoleDefinitionsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientGetOptions contains the optional parameters for the RoleDefinitionsClient.Get method.
+type RoleDefinitionsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// RoleDefinitionsClientListOptions contains the optional parameters for the RoleDefinitionsClient.NewListPager method.
+type RoleDefinitionsClientListOptions struct {
+	// placeholder for future optional parameters
+}
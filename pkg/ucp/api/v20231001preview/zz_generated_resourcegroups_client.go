@@ -266,6 +266,83 @@ func (client *ResourceGroupsClient) listHandleResponse(resp *http.Response) (Res
 	return result, nil
 }
 
+// BeginMoveResources - Move resources from the resource group to another resource group in the same plane
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - resourceGroupName - The name of resource group
+//   - body - The resources to move and the target resource group.
+//   - options - ResourceGroupsClientBeginMoveResourcesOptions contains the optional parameters for the ResourceGroupsClient.BeginMoveResources
+//     method.
+func (client *ResourceGroupsClient) BeginMoveResources(ctx context.Context, planeName string, resourceGroupName string, body ResourcesMoveInfo, options *ResourceGroupsClientBeginMoveResourcesOptions) (*runtime.Poller[ResourceGroupsClientMoveResourcesResponse], error) {
+	if options == nil || options.ResumeToken == "" {
+		resp, err := client.moveResources(ctx, planeName, resourceGroupName, body, options)
+		if err != nil {
+			return nil, err
+		}
+		poller, err := runtime.NewPoller(resp, client.internal.Pipeline(), &runtime.NewPollerOptions[ResourceGroupsClientMoveResourcesResponse]{
+			FinalStateVia: runtime.FinalStateViaLocation,
+			Tracer:        client.internal.Tracer(),
+		})
+		return poller, err
+	} else {
+		return runtime.NewPollerFromResumeToken(options.ResumeToken, client.internal.Pipeline(), &runtime.NewPollerFromResumeTokenOptions[ResourceGroupsClientMoveResourcesResponse]{
+			Tracer: client.internal.Tracer(),
+		})
+	}
+}
+
+// MoveResources - Move resources from the resource group to another resource group in the same plane
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+func (client *ResourceGroupsClient) moveResources(ctx context.Context, planeName string, resourceGroupName string, body ResourcesMoveInfo, options *ResourceGroupsClientBeginMoveResourcesOptions) (*http.Response, error) {
+	var err error
+	const operationName = "ResourceGroupsClient.BeginMoveResources"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.moveResourcesCreateRequest(ctx, planeName, resourceGroupName, body, options)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusAccepted) {
+		err = runtime.NewResponseError(httpResp)
+		return nil, err
+	}
+	return httpResp, nil
+}
+
+// moveResourcesCreateRequest creates the MoveResources request.
+func (client *ResourceGroupsClient) moveResourcesCreateRequest(ctx context.Context, planeName string, resourceGroupName string, body ResourcesMoveInfo, _ *ResourceGroupsClientBeginMoveResourcesOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/resourcegroups/{resourceGroupName}/moveResources"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if resourceGroupName == "" {
+		return nil, errors.New("parameter resourceGroupName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceGroupName}", url.PathEscape(resourceGroupName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
 // Update - Update a resource group
 // If the operation fails it returns an *azcore.ResponseError type.
 //
@@ -329,3 +406,58 @@ func (client *ResourceGroupsClient) updateHandleResponse(resp *http.Response) (R
 	}
 	return result, nil
 }
+
+// ValidateMoveResources - Validate whether resources can be moved from the resource group to another resource group in the same plane
+// If the operation fails it returns an *azcore.ResponseError type.
+//
+// Generated from API version 2023-10-01-preview
+//   - planeName - The plane name.
+//   - resourceGroupName - The name of resource group
+//   - body - The resources to move and the target resource group.
+//   - options - ResourceGroupsClientValidateMoveResourcesOptions contains the optional parameters for the ResourceGroupsClient.ValidateMoveResources
+//     method.
+func (client *ResourceGroupsClient) ValidateMoveResources(ctx context.Context, planeName string, resourceGroupName string, body ResourcesMoveInfo, options *ResourceGroupsClientValidateMoveResourcesOptions) (ResourceGroupsClientValidateMoveResourcesResponse, error) {
+	var err error
+	const operationName = "ResourceGroupsClient.ValidateMoveResources"
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, operationName)
+	ctx, endSpan := runtime.StartSpan(ctx, operationName, client.internal.Tracer(), nil)
+	defer func() { endSpan(err) }()
+	req, err := client.validateMoveResourcesCreateRequest(ctx, planeName, resourceGroupName, body, options)
+	if err != nil {
+		return ResourceGroupsClientValidateMoveResourcesResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ResourceGroupsClientValidateMoveResourcesResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusNoContent) {
+		err = runtime.NewResponseError(httpResp)
+		return ResourceGroupsClientValidateMoveResourcesResponse{}, err
+	}
+	return ResourceGroupsClientValidateMoveResourcesResponse{}, nil
+}
+
+// validateMoveResourcesCreateRequest creates the ValidateMoveResources request.
+func (client *ResourceGroupsClient) validateMoveResourcesCreateRequest(ctx context.Context, planeName string, resourceGroupName string, body ResourcesMoveInfo, _ *ResourceGroupsClientValidateMoveResourcesOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/resourcegroups/{resourceGroupName}/validateMoveResources"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if resourceGroupName == "" {
+		return nil, errors.New("parameter resourceGroupName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceGroupName}", url.PathEscape(resourceGroupName))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", "2023-10-01-preview")
+	req.Raw().URL.RawQuery = reqQP.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, body); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
@@ -27,6 +27,12 @@ type APIVersionsClientListResponse struct {
 	APIVersionResourceListResult
 }
 
+// AuditRecordsClientListResponse contains the response from method AuditRecordsClient.NewListPager.
+type AuditRecordsClientListResponse struct {
+	// The response of a AuditRecordResource list operation.
+	AuditRecordResourceListResult
+}
+
 // AwsCredentialsClientCreateOrUpdateResponse contains the response from method AwsCredentialsClient.CreateOrUpdate.
 type AwsCredentialsClientCreateOrUpdateResponse struct {
 	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
@@ -168,6 +174,29 @@ type LocationsClientListResponse struct {
 	LocationResourceListResult
 }
 
+// LocksClientCreateOrUpdateResponse contains the response from method LocksClient.CreateOrUpdate.
+type LocksClientCreateOrUpdateResponse struct {
+	// A management lock that prevents resources in a resource group from being modified or deleted.
+	LockResource
+}
+
+// LocksClientDeleteResponse contains the response from method LocksClient.Delete.
+type LocksClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// LocksClientGetResponse contains the response from method LocksClient.Get.
+type LocksClientGetResponse struct {
+	// A management lock that prevents resources in a resource group from being modified or deleted.
+	LockResource
+}
+
+// LocksClientListResponse contains the response from method LocksClient.NewListPager.
+type LocksClientListResponse struct {
+	// The response of a LockResource list operation.
+	LockResourceListResult
+}
+
 // PlanesClientListPlanesResponse contains the response from method PlanesClient.NewListPlanesPager.
 type PlanesClientListPlanesResponse struct {
 	// The response of a GenericPlaneResource list operation.
@@ -226,12 +255,22 @@ type ResourceGroupsClientListResponse struct {
 	ResourceGroupResourceListResult
 }
 
+// ResourceGroupsClientMoveResourcesResponse contains the response from method ResourceGroupsClient.BeginMoveResources.
+type ResourceGroupsClientMoveResourcesResponse struct {
+	// placeholder for future response values
+}
+
 // ResourceGroupsClientUpdateResponse contains the response from method ResourceGroupsClient.Update.
 type ResourceGroupsClientUpdateResponse struct {
 	// The resource group resource
 	ResourceGroupResource
 }
 
+// ResourceGroupsClientValidateMoveResourcesResponse contains the response from method ResourceGroupsClient.ValidateMoveResources.
+type ResourceGroupsClientValidateMoveResourcesResponse struct {
+	// placeholder for future response values
+}
+
 // ResourceProvidersClientCreateOrUpdateResponse contains the response from method ResourceProvidersClient.BeginCreateOrUpdate.
 type ResourceProvidersClientCreateOrUpdateResponse struct {
 	// The resource type for defining a resource provider.
@@ -268,6 +307,12 @@ type ResourceProvidersClientListResponse struct {
 	ResourceProviderResourceListResult
 }
 
+// ResourceQueryClientQueryResponse contains the response from method ResourceQueryClient.Query.
+type ResourceQueryClientQueryResponse struct {
+	// A page of the results of a resource query.
+	ResourceQueryResponse
+}
+
 // ResourceTypesClientCreateOrUpdateResponse contains the response from method ResourceTypesClient.BeginCreateOrUpdate.
 type ResourceTypesClientCreateOrUpdateResponse struct {
 	// The resource type for defining a resource type supported by the containing resource provider.
@@ -296,3 +341,49 @@ type ResourcesClientListResponse struct {
 	// The response of a GenericResource list operation.
 	GenericResourceListResult
 }
+
+// RoleAssignmentsClientCreateOrUpdateResponse contains the response from method RoleAssignmentsClient.CreateOrUpdate.
+type RoleAssignmentsClientCreateOrUpdateResponse struct {
+	// A role assignment grants a principal the permissions of a role definition at a scope.
+	RoleAssignmentResource
+}
+
+// RoleAssignmentsClientDeleteResponse contains the response from method RoleAssignmentsClient.Delete.
+type RoleAssignmentsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// RoleAssignmentsClientGetResponse contains the response from method RoleAssignmentsClient.Get.
+type RoleAssignmentsClientGetResponse struct {
+	// A role assignment grants a principal the permissions of a role definition at a scope.
+	RoleAssignmentResource
+}
+
+// RoleAssignmentsClientListResponse contains the response from method RoleAssignmentsClient.NewListPager.
+type RoleAssignmentsClientListResponse struct {
+	// The response of a RoleAssignmentResource list operation.
+	RoleAssignmentResourceListResult
+}
+
+// RoleDefinitionsClientCreateOrUpdateResponse contains the response from method RoleDefinitionsClient.CreateOrUpdate.
+type RoleDefinitionsClientCreateOrUpdateResponse struct {
+	// A role definition defines the actions that a principal is allowed to perform.
+	RoleDefinitionResource
+}
+
+// RoleDefinitionsClientDeleteResponse contains the response from method RoleDefinitionsClient.Delete.
+type RoleDefinitionsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// RoleDefinitionsClientGetResponse contains the response from method RoleDefinitionsClient.Get.
+type RoleDefinitionsClientGetResponse struct {
+	// A role definition defines the actions that a principal is allowed to perform.
+	RoleDefinitionResource
+}
+
+// RoleDefinitionsClientListResponse contains the response from method RoleDefinitionsClient.NewListPager.
+type RoleDefinitionsClientListResponse struct {
+	// The response of a RoleDefinitionResource list operation.
+	RoleDefinitionResourceListResult
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// rootScope is the scope that audit records are stored at when the scope of the operation cannot be determined.
+	rootScope = "/planes"
+)
+
+var _ Sink = (*DatabaseSink)(nil)
+var _ Querier = (*DatabaseSink)(nil)
+
+// DatabaseSink stores audit records in the database used for resource data. Audit records are stored as
+// 'System.Audit/records' resources in the scope of the operation so that they can be queried by scope.
+type DatabaseSink struct {
+	client database.Client
+}
+
+// NewDatabaseSink creates a new DatabaseSink.
+func NewDatabaseSink(client database.Client) *DatabaseSink {
+	return &DatabaseSink{client: client}
+}
+
+// Write stores the audit record. The ID of the audit record is assigned when it is empty.
+func (s *DatabaseSink) Write(ctx context.Context, record *datamodel.AuditRecord) error {
+	if record.ID == "" {
+		name := uuid.New().String()
+		record.ID = storageScope(record.Properties.ResourceID) + "/providers/" + datamodel.AuditRecordResourceType + "/" + name
+		record.Name = name
+		record.Type = datamodel.AuditRecordResourceType
+	}
+
+	return s.client.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: record.ID},
+		Data:     record,
+	})
+}
+
+// Query returns the audit records of operations on the scope of the filter and every resource in it, newest first.
+//
+// The pagination token is a keyset token made of the timestamp and the ID of the last audit record of the previous
+// page, so pages stay consistent when audit records are written between requests. The database does not support
+// ordering, so the audit records are read page by page and only the first top+1 audit records after the token are
+// kept in memory.
+func (s *DatabaseSink) Query(ctx context.Context, filter QueryFilter, paginationToken string, top int) (*QueryResult, error) {
+	var after *cursor
+	if paginationToken != "" {
+		var err error
+		after, err = parseCursor(paginationToken)
+		if err != nil {
+			return nil, &database.ErrInvalid{Message: "invalid pagination token"}
+		}
+	}
+
+	scope := filter.Scope
+	if scope == "" {
+		scope = rootScope
+	}
+
+	query := database.Query{
+		RootScope:      storageScope(scope),
+		ScopeRecursive: true,
+		ResourceType:   datamodel.AuditRecordResourceType,
+	}
+
+	records := []*datamodel.AuditRecord{}
+	token := ""
+	for {
+		result, err := s.client.Query(ctx, query, database.WithPaginationToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			record := &datamodel.AuditRecord{}
+			if err := item.As(record); err != nil {
+				return nil, err
+			}
+
+			if !matches(record, filter, scope) || (after != nil && !after.before(record)) {
+				continue
+			}
+
+			records = append(records, record)
+
+			// Trim the candidates regularly so that memory is bounded by the page size rather than the number of
+			// audit records.
+			if top > 0 && len(records) > 2*(top+1) {
+				sortRecords(records)
+				records = records[:top+1]
+			}
+		}
+
+		token = result.PaginationToken
+		if token == "" {
+			break
+		}
+	}
+
+	sortRecords(records)
+
+	next := ""
+	if top > 0 && len(records) > top {
+		records = records[:top]
+		next = newCursor(records[top-1]).String()
+	}
+
+	return &QueryResult{Records: records, PaginationToken: next}, nil
+}
+
+// cursor is the position of an audit record in the order of query results.
+type cursor struct {
+	timestamp time.Time
+	id        string
+}
+
+func newCursor(record *datamodel.AuditRecord) *cursor {
+	return &cursor{timestamp: record.Properties.Timestamp, id: record.ID}
+}
+
+// parseCursor parses a pagination token created by cursor.String.
+func parseCursor(token string) (*cursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp, id, ok := strings.Cut(string(decoded), "|")
+	if !ok || id == "" {
+		return nil, errors.New("the pagination token does not specify an audit record")
+	}
+
+	nanos, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cursor{timestamp: time.Unix(0, nanos).UTC(), id: id}, nil
+}
+
+// String returns the pagination token of the cursor.
+func (c *cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.timestamp.UnixNano(), 10) + "|" + c.id))
+}
+
+// before returns true if the cursor comes before the audit record. Audit records are ordered by timestamp, newest
+// first, and then by ID.
+func (c *cursor) before(record *datamodel.AuditRecord) bool {
+	if !c.timestamp.Equal(record.Properties.Timestamp) {
+		return c.timestamp.After(record.Properties.Timestamp)
+	}
+
+	return c.id < record.ID
+}
+
+// sortRecords sorts the audit records newest first, using the ID to order audit records with the same timestamp.
+func sortRecords(records []*datamodel.AuditRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		return newCursor(records[i]).before(records[j])
+	})
+}
+
+// matches returns true if the audit record is within the scope and time range of the filter.
+func matches(record *datamodel.AuditRecord, filter QueryFilter, scope string) bool {
+	if !filter.StartTime.IsZero() && record.Properties.Timestamp.Before(filter.StartTime) {
+		return false
+	}
+
+	if !filter.EndTime.IsZero() && record.Properties.Timestamp.After(filter.EndTime) {
+		return false
+	}
+
+	target := strings.ToLower(record.Properties.ResourceID)
+	scope = strings.ToLower(strings.TrimSuffix(scope, "/"))
+	return target == scope || strings.HasPrefix(target, scope+"/")
+}
+
+// storageScope returns the scope that audit records of operations on the given ID are stored at. This is the ID
+// itself for scopes, such as resource groups, and the root scope of the resource otherwise.
+func storageScope(id string) string {
+	parsed, err := resources.Parse(id)
+	if err != nil || len(parsed.ScopeSegments()) == 0 {
+		return rootScope
+	}
+
+	if parsed.IsScope() {
+		return parsed.String()
+	}
+
+	return parsed.RootScope()
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DatabaseSink(t *testing.T) {
+	ctx := testcontext.New(t)
+	sink := NewDatabaseSink(inmemory.NewClient())
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	targets := []string{
+		"/planes/radius/local/resourceGroups/test-group",
+		"/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app",
+		"/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container",
+		"/planes/radius/local/resourceGroups/other-group/providers/Applications.Core/applications/other-app",
+		"/planes/radius/local",
+	}
+	for i, target := range targets {
+		record := &datamodel.AuditRecord{
+			Properties: datamodel.AuditRecordProperties{
+				Timestamp:  start.Add(time.Duration(i) * time.Hour),
+				Method:     "PUT",
+				ResourceID: target,
+			},
+		}
+		require.NoError(t, sink.Write(ctx, record))
+		require.NotEmpty(t, record.ID)
+		require.Equal(t, datamodel.AuditRecordResourceType, record.Type)
+	}
+
+	resourceIDs := func(result *QueryResult) []string {
+		ids := []string{}
+		for _, record := range result.Records {
+			ids = append(ids, record.Properties.ResourceID)
+		}
+		return ids
+	}
+
+	t.Run("plane", func(t *testing.T) {
+		result, err := sink.Query(ctx, QueryFilter{Scope: "/planes/radius/local"}, "", 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{targets[4], targets[3], targets[2], targets[1], targets[0]}, resourceIDs(result))
+		require.Empty(t, result.PaginationToken)
+	})
+
+	t.Run("resource group", func(t *testing.T) {
+		result, err := sink.Query(ctx, QueryFilter{Scope: "/planes/radius/local/resourceGroups/test-group"}, "", 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{targets[2], targets[1], targets[0]}, resourceIDs(result))
+	})
+
+	t.Run("resource", func(t *testing.T) {
+		result, err := sink.Query(ctx, QueryFilter{Scope: targets[1]}, "", 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{targets[1]}, resourceIDs(result))
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		filter := QueryFilter{
+			Scope:     "/planes/radius/local",
+			StartTime: start.Add(1 * time.Hour),
+			EndTime:   start.Add(3 * time.Hour),
+		}
+		result, err := sink.Query(ctx, filter, "", 0)
+		require.NoError(t, err)
+		require.Equal(t, []string{targets[3], targets[2], targets[1]}, resourceIDs(result))
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		filter := QueryFilter{Scope: "/planes/radius/local"}
+		result, err := sink.Query(ctx, filter, "", 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{targets[4], targets[3]}, resourceIDs(result))
+		require.NotEmpty(t, result.PaginationToken)
+
+		result, err = sink.Query(ctx, filter, result.PaginationToken, 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{targets[2], targets[1]}, resourceIDs(result))
+		require.NotEmpty(t, result.PaginationToken)
+
+		result, err = sink.Query(ctx, filter, result.PaginationToken, 2)
+		require.NoError(t, err)
+		require.Equal(t, []string{targets[0]}, resourceIDs(result))
+		require.Empty(t, result.PaginationToken)
+	})
+
+	t.Run("pagination with new records", func(t *testing.T) {
+		sink := NewDatabaseSink(inmemory.NewClient())
+		for i := 0; i < 5; i++ {
+			require.NoError(t, sink.Write(ctx, &datamodel.AuditRecord{
+				Properties: datamodel.AuditRecordProperties{
+					Timestamp:  start,
+					Method:     "PUT",
+					ResourceID: targets[i],
+				},
+			}))
+		}
+
+		filter := QueryFilter{Scope: "/planes/radius/local"}
+		first, err := sink.Query(ctx, filter, "", 2)
+		require.NoError(t, err)
+		require.Len(t, first.Records, 2)
+
+		// A newer audit record does not shift the following pages.
+		require.NoError(t, sink.Write(ctx, &datamodel.AuditRecord{
+			Properties: datamodel.AuditRecordProperties{
+				Timestamp:  start.Add(time.Hour),
+				Method:     "DELETE",
+				ResourceID: targets[0],
+			},
+		}))
+
+		seen := map[string]bool{}
+		for _, record := range first.Records {
+			seen[record.ID] = true
+		}
+
+		token := first.PaginationToken
+		for token != "" {
+			result, err := sink.Query(ctx, filter, token, 2)
+			require.NoError(t, err)
+			for _, record := range result.Records {
+				require.False(t, seen[record.ID], "audit record %q returned twice", record.ID)
+				require.Equal(t, start, record.Properties.Timestamp)
+				seen[record.ID] = true
+			}
+			token = result.PaginationToken
+		}
+		require.Len(t, seen, 5)
+	})
+
+	t.Run("invalid pagination token", func(t *testing.T) {
+		_, err := sink.Query(ctx, QueryFilter{}, "not-a-token", 2)
+		require.Error(t, err)
+	})
+}
+
+func Test_storageScope(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected string
+	}{
+		{id: "/planes/radius/local", expected: "/planes/radius/local"},
+		{id: "/planes/radius/local/resourceGroups/test-group", expected: "/planes/radius/local/resourceGroups/test-group"},
+		{id: "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app", expected: "/planes/radius/local/resourceGroups/test-group"},
+		{id: "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test", expected: "/planes/radius/local"},
+		{id: "/planes", expected: "/planes"},
+		{id: "not-an-id", expected: "/planes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			require.Equal(t, tt.expected, storageScope(tt.id))
+		})
+	}
+}
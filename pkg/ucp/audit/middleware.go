@@ -0,0 +1,185 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// asyncOperationHeader is the header of the URL of the status of an asynchronous operation.
+	asyncOperationHeader = "Azure-AsyncOperation"
+)
+
+// Middleware records every mutating operation (PUT, PATCH, DELETE and POST) on a UCP resource in the audit sink after
+// the operation has completed. Failures to record an operation are logged and do not fail the operation.
+//
+// The middleware must run after the ARM request context and the principal of the request have been added to the
+// context. Requests rejected by authentication or authorization are not recorded. The path of the request is used
+// rather than the ARM request context, because the ARM request context honours the client-specified Referer header.
+func Middleware(pathBase string, sink Sink) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path := strings.TrimPrefix(r.URL.Path, pathBase)
+			if !isMutating(r.Method) || !isResourcePath(path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			logger := ucplog.FromContextOrDiscard(ctx)
+
+			timestamp := time.Now().UTC()
+			digest, err := digestBody(r)
+			if err != nil {
+				logger.Error(err, "failed to read the request body for the audit log")
+			}
+
+			recorder := &statusRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+			next.ServeHTTP(recorder, r)
+
+			result := resultFromResponse(recorder.statusCode, w.Header())
+			record := newRecord(ctx, r.Method, path, timestamp, digest, recorder.statusCode, result)
+
+			// The operation has completed, so the audit record must be written even if the caller has gone away.
+			if err := sink.Write(context.WithoutCancel(ctx), record); err != nil {
+				logger.Error(err, "failed to write the audit record", "resourceId", path, "method", r.Method)
+			}
+		})
+	}
+}
+
+func newRecord(ctx context.Context, method string, path string, timestamp time.Time, digest string, statusCode int, result datamodel.AuditResult) *datamodel.AuditRecord {
+	principal := authorization.PrincipalFromContext(ctx)
+	if principal == nil {
+		principal = authorization.Anonymous()
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	return &datamodel.AuditRecord{
+		Properties: datamodel.AuditRecordProperties{
+			Timestamp:       timestamp,
+			PrincipalName:   principal.Name,
+			PrincipalGroups: principal.Groups,
+			Method:          method,
+			ResourceID:      path,
+			APIVersion:      serviceCtx.APIVersion,
+			CorrelationID:   serviceCtx.CorrelationID,
+			OperationID:     serviceCtx.OperationID.String(),
+			StatusCode:      statusCode,
+			Result:          result,
+			RequestDigest:   digest,
+		},
+	}
+}
+
+// digestBody returns the SHA-256 digest of the request body and replaces the body so that it can be read again.
+func digestBody(r *http.Request) (string, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return "", nil
+	}
+
+	b, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(b))
+	if err != nil {
+		return "", err
+	}
+
+	if len(b) == 0 {
+		return "", nil
+	}
+
+	sum := sha256.Sum256(b)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+// resultFromResponse returns the result of the operation from the response. Asynchronous operations respond with
+// 202 Accepted, or with 201 Created and an Azure-AsyncOperation header.
+func resultFromResponse(statusCode int, header http.Header) datamodel.AuditResult {
+	switch {
+	case statusCode == http.StatusAccepted:
+		return datamodel.AuditResultAccepted
+	case statusCode == http.StatusCreated && header.Get(asyncOperationHeader) != "":
+		return datamodel.AuditResultAccepted
+	case statusCode >= 200 && statusCode < 300:
+		return datamodel.AuditResultSucceeded
+	default:
+		return datamodel.AuditResultFailed
+	}
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodPost:
+		return true
+	default:
+		return false
+	}
+}
+
+// isResourcePath returns true if the path targets a UCP resource.
+func isResourcePath(path string) bool {
+	path = strings.ToLower(path)
+	return path == rootScope || strings.HasPrefix(path, rootScope+"/")
+}
+
+// statusRecorder records the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+}
+
+// WriteHeader records the status code and writes it to the response.
+func (r *statusRecorder) WriteHeader(statusCode int) {
+	if !r.wroteHeader {
+		r.statusCode = statusCode
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(statusCode)
+}
+
+// Write writes the data to the response. The status code is 200 if it has not been written.
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush flushes the response if the underlying response writer supports it.
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap returns the underlying response writer, for use with http.ResponseController.
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
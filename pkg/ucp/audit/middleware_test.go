@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+type testSink struct {
+	records []*datamodel.AuditRecord
+}
+
+func (s *testSink) Write(ctx context.Context, record *datamodel.AuditRecord) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func Test_Middleware(t *testing.T) {
+	const pathBase = "/apis/api.ucp.dev/v1alpha3"
+	const resourceID = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+
+	operationID := uuid.New()
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		principal      *authorization.Principal
+		statusCode     int
+		async          bool
+		expectedRecord *datamodel.AuditRecordProperties
+	}{
+		{
+			name:       "put",
+			method:     http.MethodPut,
+			path:       pathBase + resourceID,
+			body:       `{"properties":{}}`,
+			principal:  &authorization.Principal{Name: "alice", Groups: []string{"admins"}},
+			statusCode: http.StatusCreated,
+			expectedRecord: &datamodel.AuditRecordProperties{
+				PrincipalName:   "alice",
+				PrincipalGroups: []string{"admins"},
+				Method:          http.MethodPut,
+				ResourceID:      resourceID,
+				APIVersion:      "2023-10-01-preview",
+				CorrelationID:   "test-correlation-id",
+				OperationID:     operationID.String(),
+				StatusCode:      http.StatusCreated,
+				Result:          datamodel.AuditResultSucceeded,
+				// sha256 of {"properties":{}}
+				RequestDigest: "sha256:de1876e8a7424574cd817bc9046a4d22449e85c5d2ff7ec27d92d5a059a9ab5f",
+			},
+		},
+		{
+			name:       "async put",
+			method:     http.MethodPut,
+			path:       pathBase + resourceID,
+			statusCode: http.StatusCreated,
+			async:      true,
+			expectedRecord: &datamodel.AuditRecordProperties{
+				PrincipalName:   authorization.AnonymousUser,
+				PrincipalGroups: []string{authorization.UnauthenticatedGroup},
+				Method:          http.MethodPut,
+				ResourceID:      resourceID,
+				APIVersion:      "2023-10-01-preview",
+				CorrelationID:   "test-correlation-id",
+				OperationID:     operationID.String(),
+				StatusCode:      http.StatusCreated,
+				Result:          datamodel.AuditResultAccepted,
+			},
+		},
+		{
+			name:       "async delete",
+			method:     http.MethodDelete,
+			path:       pathBase + resourceID,
+			statusCode: http.StatusAccepted,
+			expectedRecord: &datamodel.AuditRecordProperties{
+				PrincipalName:   authorization.AnonymousUser,
+				PrincipalGroups: []string{authorization.UnauthenticatedGroup},
+				Method:          http.MethodDelete,
+				ResourceID:      resourceID,
+				APIVersion:      "2023-10-01-preview",
+				CorrelationID:   "test-correlation-id",
+				OperationID:     operationID.String(),
+				StatusCode:      http.StatusAccepted,
+				Result:          datamodel.AuditResultAccepted,
+			},
+		},
+		{
+			name:       "failed action",
+			method:     http.MethodPost,
+			path:       pathBase + resourceID + "/listSecrets",
+			principal:  &authorization.Principal{Name: "alice"},
+			statusCode: http.StatusNotFound,
+			expectedRecord: &datamodel.AuditRecordProperties{
+				PrincipalName: "alice",
+				Method:        http.MethodPost,
+				ResourceID:    resourceID + "/listSecrets",
+				APIVersion:    "2023-10-01-preview",
+				CorrelationID: "test-correlation-id",
+				OperationID:   operationID.String(),
+				StatusCode:    http.StatusNotFound,
+				Result:        datamodel.AuditResultFailed,
+			},
+		},
+		{
+			name:       "read",
+			method:     http.MethodGet,
+			path:       pathBase + resourceID,
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "not a resource",
+			method:     http.MethodPost,
+			path:       "/healthz",
+			statusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			ctx = v1.WithARMRequestContext(ctx, &v1.ARMRequestContext{
+				APIVersion:    "2023-10-01-preview",
+				CorrelationID: "test-correlation-id",
+				OperationID:   operationID,
+			})
+			if tt.principal != nil {
+				ctx = authorization.WithPrincipal(ctx, tt.principal)
+			}
+
+			body := ""
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				b, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				body = string(b)
+				if tt.async {
+					w.Header().Set("Azure-AsyncOperation", "http://localhost/operationStatuses/test")
+				}
+				w.WriteHeader(tt.statusCode)
+			})
+
+			sink := &testSink{}
+			handler := Middleware(pathBase, sink)(next)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			handler.ServeHTTP(w, req.WithContext(ctx))
+
+			require.Equal(t, tt.statusCode, w.Code)
+			require.Equal(t, tt.body, body, "the request body should be readable by the handler")
+
+			if tt.expectedRecord == nil {
+				require.Empty(t, sink.records)
+				return
+			}
+
+			require.Len(t, sink.records, 1)
+			record := sink.records[0]
+			require.False(t, record.Properties.Timestamp.IsZero())
+
+			record.Properties.Timestamp = tt.expectedRecord.Timestamp
+			require.Equal(t, *tt.expectedRecord, record.Properties)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+// SinkKind is the kind of the sink that audit records are written to.
+type SinkKind string
+
+const (
+	// SinkKindDatabase stores audit records in the database used for resource data. This is the only sink that
+	// supports querying audit records.
+	SinkKindDatabase SinkKind = "database"
+
+	// SinkKindFile writes audit records as a stream of JSON objects to a file or to stdout.
+	SinkKindFile SinkKind = "file"
+
+	// SinkKindOTLP exports audit records as OpenTelemetry log records using OTLP over HTTP.
+	SinkKindOTLP SinkKind = "otlp"
+)
+
+// Options defines the configuration for the audit log of mutating operations.
+type Options struct {
+	// Enabled enables recording mutating operations in the audit log.
+	Enabled bool `yaml:"enabled"`
+
+	// Sink is the kind of the sink that audit records are written to. Defaults to 'database'.
+	Sink SinkKind `yaml:"sink,omitempty"`
+
+	// File is the configuration for the 'file' sink.
+	File FileOptions `yaml:"file"`
+
+	// OTLP is the configuration for the 'otlp' sink.
+	OTLP OTLPOptions `yaml:"otlp"`
+}
+
+// FileOptions defines the configuration for the 'file' sink.
+type FileOptions struct {
+	// Path is the path of the file audit records are appended to. Audit records are written to stdout when empty.
+	Path string `yaml:"path,omitempty"`
+}
+
+// OTLPOptions defines the configuration for the 'otlp' sink.
+type OTLPOptions struct {
+	// Endpoint is the base URL of the OTLP/HTTP receiver, for example 'http://otel-collector:4318'. Audit records are
+	// sent to the '/v1/logs' path of the endpoint.
+	Endpoint string `yaml:"endpoint"`
+
+	// Headers is the set of headers sent with every export request, for example for authentication.
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
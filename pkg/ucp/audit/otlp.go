@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// otlpLogsPath is the path of the OTLP/HTTP logs endpoint.
+	otlpLogsPath = "/v1/logs"
+
+	// otlpScopeName is the instrumentation scope name of the exported log records.
+	otlpScopeName = "github.com/radius-project/radius/pkg/ucp/audit"
+
+	// otlpSeverityInfo is the OTLP severity number for INFO.
+	otlpSeverityInfo = 9
+
+	// otlpTimeout is the timeout for exporting an audit record.
+	otlpTimeout = 10 * time.Second
+)
+
+var _ Sink = (*OTLPSink)(nil)
+
+// OTLPSink exports audit records as OpenTelemetry log records using the JSON encoding of OTLP over HTTP. Each
+// audit record is exported as it is written so that no audit record is lost when UCP stops.
+type OTLPSink struct {
+	client   *http.Client
+	endpoint string
+	headers  map[string]string
+}
+
+// NewOTLPSink creates a new OTLPSink. The default HTTP client is used when client is nil.
+func NewOTLPSink(options OTLPOptions, client *http.Client) *OTLPSink {
+	if client == nil {
+		client = &http.Client{Timeout: otlpTimeout}
+	}
+
+	return &OTLPSink{
+		client:   client,
+		endpoint: strings.TrimSuffix(options.Endpoint, "/") + otlpLogsPath,
+		headers:  options.Headers,
+	}
+}
+
+// Write exports the audit record to the OTLP receiver.
+func (s *OTLPSink) Write(ctx context.Context, record *datamodel.AuditRecord) error {
+	b, err := json.Marshal(toOTLPLogs(record))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to export audit record: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("failed to export audit record: the OTLP receiver responded with status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// The following types are the subset of the JSON encoding of the OTLP logs data model used to export audit records.
+// See: https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding
+
+type otlpLogs struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string          `json:"timeUnixNano"`
+	SeverityNumber int             `json:"severityNumber"`
+	SeverityText   string          `json:"severityText"`
+	Body           otlpValue       `json:"body"`
+	Attributes     []otlpAttribute `json:"attributes"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string         `json:"stringValue,omitempty"`
+	IntValue    *string         `json:"intValue,omitempty"`
+	ArrayValue  *otlpArrayValue `json:"arrayValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpValue `json:"values"`
+}
+
+func toOTLPLogs(record *datamodel.AuditRecord) *otlpLogs {
+	p := record.Properties
+
+	groups := []otlpValue{}
+	for _, group := range p.PrincipalGroups {
+		groups = append(groups, stringValue(group))
+	}
+
+	attributes := []otlpAttribute{
+		{Key: "audit.id", Value: stringValue(record.ID)},
+		{Key: "audit.principal.name", Value: stringValue(p.PrincipalName)},
+		{Key: "audit.principal.groups", Value: otlpValue{ArrayValue: &otlpArrayValue{Values: groups}}},
+		{Key: "audit.result", Value: stringValue(string(p.Result))},
+		{Key: "audit.request.digest", Value: stringValue(p.RequestDigest)},
+		{Key: "http.request.method", Value: stringValue(p.Method)},
+		{Key: "http.response.status_code", Value: intValue(p.StatusCode)},
+		{Key: "ucp.resource.id", Value: stringValue(p.ResourceID)},
+		{Key: "ucp.api_version", Value: stringValue(p.APIVersion)},
+		{Key: "ucp.correlation_id", Value: stringValue(p.CorrelationID)},
+		{Key: "ucp.operation_id", Value: stringValue(p.OperationID)},
+	}
+
+	return &otlpLogs{
+		ResourceLogs: []otlpResourceLogs{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpAttribute{{Key: "service.name", Value: stringValue("ucp")}},
+				},
+				ScopeLogs: []otlpScopeLogs{
+					{
+						Scope: otlpScope{Name: otlpScopeName},
+						LogRecords: []otlpLogRecord{
+							{
+								TimeUnixNano:   strconv.FormatInt(p.Timestamp.UnixNano(), 10),
+								SeverityNumber: otlpSeverityInfo,
+								SeverityText:   "INFO",
+								Body:           stringValue(fmt.Sprintf("%s %s %s", p.PrincipalName, p.Method, p.ResourceID)),
+								Attributes:     attributes,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func stringValue(s string) otlpValue {
+	return otlpValue{StringValue: &s}
+}
+
+func intValue(i int) otlpValue {
+	s := strconv.Itoa(i)
+	return otlpValue{IntValue: &s}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ErrQueryNotSupported is returned when audit records are queried but the configured sink does not support queries.
+var ErrQueryNotSupported = errors.New("the configured audit sink does not support querying audit records")
+
+// Sink records audit records.
+type Sink interface {
+	// Write records the audit record.
+	Write(ctx context.Context, record *datamodel.AuditRecord) error
+}
+
+// Querier is implemented by sinks that support querying the audit records they have recorded.
+type Querier interface {
+	// Query returns the audit records matching the filter, newest first.
+	Query(ctx context.Context, filter QueryFilter, paginationToken string, top int) (*QueryResult, error)
+}
+
+// QueryFilter is the filter for querying audit records.
+type QueryFilter struct {
+	// Scope is the ID of the plane, resource group or resource to query audit records for. Audit records of
+	// operations on the scope and every resource in it are returned.
+	Scope string
+
+	// StartTime is the earliest time of the audit records to return. No lower bound is applied when zero.
+	StartTime time.Time
+
+	// EndTime is the latest time of the audit records to return. No upper bound is applied when zero.
+	EndTime time.Time
+}
+
+// QueryResult is the result of querying audit records.
+type QueryResult struct {
+	// Records is the list of audit records, newest first.
+	Records []*datamodel.AuditRecord
+
+	// PaginationToken is the token used to query the next page of audit records. Empty when there are no more
+	// audit records.
+	PaginationToken string
+}
+
+// NewSink creates the sink configured by the options. The database client is used by the 'database' sink.
+func NewSink(options Options, databaseClient database.Client) (Sink, error) {
+	switch options.Sink {
+	case SinkKindDatabase, "":
+		if databaseClient == nil {
+			return nil, errors.New("a database client is required for the database audit sink")
+		}
+		return NewDatabaseSink(databaseClient), nil
+
+	case SinkKindFile:
+		if options.File.Path == "" {
+			return NewWriterSink(os.Stdout), nil
+		}
+
+		f, err := os.OpenFile(options.File.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open audit log file: %w", err)
+		}
+		return NewWriterSink(f), nil
+
+	case SinkKindOTLP:
+		if options.OTLP.Endpoint == "" {
+			return nil, errors.New("an endpoint is required for the otlp audit sink")
+		}
+		return NewOTLPSink(options.OTLP, nil), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported audit sink %q", options.Sink)
+	}
+}
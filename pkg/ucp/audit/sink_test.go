@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func testRecord() *datamodel.AuditRecord {
+	record := &datamodel.AuditRecord{
+		Properties: datamodel.AuditRecordProperties{
+			Timestamp:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			PrincipalName: "alice",
+			Method:        http.MethodPut,
+			ResourceID:    "/planes/radius/local/resourceGroups/test-group",
+			StatusCode:    http.StatusOK,
+			Result:        datamodel.AuditResultSucceeded,
+		},
+	}
+	record.ID = "/planes/radius/local/resourceGroups/test-group/providers/System.Audit/records/test-record"
+	return record
+}
+
+func Test_WriterSink(t *testing.T) {
+	ctx := testcontext.New(t)
+	buf := &bytes.Buffer{}
+	sink := NewWriterSink(buf)
+
+	require.NoError(t, sink.Write(ctx, testRecord()))
+	require.NoError(t, sink.Write(ctx, testRecord()))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	actual := &datamodel.AuditRecord{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), actual))
+	require.Equal(t, testRecord(), actual)
+}
+
+func Test_OTLPSink(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	var body map[string]any
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/logs", r.URL.Path)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		header = r.Header.Get("Authorization")
+
+		b, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &body))
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sink := NewOTLPSink(OTLPOptions{Endpoint: server.URL + "/", Headers: map[string]string{"Authorization": "Bearer token"}}, server.Client())
+	require.NoError(t, sink.Write(ctx, testRecord()))
+	require.Equal(t, "Bearer token", header)
+
+	logRecord := body["resourceLogs"].([]any)[0].(map[string]any)["scopeLogs"].([]any)[0].(map[string]any)["logRecords"].([]any)[0].(map[string]any)
+	require.Equal(t, "1704067200000000000", logRecord["timeUnixNano"])
+	require.Equal(t, "alice PUT /planes/radius/local/resourceGroups/test-group", logRecord["body"].(map[string]any)["stringValue"])
+
+	attributes := map[string]any{}
+	for _, attribute := range logRecord["attributes"].([]any) {
+		attribute := attribute.(map[string]any)
+		attributes[attribute["key"].(string)] = attribute["value"]
+	}
+	require.Equal(t, map[string]any{"stringValue": "alice"}, attributes["audit.principal.name"])
+	require.Equal(t, map[string]any{"intValue": "200"}, attributes["http.response.status_code"])
+	require.Equal(t, map[string]any{"stringValue": "Succeeded"}, attributes["audit.result"])
+}
+
+func Test_OTLPSink_Error(t *testing.T) {
+	ctx := testcontext.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sink := NewOTLPSink(OTLPOptions{Endpoint: server.URL}, server.Client())
+	err := sink.Write(ctx, testRecord())
+	require.ErrorContains(t, err, "status code 503")
+}
+
+func Test_NewSink(t *testing.T) {
+	t.Run("database", func(t *testing.T) {
+		sink, err := NewSink(Options{Enabled: true}, nil)
+		require.Error(t, err)
+		require.Nil(t, sink)
+	})
+
+	t.Run("stdout", func(t *testing.T) {
+		sink, err := NewSink(Options{Enabled: true, Sink: SinkKindFile}, nil)
+		require.NoError(t, err)
+		require.IsType(t, &WriterSink{}, sink)
+	})
+
+	t.Run("otlp without endpoint", func(t *testing.T) {
+		_, err := NewSink(Options{Enabled: true, Sink: SinkKindOTLP}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		_, err := NewSink(Options{Enabled: true, Sink: "kafka"}, nil)
+		require.ErrorContains(t, err, `unsupported audit sink "kafka"`)
+	})
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+var _ Sink = (*WriterSink)(nil)
+
+// WriterSink writes audit records as a stream of JSON objects, one per line, to a writer such as a file or stdout.
+type WriterSink struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewWriterSink creates a new WriterSink.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{encoder: json.NewEncoder(w)}
+}
+
+// Write writes the audit record as a single line of JSON.
+func (s *WriterSink) Write(ctx context.Context, record *datamodel.AuditRecord) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.encoder.Encode(record)
+}
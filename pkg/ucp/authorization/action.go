@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// rootScope is the scope of requests that do not target a plane, such as listing planes.
+	rootScope = "/planes"
+
+	// rootResourceType is the resource type used for requests that do not target a plane.
+	rootResourceType = "System.Resources/planes"
+
+	// providersSegment separates a scope from a resource provider namespace in a path.
+	providersSegment = "/providers/"
+)
+
+// ActionFromRequest returns the scope targeted by a request with the given HTTP method and path, and the action the
+// request performs on it.
+//
+// The action is formatted as '{resourceType}/{operation}':
+//
+//   - GET and HEAD requests perform the 'read' operation.
+//   - PUT and PATCH requests perform the 'write' operation.
+//   - DELETE requests perform the 'delete' operation.
+//   - POST requests perform the '{actionName}/action' operation, where the action name is the last segment of the path.
+//     The ':get', ':put' and ':delete' operations on AWS resource collections perform 'read', 'write' and 'delete'.
+//
+// Actions on a resource provider namespace, such as '/planes/radius/local/providers/System.Resources/query', are
+// performed on the enclosing scope and formatted as '{namespace}/{operation}'.
+func ActionFromRequest(method string, path string) (string, string) {
+	path = strings.TrimSuffix(path, resources.SegmentSeparator)
+
+	operation := ""
+	if strings.EqualFold(method, http.MethodPost) {
+		index := strings.LastIndex(path, resources.SegmentSeparator)
+		name := path[index+1:]
+		path = path[:index]
+
+		switch strings.ToLower(name) {
+		case ":get":
+			operation = "read"
+		case ":put":
+			operation = "write"
+		case ":delete":
+			operation = "delete"
+		default:
+			operation = name + "/action"
+		}
+	} else {
+		operation = operationFromMethod(method)
+	}
+
+	// Actions on a resource provider namespace, such as querying resources, are performed on the enclosing scope.
+	if index := strings.LastIndex(strings.ToLower(path), providersSegment); index >= 0 && !strings.Contains(path[index+len(providersSegment):], resources.SegmentSeparator) {
+		namespace := path[index+len(providersSegment):]
+		if id, err := resources.ParseScope(path[:index]); err == nil && len(id.ScopeSegments()) > 0 {
+			return id.String(), namespace + resources.SegmentSeparator + operation
+		}
+	}
+
+	id, err := resources.Parse(path)
+	if err != nil || len(id.ScopeSegments()) == 0 {
+		return rootScope, rootResourceType + resources.SegmentSeparator + operation
+	}
+
+	return id.String(), resourceType(id) + resources.SegmentSeparator + operation
+}
+
+func operationFromMethod(method string) string {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead:
+		return "read"
+	case http.MethodPut, http.MethodPatch:
+		return "write"
+	case http.MethodDelete:
+		return "delete"
+	default:
+		return strings.ToLower(method)
+	}
+}
+
+// resourceType returns the resource type of the id. Collections of scopes, such as resource groups, have no type in the
+// id, so they use the 'System.Resources/{scopeType}' type.
+func resourceType(id resources.ID) string {
+	if resourceType := id.Type(); resourceType != "" {
+		return resourceType
+	}
+
+	scopes := id.ScopeSegments()
+	return "System.Resources" + resources.SegmentSeparator + scopes[len(scopes)-1].Type
+}
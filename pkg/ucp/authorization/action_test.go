@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ActionFromRequest(t *testing.T) {
+	tests := []struct {
+		method         string
+		path           string
+		expectedScope  string
+		expectedAction string
+	}{
+		{
+			method:         http.MethodGet,
+			path:           "/planes",
+			expectedScope:  "/planes",
+			expectedAction: "System.Resources/planes/read",
+		},
+		{
+			method:         http.MethodPut,
+			path:           "/planes/radius/local",
+			expectedScope:  "/planes/radius/local",
+			expectedAction: "System.Radius/planes/write",
+		},
+		{
+			method:         http.MethodGet,
+			path:           "/planes/radius/local/resourceGroups",
+			expectedScope:  "/planes/radius/local/resourceGroups",
+			expectedAction: "System.Resources/resourceGroups/read",
+		},
+		{
+			method:         http.MethodDelete,
+			path:           "/planes/radius/local/resourceGroups/test-group",
+			expectedScope:  "/planes/radius/local/resourceGroups/test-group",
+			expectedAction: "System.Resources/resourceGroups/delete",
+		},
+		{
+			method:         http.MethodPatch,
+			path:           "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend",
+			expectedScope:  "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend",
+			expectedAction: "Applications.Core/containers/write",
+		},
+		{
+			method:         http.MethodPost,
+			path:           "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/default/getMetadata",
+			expectedScope:  "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/default",
+			expectedAction: "Applications.Core/environments/getMetadata/action",
+		},
+		{
+			method:         http.MethodPost,
+			path:           "/planes/aws/aws/accounts/0000/regions/us-east-1/providers/AWS.S3/Bucket/:get",
+			expectedScope:  "/planes/aws/aws/accounts/0000/regions/us-east-1/providers/AWS.S3/Bucket",
+			expectedAction: "AWS.S3/Bucket/read",
+		},
+		{
+			method:         http.MethodPost,
+			path:           "/planes/radius/local/providers/System.Resources/query",
+			expectedScope:  "/planes/radius/local",
+			expectedAction: "System.Resources/query/action",
+		},
+		{
+			method:         http.MethodPost,
+			path:           "/planes/radius/local/resourceGroups/test-group/moveResources",
+			expectedScope:  "/planes/radius/local/resourceGroups/test-group",
+			expectedAction: "System.Resources/resourceGroups/moveResources/action",
+		},
+		{
+			method:         http.MethodPut,
+			path:           "/planes/radius/local/providers/System.Authorization/roleAssignments/admin/",
+			expectedScope:  "/planes/radius/local/providers/System.Authorization/roleAssignments/admin",
+			expectedAction: "System.Authorization/roleAssignments/write",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			scope, action := ActionFromRequest(tt.method, tt.path)
+			require.Equal(t, tt.expectedScope, scope)
+			require.Equal(t, tt.expectedAction, action)
+		})
+	}
+}
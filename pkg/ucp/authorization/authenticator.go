@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+)
+
+// Authenticator authenticates the caller of a request.
+type Authenticator interface {
+	// Authenticate returns the principal of the caller of the request. Returns nil and no error if the request does not
+	// carry credentials the authenticator understands, and an error if the credentials are invalid.
+	Authenticate(req *http.Request) (*Principal, error)
+}
+
+// TokenAuthenticator authenticates bearer tokens.
+type TokenAuthenticator interface {
+	// AuthenticateToken returns the principal the token was issued for. Returns an error if the token is invalid.
+	AuthenticateToken(ctx context.Context, token string) (*Principal, error)
+}
+
+// NewAuthenticator creates an Authenticator from the options. The Kubernetes client is only used when
+// authenticating tokens with the TokenReview API is enabled.
+func NewAuthenticator(options Options, kubeClient kubernetes.Interface) (Authenticator, error) {
+	authenticator := &unionAuthenticator{}
+	if options.Kubernetes.RequestHeader.ClientCAFile != "" {
+		authenticator.requestHeader = NewRequestHeaderAuthenticator(options.Kubernetes.RequestHeader)
+	}
+
+	if options.Kubernetes.TokenReview {
+		if kubeClient == nil {
+			return nil, errors.New("a kubernetes client is required to authenticate tokens with the TokenReview API")
+		}
+
+		authenticator.tokens = append(authenticator.tokens, NewTokenReviewAuthenticator(kubeClient, options.Kubernetes.Audiences))
+	}
+
+	if options.OIDC.IssuerURL != "" {
+		authenticator.tokens = append(authenticator.tokens, NewOIDCAuthenticator(options.OIDC, http.DefaultClient))
+	}
+
+	return authenticator, nil
+}
+
+// unionAuthenticator authenticates requests proxied by the Kubernetes API server, then bearer tokens with each of the
+// token authenticators until one succeeds.
+type unionAuthenticator struct {
+	requestHeader Authenticator
+	tokens        []TokenAuthenticator
+}
+
+// Authenticate implements Authenticator.
+func (a *unionAuthenticator) Authenticate(req *http.Request) (*Principal, error) {
+	if a.requestHeader != nil {
+		principal, err := a.requestHeader.Authenticate(req)
+		if err != nil || principal != nil {
+			return principal, err
+		}
+	}
+
+	token := bearerToken(req)
+	if token == "" || len(a.tokens) == 0 {
+		return nil, nil
+	}
+
+	errs := []error{}
+	for _, authenticator := range a.tokens {
+		principal, err := authenticator.AuthenticateToken(req.Context(), token)
+		if err == nil {
+			return principal, nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// bearerToken returns the bearer token of the Authorization header of the request, or an empty string.
+func bearerToken(req *http.Request) string {
+	scheme, token, ok := strings.Cut(req.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+
+	return strings.TrimSpace(token)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// Authorizer evaluates the role assignments stored in UCP to decide whether a principal can perform an action.
+type Authorizer struct {
+	client database.Client
+	owners []string
+}
+
+// NewAuthorizer creates an Authorizer. The owners are granted the built-in Owner role on every scope.
+func NewAuthorizer(client database.Client, owners []string) *Authorizer {
+	return &Authorizer{client: client, owners: owners}
+}
+
+// Authorize returns true if the principal is allowed to perform the action on the scope.
+//
+// The action is allowed if the principal, or one of its groups, is assigned a role that allows the action on the scope
+// or one of its parent scopes. Role assignments are stored in the plane of their scope, so only the role assignments of
+// the plane of the scope are read.
+func (a *Authorizer) Authorize(ctx context.Context, principal *Principal, scope string, action string) (bool, error) {
+	if slices.ContainsFunc(a.owners, principal.Matches) {
+		return true, nil
+	}
+
+	id, err := resources.Parse(scope)
+	if err != nil || len(id.ScopeSegments()) == 0 {
+		// Scopes outside of a plane, such as the list of planes, can only be accessed by the owners.
+		return false, nil
+	}
+
+	assignments, err := ListRoleAssignments(ctx, a.client, id.PlaneScope())
+	if err != nil {
+		return false, err
+	}
+
+	// Several assignments commonly share a role, so each role definition is read once.
+	roles := map[string]datamodel.RoleDefinitionProperties{}
+	for _, assignment := range assignments {
+		if !isAssignedTo(assignment, principal) || !scopeCovers(assignment.Properties.Scope, scope) {
+			continue
+		}
+
+		key := strings.ToLower(assignment.Properties.RoleDefinitionID)
+		role, ok := roles[key]
+		if !ok {
+			role, err = a.roleDefinition(ctx, assignment.Properties.RoleDefinitionID)
+			if errors.Is(err, &database.ErrNotFound{}) {
+				// The role definition was deleted, so the assignment no longer grants anything.
+				continue
+			} else if err != nil {
+				return false, err
+			}
+
+			roles[key] = role
+		}
+
+		if Allows(role, action) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+type authorizerKey struct{}
+
+// WithAuthorizer returns a new context with the given authorizer.
+func WithAuthorizer(ctx context.Context, authorizer *Authorizer) context.Context {
+	return context.WithValue(ctx, authorizerKey{}, authorizer)
+}
+
+// AuthorizeFromContext returns true if the principal of the request is allowed to perform the action on the scope. It
+// is used by controllers that act on scopes other than the scope of the request.
+//
+// The authorizer and the principal are read from the context. The action is allowed if the context has no authorizer,
+// because authorization is disabled.
+func AuthorizeFromContext(ctx context.Context, scope string, action string) (bool, error) {
+	authorizer, ok := ctx.Value(authorizerKey{}).(*Authorizer)
+	if !ok || authorizer == nil {
+		return true, nil
+	}
+
+	principal := PrincipalFromContext(ctx)
+	if principal == nil {
+		principal = Anonymous()
+	}
+
+	return authorizer.Authorize(ctx, principal, scope, action)
+}
+
+// ListRoleAssignments returns the role assignments stored in the plane with the given scope, for example
+// '/planes/radius/local'.
+func ListRoleAssignments(ctx context.Context, client database.Client, plane string) ([]datamodel.RoleAssignment, error) {
+	result, err := client.Query(ctx, database.Query{
+		RootScope:    plane,
+		ResourceType: datamodel.RoleAssignmentResourceType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch role assignments: %w", err)
+	}
+
+	assignments := []datamodel.RoleAssignment{}
+	for _, item := range result.Items {
+		assignment := datamodel.RoleAssignment{}
+		err := item.As(&assignment)
+		if err != nil {
+			return nil, err
+		}
+
+		assignments = append(assignments, assignment)
+	}
+
+	return assignments, nil
+}
+
+// roleDefinition returns the properties of the role definition with the given id. Built-in roles are referenced by name
+// and are not stored.
+func (a *Authorizer) roleDefinition(ctx context.Context, id string) (datamodel.RoleDefinitionProperties, error) {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return datamodel.RoleDefinitionProperties{}, fmt.Errorf("invalid role definition id %q: %w", id, err)
+	}
+
+	if role, ok := BuiltInRole(parsed.Name()); ok {
+		return role, nil
+	}
+
+	obj, err := a.client.Get(ctx, parsed.String())
+	if err != nil {
+		return datamodel.RoleDefinitionProperties{}, err
+	}
+
+	definition := datamodel.RoleDefinition{}
+	err = obj.As(&definition)
+	if err != nil {
+		return datamodel.RoleDefinitionProperties{}, err
+	}
+
+	return definition.Properties, nil
+}
+
+// isAssignedTo returns true if the role assignment applies to the principal, either directly or through one of its groups.
+func isAssignedTo(assignment datamodel.RoleAssignment, principal *Principal) bool {
+	if assignment.Properties.PrincipalType == datamodel.PrincipalTypeGroup {
+		return slices.Contains(principal.Groups, assignment.Properties.PrincipalID)
+	}
+
+	return principal.Name == assignment.Properties.PrincipalID
+}
+
+// scopeCovers returns true if the scope is the target or one of its parent scopes. Matching is case-insensitive.
+func scopeCovers(scope string, target string) bool {
+	scope = strings.ToLower(strings.TrimSuffix(scope, resources.SegmentSeparator))
+	target = strings.ToLower(target)
+	return target == scope || strings.HasPrefix(target, scope+resources.SegmentSeparator)
+}
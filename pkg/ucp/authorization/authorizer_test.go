@@ -0,0 +1,283 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testRoleDefinitionsID = "/planes/radius/local/providers/System.Authorization/roleDefinitions"
+	testRoleAssignmentsID = "/planes/radius/local/providers/System.Authorization/roleAssignments"
+)
+
+func Test_Authorizer_Authorize(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := inmemory.NewClient()
+
+	saveRoleDefinition(ctx, t, client, "container-operator", datamodel.RoleDefinitionProperties{
+		Actions: []string{"*/read", "Applications.Core/containers/*"},
+	})
+	saveRoleAssignment(ctx, t, client, "alice-reader", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "alice",
+		PrincipalType:    datamodel.PrincipalTypeUser,
+		RoleDefinitionID: testRoleDefinitionsID + "/Reader",
+		Scope:            "/planes/radius/local",
+	})
+	saveRoleAssignment(ctx, t, client, "dev-contributor", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "developers",
+		PrincipalType:    datamodel.PrincipalTypeGroup,
+		RoleDefinitionID: testRoleDefinitionsID + "/Contributor",
+		Scope:            "/planes/radius/local/resourceGroups/dev",
+	})
+	saveRoleAssignment(ctx, t, client, "bob-operator", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "bob",
+		PrincipalType:    datamodel.PrincipalTypeUser,
+		RoleDefinitionID: testRoleDefinitionsID + "/container-operator",
+		Scope:            "/planes/radius/local/resourceGroups/prod",
+	})
+	saveRoleAssignment(ctx, t, client, "carol-deleted", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "carol",
+		PrincipalType:    datamodel.PrincipalTypeUser,
+		RoleDefinitionID: testRoleDefinitionsID + "/deleted",
+		Scope:            "/planes/radius/local",
+	})
+
+	authorizer := NewAuthorizer(client, []string{"system:masters"})
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		scope     string
+		action    string
+		expected  bool
+	}{
+		{
+			name:      "reader can read resource group",
+			principal: &Principal{Name: "alice"},
+			scope:     "/planes/radius/local/resourceGroups/dev",
+			action:    "System.Resources/resourceGroups/read",
+			expected:  true,
+		},
+		{
+			name:      "reader cannot write",
+			principal: &Principal{Name: "alice"},
+			scope:     "/planes/radius/local/resourceGroups/dev/providers/Applications.Core/containers/frontend",
+			action:    "Applications.Core/containers/write",
+			expected:  false,
+		},
+		{
+			name:      "reader in other plane",
+			principal: &Principal{Name: "alice"},
+			scope:     "/planes/radius/other",
+			action:    "System.Radius/planes/read",
+			expected:  false,
+		},
+		{
+			name:      "reader cannot list planes",
+			principal: &Principal{Name: "alice"},
+			scope:     "/planes",
+			action:    "System.Resources/planes/read",
+			expected:  false,
+		},
+		{
+			name:      "group contributor can write in scope",
+			principal: &Principal{Name: "dave", Groups: []string{"developers"}},
+			scope:     "/planes/radius/local/resourceGroups/DEV/providers/Applications.Core/containers/frontend",
+			action:    "Applications.Core/containers/write",
+			expected:  true,
+		},
+		{
+			name:      "group contributor cannot write in similarly named scope",
+			principal: &Principal{Name: "dave", Groups: []string{"developers"}},
+			scope:     "/planes/radius/local/resourceGroups/dev2",
+			action:    "System.Resources/resourceGroups/write",
+			expected:  false,
+		},
+		{
+			name:      "user named like a group",
+			principal: &Principal{Name: "developers"},
+			scope:     "/planes/radius/local/resourceGroups/dev",
+			action:    "System.Resources/resourceGroups/write",
+			expected:  false,
+		},
+		{
+			name:      "custom role allows action",
+			principal: &Principal{Name: "bob"},
+			scope:     "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/containers/frontend",
+			action:    "Applications.Core/containers/delete",
+			expected:  true,
+		},
+		{
+			name:      "custom role does not allow action",
+			principal: &Principal{Name: "bob"},
+			scope:     "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/default",
+			action:    "Applications.Core/environments/delete",
+			expected:  false,
+		},
+		{
+			name:      "deleted role definition",
+			principal: &Principal{Name: "carol"},
+			scope:     "/planes/radius/local",
+			action:    "System.Radius/planes/read",
+			expected:  false,
+		},
+		{
+			name:      "owner",
+			principal: &Principal{Name: "admin", Groups: []string{"system:masters"}},
+			scope:     "/planes",
+			action:    "System.Resources/planes/write",
+			expected:  true,
+		},
+		{
+			name:      "anonymous",
+			principal: Anonymous(),
+			scope:     "/planes/radius/local",
+			action:    "System.Radius/planes/read",
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := authorizer.Authorize(ctx, tt.principal, tt.scope, tt.action)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, allowed)
+		})
+	}
+}
+
+func Test_Authorizer_Authorize_Queries(t *testing.T) {
+	ctx := testcontext.New(t)
+	ctrl := gomock.NewController(t)
+	client := database.NewMockClient(ctrl)
+
+	newAssignment := func(principalID string, role string) *database.Object {
+		return &database.Object{Data: &datamodel.RoleAssignment{
+			Properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:      principalID,
+				PrincipalType:    datamodel.PrincipalTypeGroup,
+				RoleDefinitionID: testRoleDefinitionsID + "/" + role,
+				Scope:            "/planes/radius/local",
+			},
+		}}
+	}
+
+	// Only the role assignments of the plane of the scope are read, and a role definition shared by several
+	// assignments is read once.
+	client.EXPECT().
+		Query(gomock.Any(), database.Query{RootScope: "/planes/radius/local", ResourceType: datamodel.RoleAssignmentResourceType}).
+		Return(&database.ObjectQueryResult{Items: []database.Object{
+			*newAssignment("developers", "container-operator"),
+			*newAssignment("operators", "container-operator"),
+		}}, nil).
+		Times(1)
+	client.EXPECT().
+		Get(gomock.Any(), testRoleDefinitionsID+"/container-operator").
+		Return(&database.Object{Data: &datamodel.RoleDefinition{
+			Properties: datamodel.RoleDefinitionProperties{Actions: []string{"*/read"}},
+		}}, nil).
+		Times(1)
+
+	authorizer := NewAuthorizer(client, nil)
+	principal := &Principal{Name: "dave", Groups: []string{"developers", "operators"}}
+
+	allowed, err := authorizer.Authorize(ctx, principal, "/planes/radius/local/resourceGroups/dev", "System.Resources/resourceGroups/write")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// Scopes outside of a plane are not covered by role assignments.
+	allowed, err = authorizer.Authorize(ctx, principal, "/planes", "System.Resources/planes/read")
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
+
+func Test_AuthorizeFromContext(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := inmemory.NewClient()
+
+	saveRoleAssignment(ctx, t, client, "alice-contributor", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "alice",
+		PrincipalType:    datamodel.PrincipalTypeUser,
+		RoleDefinitionID: testRoleDefinitionsID + "/Contributor",
+		Scope:            "/planes/radius/local/resourceGroups/dev",
+	})
+
+	const action = "Applications.Core/containers/write"
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		allowed, err := AuthorizeFromContext(ctx, "/planes/radius/local/resourceGroups/prod", action)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	})
+
+	t.Run("principal", func(t *testing.T) {
+		ctx := WithAuthorizer(WithPrincipal(ctx, &Principal{Name: "alice"}), NewAuthorizer(client, nil))
+
+		allowed, err := AuthorizeFromContext(ctx, "/planes/radius/local/resourceGroups/dev", action)
+		require.NoError(t, err)
+		require.True(t, allowed)
+
+		allowed, err = AuthorizeFromContext(ctx, "/planes/radius/local/resourceGroups/prod", action)
+		require.NoError(t, err)
+		require.False(t, allowed)
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		ctx := WithAuthorizer(ctx, NewAuthorizer(client, nil))
+
+		allowed, err := AuthorizeFromContext(ctx, "/planes/radius/local/resourceGroups/dev", action)
+		require.NoError(t, err)
+		require.False(t, allowed)
+	})
+}
+
+func saveRoleDefinition(ctx context.Context, t *testing.T, client database.Client, name string, properties datamodel.RoleDefinitionProperties) {
+	id := testRoleDefinitionsID + "/" + name
+	err := client.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: id},
+		Data: &datamodel.RoleDefinition{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{ID: id, Name: name, Type: datamodel.RoleDefinitionResourceType},
+			},
+			Properties: properties,
+		},
+	})
+	require.NoError(t, err)
+}
+
+func saveRoleAssignment(ctx context.Context, t *testing.T, client database.Client, name string, properties datamodel.RoleAssignmentProperties) {
+	id := testRoleAssignmentsID + "/" + name
+	err := client.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: id},
+		Data: &datamodel.RoleAssignment{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{ID: id, Name: name, Type: datamodel.RoleAssignmentResourceType},
+			},
+			Properties: properties,
+		},
+	})
+	require.NoError(t, err)
+}
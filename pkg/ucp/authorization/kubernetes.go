@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+var (
+	defaultUsernameHeaders = []string{"X-Remote-User"}
+	defaultGroupHeaders    = []string{"X-Remote-Group"}
+)
+
+// NewTokenReviewAuthenticator creates a TokenAuthenticator that authenticates tokens, such as ServiceAccount tokens,
+// with the Kubernetes TokenReview API.
+func NewTokenReviewAuthenticator(client kubernetes.Interface, audiences []string) TokenAuthenticator {
+	return &tokenReviewAuthenticator{client: client, audiences: audiences}
+}
+
+type tokenReviewAuthenticator struct {
+	client    kubernetes.Interface
+	audiences []string
+}
+
+// AuthenticateToken implements TokenAuthenticator.
+func (a *tokenReviewAuthenticator) AuthenticateToken(ctx context.Context, token string) (*Principal, error) {
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     token,
+			Audiences: a.audiences,
+		},
+	}
+
+	result, err := a.client.AuthenticationV1().TokenReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to review the token: %w", err)
+	}
+
+	if !result.Status.Authenticated {
+		if result.Status.Error != "" {
+			return nil, fmt.Errorf("the token is not valid: %s", result.Status.Error)
+		}
+
+		return nil, errors.New("the token is not valid")
+	}
+
+	return &Principal{Name: result.Status.User.Username, Groups: result.Status.User.Groups}, nil
+}
+
+// NewRequestHeaderAuthenticator creates an Authenticator for requests proxied by the Kubernetes API server. The
+// identity headers are only trusted when the request presents a verified client certificate with an allowed name.
+//
+// Verifying the client certificate requires the server to use the TLS configuration returned by ClientTLSConfig.
+func NewRequestHeaderAuthenticator(options RequestHeaderOptions) Authenticator {
+	usernameHeaders := options.UsernameHeaders
+	if len(usernameHeaders) == 0 {
+		usernameHeaders = defaultUsernameHeaders
+	}
+
+	groupHeaders := options.GroupHeaders
+	if len(groupHeaders) == 0 {
+		groupHeaders = defaultGroupHeaders
+	}
+
+	return &requestHeaderAuthenticator{
+		allowedNames:    options.AllowedNames,
+		usernameHeaders: usernameHeaders,
+		groupHeaders:    groupHeaders,
+	}
+}
+
+type requestHeaderAuthenticator struct {
+	allowedNames    []string
+	usernameHeaders []string
+	groupHeaders    []string
+}
+
+// Authenticate implements Authenticator.
+func (a *requestHeaderAuthenticator) Authenticate(req *http.Request) (*Principal, error) {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 || len(req.TLS.VerifiedChains[0]) == 0 {
+		return nil, nil
+	}
+
+	commonName := req.TLS.VerifiedChains[0][0].Subject.CommonName
+	if len(a.allowedNames) > 0 && !slices.Contains(a.allowedNames, commonName) {
+		return nil, fmt.Errorf("the client certificate common name %q is not allowed", commonName)
+	}
+
+	username := ""
+	for _, header := range a.usernameHeaders {
+		username = req.Header.Get(header)
+		if username != "" {
+			break
+		}
+	}
+
+	if username == "" {
+		return nil, errors.New("the proxied request does not specify a user")
+	}
+
+	groups := []string{}
+	for _, header := range a.groupHeaders {
+		groups = append(groups, req.Header.Values(header)...)
+	}
+
+	return &Principal{Name: username, Groups: groups}, nil
+}
+
+// ClientTLSConfig returns the server TLS configuration required to verify the client certificates of requests
+// proxied by the Kubernetes API server, or nil if authenticating proxied requests is disabled.
+func ClientTLSConfig(options Options) (*tls.Config, error) {
+	if !options.Enabled || options.Kubernetes.RequestHeader.ClientCAFile == "" {
+		return nil, nil
+	}
+
+	pem, err := os.ReadFile(options.Kubernetes.RequestHeader.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the request header client CA file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("the request header client CA file %q does not contain any certificate", options.Kubernetes.RequestHeader.ClientCAFile)
+	}
+
+	return &tls.Config{
+		ClientAuth: tls.VerifyClientCertIfGiven,
+		ClientCAs:  pool,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func Test_TokenReviewAuthenticator(t *testing.T) {
+	client := fake.NewClientset()
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		require.Equal(t, []string{"ucp"}, review.Spec.Audiences)
+
+		if review.Spec.Token != "valid-token" {
+			review.Status = authenticationv1.TokenReviewStatus{Authenticated: false, Error: "token expired"}
+			return true, review, nil
+		}
+
+		review.Status = authenticationv1.TokenReviewStatus{
+			Authenticated: true,
+			User: authenticationv1.UserInfo{
+				Username: "system:serviceaccount:default:deployer",
+				Groups:   []string{"system:serviceaccounts", "system:serviceaccounts:default"},
+			},
+		}
+		return true, review, nil
+	})
+
+	authenticator := NewTokenReviewAuthenticator(client, []string{"ucp"})
+
+	t.Run("valid token", func(t *testing.T) {
+		principal, err := authenticator.AuthenticateToken(testcontext.New(t), "valid-token")
+		require.NoError(t, err)
+		require.Equal(t, &Principal{
+			Name:   "system:serviceaccount:default:deployer",
+			Groups: []string{"system:serviceaccounts", "system:serviceaccounts:default"},
+		}, principal)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		principal, err := authenticator.AuthenticateToken(testcontext.New(t), "expired-token")
+		require.EqualError(t, err, "the token is not valid: token expired")
+		require.Nil(t, principal)
+	})
+}
+
+func Test_RequestHeaderAuthenticator(t *testing.T) {
+	authenticator := NewRequestHeaderAuthenticator(RequestHeaderOptions{AllowedNames: []string{"front-proxy-client"}})
+
+	newRequest := func(commonName string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/planes/radius/local", nil)
+		req.Header.Set("X-Remote-User", "alice")
+		req.Header.Add("X-Remote-Group", "developers")
+		req.Header.Add("X-Remote-Group", "system:authenticated")
+		if commonName != "" {
+			req.TLS = &tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: commonName}}}},
+			}
+		}
+		return req
+	}
+
+	t.Run("verified proxy", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(newRequest("front-proxy-client"))
+		require.NoError(t, err)
+		require.Equal(t, &Principal{Name: "alice", Groups: []string{"developers", "system:authenticated"}}, principal)
+	})
+
+	t.Run("no client certificate", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(newRequest(""))
+		require.NoError(t, err)
+		require.Nil(t, principal)
+	})
+
+	t.Run("name not allowed", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(newRequest("someone-else"))
+		require.EqualError(t, err, "the client certificate common name \"someone-else\" is not allowed")
+		require.Nil(t, principal)
+	})
+}
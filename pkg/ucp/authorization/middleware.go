@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Middleware authenticates the caller of each request to a UCP resource and authorizes the request with the caller's
+// role assignments. Requests that do not target UCP resources, such as health checks, are not authorized.
+//
+// Unauthenticated callers use the anonymous principal, so they can only perform the actions assigned to the
+// 'system:unauthenticated' group. Requests with invalid credentials are rejected with 401 Unauthorized, and requests the
+// caller is not authorized to perform are rejected with 403 Forbidden.
+//
+// The path of the request is used rather than the ARM request context, because the ARM request context honours the
+// client-specified Referer header.
+func Middleware(pathBase string, authenticator Authenticator, authorizer *Authorizer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			logger := ucplog.FromContextOrDiscard(ctx)
+
+			path := strings.TrimPrefix(r.URL.Path, pathBase)
+			if !isResourcePath(path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			principal, err := authenticator.Authenticate(r)
+			if err != nil {
+				logger.Info("failed to authenticate the request", "error", err.Error())
+				applyResponse(w, r, rest.NewClientAuthenticationFailedARMResponse())
+				return
+			} else if principal == nil {
+				principal = Anonymous()
+			}
+
+			ctx = WithAuthorizer(WithPrincipal(ctx, principal), authorizer)
+			r = r.WithContext(ctx)
+
+			scope, action := ActionFromRequest(r.Method, path)
+			allowed, err := authorizer.Authorize(ctx, principal, scope, action)
+			if err != nil {
+				logger.Error(err, "failed to authorize the request")
+				applyResponse(w, r, rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+					Error: &v1.ErrorDetails{
+						Code:    v1.CodeInternal,
+						Message: "failed to authorize the request",
+					},
+				}))
+				return
+			}
+
+			if !allowed {
+				message := fmt.Sprintf("The principal %q does not have authorization to perform action %q over scope %q.", principal.Name, action, scope)
+				applyResponse(w, r, rest.NewAuthorizationFailedResponse(scope, message))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// isResourcePath returns true if the path targets a UCP resource.
+func isResourcePath(path string) bool {
+	path = strings.ToLower(path)
+	return path == rootScope || strings.HasPrefix(path, rootScope+"/")
+}
+
+func applyResponse(w http.ResponseWriter, r *http.Request, response rest.Response) {
+	err := response.Apply(r.Context(), w, r)
+	if err != nil {
+		// There's no way to recover if we fail writing here, we likely partially wrote to the response stream.
+		ucplog.FromContextOrDiscard(r.Context()).Error(err, "failed to write the response")
+	}
+}
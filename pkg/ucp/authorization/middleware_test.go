@@ -0,0 +1,116 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+type testAuthenticator struct {
+	principal *Principal
+	err       error
+}
+
+func (a *testAuthenticator) Authenticate(req *http.Request) (*Principal, error) {
+	return a.principal, a.err
+}
+
+func Test_Middleware(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := inmemory.NewClient()
+	saveRoleAssignment(ctx, t, client, "alice-reader", datamodel.RoleAssignmentProperties{
+		PrincipalID:      "alice",
+		PrincipalType:    datamodel.PrincipalTypeUser,
+		RoleDefinitionID: testRoleDefinitionsID + "/Reader",
+		Scope:            "/planes/radius/local",
+	})
+	authorizer := NewAuthorizer(client, nil)
+
+	tests := []struct {
+		name          string
+		authenticator Authenticator
+		method        string
+		path          string
+		expectedCode  int
+		expectedUser  string
+	}{
+		{
+			name:          "allowed",
+			authenticator: &testAuthenticator{principal: &Principal{Name: "alice"}},
+			method:        http.MethodGet,
+			path:          "/apis/api.ucp.dev/v1alpha3/planes/radius/local/resourceGroups/test-group",
+			expectedCode:  http.StatusOK,
+			expectedUser:  "alice",
+		},
+		{
+			name:          "forbidden",
+			authenticator: &testAuthenticator{principal: &Principal{Name: "alice"}},
+			method:        http.MethodPut,
+			path:          "/apis/api.ucp.dev/v1alpha3/planes/radius/local/resourceGroups/test-group",
+			expectedCode:  http.StatusForbidden,
+		},
+		{
+			name:          "anonymous",
+			authenticator: &testAuthenticator{},
+			method:        http.MethodGet,
+			path:          "/apis/api.ucp.dev/v1alpha3/planes/radius/local",
+			expectedCode:  http.StatusForbidden,
+		},
+		{
+			name:          "invalid credentials",
+			authenticator: &testAuthenticator{err: errors.New("the token is not valid")},
+			method:        http.MethodGet,
+			path:          "/apis/api.ucp.dev/v1alpha3/planes/radius/local",
+			expectedCode:  http.StatusUnauthorized,
+		},
+		{
+			name:          "not a resource",
+			authenticator: &testAuthenticator{err: errors.New("the token is not valid")},
+			method:        http.MethodGet,
+			path:          "/healthz",
+			expectedCode:  http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := ""
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if principal := PrincipalFromContext(r.Context()); principal != nil {
+					user = principal.Name
+				}
+				w.WriteHeader(http.StatusOK)
+			})
+
+			handler := Middleware("/apis/api.ucp.dev/v1alpha3", tt.authenticator, authorizer)(next)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			handler.ServeHTTP(w, req.WithContext(ctx))
+
+			require.Equal(t, tt.expectedCode, w.Code)
+			require.Equal(t, tt.expectedUser, user)
+		})
+	}
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+const (
+	defaultUsernameClaim = "sub"
+	defaultGroupsClaim   = "groups"
+
+	// defaultPrefix is the default prefix of the names and groups of OIDC principals.
+	defaultPrefix = "oidc:"
+
+	// discoveryPath is the path of the OIDC discovery document relative to the issuer URL.
+	discoveryPath = "/.well-known/openid-configuration"
+
+	// minKeyRefreshInterval is the minimum interval between refreshes of the signing keys, so that tokens signed with
+	// unknown keys cannot flood the provider with requests.
+	minKeyRefreshInterval = time.Minute
+)
+
+var supportedSignatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+}
+
+// NewOIDCAuthenticator creates a TokenAuthenticator that authenticates tokens issued by an OIDC provider. The signing
+// keys of the provider are discovered on first use and refreshed when a token is signed with an unknown key.
+//
+// The names and groups of the principals are always prefixed, like the Kubernetes API server does, so that the claims
+// of a token cannot match the built-in principals and groups, or the identities of other authenticators.
+func NewOIDCAuthenticator(options OIDCOptions, client *http.Client) TokenAuthenticator {
+	if options.UsernameClaim == "" {
+		options.UsernameClaim = defaultUsernameClaim
+	}
+
+	if options.GroupsClaim == "" {
+		options.GroupsClaim = defaultGroupsClaim
+	}
+
+	if options.UsernamePrefix == "" {
+		options.UsernamePrefix = defaultPrefix
+	}
+
+	if options.GroupsPrefix == "" {
+		options.GroupsPrefix = defaultPrefix
+	}
+
+	return &oidcAuthenticator{options: options, client: client}
+}
+
+type oidcAuthenticator struct {
+	options OIDCOptions
+	client  *http.Client
+
+	mu        sync.Mutex
+	keys      *jose.JSONWebKeySet
+	refreshed time.Time
+}
+
+// AuthenticateToken implements TokenAuthenticator.
+func (a *oidcAuthenticator) AuthenticateToken(ctx context.Context, token string) (*Principal, error) {
+	parsed, err := jwt.ParseSigned(token, supportedSignatureAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the token: %w", err)
+	}
+
+	keyID := ""
+	if len(parsed.Headers) > 0 {
+		keyID = parsed.Headers[0].KeyID
+	}
+
+	key, err := a.signingKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	standard := jwt.Claims{}
+	claims := map[string]any{}
+	err = parsed.Claims(key, &standard, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify the token: %w", err)
+	}
+
+	if standard.Expiry == nil {
+		return nil, errors.New("the token does not specify an expiry")
+	}
+
+	err = standard.Validate(jwt.Expected{
+		Issuer:      a.options.IssuerURL,
+		AnyAudience: jwt.Audience{a.options.Audience},
+		Time:        time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("the token is not valid: %w", err)
+	}
+
+	username, ok := claims[a.options.UsernameClaim].(string)
+	if !ok || username == "" {
+		return nil, fmt.Errorf("the token does not specify the %q claim", a.options.UsernameClaim)
+	}
+
+	groups := []string{}
+	switch value := claims[a.options.GroupsClaim].(type) {
+	case string:
+		groups = append(groups, a.options.GroupsPrefix+value)
+	case []any:
+		for _, group := range value {
+			if s, ok := group.(string); ok {
+				groups = append(groups, a.options.GroupsPrefix+s)
+			}
+		}
+	}
+
+	return &Principal{Name: a.options.UsernamePrefix + username, Groups: groups}, nil
+}
+
+// signingKey returns the signing key with the given key ID, refreshing the keys of the provider if the key is unknown.
+func (a *oidcAuthenticator) signingKey(ctx context.Context, keyID string) (*jose.JSONWebKey, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.keys != nil {
+		if key := findKey(a.keys, keyID); key != nil {
+			return key, nil
+		}
+
+		if time.Since(a.refreshed) < minKeyRefreshInterval {
+			return nil, fmt.Errorf("the token is signed with unknown key %q", keyID)
+		}
+	}
+
+	keys, err := a.fetchKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a.keys = keys
+	a.refreshed = time.Now()
+
+	if key := findKey(a.keys, keyID); key != nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("the token is signed with unknown key %q", keyID)
+}
+
+// fetchKeys fetches the signing keys of the provider using OIDC discovery.
+func (a *oidcAuthenticator) fetchKeys(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	discovery := struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}{}
+	err := a.get(ctx, strings.TrimSuffix(a.options.IssuerURL, "/")+discoveryPath, &discovery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover the OIDC provider: %w", err)
+	}
+
+	if discovery.Issuer != a.options.IssuerURL {
+		return nil, fmt.Errorf("the OIDC provider issuer %q does not match the configured issuer %q", discovery.Issuer, a.options.IssuerURL)
+	}
+
+	keys := &jose.JSONWebKeySet{}
+	err = a.get(ctx, discovery.JWKSURI, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the OIDC provider keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (a *oidcAuthenticator) get(ctx context.Context, url string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d from %q", resp.StatusCode, url)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// findKey returns the signing key with the given key ID, or the only key if the token does not specify a key ID.
+func findKey(keys *jose.JSONWebKeySet, keyID string) *jose.JSONWebKey {
+	if keyID == "" {
+		if len(keys.Keys) == 1 {
+			return &keys.Keys[0]
+		}
+
+		return nil
+	}
+
+	for _, key := range keys.Key(keyID) {
+		if key.Use == "" || key.Use == "sig" {
+			return &key
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jose "github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_OIDCAuthenticator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc(discoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": server.URL, "jwks_uri": server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "key-1", Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "key-1"))
+	require.NoError(t, err)
+
+	newToken := func(claims jwt.Claims, groups []string) string {
+		token, err := jwt.Signed(signer).Claims(claims).Claims(map[string]any{"groups": groups}).Serialize()
+		require.NoError(t, err)
+		return token
+	}
+
+	authenticator := NewOIDCAuthenticator(OIDCOptions{IssuerURL: server.URL, Audience: "radius"}, server.Client())
+
+	t.Run("valid token", func(t *testing.T) {
+		token := newToken(jwt.Claims{
+			Issuer:   server.URL,
+			Subject:  "alice",
+			Audience: jwt.Audience{"radius"},
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}, []string{"developers"})
+
+		principal, err := authenticator.AuthenticateToken(testcontext.New(t), token)
+		require.NoError(t, err)
+		require.Equal(t, &Principal{Name: "oidc:alice", Groups: []string{"oidc:developers"}}, principal)
+	})
+
+	t.Run("custom prefixes", func(t *testing.T) {
+		authenticator := NewOIDCAuthenticator(OIDCOptions{
+			IssuerURL:      server.URL,
+			Audience:       "radius",
+			UsernamePrefix: "corp-user:",
+			GroupsPrefix:   "corp-group:",
+		}, server.Client())
+
+		token := newToken(jwt.Claims{
+			Issuer:   server.URL,
+			Subject:  "system:anonymous",
+			Audience: jwt.Audience{"radius"},
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}, []string{"system:masters"})
+
+		principal, err := authenticator.AuthenticateToken(testcontext.New(t), token)
+		require.NoError(t, err)
+		require.Equal(t, &Principal{Name: "corp-user:system:anonymous", Groups: []string{"corp-group:system:masters"}}, principal)
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		token := newToken(jwt.Claims{
+			Issuer:   server.URL,
+			Subject:  "alice",
+			Audience: jwt.Audience{"other"},
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}, nil)
+
+		_, err := authenticator.AuthenticateToken(testcontext.New(t), token)
+		require.ErrorIs(t, err, jwt.ErrInvalidAudience)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		token := newToken(jwt.Claims{
+			Issuer:   server.URL,
+			Subject:  "alice",
+			Audience: jwt.Audience{"radius"},
+			Expiry:   jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+		}, nil)
+
+		_, err := authenticator.AuthenticateToken(testcontext.New(t), token)
+		require.ErrorIs(t, err, jwt.ErrExpired)
+	})
+
+	t.Run("untrusted key", func(t *testing.T) {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		otherSigner, err := jose.NewSigner(
+			jose.SigningKey{Algorithm: jose.RS256, Key: otherKey},
+			(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "key-1"))
+		require.NoError(t, err)
+
+		token, err := jwt.Signed(otherSigner).Claims(jwt.Claims{
+			Issuer:   server.URL,
+			Subject:  "mallory",
+			Audience: jwt.Audience{"radius"},
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}).Serialize()
+		require.NoError(t, err)
+
+		_, err = authenticator.AuthenticateToken(testcontext.New(t), token)
+		require.ErrorContains(t, err, "failed to verify the token")
+	})
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+// Options defines the configuration for role-based authorization in UCP.
+type Options struct {
+	// Enabled enables authentication and role-based authorization of requests. When disabled, UCP accepts every request.
+	Enabled bool `yaml:"enabled"`
+
+	// Owners is a list of principals and groups that are granted the built-in Owner role on every scope. This is used to
+	// bootstrap access before any role assignment exists.
+	Owners []string `yaml:"owners,omitempty"`
+
+	// Kubernetes is the configuration for authenticating Kubernetes identities.
+	Kubernetes KubernetesOptions `yaml:"kubernetes"`
+
+	// OIDC is the configuration for authenticating OIDC tokens.
+	OIDC OIDCOptions `yaml:"oidc"`
+}
+
+// KubernetesOptions defines the configuration for authenticating Kubernetes identities.
+type KubernetesOptions struct {
+	// TokenReview enables authenticating bearer tokens, such as ServiceAccount tokens, with the Kubernetes TokenReview API.
+	TokenReview bool `yaml:"tokenReview"`
+
+	// Audiences is the list of audiences the tokens must be issued for. The API server's audiences are used when empty.
+	Audiences []string `yaml:"audiences,omitempty"`
+
+	// RequestHeader is the configuration for authenticating requests proxied by the Kubernetes API server.
+	RequestHeader RequestHeaderOptions `yaml:"requestHeader"`
+}
+
+// RequestHeaderOptions defines the configuration for authenticating requests proxied by the Kubernetes API server
+// (the aggregation layer). The identity headers are only trusted when the request presents a client certificate
+// signed by the configured CA.
+type RequestHeaderOptions struct {
+	// ClientCAFile is the path to the CA bundle used to verify the client certificate of the proxy.
+	// Authenticating proxied requests is disabled when empty.
+	ClientCAFile string `yaml:"clientCAFile"`
+
+	// AllowedNames is the list of common names allowed for the client certificate of the proxy. Any common name is
+	// allowed when empty.
+	AllowedNames []string `yaml:"allowedNames,omitempty"`
+
+	// UsernameHeaders is the list of headers to read the username from. Defaults to X-Remote-User.
+	UsernameHeaders []string `yaml:"usernameHeaders,omitempty"`
+
+	// GroupHeaders is the list of headers to read the groups from. Defaults to X-Remote-Group.
+	GroupHeaders []string `yaml:"groupHeaders,omitempty"`
+}
+
+// OIDCOptions defines the configuration for authenticating OIDC tokens.
+type OIDCOptions struct {
+	// IssuerURL is the URL of the OIDC issuer. Authenticating OIDC tokens is disabled when empty.
+	IssuerURL string `yaml:"issuerURL"`
+
+	// Audience is the audience the tokens must be issued for.
+	Audience string `yaml:"audience"`
+
+	// UsernameClaim is the claim to use as the name of the principal. Defaults to "sub".
+	UsernameClaim string `yaml:"usernameClaim,omitempty"`
+
+	// GroupsClaim is the claim to use as the groups of the principal. Defaults to "groups".
+	GroupsClaim string `yaml:"groupsClaim,omitempty"`
+
+	// UsernamePrefix is the prefix added to the name of the principal, so that OIDC users cannot impersonate other
+	// principals such as 'system:anonymous'. Defaults to "oidc:". The prefix cannot be disabled.
+	UsernamePrefix string `yaml:"usernamePrefix,omitempty"`
+
+	// GroupsPrefix is the prefix added to the groups of the principal, so that OIDC users cannot claim membership of
+	// other groups such as 'system:masters'. Defaults to "oidc:". The prefix cannot be disabled.
+	GroupsPrefix string `yaml:"groupsPrefix,omitempty"`
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"context"
+	"slices"
+)
+
+const (
+	// AnonymousUser is the name of the principal of unauthenticated requests.
+	AnonymousUser = "system:anonymous"
+
+	// UnauthenticatedGroup is the group of the principal of unauthenticated requests.
+	UnauthenticatedGroup = "system:unauthenticated"
+)
+
+// Principal is the authenticated identity of the caller of a request.
+type Principal struct {
+	// Name is the name of the principal, such as a username or a ServiceAccount name.
+	Name string
+
+	// Groups is the list of groups the principal belongs to.
+	Groups []string
+}
+
+// Anonymous returns the principal of unauthenticated requests.
+func Anonymous() *Principal {
+	return &Principal{Name: AnonymousUser, Groups: []string{UnauthenticatedGroup}}
+}
+
+// Matches returns true if the principal has the given name or belongs to the group with the given name. Names are
+// case-sensitive.
+func (p *Principal) Matches(name string) bool {
+	return p.Name == name || slices.Contains(p.Groups, name)
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a new context with the given principal.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal of the request from the context, or nil if the request
+// has not been authenticated.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	if !ok {
+		return nil
+	}
+
+	return principal
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// ReaderRoleName is the name of the built-in role that can read every resource.
+	ReaderRoleName = "Reader"
+
+	// ContributorRoleName is the name of the built-in role that can manage every resource, but cannot manage access.
+	ContributorRoleName = "Contributor"
+
+	// OwnerRoleName is the name of the built-in role that can manage every resource, including access.
+	OwnerRoleName = "Owner"
+)
+
+// builtInRoles is the set of built-in roles keyed by their lowercase name.
+var builtInRoles = map[string]datamodel.RoleDefinitionProperties{
+	strings.ToLower(ReaderRoleName): {
+		Description: "View all resources, but does not allow you to make any changes.",
+		Actions:     []string{"*/read", "System.Resources/query/action"},
+	},
+	strings.ToLower(ContributorRoleName): {
+		Description: "Manage all resources, but does not allow you to assign roles.",
+		Actions:     []string{"*"},
+		NotActions:  []string{"System.Authorization/*/write", "System.Authorization/*/delete"},
+	},
+	strings.ToLower(OwnerRoleName): {
+		Description: "Manage all resources, including assigning roles.",
+		Actions:     []string{"*"},
+	},
+}
+
+// BuiltInRole returns the properties of the built-in role with the given name. The name is case-insensitive.
+func BuiltInRole(name string) (datamodel.RoleDefinitionProperties, bool) {
+	role, ok := builtInRoles[strings.ToLower(name)]
+	return role, ok
+}
+
+// Allows returns true if the role allows the action. An action is allowed if it matches one of the actions of the role
+// and none of its not-actions.
+//
+// Actions are formatted as '{resourceType}/{operation}', for example 'Applications.Core/containers/write'. Patterns can
+// use '*' as a wildcard that matches any sequence of characters, for example 'Applications.Core/*' or '*/read'.
+// Matching is case-insensitive.
+func Allows(role datamodel.RoleDefinitionProperties, action string) bool {
+	for _, notAction := range role.NotActions {
+		if matchAction(notAction, action) {
+			return false
+		}
+	}
+
+	for _, allowed := range role.Actions {
+		if matchAction(allowed, action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchAction returns true if the action matches the pattern.
+func matchAction(pattern string, action string) bool {
+	pattern = strings.ToLower(pattern)
+	action = strings.ToLower(action)
+
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == action
+	}
+
+	if !strings.HasPrefix(action, parts[0]) {
+		return false
+	}
+	action = action[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(action, part)
+		if index < 0 {
+			return false
+		}
+		action = action[index+len(part):]
+	}
+
+	return strings.HasSuffix(action, last)
+}
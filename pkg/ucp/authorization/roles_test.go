@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BuiltInRole(t *testing.T) {
+	role, ok := BuiltInRole("reader")
+	require.True(t, ok)
+	require.Equal(t, []string{"*/read", "System.Resources/query/action"}, role.Actions)
+
+	_, ok = BuiltInRole("Operator")
+	require.False(t, ok)
+}
+
+func Test_Allows(t *testing.T) {
+	reader, _ := BuiltInRole(ReaderRoleName)
+	contributor, _ := BuiltInRole(ContributorRoleName)
+	owner, _ := BuiltInRole(OwnerRoleName)
+	custom := datamodel.RoleDefinitionProperties{
+		Actions:    []string{"*/read", "Applications.Core/containers/*"},
+		NotActions: []string{"Applications.Core/containers/delete"},
+	}
+
+	tests := []struct {
+		name     string
+		role     datamodel.RoleDefinitionProperties
+		action   string
+		expected bool
+	}{
+		{name: "reader read", role: reader, action: "Applications.Core/containers/read", expected: true},
+		{name: "reader query", role: reader, action: "System.Resources/query/action", expected: true},
+		{name: "reader write", role: reader, action: "Applications.Core/containers/write", expected: false},
+		{name: "contributor write", role: contributor, action: "Applications.Core/containers/write", expected: true},
+		{name: "contributor action", role: contributor, action: "Applications.Core/environments/getMetadata/action", expected: true},
+		{name: "contributor assign role", role: contributor, action: "System.Authorization/roleAssignments/write", expected: false},
+		{name: "contributor read role", role: contributor, action: "System.Authorization/roleAssignments/read", expected: true},
+		{name: "owner assign role", role: owner, action: "System.Authorization/roleAssignments/write", expected: true},
+		{name: "custom wildcard", role: custom, action: "applications.core/CONTAINERS/write", expected: true},
+		{name: "custom not action", role: custom, action: "Applications.Core/containers/delete", expected: false},
+		{name: "custom other type", role: custom, action: "Applications.Core/environments/write", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, Allows(tt.role, tt.action))
+		})
+	}
+}
+
+func Test_matchAction(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		action   string
+		expected bool
+	}{
+		{pattern: "*", action: "Applications.Core/containers/write", expected: true},
+		{pattern: "Applications.Core/containers/write", action: "Applications.Core/containers/write", expected: true},
+		{pattern: "Applications.Core/containers/write", action: "Applications.Core/containers/writer", expected: false},
+		{pattern: "Applications.*/read", action: "Applications.Core/containers/read", expected: true},
+		{pattern: "Applications.*/read", action: "Applications.Core/containers/write", expected: false},
+		{pattern: "*/containers/*", action: "Applications.Core/containers/delete", expected: true},
+		{pattern: "*/containers/*", action: "Applications.Core/environments/delete", expected: false},
+		{pattern: "a*b*b", action: "ab", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.action, func(t *testing.T) {
+			require.Equal(t, tt.expected, matchAction(tt.pattern, tt.action))
+		})
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	"github.com/radius-project/radius/pkg/ucp/move"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ ctrl.Controller = (*MoveResourcesController)(nil)
+
+// MoveResourcesController is the async operation controller to move resources from a resource group to another resource
+// group.
+type MoveResourcesController struct {
+	ctrl.BaseController
+
+	// mover validates and performs moves.
+	mover *move.Mover
+}
+
+// NewMoveResourcesController creates a new MoveResourcesController. The transport is used to call the resource providers
+// of the resources, and the default downstream is the address of the resource provider of resource types that do not
+// configure one.
+func NewMoveResourcesController(opts ctrl.Options, transport http.RoundTripper, defaultDownstream *url.URL) (ctrl.Controller, error) {
+	return &MoveResourcesController{
+		BaseController: ctrl.NewBaseAsyncController(opts),
+		mover:          move.NewMover(opts.DatabaseClient, &http.Client{Transport: transport}, defaultDownstream),
+	}, nil
+}
+
+// Run reads the move request, validates the move again since the resources may have changed after it was accepted, and
+// moves the resources. The move request is deleted once it has been processed.
+func (c *MoveResourcesController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	moveRequest, err := database.GetResource[datamodel.MoveRequest](ctx, c.DatabaseClient(), request.ResourceID)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeNotFound, Message: fmt.Sprintf("move request %q not found", request.ResourceID), Target: request.ResourceID}), nil
+	} else if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	id, err := resources.ParseResource(request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	source, err := resources.ParseScope(id.RootScope())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	result, err := c.move(ctx, source, moveRequest)
+
+	// The status of the operation records the result of the move. The move request is kept if the operation was
+	// canceled, because it is processed again.
+	if ctx.Err() == nil {
+		if err := c.DatabaseClient().Delete(ctx, request.ResourceID); err != nil && !errors.Is(err, &database.ErrNotFound{}) {
+			logger.Error(err, "failed to delete move request", "id", request.ResourceID)
+		}
+	}
+
+	return result, err
+}
+
+func (c *MoveResourcesController) move(ctx context.Context, source resources.ID, moveRequest *datamodel.MoveRequest) (ctrl.Result, error) {
+	info := &v1.ResourcesMoveInfo{Resources: moveRequest.Properties.Resources, TargetResourceGroup: moveRequest.Properties.TargetResourceGroup}
+
+	plan, err := c.mover.Validate(ctx, source, info)
+	if err == nil {
+		err = c.mover.Move(ctx, plan)
+	}
+
+	if errors.Is(err, &move.ErrInvalidMove{}) {
+		return ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeInvalid, Message: err.Error(), Target: source.String()}), nil
+	} else if errors.Is(err, &move.ErrMoveConflict{}) {
+		return ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeConflict, Message: err.Error(), Target: source.String()}), nil
+	} else if errors.Is(err, &resourcegroups.LockedError{}) {
+		return ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeScopeLocked, Message: err.Error(), Target: source.String()}), nil
+	} else if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
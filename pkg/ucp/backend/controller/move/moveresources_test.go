@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPlaneID    = "/planes/radius/local"
+	sourceGroupID  = testPlaneID + "/resourceGroups/source"
+	targetGroupID  = testPlaneID + "/resourceGroups/target"
+	containerID    = sourceGroupID + "/providers/Applications.Core/containers/frontend"
+	movedContainer = targetGroupID + "/providers/Applications.Core/containers/frontend"
+	moveRequestID  = sourceGroupID + "/providers/System.Resources/moveRequests/2b2a5b3e-6f8e-4a4b-8f0a-1f5a2c7d9e10"
+)
+
+func setup(t *testing.T, ctx context.Context) database.Client {
+	client := inmemory.NewClient()
+
+	for _, id := range []string{sourceGroupID, targetGroupID} {
+		save(t, ctx, client, id, &datamodel.ResourceGroup{
+			BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: id}},
+		})
+	}
+
+	save(t, ctx, client, containerID, map[string]any{
+		"id":         containerID,
+		"name":       "frontend",
+		"type":       "Applications.Core/containers",
+		"properties": map[string]any{},
+	})
+
+	save(t, ctx, client, moveRequestID, &datamodel.MoveRequest{
+		BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: moveRequestID}},
+		Properties: datamodel.ResourcesMoveInfo{
+			Resources:           []string{containerID},
+			TargetResourceGroup: targetGroupID,
+		},
+	})
+
+	return client
+}
+
+func save(t *testing.T, ctx context.Context, client database.Client, id string, data any) {
+	err := client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: data})
+	require.NoError(t, err)
+}
+
+func Test_MoveResourcesController_Run(t *testing.T) {
+	run := func(t *testing.T, ctx context.Context, client database.Client) (controller.Result, error) {
+		c, err := NewMoveResourcesController(controller.Options{DatabaseClient: client}, nil, nil)
+		require.NoError(t, err)
+
+		return c.Run(ctx, &controller.Request{ResourceID: moveRequestID})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		client := setup(t, ctx)
+
+		result, err := run(t, ctx, client)
+		require.NoError(t, err)
+		require.Equal(t, controller.Result{}, result)
+
+		_, err = client.Get(ctx, containerID)
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: containerID})
+
+		_, err = client.Get(ctx, movedContainer)
+		require.NoError(t, err)
+
+		// The move request is deleted once it has been processed.
+		_, err = client.Get(ctx, moveRequestID)
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: moveRequestID})
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		client := setup(t, ctx)
+
+		// The resource was created in the target resource group after the move was accepted.
+		save(t, ctx, client, movedContainer, map[string]any{"id": movedContainer})
+
+		result, err := run(t, ctx, client)
+		require.NoError(t, err)
+		require.Equal(t, v1.CodeConflict, result.Error.Code)
+		require.Equal(t, "the resource \""+movedContainer+"\" already exists", result.Error.Message)
+
+		_, err = client.Get(ctx, containerID)
+		require.NoError(t, err)
+
+		_, err = client.Get(ctx, moveRequestID)
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: moveRequestID})
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		client := setup(t, ctx)
+
+		// The target resource group was deleted after the move was accepted.
+		err := client.Delete(ctx, targetGroupID)
+		require.NoError(t, err)
+
+		result, err := run(t, ctx, client)
+		require.NoError(t, err)
+		require.Equal(t, v1.CodeInvalid, result.Error.Code)
+
+		_, err = client.Get(ctx, containerID)
+		require.NoError(t, err)
+	})
+
+	t.Run("move request not found", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		client := inmemory.NewClient()
+
+		result, err := run(t, ctx, client)
+		require.NoError(t, err)
+		require.Equal(t, v1.CodeNotFound, result.Error.Code)
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/ucp"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/backend/controller/move"
 	"github.com/radius-project/radius/pkg/ucp/backend/controller/resourcegroups"
 	"github.com/radius-project/radius/pkg/ucp/backend/controller/resourceproviders"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -104,6 +105,11 @@ func RegisterControllers(registry *worker.ControllerRegistry, connection sdk.Con
 		return resourcegroups.NewTrackedResourceProcessController(opts, transport, defaultDownstream)
 	}, opts))
 
+	// Moves of resources between resource groups
+	err = errors.Join(err, registry.Register(datamodel.MoveResourcesResourceType, v1.OperationPost, func(opts ctrl.Options) (ctrl.Controller, error) {
+		return move.NewMoveResourcesController(opts, transport, defaultDownstream)
+	}, opts))
+
 	// Resource providers and related types
 	err = errors.Join(err, registry.Register(datamodel.ResourceProviderResourceType, v1.OperationPut, func(opts ctrl.Options) (ctrl.Controller, error) {
 		return &resourceproviders.ResourceProviderPutController{BaseController: ctrl.NewBaseAsyncController(opts)}, nil
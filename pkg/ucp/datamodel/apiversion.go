@@ -40,4 +40,53 @@ func (r *APIVersion) ResourceTypeName() string {
 type APIVersionProperties struct {
 	// Schema is the schema for the resource type.
 	Schema map[string]any
+
+	// Conversion is the conversion between this API version and the storage API version of the resource type.
+	Conversion *APIVersionConversion `json:"conversion,omitempty"`
+}
+
+// APIVersionConversionRuleKind is the kind of a conversion rule.
+type APIVersionConversionRuleKind string
+
+const (
+	// APIVersionConversionRuleKindRename is a rule that renames or moves a property.
+	APIVersionConversionRuleKindRename APIVersionConversionRuleKind = "rename"
+
+	// APIVersionConversionRuleKindDefault is a rule that sets a property that only exists in the storage API version.
+	APIVersionConversionRuleKindDefault APIVersionConversionRuleKind = "default"
+)
+
+// APIVersionConversion is the conversion between an API version and the storage API version of a resource type.
+// Either Rules or Webhook is set.
+type APIVersionConversion struct {
+	// Rules are the rules that convert a resource from the API version to the storage API version. The rules are
+	// applied in reverse order to convert a resource from the storage API version to the API version.
+	Rules []APIVersionConversionRule `json:"rules,omitempty"`
+
+	// Webhook is the webhook that converts resources between the API version and the storage API version.
+	Webhook *APIVersionConversionWebhook `json:"webhook,omitempty"`
+}
+
+// APIVersionConversionRule is a declarative rule that converts a resource from an API version to the storage API version.
+type APIVersionConversionRule struct {
+	// Kind is the kind of rule.
+	Kind APIVersionConversionRuleKind `json:"kind"`
+
+	// From is the path of the property in the API version for a rename rule, for example "database.host".
+	From string `json:"from,omitempty"`
+
+	// To is the path of the property in the storage API version for a rename rule.
+	To string `json:"to,omitempty"`
+
+	// Path is the path of the property in the storage API version for a default rule.
+	Path string `json:"path,omitempty"`
+
+	// Value is the value of the property for a default rule.
+	Value any `json:"value,omitempty"`
+}
+
+// APIVersionConversionWebhook is a webhook that converts resources between API versions.
+type APIVersionConversionWebhook struct {
+	// URL is the URL of the webhook.
+	URL string `json:"url"`
 }
@@ -0,0 +1,91 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+const (
+	// AuditRecordResourceType is the resource type for an audit record.
+	AuditRecordResourceType = "System.Audit/records"
+)
+
+// AuditResult is the result of an audited operation.
+type AuditResult string
+
+const (
+	// AuditResultSucceeded indicates that the operation completed successfully.
+	AuditResultSucceeded AuditResult = "Succeeded"
+
+	// AuditResultAccepted indicates that the operation was accepted and will complete asynchronously.
+	AuditResultAccepted AuditResult = "Accepted"
+
+	// AuditResultFailed indicates that the operation failed.
+	AuditResultFailed AuditResult = "Failed"
+)
+
+// AuditRecord represents a record of a mutating operation performed on the control plane.
+type AuditRecord struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the audit record.
+	Properties AuditRecordProperties `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *AuditRecord) ResourceTypeName() string {
+	return AuditRecordResourceType
+}
+
+// AuditRecordProperties stores the properties of an audit record.
+type AuditRecordProperties struct {
+	// Timestamp is the time the operation was received.
+	Timestamp time.Time `json:"timestamp"`
+
+	// PrincipalName is the name of the caller.
+	PrincipalName string `json:"principalName"`
+
+	// PrincipalGroups is the list of groups of the caller.
+	PrincipalGroups []string `json:"principalGroups,omitempty"`
+
+	// Method is the HTTP method of the operation.
+	Method string `json:"method"`
+
+	// ResourceID is the ID of the resource or scope the operation targets.
+	ResourceID string `json:"resourceId"`
+
+	// APIVersion is the API version of the operation.
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// CorrelationID is the correlation ID sent by the caller.
+	CorrelationID string `json:"correlationId,omitempty"`
+
+	// OperationID is the ID assigned to the operation by UCP.
+	OperationID string `json:"operationId,omitempty"`
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int `json:"statusCode"`
+
+	// Result is the result of the operation.
+	Result AuditResult `json:"result"`
+
+	// RequestDigest is the SHA-256 digest of the request body, in the form 'sha256:<hex>'. Empty when the request has no body.
+	RequestDigest string `json:"requestDigest,omitempty"`
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// LockResourceType is the resource type for a management lock.
+	LockResourceType = "System.Resources/locks"
+)
+
+// LockLevel is the level of a management lock.
+type LockLevel string
+
+const (
+	// LockLevelCanNotDelete prevents the locked resources from being deleted.
+	LockLevelCanNotDelete LockLevel = "CanNotDelete"
+
+	// LockLevelReadOnly prevents the locked resources from being modified or deleted.
+	LockLevelReadOnly LockLevel = "ReadOnly"
+)
+
+// Lock represents a management lock in a resource group.
+type Lock struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the lock.
+	Properties LockProperties `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *Lock) ResourceTypeName() string {
+	return LockResourceType
+}
+
+// LockProperties stores the properties of a management lock.
+type LockProperties struct {
+	// Level is the level of the lock.
+	Level LockLevel `json:"level"`
+
+	// ResourceID is the ID of the resource the lock applies to. The lock applies to the resource and its child resources.
+	// A lock on an application or environment also applies to the resources in it. When empty, the lock applies to the
+	// resource group and every resource in it.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// Notes are notes about the lock.
+	Notes string `json:"notes,omitempty"`
+}
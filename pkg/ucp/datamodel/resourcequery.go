@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+const (
+	// ResourceQueryResourceType is the resource type of the resource query action.
+	ResourceQueryResourceType = "System.Resources/query"
+)
+
+// ResourceQuery represents a query for resources across scopes.
+type ResourceQuery struct {
+	// Scopes are the IDs of the planes, resource groups or resources to query. Resources in the scopes and their
+	// child scopes are queried.
+	Scopes []string `json:"scopes,omitempty"`
+
+	// Filter is the filter expression that resources must match. An empty filter matches every resource.
+	Filter string `json:"filter,omitempty"`
+
+	// Select is the list of property paths to return for each resource. An empty list returns the id, name, type,
+	// location, tags, systemData and properties of each resource.
+	Select []string `json:"select,omitempty"`
+
+	// Top is the maximum number of resources to return.
+	Top int `json:"top,omitempty"`
+
+	// SkipToken is the token returned by a previous query to continue from.
+	SkipToken string `json:"skipToken,omitempty"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (q *ResourceQuery) ResourceTypeName() string {
+	return ResourceQueryResourceType
+}
+
+// ResourceQueryResult represents a page of the results of a resource query.
+type ResourceQueryResult struct {
+	// Count is the number of resources on this page.
+	Count int `json:"count"`
+
+	// Data is the list of matching resources on this page, ordered by resource ID.
+	Data []map[string]any `json:"data"`
+
+	// SkipToken is the token to query the next page. It is empty on the last page.
+	SkipToken string `json:"skipToken,omitempty"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *ResourceQueryResult) ResourceTypeName() string {
+	return ResourceQueryResourceType
+}
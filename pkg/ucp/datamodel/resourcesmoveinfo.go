@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// ValidateMoveResourcesResourceType is the resource type of the validateMoveResources action of resource groups.
+	ValidateMoveResourcesResourceType = "System.Resources/resourceGroups/validateMoveResources"
+
+	// MoveResourcesResourceType is the resource type of the moveResources action of resource groups.
+	MoveResourcesResourceType = "System.Resources/resourceGroups/moveResources"
+
+	// MoveRequestResourceType is the resource type of a move of resources that is processed asynchronously.
+	MoveRequestResourceType = "System.Resources/moveRequests"
+)
+
+// ResourcesMoveInfo represents the resources to move from a resource group to another resource group.
+type ResourcesMoveInfo struct {
+	// Resources is the list of IDs of the resources to move.
+	Resources []string `json:"resources"`
+
+	// TargetResourceGroup is the ID of the resource group to move the resources to.
+	TargetResourceGroup string `json:"targetResourceGroup"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *ResourcesMoveInfo) ResourceTypeName() string {
+	return MoveResourcesResourceType
+}
+
+// MoveRequest represents a move of resources from a resource group that was accepted and is processed asynchronously.
+// Move requests are stored in the source resource group, and are named after the ID of the operation.
+type MoveRequest struct {
+	v1.BaseResource
+
+	// Properties stores the resources to move.
+	Properties ResourcesMoveInfo `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *MoveRequest) ResourceTypeName() string {
+	return MoveRequestResourceType
+}
@@ -49,4 +49,56 @@ type ResourceTypeProperties struct {
 
 	// Description of the resource type.
 	Description *string `json:"description,omitempty"`
+
+	// Actions is the set of custom actions supported by resources of the resource type, keyed by action name.
+	Actions map[string]ResourceTypeAction `json:"actions,omitempty"`
+
+	// StorageAPIVersion is the API version used to store resources of the resource type. Resources are stored as-is
+	// when not set.
+	StorageAPIVersion *string `json:"storageApiVersion,omitempty"`
+}
+
+// ResourceTypeActionHandlerKind is the kind of handler that implements a custom action.
+type ResourceTypeActionHandlerKind string
+
+const (
+	// ResourceTypeActionHandlerKindRecipe is a handler that returns the outputs of the recipe that deployed the resource.
+	// Secret outputs are only returned when named by the handler.
+	ResourceTypeActionHandlerKindRecipe ResourceTypeActionHandlerKind = "recipe"
+
+	// ResourceTypeActionHandlerKindHook is a handler that forwards the action to the hook endpoint whose address is
+	// returned by the recipe that deployed the resource.
+	ResourceTypeActionHandlerKindHook ResourceTypeActionHandlerKind = "hook"
+
+	// ResourceTypeActionHandlerKindProvider is a handler that forwards the action to an external resource provider.
+	ResourceTypeActionHandlerKindProvider ResourceTypeActionHandlerKind = "provider"
+)
+
+// ResourceTypeAction is a custom action that can be invoked on resources of a resource type with a POST request.
+type ResourceTypeAction struct {
+	// Description of the action.
+	Description *string `json:"description,omitempty"`
+
+	// RequestSchema is the schema of the request body of the action.
+	RequestSchema map[string]any `json:"requestSchema,omitempty"`
+
+	// ResponseSchema is the schema of the response body of the action.
+	ResponseSchema map[string]any `json:"responseSchema,omitempty"`
+
+	// Handler is the handler that implements the action.
+	Handler ResourceTypeActionHandler `json:"handler"`
+}
+
+// ResourceTypeActionHandler is the handler that implements a custom action.
+type ResourceTypeActionHandler struct {
+	// Kind is the kind of handler.
+	Kind ResourceTypeActionHandlerKind `json:"kind"`
+
+	// Output is the name of the recipe output returned by a recipe handler, or of the recipe output that contains the
+	// address of the hook endpoint for a hook handler. The recipe outputs that are not secrets are returned by a recipe
+	// handler when empty.
+	Output string `json:"output,omitempty"`
+
+	// Location is the address of the resource provider that implements a provider handler.
+	Location string `json:"location,omitempty"`
 }
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// RoleAssignmentResourceType is the resource type for a role assignment.
+	RoleAssignmentResourceType = "System.Authorization/roleAssignments"
+)
+
+// PrincipalType is the type of the principal of a role assignment.
+type PrincipalType string
+
+const (
+	// PrincipalTypeUser is a user, such as a Kubernetes service account or the subject of an OIDC token.
+	PrincipalTypeUser PrincipalType = "User"
+
+	// PrincipalTypeGroup is a group of users.
+	PrincipalTypeGroup PrincipalType = "Group"
+)
+
+// RoleAssignment represents a role assignment in a plane.
+type RoleAssignment struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the role assignment.
+	Properties RoleAssignmentProperties `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *RoleAssignment) ResourceTypeName() string {
+	return RoleAssignmentResourceType
+}
+
+// RoleAssignmentProperties stores the properties of a role assignment.
+type RoleAssignmentProperties struct {
+	// PrincipalID is the name of the user or group the role is assigned to.
+	PrincipalID string `json:"principalId"`
+
+	// PrincipalType is the type of the principal.
+	PrincipalType PrincipalType `json:"principalType"`
+
+	// RoleDefinitionID is the ID of the assigned role definition.
+	RoleDefinitionID string `json:"roleDefinitionId"`
+
+	// Scope is the ID of the plane, resource group or resource the role is assigned at. The role applies to the
+	// scope and every resource in it.
+	Scope string `json:"scope"`
+}
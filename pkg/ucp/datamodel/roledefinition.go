@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package datamodel
+
+import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+
+const (
+	// RoleDefinitionResourceType is the resource type for a role definition.
+	RoleDefinitionResourceType = "System.Authorization/roleDefinitions"
+)
+
+// RoleDefinition represents a custom role definition in a plane.
+type RoleDefinition struct {
+	v1.BaseResource
+
+	// Properties stores the properties of the role definition.
+	Properties RoleDefinitionProperties `json:"properties"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *RoleDefinition) ResourceTypeName() string {
+	return RoleDefinitionResourceType
+}
+
+// RoleDefinitionProperties stores the properties of a role definition.
+type RoleDefinitionProperties struct {
+	// Description is the description of the role.
+	Description string `json:"description,omitempty"`
+
+	// Actions are the actions allowed by the role, for example "Applications.Core/containers/write". Wildcards
+	// are supported.
+	Actions []string `json:"actions"`
+
+	// NotActions are the actions excluded from Actions. Wildcards are supported.
+	NotActions []string `json:"notActions,omitempty"`
+}
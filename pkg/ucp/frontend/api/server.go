@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -32,6 +33,8 @@ import (
 	"github.com/radius-project/radius/pkg/components/hosting"
 	"github.com/radius-project/radius/pkg/middleware"
 	"github.com/radius-project/radius/pkg/ucp"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	aws_frontend "github.com/radius-project/radius/pkg/ucp/frontend/aws"
@@ -99,7 +102,31 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 	}
 
 	app := http.Handler(r)
+	if s.options.Config.Audit.Enabled {
+		if s.options.AuditSink == nil {
+			return nil, errors.New("an audit sink is required when the audit log is enabled")
+		}
+
+		// The audit log runs after the ARM request context and the principal of the request have been added to the context.
+		app = audit.Middleware(s.options.Config.Server.PathBase, s.options.AuditSink)(app)
+	}
+
 	app = servicecontext.ARMRequestCtx(s.options.Config.Server.PathBase, s.options.Config.Environment.RoleLocation)(app)
+
+	if s.options.Config.Authorization.Enabled {
+		if s.options.Authenticator == nil {
+			return nil, errors.New("an authenticator is required when authorization is enabled")
+		}
+
+		db, err := s.options.DatabaseProvider.GetClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		authorizer := authorization.NewAuthorizer(db, s.options.Config.Authorization.Owners)
+		app = authorization.Middleware(s.options.Config.Server.PathBase, s.options.Authenticator, authorizer)(app)
+	}
+
 	app = middleware.WithLogger(app)
 
 	app = otelhttp.NewHandler(
@@ -123,6 +150,13 @@ func (s *Service) Initialize(ctx context.Context) (*http.Server, error) {
 			return ctx
 		},
 	}
+
+	// Verifies the client certificates of requests proxied by the Kubernetes API server when enabled.
+	server.TLSConfig, err = authorization.ClientTLSConfig(s.options.Config.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
 	return server, nil
 }
 
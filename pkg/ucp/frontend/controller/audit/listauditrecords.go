@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	http "net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// scopeParameterName is the name of the query parameter for the scope of the audit records.
+	scopeParameterName = "scope"
+
+	// startTimeParameterName is the name of the query parameter for the earliest time of the audit records.
+	startTimeParameterName = "startTime"
+
+	// endTimeParameterName is the name of the query parameter for the latest time of the audit records.
+	endTimeParameterName = "endTime"
+)
+
+var _ armrpc_controller.Controller = (*ListAuditRecords)(nil)
+
+// ListAuditRecords is the controller implementation to list the audit records of a plane.
+type ListAuditRecords struct {
+	armrpc_controller.Operation[*datamodel.AuditRecord, datamodel.AuditRecord]
+	sink audit.Sink
+}
+
+// NewListAuditRecords creates a new controller for listing the audit records of a plane. The sink is nil when the
+// audit log is disabled.
+func NewListAuditRecords(opts armrpc_controller.Options, sink audit.Sink) (armrpc_controller.Controller, error) {
+	return &ListAuditRecords{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.AuditRecord]{
+				RequestConverter:  converter.AuditRecordDataModelFromVersioned,
+				ResponseConverter: converter.AuditRecordDataModelToVersioned,
+			},
+		),
+		sink: sink,
+	}, nil
+}
+
+// Run implements controller.Controller.
+func (r *ListAuditRecords) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	querier, ok := r.sink.(audit.Querier)
+	if r.sink == nil {
+		return armrpc_rest.NewBadRequestResponse("The audit log is not enabled."), nil
+	} else if !ok {
+		return armrpc_rest.NewBadRequestResponse(audit.ErrQueryNotSupported.Error()), nil
+	}
+
+	// NOTE: the URL path should be something like: /planes/radius/local/providers/System.Audit/records.
+	//
+	// We trim this to just /planes/radius/local
+	relativePath := middleware.GetRelativePath(r.Options().PathBase, req.URL.Path)
+	plane, err := resources.ParseScope(
+		strings.TrimSuffix(
+			strings.TrimSuffix(relativePath, resources.SegmentSeparator),
+			resources.SegmentSeparator+resources.ProvidersSegment+resources.SegmentSeparator+datamodel.AuditRecordResourceType))
+	if err != nil {
+		return nil, err
+	}
+
+	filter, response := parseFilter(req.URL.Query(), plane)
+	if response != nil {
+		return response, nil
+	}
+
+	result, err := querier.Query(ctx, *filter, serviceCtx.SkipToken, serviceCtx.Top)
+	if err != nil {
+		return nil, err
+	}
+
+	items := v1.PaginatedList{
+		Value: []any{}, // Initialize to empty list for testability
+	}
+	for _, record := range result.Records {
+		versioned, err := converter.AuditRecordDataModelToVersioned(record, serviceCtx.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		items.Value = append(items.Value, versioned)
+	}
+
+	if result.PaginationToken != "" {
+		// Unlike other list operations, the filter must be preserved in the next link.
+		qps := req.URL.Query()
+		qps.Set(v1.SkipTokenParameterName, result.PaginationToken)
+		qps.Set(v1.TopParameterName, strconv.Itoa(serviceCtx.Top))
+		items.NextLink = armrpc_controller.GetURLFromReqWithQueryParameters(req, qps).String()
+	}
+
+	return armrpc_rest.NewOKResponse(&items), nil
+}
+
+// parseFilter parses the filter from the query parameters. The scope defaults to the plane, and must be the plane or
+// a scope or resource in the plane because callers are authorized for the plane.
+func parseFilter(qps url.Values, plane resources.ID) (*audit.QueryFilter, armrpc_rest.Response) {
+	filter := &audit.QueryFilter{Scope: plane.String()}
+
+	if scope := qps.Get(scopeParameterName); scope != "" {
+		id, err := resources.Parse(scope)
+		if err != nil {
+			return nil, armrpc_rest.NewBadRequestResponse(fmt.Sprintf("The scope %q is not a valid resource id.", scope))
+		}
+
+		if !strings.EqualFold(id.String(), plane.String()) && !strings.HasPrefix(strings.ToLower(id.String()), strings.ToLower(plane.String())+resources.SegmentSeparator) {
+			return nil, armrpc_rest.NewBadRequestResponse(fmt.Sprintf("The scope %q is not in the plane %q.", scope, plane.String()))
+		}
+
+		filter.Scope = id.String()
+	}
+
+	for _, p := range []struct {
+		name string
+		dst  *time.Time
+	}{
+		{name: startTimeParameterName, dst: &filter.StartTime},
+		{name: endTimeParameterName, dst: &filter.EndTime},
+	} {
+		value := qps.Get(p.name)
+		if value == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, armrpc_rest.NewBadRequestResponse(fmt.Sprintf("The %s %q is not a valid RFC 3339 time.", p.name, value))
+		}
+		*p.dst = t
+	}
+
+	if !filter.StartTime.IsZero() && !filter.EndTime.IsZero() && filter.EndTime.Before(filter.StartTime) {
+		return nil, armrpc_rest.NewBadRequestResponse(fmt.Sprintf("The %s must not be before the %s.", endTimeParameterName, startTimeParameterName))
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	testPlaneID        = "/planes/radius/local"
+	testResourceGroup  = testPlaneID + "/resourceGroups/test-group"
+	testApplicationID  = testResourceGroup + "/providers/Applications.Core/applications/test-app"
+	testAuditRecordsID = testPlaneID + "/providers/System.Audit/records"
+)
+
+func Test_ListAuditRecords(t *testing.T) {
+	ctx := testcontext.New(t)
+	sink := audit.NewDatabaseSink(inmemory.NewClient())
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, target := range []string{testResourceGroup, testApplicationID, testPlaneID + "/resourceGroups/other-group"} {
+		err := sink.Write(ctx, &datamodel.AuditRecord{
+			Properties: datamodel.AuditRecordProperties{
+				Timestamp:     start.Add(time.Duration(i) * time.Hour),
+				PrincipalName: "alice",
+				Method:        http.MethodPut,
+				ResourceID:    target,
+				StatusCode:    http.StatusOK,
+				Result:        datamodel.AuditResultSucceeded,
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	run := func(t *testing.T, sink audit.Sink, query url.Values) (armrpc_rest.Response, *http.Request) {
+		pathBase := "/" + uuid.New().String()
+		c, err := NewListAuditRecords(armrpc_controller.Options{PathBase: pathBase}, sink)
+		require.NoError(t, err)
+
+		query.Set("api-version", v20231001preview.Version)
+		request, err := http.NewRequest(http.MethodGet, pathBase+testAuditRecordsID+"?"+query.Encode(), nil)
+		require.NoError(t, err)
+
+		response, err := c.Run(rpctest.NewARMRequestContext(request), nil, request)
+		require.NoError(t, err)
+		return response, request
+	}
+
+	resourceIDs := func(t *testing.T, response armrpc_rest.Response) []string {
+		ok, isOK := response.(*armrpc_rest.OKResponse)
+		require.True(t, isOK, "expected an OK response, got %T", response)
+
+		ids := []string{}
+		for _, item := range ok.Body.(*v1.PaginatedList).Value {
+			ids = append(ids, to.String(item.(*v20231001preview.AuditRecordResource).Properties.ResourceID))
+		}
+		return ids
+	}
+
+	t.Run("plane", func(t *testing.T) {
+		response, _ := run(t, sink, url.Values{})
+		require.Equal(t, []string{testPlaneID + "/resourceGroups/other-group", testApplicationID, testResourceGroup}, resourceIDs(t, response))
+	})
+
+	t.Run("scope and time range", func(t *testing.T) {
+		response, _ := run(t, sink, url.Values{
+			"scope":     []string{testResourceGroup},
+			"startTime": []string{start.Add(30 * time.Minute).Format(time.RFC3339)},
+		})
+		require.Equal(t, []string{testApplicationID}, resourceIDs(t, response))
+	})
+
+	t.Run("pagination preserves the filter", func(t *testing.T) {
+		sink := audit.NewDatabaseSink(inmemory.NewClient())
+		for i := 0; i < v1.MinQueryItemCount+1; i++ {
+			err := sink.Write(ctx, &datamodel.AuditRecord{
+				Properties: datamodel.AuditRecordProperties{
+					Timestamp:  start.Add(time.Duration(i) * time.Minute),
+					Method:     http.MethodDelete,
+					ResourceID: testApplicationID,
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		top := strconv.Itoa(v1.MinQueryItemCount)
+		response, request := run(t, sink, url.Values{"scope": []string{testResourceGroup}, "top": []string{top}})
+		require.Len(t, resourceIDs(t, response), v1.MinQueryItemCount)
+
+		nextLink, err := url.Parse(response.(*armrpc_rest.OKResponse).Body.(*v1.PaginatedList).NextLink)
+		require.NoError(t, err)
+		require.Equal(t, request.URL.Path, nextLink.Path)
+		require.Equal(t, testResourceGroup, nextLink.Query().Get("scope"))
+		require.NotEmpty(t, nextLink.Query().Get("skipToken"))
+		require.Equal(t, top, nextLink.Query().Get("top"))
+
+		response, _ = run(t, sink, nextLink.Query())
+		require.Len(t, resourceIDs(t, response), 1)
+	})
+
+	t.Run("scope outside the plane", func(t *testing.T) {
+		response, _ := run(t, sink, url.Values{"scope": []string{"/planes/radius/other"}})
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+
+	t.Run("invalid time", func(t *testing.T) {
+		response, _ := run(t, sink, url.Values{"endTime": []string{"yesterday"}})
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+
+	t.Run("end time before start time", func(t *testing.T) {
+		response, _ := run(t, sink, url.Values{
+			"startTime": []string{start.Format(time.RFC3339)},
+			"endTime":   []string{start.Add(-time.Hour).Format(time.RFC3339)},
+		})
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+
+	t.Run("audit log disabled", func(t *testing.T) {
+		response, _ := run(t, nil, url.Values{})
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+
+	t.Run("sink does not support queries", func(t *testing.T) {
+		response, _ := run(t, audit.NewWriterSink(&bytes.Buffer{}), url.Values{})
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+}
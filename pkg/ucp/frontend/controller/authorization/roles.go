@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// ValidateRoleDefinition is an update filter that validates a custom role definition. The names of the built-in roles
+// are reserved.
+func ValidateRoleDefinition(ctx context.Context, newResource *datamodel.RoleDefinition, oldResource *datamodel.RoleDefinition, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	if _, ok := authorization.BuiltInRole(serviceCtx.ResourceID.Name()); ok {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The role definition name %q is reserved for a built-in role.", serviceCtx.ResourceID.Name())), nil
+	}
+
+	return nil, nil
+}
+
+// ValidateRoleAssignment is an update filter that validates a role assignment. The role definition of the assignment
+// must be a built-in role or an existing custom role definition.
+//
+// The scope and the role definition of the assignment must belong to the plane of the assignment, and the caller must be
+// allowed to write role assignments over the scope of the assignment. Otherwise a caller that can write a role
+// assignment could grant access to scopes it cannot manage.
+func ValidateRoleAssignment(ctx context.Context, newResource *datamodel.RoleAssignment, oldResource *datamodel.RoleAssignment, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	plane := serviceCtx.ResourceID.PlaneScope()
+
+	scope, err := resources.Parse(newResource.Properties.Scope)
+	if err != nil || !strings.EqualFold(scope.PlaneScope(), plane) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The scope %q is not in the plane %q of the role assignment.", newResource.Properties.Scope, plane)), nil
+	}
+
+	roleDefinitionID, err := resources.ParseResource(newResource.Properties.RoleDefinitionID)
+	if err != nil || !strings.EqualFold(roleDefinitionID.Type(), datamodel.RoleDefinitionResourceType) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The role definition id %q is not valid.", newResource.Properties.RoleDefinitionID)), nil
+	}
+
+	if !strings.EqualFold(roleDefinitionID.PlaneScope(), plane) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The role definition %q is not in the plane %q of the role assignment.", roleDefinitionID.String(), plane)), nil
+	}
+
+	action := datamodel.RoleAssignmentResourceType + "/write"
+	allowed, err := authorization.AuthorizeFromContext(ctx, scope.String(), action)
+	if err != nil {
+		return nil, err
+	}
+
+	if !allowed {
+		message := fmt.Sprintf("The client does not have authorization to perform action %q over scope %q.", action, scope.String())
+		return rest.NewAuthorizationFailedResponse(scope.String(), message), nil
+	}
+
+	if _, ok := authorization.BuiltInRole(roleDefinitionID.Name()); ok {
+		return nil, nil
+	}
+
+	_, err = options.DatabaseClient.Get(ctx, roleDefinitionID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The role definition %q does not exist.", roleDefinitionID.String())), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	roleDefinitionsID = "/planes/radius/local/providers/System.Authorization/roleDefinitions"
+	roleAssignmentID  = "/planes/radius/local/providers/System.Authorization/roleAssignments/test-assignment"
+)
+
+func Test_ValidateRoleDefinition(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		expected rest.Response
+	}{
+		{
+			name: "custom role",
+			id:   roleDefinitionsID + "/env-operator",
+		},
+		{
+			name:     "built-in name",
+			id:       roleDefinitionsID + "/owner",
+			expected: rest.NewBadRequestResponse("The role definition name \"owner\" is reserved for a built-in role."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := resources.ParseResource(tt.id)
+			require.NoError(t, err)
+			ctx := v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{ResourceID: id})
+
+			response, err := ValidateRoleDefinition(ctx, &datamodel.RoleDefinition{}, nil, &controller.Options{})
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, response)
+		})
+	}
+}
+
+func Test_ValidateRoleAssignment(t *testing.T) {
+	newAssignment := func(roleDefinitionID string) *datamodel.RoleAssignment {
+		return &datamodel.RoleAssignment{
+			Properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:      "alice",
+				PrincipalType:    datamodel.PrincipalTypeUser,
+				RoleDefinitionID: roleDefinitionID,
+				Scope:            "/planes/radius/local",
+			},
+		}
+	}
+
+	newContext := func(t *testing.T) context.Context {
+		id, err := resources.ParseResource(roleAssignmentID)
+		require.NoError(t, err)
+		return v1.WithARMRequestContext(testcontext.New(t), &v1.ARMRequestContext{ResourceID: id})
+	}
+
+	t.Run("built-in role", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(ctrl)
+
+		response, err := ValidateRoleAssignment(newContext(t), newAssignment(roleDefinitionsID+"/Reader"), nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("custom role", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(ctrl)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), roleDefinitionsID+"/env-operator").
+			Return(&database.Object{}, nil).
+			Times(1)
+
+		response, err := ValidateRoleAssignment(newContext(t), newAssignment(roleDefinitionsID+"/env-operator"), nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("missing custom role", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(ctrl)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), roleDefinitionsID+"/missing").
+			Return(nil, &database.ErrNotFound{ID: roleDefinitionsID + "/missing"}).
+			Times(1)
+
+		response, err := ValidateRoleAssignment(newContext(t), newAssignment(roleDefinitionsID+"/missing"), nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Equal(t, rest.NewBadRequestResponse("The role definition \"/planes/radius/local/providers/System.Authorization/roleDefinitions/missing\" does not exist."), response)
+	})
+
+	t.Run("invalid role definition id", func(t *testing.T) {
+		response, err := ValidateRoleAssignment(newContext(t), newAssignment("/planes/radius/local/resourceGroups/test-group"), nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Equal(t, rest.NewBadRequestResponse("The role definition id \"/planes/radius/local/resourceGroups/test-group\" is not valid."), response)
+	})
+
+	t.Run("role definition in another plane", func(t *testing.T) {
+		response, err := ValidateRoleAssignment(newContext(t), newAssignment("/planes/radius/other/providers/System.Authorization/roleDefinitions/Owner"), nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Equal(t, rest.NewBadRequestResponse("The role definition \"/planes/radius/other/providers/System.Authorization/roleDefinitions/Owner\" is not in the plane \"/planes/radius/local\" of the role assignment."), response)
+	})
+
+	t.Run("scope in another plane", func(t *testing.T) {
+		assignment := newAssignment(roleDefinitionsID + "/Owner")
+		assignment.Properties.Scope = "/planes/radius/other/resourceGroups/test-group"
+
+		response, err := ValidateRoleAssignment(newContext(t), assignment, nil, &controller.Options{})
+		require.NoError(t, err)
+		require.Equal(t, rest.NewBadRequestResponse("The scope \"/planes/radius/other/resourceGroups/test-group\" is not in the plane \"/planes/radius/local\" of the role assignment."), response)
+	})
+
+	t.Run("scope not managed by the caller", func(t *testing.T) {
+		databaseClient := inmemory.NewClient()
+		assignment := &datamodel.RoleAssignment{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{ID: roleAssignmentID},
+			},
+			Properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:      "bob",
+				PrincipalType:    datamodel.PrincipalTypeUser,
+				RoleDefinitionID: roleDefinitionsID + "/Owner",
+				Scope:            roleAssignmentID,
+			},
+		}
+		err := databaseClient.Save(testcontext.New(t), &database.Object{Metadata: database.Metadata{ID: roleAssignmentID}, Data: assignment})
+		require.NoError(t, err)
+
+		// Bob owns the role assignment, but not the plane it would grant access to.
+		ctx := authorization.WithAuthorizer(newContext(t), authorization.NewAuthorizer(databaseClient, nil))
+		ctx = authorization.WithPrincipal(ctx, &authorization.Principal{Name: "bob"})
+
+		response, err := ValidateRoleAssignment(ctx, newAssignment(roleDefinitionsID+"/Owner"), nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Equal(t, rest.NewAuthorizationFailedResponse("/planes/radius/local", "The client does not have authorization to perform action \"System.Authorization/roleAssignments/write\" over scope \"/planes/radius/local\"."), response)
+
+		// Alice owns the plane.
+		ctx = authorization.WithPrincipal(ctx, &authorization.Principal{Name: "alice"})
+		ctx = authorization.WithAuthorizer(ctx, authorization.NewAuthorizer(databaseClient, []string{"alice"}))
+
+		response, err = ValidateRoleAssignment(ctx, newAssignment(roleDefinitionsID+"/Owner"), nil, &controller.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+}
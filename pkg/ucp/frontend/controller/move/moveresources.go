@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	http "net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	"github.com/radius-project/radius/pkg/ucp/move"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ armrpc_controller.Controller = (*ValidateMoveResources)(nil)
+
+// ValidateMoveResources is the controller implementation to validate whether resources can be moved from a resource
+// group to another resource group.
+type ValidateMoveResources struct {
+	controller
+}
+
+// NewValidateMoveResources creates a new controller for validating moves of resources. The transport is used to call the
+// resource providers of the resources, and the default downstream is the address of the resource provider of resource
+// types that do not configure one.
+func NewValidateMoveResources(opts armrpc_controller.Options, transport http.RoundTripper, defaultDownstream string) (armrpc_controller.Controller, error) {
+	c, err := newController(opts, transport, defaultDownstream)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ValidateMoveResources{controller: *c}, nil
+}
+
+// Run implements controller.Controller.
+func (c *ValidateMoveResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	_, response, err := c.validate(ctx, req)
+	if response != nil || err != nil {
+		return response, err
+	}
+
+	return armrpc_rest.NewNoContentResponse(), nil
+}
+
+var _ armrpc_controller.Controller = (*MoveResources)(nil)
+
+const (
+	// MoveOperationTimeout is the timeout for moving resources in the background.
+	MoveOperationTimeout = 30 * time.Minute
+
+	// MoveOperationRetryAfter is the retry interval for polling the status of a move of resources.
+	MoveOperationRetryAfter = 5 * time.Second
+)
+
+// MoveResources is the controller implementation to move resources from a resource group to another resource group.
+//
+// The move is validated and then queued as an asynchronous operation, which validates the move again and performs it.
+type MoveResources struct {
+	controller
+}
+
+// NewMoveResources creates a new controller for moving resources. The transport is used to call the resource providers
+// of the resources, and the default downstream is the address of the resource provider of resource types that do not
+// configure one.
+func NewMoveResources(opts armrpc_controller.Options, transport http.RoundTripper, defaultDownstream string) (armrpc_controller.Controller, error) {
+	c, err := newController(opts, transport, defaultDownstream)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MoveResources{controller: *c}, nil
+}
+
+// Run implements controller.Controller.
+func (c *MoveResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	plan, response, err := c.validate(ctx, req)
+	if response != nil || err != nil {
+		return response, err
+	}
+
+	// The move request is stored so that the background operation can read the resources to move.
+	id, err := resources.ParseResource(plan.Source.String() + "/providers/" + datamodel.MoveRequestResourceType + "/" + serviceCtx.OperationID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	moveRequest := &datamodel.MoveRequest{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       id.String(),
+				Name:     id.Name(),
+				Type:     id.Type(),
+				Location: v1.LocationGlobal,
+			},
+			InternalMetadata: v1.InternalMetadata{
+				AsyncProvisioningState: v1.ProvisioningStateAccepted,
+			},
+		},
+		Properties: datamodel.ResourcesMoveInfo{
+			TargetResourceGroup: plan.Target.String(),
+		},
+	}
+	for _, resource := range plan.Resources {
+		moveRequest.Properties.Resources = append(moveRequest.Properties.Resources, resource.ID.String())
+	}
+
+	err = c.DatabaseClient().Save(ctx, &database.Object{Metadata: database.Metadata{ID: id.String()}, Data: moveRequest})
+	if err != nil {
+		return nil, err
+	}
+
+	operationCtx := *serviceCtx
+	operationCtx.ResourceID = id
+	operationCtx.OperationType = v1.OperationType{Type: datamodel.MoveResourcesResourceType, Method: v1.OperationPost}
+
+	err = c.StatusManager().QueueAsyncOperation(ctx, &operationCtx, statusmanager.QueueOperationOptions{OperationTimeout: MoveOperationTimeout, RetryAfter: MoveOperationRetryAfter})
+	if err != nil {
+		return nil, errors.Join(err, c.DatabaseClient().Delete(ctx, id.String()))
+	}
+
+	return armrpc_rest.NewAsyncOperationResponse(map[string]any{}, v1.LocationGlobal, http.StatusAccepted, id, serviceCtx.OperationID, serviceCtx.APIVersion, "", c.Options().PathBase), nil
+}
+
+// controller implements the validation shared by the validateMoveResources and moveResources actions.
+type controller struct {
+	armrpc_controller.Operation[*datamodel.GenericResource, datamodel.GenericResource]
+
+	// mover validates and performs moves.
+	mover *move.Mover
+}
+
+func newController(opts armrpc_controller.Options, transport http.RoundTripper, defaultDownstream string) (*controller, error) {
+	parsedDefaultDownstream, err := url.Parse(defaultDownstream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse default downstream URL: %w", err)
+	}
+
+	return &controller{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.GenericResource]{
+				RequestConverter:  converter.GenericResourceDataModelFromVersioned,
+				ResponseConverter: converter.GenericResourceDataModelToVersioned,
+			},
+		),
+		mover: move.NewMover(opts.DatabaseClient, &http.Client{Transport: transport}, parsedDefaultDownstream),
+	}, nil
+}
+
+// validate reads the move request, validates that the caller can write the moved resources in the target resource group,
+// and returns the plan of the move.
+func (c *controller) validate(ctx context.Context, req *http.Request) (*move.Plan, armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// NOTE: the URL path should be something like: /planes/radius/local/resourcegroups/rg1/moveResources.
+	//
+	// We trim this to just /planes/radius/local/resourcegroups/rg1
+	relativePath := strings.TrimSuffix(middleware.GetRelativePath(c.Options().PathBase, req.URL.Path), resources.SegmentSeparator)
+	source, err := resources.ParseScope(relativePath[:strings.LastIndex(relativePath, resources.SegmentSeparator)])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = c.DatabaseClient().Get(ctx, source.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil, armrpc_rest.NewNotFoundResponse(source), nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := armrpc_controller.ReadJSONBody(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, err := converter.ResourcesMoveInfoDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	plan, err := c.mover.Validate(ctx, source, &v1.ResourcesMoveInfo{Resources: info.Resources, TargetResourceGroup: info.TargetResourceGroup})
+	if errors.Is(err, &move.ErrInvalidMove{}) {
+		return nil, armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	} else if errors.Is(err, &move.ErrMoveConflict{}) {
+		return nil, armrpc_rest.NewConflictResponse(err.Error()), nil
+	} else if errors.Is(err, &resourcegroups.LockedError{}) {
+		return nil, armrpc_rest.NewScopeLockedResponse(source.String(), err.Error()), nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	// The caller is authorized to move resources out of the source resource group by the authorization middleware, but
+	// moving them also writes them to the target resource group.
+	for _, resource := range plan.Resources {
+		action := resource.ID.Type() + resources.SegmentSeparator + "write"
+		allowed, err := authorization.AuthorizeFromContext(ctx, plan.Target.String(), action)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !allowed {
+			message := fmt.Sprintf("The client does not have authorization to perform action %q over scope %q.", action, plan.Target.String())
+			return nil, armrpc_rest.NewAuthorizationFailedResponse(plan.Target.String(), message), nil
+		}
+	}
+
+	return plan, nil, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	testPlaneID     = "/planes/radius/local"
+	sourceGroupID   = testPlaneID + "/resourceGroups/source"
+	targetGroupID   = testPlaneID + "/resourceGroups/target"
+	containerID     = sourceGroupID + "/providers/Applications.Core/containers/frontend"
+	movedContainer  = targetGroupID + "/providers/Applications.Core/containers/frontend"
+	roleAssignments = testPlaneID + "/providers/System.Authorization/roleAssignments"
+)
+
+func setup(t *testing.T, ctx context.Context) database.Client {
+	client := inmemory.NewClient()
+
+	for _, id := range []string{sourceGroupID, targetGroupID} {
+		save(t, ctx, client, id, &datamodel.ResourceGroup{
+			BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: id}},
+		})
+	}
+
+	save(t, ctx, client, containerID, map[string]any{
+		"id":         containerID,
+		"name":       "frontend",
+		"type":       "Applications.Core/containers",
+		"properties": map[string]any{},
+	})
+
+	return client
+}
+
+func save(t *testing.T, ctx context.Context, client database.Client, id string, data any) {
+	err := client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: data})
+	require.NoError(t, err)
+}
+
+func run(t *testing.T, ctx context.Context, client database.Client, statusManager statusmanager.StatusManager, action string, resourceGroup string, body *v20231001preview.ResourcesMoveInfo) armrpc_rest.Response {
+	pathBase := "/" + uuid.New().String()
+	opts := armrpc_controller.Options{PathBase: pathBase, DatabaseClient: client, StatusManager: statusManager}
+
+	var c armrpc_controller.Controller
+	var err error
+	if action == v1.MoveResourcesActionName {
+		c, err = NewMoveResources(opts, http.DefaultTransport, "")
+	} else {
+		c, err = NewValidateMoveResources(opts, http.DefaultTransport, "")
+	}
+	require.NoError(t, err)
+
+	content, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, pathBase+resourceGroup+"/"+action+"?api-version="+v20231001preview.Version, bytes.NewBuffer(content))
+	require.NoError(t, err)
+	request.Header.Set("Content-Type", "application/json")
+
+	// Keep the authorizer and principal of the test context.
+	ctx = v1.WithARMRequestContext(ctx, v1.ARMRequestContextFromContext(rpctest.NewARMRequestContext(request)))
+	response, err := c.Run(ctx, nil, request)
+	require.NoError(t, err)
+	return response
+}
+
+func Test_MoveResources(t *testing.T) {
+	body := &v20231001preview.ResourcesMoveInfo{
+		Resources:           to.SliceOfPtrs(containerID),
+		TargetResourceGroup: to.Ptr(targetGroupID),
+	}
+
+	t.Run("validate", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		client := setup(t, ctx)
+
+		response := run(t, ctx, client, nil, v1.ValidateMoveResourcesActionName, sourceGroupID, body)
+		require.IsType(t, &armrpc_rest.NoContentResponse{}, response)
+
+		_, err := client.Get(ctx, containerID)
+		require.NoError(t, err)
+	})
+
+	t.Run("move", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		client := setup(t, ctx)
+
+		// The move is queued as an asynchronous operation on the move request.
+		var operationCtx *v1.ARMRequestContext
+		statusManager := statusmanager.NewMockStatusManager(gomock.NewController(t))
+		statusManager.EXPECT().
+			QueueAsyncOperation(gomock.Any(), gomock.Any(), statusmanager.QueueOperationOptions{OperationTimeout: MoveOperationTimeout, RetryAfter: MoveOperationRetryAfter}).
+			DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+				operationCtx = sCtx
+				return nil
+			}).Times(1)
+
+		response := run(t, ctx, client, statusManager, v1.MoveResourcesActionName, sourceGroupID, body)
+		require.IsType(t, &armrpc_rest.AsyncOperationResponse{}, response)
+		require.Equal(t, http.StatusAccepted, response.(*armrpc_rest.AsyncOperationResponse).Code)
+
+		moveRequestID := sourceGroupID + "/providers/System.Resources/moveRequests/" + operationCtx.OperationID.String()
+		require.Equal(t, moveRequestID, operationCtx.ResourceID.String())
+		require.Equal(t, v1.OperationType{Type: datamodel.MoveResourcesResourceType, Method: v1.OperationPost}, operationCtx.OperationType)
+
+		moveRequest, err := database.GetResource[datamodel.MoveRequest](ctx, client, moveRequestID)
+		require.NoError(t, err)
+		require.Equal(t, datamodel.ResourcesMoveInfo{Resources: []string{containerID}, TargetResourceGroup: targetGroupID}, moveRequest.Properties)
+
+		// The resources are moved by the operation.
+		_, err = client.Get(ctx, containerID)
+		require.NoError(t, err)
+	})
+
+	t.Run("source resource group not found", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		response := run(t, ctx, setup(t, ctx), nil, v1.MoveResourcesActionName, testPlaneID+"/resourceGroups/missing", body)
+		require.IsType(t, &armrpc_rest.NotFoundResponse{}, response)
+	})
+
+	t.Run("invalid move", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		response := run(t, ctx, setup(t, ctx), nil, v1.MoveResourcesActionName, sourceGroupID, &v20231001preview.ResourcesMoveInfo{
+			Resources:           to.SliceOfPtrs(containerID),
+			TargetResourceGroup: to.Ptr(testPlaneID + "/resourceGroups/missing"),
+		})
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		client := setup(t, ctx)
+		save(t, ctx, client, movedContainer, map[string]any{"id": movedContainer})
+
+		response := run(t, ctx, client, nil, v1.MoveResourcesActionName, sourceGroupID, body)
+		require.IsType(t, &armrpc_rest.ConflictResponse{}, response)
+
+		_, err := client.Get(ctx, containerID)
+		require.NoError(t, err)
+	})
+
+	t.Run("not authorized for target", func(t *testing.T) {
+		ctx := testcontext.New(t)
+		client := setup(t, ctx)
+		save(t, ctx, client, roleAssignments+"/alice", &datamodel.RoleAssignment{
+			BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: roleAssignments + "/alice"}},
+			Properties: datamodel.RoleAssignmentProperties{
+				PrincipalID:      "alice",
+				PrincipalType:    datamodel.PrincipalTypeUser,
+				RoleDefinitionID: testPlaneID + "/providers/System.Authorization/roleDefinitions/Contributor",
+				Scope:            sourceGroupID,
+			},
+		})
+		ctx = authorization.WithAuthorizer(authorization.WithPrincipal(ctx, &authorization.Principal{Name: "alice"}), authorization.NewAuthorizer(client, nil))
+
+		response := run(t, ctx, client, nil, v1.MoveResourcesActionName, sourceGroupID, body)
+		require.IsType(t, &armrpc_rest.ForbiddenResponse{}, response)
+
+		_, err := client.Get(ctx, containerID)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	http "net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/middleware"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/query"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ armrpc_controller.Controller = (*QueryResources)(nil)
+
+// QueryResources is the controller implementation to query the resources of a plane.
+type QueryResources struct {
+	armrpc_controller.Operation[*datamodel.GenericResource, datamodel.GenericResource]
+}
+
+// NewQueryResources creates a new controller for querying the resources of a plane.
+func NewQueryResources(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+	return &QueryResources{
+		Operation: armrpc_controller.NewOperation(opts,
+			armrpc_controller.ResourceOptions[datamodel.GenericResource]{
+				RequestConverter:  converter.GenericResourceDataModelFromVersioned,
+				ResponseConverter: converter.GenericResourceDataModelToVersioned,
+			},
+		),
+	}, nil
+}
+
+// Run implements controller.Controller.
+func (r *QueryResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// NOTE: the URL path should be something like: /planes/radius/local/providers/System.Resources/query.
+	//
+	// We trim this to just /planes/radius/local
+	relativePath := middleware.GetRelativePath(r.Options().PathBase, req.URL.Path)
+	plane, err := resources.ParseScope(
+		strings.TrimSuffix(
+			strings.TrimSuffix(relativePath, resources.SegmentSeparator),
+			resources.SegmentSeparator+resources.ProvidersSegment+resources.SegmentSeparator+datamodel.ResourceQueryResourceType))
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := armrpc_controller.ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	q, err := converter.ResourceQueryDataModelFromVersioned(content, serviceCtx.APIVersion)
+	if err != nil {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	if response := validateScopes(q, plane); response != nil {
+		return response, nil
+	}
+
+	result, err := query.NewEngine(r.DatabaseClient()).Execute(ctx, q)
+	if errors.Is(err, &query.ErrInvalidQuery{}) {
+		return armrpc_rest.NewBadRequestResponse(err.Error()), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	versioned, err := converter.ResourceQueryResultDataModelToVersioned(result, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewOKResponse(versioned), nil
+}
+
+// validateScopes defaults the scopes of the query to the plane. The scopes must be the plane or scopes in the plane
+// because callers are authorized for the plane.
+func validateScopes(q *datamodel.ResourceQuery, plane resources.ID) armrpc_rest.Response {
+	if len(q.Scopes) == 0 {
+		q.Scopes = []string{plane.String()}
+		return nil
+	}
+
+	for _, scope := range q.Scopes {
+		if !strings.EqualFold(scope, plane.String()) && !strings.HasPrefix(strings.ToLower(scope), strings.ToLower(plane.String())+resources.SegmentSeparator) {
+			return armrpc_rest.NewBadRequestResponse(fmt.Sprintf("The scope %q is not in the plane %q.", scope, plane.String()))
+		}
+	}
+
+	return nil
+}
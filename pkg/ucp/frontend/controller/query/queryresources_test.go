@@ -0,0 +1,124 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+)
+
+const (
+	testPlaneID = "/planes/radius/local"
+	testQueryID = testPlaneID + "/providers/System.Resources/query"
+	prodCacheID = testPlaneID + "/resourceGroups/prod/providers/Applications.Datastores/redisCaches/cache"
+	devCacheID  = testPlaneID + "/resourceGroups/dev/providers/Applications.Datastores/redisCaches/cache"
+)
+
+func Test_QueryResources(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := inmemory.NewClient()
+
+	for _, id := range []string{prodCacheID, devCacheID} {
+		parsed := resources.MustParse(id)
+		err := client.Save(ctx, &database.Object{
+			Metadata: database.Metadata{ID: id},
+			Data: map[string]any{
+				"id":   id,
+				"name": parsed.Name(),
+				"type": parsed.Type(),
+				"properties": map[string]any{
+					"environment": parsed.RootScope() + "/providers/Applications.Core/environments/default",
+				},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	run := func(t *testing.T, body *v20231001preview.ResourceQueryRequest) armrpc_rest.Response {
+		pathBase := "/" + uuid.New().String()
+		c, err := NewQueryResources(armrpc_controller.Options{PathBase: pathBase, DatabaseClient: client})
+		require.NoError(t, err)
+
+		content, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		request, err := http.NewRequest(http.MethodPost, pathBase+testQueryID+"?api-version="+v20231001preview.Version, bytes.NewBuffer(content))
+		require.NoError(t, err)
+		request.Header.Set("Content-Type", "application/json")
+
+		response, err := c.Run(rpctest.NewARMRequestContext(request), nil, request)
+		require.NoError(t, err)
+		return response
+	}
+
+	t.Run("plane", func(t *testing.T) {
+		response := run(t, &v20231001preview.ResourceQueryRequest{
+			Filter: to.Ptr("type == 'Applications.Datastores/redisCaches'"),
+			Select: to.SliceOfPtrs("id"),
+		})
+
+		ok, isOK := response.(*armrpc_rest.OKResponse)
+		require.True(t, isOK, "expected an OK response, got %T", response)
+
+		expected := &v20231001preview.ResourceQueryResponse{
+			Count: to.Ptr(int64(2)),
+			Data:  []map[string]any{{"id": devCacheID}, {"id": prodCacheID}},
+		}
+		require.Equal(t, expected, ok.Body)
+	})
+
+	t.Run("resource group", func(t *testing.T) {
+		response := run(t, &v20231001preview.ResourceQueryRequest{
+			Scopes: to.SliceOfPtrs(testPlaneID + "/resourceGroups/prod"),
+			Filter: to.Ptr("type == 'Applications.Datastores/redisCaches' and properties.environment contains '/prod/'"),
+			Select: to.SliceOfPtrs("name"),
+		})
+
+		ok, isOK := response.(*armrpc_rest.OKResponse)
+		require.True(t, isOK, "expected an OK response, got %T", response)
+		require.Equal(t, []map[string]any{{"name": "cache"}}, ok.Body.(*v20231001preview.ResourceQueryResponse).Data)
+	})
+
+	t.Run("scope outside the plane", func(t *testing.T) {
+		response := run(t, &v20231001preview.ResourceQueryRequest{
+			Scopes: to.SliceOfPtrs("/planes/radius/other"),
+		})
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		response := run(t, &v20231001preview.ResourceQueryRequest{
+			Filter: to.Ptr("type =="),
+		})
+		require.IsType(t, &armrpc_rest.BadRequestResponse{}, response)
+	})
+}
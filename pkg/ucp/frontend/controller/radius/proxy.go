@@ -123,6 +123,13 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return nil, fmt.Errorf("failed to validate downstream: %w", err)
 	}
 
+	err = resourcegroups.ValidateLocks(ctx, p.DatabaseClient(), id, req.Method)
+	if errors.Is(err, &resourcegroups.LockedError{}) {
+		return armrpc_rest.NewScopeLockedResponse(id.String(), err.Error()), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to validate locks: %w", err)
+	}
+
 	if downstreamURL == nil {
 		downstreamURL = p.defaultDownstream
 	}
@@ -19,6 +19,7 @@ package radius
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -190,6 +191,16 @@ func Test_Run(t *testing.T) {
 			Get(gomock.Any(), locationResource.ID).
 			Return(&database.Object{Data: locationResource}, nil).Times(1)
 
+		// No locks in the resource group
+		databaseClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&database.ObjectQueryResult{}, nil).Times(1)
+
+		// The resource is not stored, so it does not reference an application or environment.
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.String()).
+			Return(nil, &database.ErrNotFound{}).Times(1)
+
 		downstreamResponse := httptest.NewRecorder()
 		downstreamResponse.WriteHeader(http.StatusOK)
 		roundTripper.Response = downstreamResponse.Result()
@@ -233,6 +244,16 @@ func Test_Run(t *testing.T) {
 			Get(gomock.Any(), locationResource.ID).
 			Return(&database.Object{Data: locationResource}, nil).Times(1)
 
+		// No locks in the resource group
+		databaseClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&database.ObjectQueryResult{}, nil).Times(1)
+
+		// The resource is not stored, so it does not reference an application or environment.
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.String()).
+			Return(nil, &database.ErrNotFound{}).Times(1)
+
 		// Tracking entry created
 		databaseClient.EXPECT().
 			Get(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -288,6 +309,16 @@ func Test_Run(t *testing.T) {
 			Get(gomock.Any(), locationResource.ID).
 			Return(&database.Object{Data: locationResource}, nil).Times(1)
 
+		// No locks in the resource group
+		databaseClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(&database.ObjectQueryResult{}, nil).Times(1)
+
+		// The resource is not stored, so it does not reference an application or environment.
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.String()).
+			Return(nil, &database.ErrNotFound{}).Times(1)
+
 		// Tracking entry created
 		existingEntry := &database.Object{
 			Data: &datamodel.GenericResource{
@@ -319,6 +350,182 @@ func Test_Run(t *testing.T) {
 		require.Nil(t, response)
 	})
 
+	t.Run("failure (locked)", func(t *testing.T) {
+		p, databaseClient, _, _, _ := createController(t)
+
+		svcContext := &v1.ARMRequestContext{
+			APIVersion: apiVersion,
+			ResourceID: id,
+		}
+		ctx := testcontext.New(t)
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodDelete, id.String()+"?api-version="+apiVersion, nil)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.PlaneScope(), gomock.Any()).
+			Return(&database.Object{Data: plane}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), locationResource.ID).
+			Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		lock := datamodel.Lock{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID: id.RootScope() + "/providers/System.Resources/locks/protect",
+				},
+			},
+			Properties: datamodel.LockProperties{
+				Level:      datamodel.LockLevelCanNotDelete,
+				ResourceID: id.String(),
+			},
+		}
+		databaseClient.EXPECT().
+			Query(gomock.Any(), database.Query{RootScope: id.RootScope(), ResourceType: datamodel.LockResourceType}).
+			Return(&database.ObjectQueryResult{Items: []database.Object{{Data: lock}}}, nil).Times(1)
+
+		expected := rest.NewScopeLockedResponse(id.String(), fmt.Sprintf("the DELETE request on %q is blocked by the CanNotDelete lock %q", id.String(), lock.ID))
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
+	t.Run("failure (locked application)", func(t *testing.T) {
+		p, databaseClient, _, _, _ := createController(t)
+
+		svcContext := &v1.ARMRequestContext{
+			APIVersion: apiVersion,
+			ResourceID: id,
+		}
+		ctx := testcontext.New(t)
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodDelete, id.String()+"?api-version="+apiVersion, nil)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.PlaneScope(), gomock.Any()).
+			Return(&database.Object{Data: plane}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), locationResource.ID).
+			Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		// The resource is in an application, and the lock applies to the application.
+		applicationID := id.RootScope() + "/providers/Applications.Core/applications/my-app"
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.String()).
+			Return(&database.Object{Data: map[string]any{"properties": map[string]any{"application": applicationID}}}, nil).Times(1)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), applicationID).
+			Return(&database.Object{Data: map[string]any{"properties": map[string]any{}}}, nil).Times(1)
+
+		lock := datamodel.Lock{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID: id.RootScope() + "/providers/System.Resources/locks/protect",
+				},
+			},
+			Properties: datamodel.LockProperties{
+				Level:      datamodel.LockLevelCanNotDelete,
+				ResourceID: applicationID,
+			},
+		}
+		databaseClient.EXPECT().
+			Query(gomock.Any(), database.Query{RootScope: id.RootScope(), ResourceType: datamodel.LockResourceType}).
+			Return(&database.ObjectQueryResult{Items: []database.Object{{Data: lock}}}, nil).Times(2)
+
+		expected := rest.NewScopeLockedResponse(id.String(), fmt.Sprintf("the DELETE request on %q is blocked by the CanNotDelete lock %q on %q", id.String(), lock.ID, applicationID))
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
+	t.Run("failure (locked environment)", func(t *testing.T) {
+		p, databaseClient, _, _, _ := createController(t)
+
+		svcContext := &v1.ARMRequestContext{
+			APIVersion: apiVersion,
+			ResourceID: id,
+		}
+		ctx := testcontext.New(t)
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodDelete, id.String()+"?api-version="+apiVersion, nil)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.PlaneScope(), gomock.Any()).
+			Return(&database.Object{Data: plane}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), locationResource.ID).
+			Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		// The resource is in an application, and the lock applies to the environment of the application, which is in
+		// another resource group.
+		applicationID := id.RootScope() + "/providers/Applications.Core/applications/my-app"
+		environmentID := "/planes/test/local/resourceGroups/prod-rg/providers/Applications.Core/environments/prod"
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.String()).
+			Return(&database.Object{Data: map[string]any{"properties": map[string]any{"application": applicationID}}}, nil).Times(1)
+		databaseClient.EXPECT().
+			Get(gomock.Any(), applicationID).
+			Return(&database.Object{Data: map[string]any{"properties": map[string]any{"environment": environmentID}}}, nil).Times(1)
+
+		lock := datamodel.Lock{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID: "/planes/test/local/resourceGroups/prod-rg/providers/System.Resources/locks/protect",
+				},
+			},
+			Properties: datamodel.LockProperties{
+				Level:      datamodel.LockLevelCanNotDelete,
+				ResourceID: environmentID,
+			},
+		}
+		databaseClient.EXPECT().
+			Query(gomock.Any(), database.Query{RootScope: id.RootScope(), ResourceType: datamodel.LockResourceType}).
+			Return(&database.ObjectQueryResult{}, nil).Times(2)
+		databaseClient.EXPECT().
+			Query(gomock.Any(), database.Query{RootScope: "/planes/test/local/resourceGroups/prod-rg", ResourceType: datamodel.LockResourceType}).
+			Return(&database.ObjectQueryResult{Items: []database.Object{{Data: lock}}}, nil).Times(1)
+
+		expected := rest.NewScopeLockedResponse(id.String(), fmt.Sprintf("the DELETE request on %q is blocked by the CanNotDelete lock %q on %q", id.String(), lock.ID, environmentID))
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
 	t.Run("failure (validate downstream: not found)", func(t *testing.T) {
 		p, databaseClient, _, _, _ := createController(t)
 
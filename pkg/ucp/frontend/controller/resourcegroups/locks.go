@@ -0,0 +1,278 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+// LockedError is returned when a request is blocked by a management lock.
+type LockedError struct {
+	Message string
+}
+
+// Error returns the error message.
+func (e *LockedError) Error() string {
+	return e.Message
+}
+
+// Is returns true if the error is a LockedError.
+func (e *LockedError) Is(err error) bool {
+	_, ok := err.(*LockedError)
+	return ok
+}
+
+// ValidateLocks validates that a request with the given HTTP method on the resource or resource group specified in the id
+// is not blocked by a management lock in its resource group. Returns LockedError if the request is blocked.
+//
+// - A CanNotDelete lock blocks DELETE requests.
+// - A ReadOnly lock blocks PUT, PATCH, POST and DELETE requests.
+//
+// A lock applies to the resource it specifies and its child resources, or to every resource in the resource group if it
+// does not specify a resource. A lock that applies to an application or environment also applies to the resources
+// that reference it in their 'properties.application' or 'properties.environment' property, and to the resources of
+// the applications in the environment. Deleting a resource group is blocked by any lock in it, because it deletes the
+// locked resources too.
+func ValidateLocks(ctx context.Context, client database.Client, id resources.ID, method string) error {
+	if !isMutatingMethod(method) {
+		return nil
+	}
+
+	if id.FindScope(resources_radius.ScopeResourceGroups) == "" {
+		return nil
+	}
+
+	err := validateLocks(ctx, client, id, id, method)
+	if err != nil || id.IsScope() {
+		return err
+	}
+
+	references, err := containerReferences(ctx, client, id)
+	if err != nil {
+		return err
+	}
+
+	for _, reference := range references {
+		err := validateLocks(ctx, client, reference, id, method)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateLocks validates that the request on the target is not blocked by a lock that applies to the locked id, which
+// is either the target or an application or environment that contains it.
+func validateLocks(ctx context.Context, client database.Client, lockedID resources.ID, target resources.ID, method string) error {
+	resourceGroupID, err := resources.ParseScope(lockedID.RootScope())
+	if err != nil {
+		// Not expected to happen.
+		return err
+	}
+
+	locks, err := ListLocks(ctx, client, resourceGroupID)
+	if err != nil {
+		return err
+	}
+
+	isResourceGroup := lockedID.IsScope()
+	for _, lock := range locks {
+		if lock.Properties.Level == datamodel.LockLevelCanNotDelete && !strings.EqualFold(method, http.MethodDelete) {
+			continue
+		}
+
+		// Deleting the resource group deletes every resource in it, so any lock in the resource group applies.
+		if !(isResourceGroup && strings.EqualFold(method, http.MethodDelete)) && !lockAppliesTo(lock, lockedID) {
+			continue
+		}
+
+		if lockedID.String() != target.String() {
+			return &LockedError{Message: fmt.Sprintf("the %s request on %q is blocked by the %s lock %q on %q", strings.ToUpper(method), target.String(), lock.Properties.Level, lock.ID, lockedID.String())}
+		}
+
+		return &LockedError{Message: fmt.Sprintf("the %s request on %q is blocked by the %s lock %q", strings.ToUpper(method), target.String(), lock.Properties.Level, lock.ID)}
+	}
+
+	return nil
+}
+
+// containerReferences returns the ids of the application and environment referenced by the stored resource. The
+// environment of the application is returned too when the resource only references its application.
+func containerReferences(ctx context.Context, client database.Client, id resources.ID) ([]resources.ID, error) {
+	// Child resources and actions are part of the application of their top-level resource.
+	for len(id.TypeSegments()) > 1 {
+		id = id.Truncate()
+	}
+
+	application, environment, err := readContainerReferences(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if application != nil && environment == nil {
+		_, environment, err = readContainerReferences(ctx, client, *application)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	references := []resources.ID{}
+	for _, reference := range []*resources.ID{application, environment} {
+		if reference != nil && !strings.EqualFold(reference.String(), id.String()) {
+			references = append(references, *reference)
+		}
+	}
+
+	return references, nil
+}
+
+// readContainerReferences reads the 'properties.application' and 'properties.environment' properties of the stored
+// resource. References that are not resources in a resource group are ignored.
+func readContainerReferences(ctx context.Context, client database.Client, id resources.ID) (*resources.ID, *resources.ID, error) {
+	obj, err := client.Get(ctx, id.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil, nil, nil
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch the resource %q: %w", id.String(), err)
+	}
+
+	stored := struct {
+		Properties struct {
+			Application string `json:"application"`
+			Environment string `json:"environment"`
+		} `json:"properties"`
+	}{}
+	if err := obj.As(&stored); err != nil {
+		// The stored data of some resources does not have this shape, they are not part of an application.
+		return nil, nil, nil
+	}
+
+	parse := func(value string) *resources.ID {
+		parsed, err := resources.ParseResource(value)
+		if err != nil || parsed.FindScope(resources_radius.ScopeResourceGroups) == "" {
+			return nil
+		}
+		return &parsed
+	}
+
+	return parse(stored.Properties.Application), parse(stored.Properties.Environment), nil
+}
+
+// ListLocks returns the management locks in the given resource group.
+func ListLocks(ctx context.Context, client database.Client, resourceGroupID resources.ID) ([]datamodel.Lock, error) {
+	result, err := client.Query(ctx, database.Query{
+		RootScope:    resourceGroupID.String(),
+		ResourceType: datamodel.LockResourceType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the locks of resource group %q: %w", resourceGroupID.String(), err)
+	}
+
+	locks := []datamodel.Lock{}
+	for _, item := range result.Items {
+		lock := datamodel.Lock{}
+		err := item.As(&lock)
+		if err != nil {
+			return nil, err
+		}
+
+		locks = append(locks, lock)
+	}
+
+	return locks, nil
+}
+
+// ValidateLock is an update filter that validates a management lock. The resource group of the lock must exist, and the
+// resource the lock applies to must be in the resource group.
+func ValidateLock(ctx context.Context, newResource *datamodel.Lock, oldResource *datamodel.Lock, options *controller.Options) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	err := ValidateResourceGroup(ctx, options.DatabaseClient, serviceCtx.ResourceID)
+	if errors.Is(err, &NotFoundError{}) {
+		return rest.NewNotFoundResponseWithCause(serviceCtx.ResourceID, err.Error()), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if newResource.Properties.ResourceID == "" {
+		return nil, nil
+	}
+
+	resourceGroupID := serviceCtx.ResourceID.RootScope()
+	lockedID, err := resources.Parse(newResource.Properties.ResourceID)
+	if err != nil || !strings.EqualFold(lockedID.RootScope(), resourceGroupID) {
+		return rest.NewBadRequestResponse(fmt.Sprintf("The lock can only apply to resources in resource group %q.", resourceGroupID)), nil
+	}
+
+	return nil, nil
+}
+
+// CheckResourceGroupUpdateLocks is an update filter that blocks updating a resource group with a ReadOnly lock.
+func CheckResourceGroupUpdateLocks(ctx context.Context, newResource *datamodel.ResourceGroup, oldResource *datamodel.ResourceGroup, options *controller.Options) (rest.Response, error) {
+	return checkLocks(ctx, options, http.MethodPut)
+}
+
+// CheckResourceGroupDeleteLocks is a delete filter that blocks deleting a resource group that contains any lock.
+func CheckResourceGroupDeleteLocks(ctx context.Context, oldResource *datamodel.ResourceGroup, options *controller.Options) (rest.Response, error) {
+	return checkLocks(ctx, options, http.MethodDelete)
+}
+
+func checkLocks(ctx context.Context, options *controller.Options, method string) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	err := ValidateLocks(ctx, options.DatabaseClient, serviceCtx.ResourceID, method)
+	if errors.Is(err, &LockedError{}) {
+		return rest.NewScopeLockedResponse(serviceCtx.ResourceID.String(), err.Error()), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// lockAppliesTo returns true if the lock applies to the resource or resource group specified in the id.
+func lockAppliesTo(lock datamodel.Lock, id resources.ID) bool {
+	if lock.Properties.ResourceID == "" {
+		return true
+	}
+
+	locked := strings.ToLower(lock.Properties.ResourceID)
+	target := strings.ToLower(id.String())
+	return target == locked || strings.HasPrefix(target, locked+resources.SegmentSeparator)
+}
+
+func isMutatingMethod(method string) bool {
+	switch strings.ToUpper(method) {
+	case http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_ValidateLocks(t *testing.T) {
+	resourceGroupID := "/planes/radius/local/resourceGroups/test-group"
+	otherResourceGroupID := "/planes/radius/local/resourceGroups/other-group"
+	applicationID := resourceGroupID + "/providers/Applications.Core/applications/app"
+	containerID := resourceGroupID + "/providers/Applications.Core/containers/frontend"
+	environmentID := otherResourceGroupID + "/providers/Applications.Core/environments/env"
+
+	// The container references the application, and the application references the environment in another resource
+	// group.
+	stored := map[string]any{
+		applicationID: map[string]any{"id": applicationID, "properties": map[string]any{"environment": environmentID}},
+		containerID:   map[string]any{"id": containerID, "properties": map[string]any{"application": applicationID}},
+	}
+
+	newLock := func(level datamodel.LockLevel, resourceID string) datamodel.Lock {
+		return datamodel.Lock{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID: resourceGroupID + "/providers/System.Resources/locks/lock",
+				},
+			},
+			Properties: datamodel.LockProperties{
+				Level:      level,
+				ResourceID: resourceID,
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		id     string
+		method string
+		locks  []datamodel.Lock
+		// otherLocks are the locks in the other resource group.
+		otherLocks []datamodel.Lock
+		blocked    bool
+	}{
+		{name: "no locks", id: containerID, method: http.MethodDelete},
+		{name: "CanNotDelete blocks delete", id: containerID, method: http.MethodDelete, locks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, "")}, blocked: true},
+		{name: "CanNotDelete allows update", id: containerID, method: http.MethodPut, locks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, "")}},
+		{name: "ReadOnly blocks update", id: containerID, method: http.MethodPut, locks: []datamodel.Lock{newLock(datamodel.LockLevelReadOnly, "")}, blocked: true},
+		{name: "ReadOnly blocks action", id: containerID + "/listSecrets", method: http.MethodPost, locks: []datamodel.Lock{newLock(datamodel.LockLevelReadOnly, "")}, blocked: true},
+		{name: "lock applies to resource", id: applicationID, method: http.MethodDelete, locks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, applicationID)}, blocked: true},
+		{name: "lock applies to child resource", id: applicationID + "/nested/child", method: http.MethodDelete, locks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, applicationID)}, blocked: true},
+		{name: "lock is case-insensitive", id: applicationID, method: http.MethodDelete, locks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, "/PLANES/radius/local/resourcegroups/TEST-group/providers/applications.core/applications/APP")}, blocked: true},
+		{name: "lock does not apply to other resource", id: resourceGroupID + "/providers/Applications.Core/containers/backend", method: http.MethodDelete, locks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, applicationID)}},
+		{name: "lock does not apply to resource with common prefix", id: applicationID + "2", method: http.MethodDelete, locks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, applicationID)}},
+		{name: "resource group delete is blocked by any lock", id: resourceGroupID, method: http.MethodDelete, locks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, applicationID)}, blocked: true},
+		{name: "resource group update is allowed with resource lock", id: resourceGroupID, method: http.MethodPut, locks: []datamodel.Lock{newLock(datamodel.LockLevelReadOnly, applicationID)}},
+		{name: "application lock applies to resource in application", id: containerID, method: http.MethodDelete, locks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, applicationID)}, blocked: true},
+		{name: "application lock applies to action on resource in application", id: containerID + "/listSecrets", method: http.MethodPost, locks: []datamodel.Lock{newLock(datamodel.LockLevelReadOnly, applicationID)}, blocked: true},
+		{name: "environment lock applies to application", id: applicationID, method: http.MethodDelete, otherLocks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, environmentID)}, blocked: true},
+		{name: "environment lock applies to resource in application", id: containerID, method: http.MethodDelete, otherLocks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, environmentID)}, blocked: true},
+		{name: "environment CanNotDelete lock allows update of resource in application", id: containerID, method: http.MethodPut, otherLocks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, environmentID)}},
+		{name: "environment lock does not apply to other environment", id: containerID, method: http.MethodDelete, otherLocks: []datamodel.Lock{newLock(datamodel.LockLevelCanNotDelete, environmentID+"2")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			databaseClient := database.NewMockClient(ctrl)
+
+			databaseClient.EXPECT().
+				Query(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, query database.Query, _ ...database.QueryOptions) (*database.ObjectQueryResult, error) {
+					require.Equal(t, datamodel.LockResourceType, query.ResourceType)
+
+					locks := tt.locks
+					if query.RootScope == otherResourceGroupID {
+						locks = tt.otherLocks
+					}
+
+					items := []database.Object{}
+					for _, lock := range locks {
+						items = append(items, database.Object{Data: lock})
+					}
+					return &database.ObjectQueryResult{Items: items}, nil
+				}).
+				AnyTimes()
+
+			databaseClient.EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+					data, ok := stored[id]
+					if !ok {
+						return nil, &database.ErrNotFound{ID: id}
+					}
+					return &database.Object{Data: data}, nil
+				}).
+				AnyTimes()
+
+			id, err := resources.Parse(tt.id)
+			require.NoError(t, err)
+
+			err = ValidateLocks(testcontext.New(t), databaseClient, id, tt.method)
+			if tt.blocked {
+				require.ErrorIs(t, err, &LockedError{})
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("read is not validated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(ctrl)
+
+		id, err := resources.Parse(containerID)
+		require.NoError(t, err)
+
+		err = ValidateLocks(testcontext.New(t), databaseClient, id, http.MethodGet)
+		require.NoError(t, err)
+	})
+
+	t.Run("resource without resource group is not validated", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(ctrl)
+
+		id, err := resources.Parse("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test")
+		require.NoError(t, err)
+
+		err = ValidateLocks(testcontext.New(t), databaseClient, id, http.MethodDelete)
+		require.NoError(t, err)
+	})
+
+	t.Run("query failure", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		databaseClient := database.NewMockClient(ctrl)
+
+		databaseClient.EXPECT().
+			Query(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("test error")).
+			Times(1)
+
+		id, err := resources.Parse(containerID)
+		require.NoError(t, err)
+
+		err = ValidateLocks(testcontext.New(t), databaseClient, id, http.MethodDelete)
+		require.EqualError(t, err, "failed to fetch the locks of resource group \"/planes/radius/local/resourceGroups/test-group\": test error")
+	})
+}
@@ -28,9 +28,14 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
 	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
 	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/audit"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	audit_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/audit"
+	authorization_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/authorization"
+	move_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/move"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
+	query_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/query"
 	radius_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/radius"
 	resourcegroups_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
 	resourceproviders_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourceproviders"
@@ -99,6 +104,8 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 						r.Get("/operationResults/{operationId}", capture(operationResultGetHandler(ctx, ctrlOptions)))
 					})
 
+					r.With(apiValidator).Post("/query", capture(resourceQueryHandler(ctx, ctrlOptions)))
+
 					r.Route("/resourceproviders", func(r chi.Router) {
 						r.With(apiValidator).Get("/", capture(resourceProviderListHandler(ctx, ctrlOptions)))
 						r.Route("/{resourceProviderName}", func(r chi.Router) {
@@ -136,6 +143,30 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 					})
 				})
 
+				r.Route("/System.Authorization", func(r chi.Router) {
+					r.Route("/roleDefinitions", func(r chi.Router) {
+						r.With(apiValidator).Get("/", capture(roleDefinitionListHandler(ctx, ctrlOptions)))
+						r.Route("/{roleDefinitionName}", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(roleDefinitionGetHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Put("/", capture(roleDefinitionPutHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Delete("/", capture(roleDefinitionDeleteHandler(ctx, ctrlOptions)))
+						})
+					})
+
+					r.Route("/roleAssignments", func(r chi.Router) {
+						r.With(apiValidator).Get("/", capture(roleAssignmentListHandler(ctx, ctrlOptions)))
+						r.Route("/{roleAssignmentName}", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(roleAssignmentGetHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Put("/", capture(roleAssignmentPutHandler(ctx, ctrlOptions)))
+							r.With(apiValidator).Delete("/", capture(roleAssignmentDeleteHandler(ctx, ctrlOptions)))
+						})
+					})
+				})
+
+				r.Route("/System.Audit/records", func(r chi.Router) {
+					r.With(apiValidator).Get("/", capture(auditRecordListHandler(ctx, ctrlOptions, m.options.AuditSink)))
+				})
+
 				// Proxy to plane-scoped ResourceProvider APIs
 				//
 				// NOTE: DO NOT validate schema for proxy routes.
@@ -151,8 +182,19 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 					r.With(apiValidator).Route("/resources", func(r chi.Router) {
 						r.Get("/", capture(resourceGroupResourcesHandler(ctx, ctrlOptions)))
 					})
+					r.With(apiValidator).Post("/validateMoveResources", capture(resourceGroupValidateMoveResourcesHandler(ctx, ctrlOptions, transport, m.defaultDownstream)))
+					r.With(apiValidator).Post("/moveResources", capture(resourceGroupMoveResourcesHandler(ctx, ctrlOptions, transport, m.defaultDownstream)))
 
 					r.Route("/providers", func(r chi.Router) {
+						r.Route("/System.Resources/locks", func(r chi.Router) {
+							r.With(apiValidator).Get("/", capture(lockListHandler(ctx, ctrlOptions)))
+							r.Route("/{lockName}", func(r chi.Router) {
+								r.With(apiValidator).Get("/", capture(lockGetHandler(ctx, ctrlOptions)))
+								r.With(apiValidator).Put("/", capture(lockPutHandler(ctx, ctrlOptions)))
+								r.With(apiValidator).Delete("/", capture(lockDeleteHandler(ctx, ctrlOptions)))
+							})
+						})
+
 						// Proxy to resource-group-scoped ResourceProvider APIs
 						//
 						// NOTE: DO NOT validate schema for proxy routes.
@@ -204,6 +246,12 @@ func radiusPlaneDeleteHandler(ctx context.Context, ctrlOptions controller.Option
 var resourceGroupResourceOptions = controller.ResourceOptions[datamodel.ResourceGroup]{
 	RequestConverter:  converter.ResourceGroupDataModelFromVersioned,
 	ResponseConverter: converter.ResourceGroupDataModelToVersioned,
+	UpdateFilters: []controller.UpdateFilter[datamodel.ResourceGroup]{
+		resourcegroups_ctrl.CheckResourceGroupUpdateLocks,
+	},
+	DeleteFilters: []controller.DeleteFilter[datamodel.ResourceGroup]{
+		resourcegroups_ctrl.CheckResourceGroupDeleteLocks,
+	},
 }
 
 func resourceGroupListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
@@ -234,6 +282,126 @@ func resourceGroupResourcesHandler(ctx context.Context, ctrlOptions controller.O
 	})
 }
 
+func resourceGroupValidateMoveResourcesHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.ValidateMoveResourcesResourceType, v1.OperationPost, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return move_ctrl.NewValidateMoveResources(opts, transport, defaultDownstream)
+	})
+}
+
+func resourceGroupMoveResourcesHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.MoveResourcesResourceType, v1.OperationPost, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return move_ctrl.NewMoveResources(opts, transport, defaultDownstream)
+	})
+}
+
+var lockResourceOptions = controller.ResourceOptions[datamodel.Lock]{
+	RequestConverter:  converter.LockDataModelFromVersioned,
+	ResponseConverter: converter.LockDataModelToVersioned,
+	UpdateFilters: []controller.UpdateFilter[datamodel.Lock]{
+		resourcegroups_ctrl.ValidateLock,
+	},
+}
+
+func lockListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, lockResourceOptions)
+	})
+}
+
+func lockGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, lockResourceOptions)
+	})
+}
+
+func lockPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, lockResourceOptions)
+	})
+}
+
+func lockDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.LockResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, lockResourceOptions)
+	})
+}
+
+var roleDefinitionResourceOptions = controller.ResourceOptions[datamodel.RoleDefinition]{
+	RequestConverter:  converter.RoleDefinitionDataModelFromVersioned,
+	ResponseConverter: converter.RoleDefinitionDataModelToVersioned,
+	UpdateFilters: []controller.UpdateFilter[datamodel.RoleDefinition]{
+		authorization_ctrl.ValidateRoleDefinition,
+	},
+}
+
+func roleDefinitionListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, roleDefinitionResourceOptions)
+	})
+}
+
+func roleDefinitionDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleDefinitionResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, roleDefinitionResourceOptions)
+	})
+}
+
+var roleAssignmentResourceOptions = controller.ResourceOptions[datamodel.RoleAssignment]{
+	RequestConverter:  converter.RoleAssignmentDataModelFromVersioned,
+	ResponseConverter: converter.RoleAssignmentDataModelToVersioned,
+	UpdateFilters: []controller.UpdateFilter[datamodel.RoleAssignment]{
+		authorization_ctrl.ValidateRoleAssignment,
+	},
+}
+
+func roleAssignmentListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewListResources(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewGetResource(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentPutHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationPut, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncPut(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func roleAssignmentDeleteHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.RoleAssignmentResourceType, v1.OperationDelete, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return defaultoperation.NewDefaultSyncDelete(opts, roleAssignmentResourceOptions)
+	})
+}
+
+func auditRecordListHandler(ctx context.Context, ctrlOptions controller.Options, sink audit.Sink) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.AuditRecordResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return audit_ctrl.NewListAuditRecords(opts, sink)
+	})
+}
+
+func resourceQueryHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.ResourceQueryResourceType, v1.OperationPost, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return query_ctrl.NewQueryResources(opts)
+	})
+}
+
 func resourceProviderSummaryListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, datamodel.ResourceProviderSummaryResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
 		return resourceproviders_ctrl.NewListResourceProviderSummaries(opts)
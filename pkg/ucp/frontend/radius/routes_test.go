@@ -163,6 +163,90 @@ func Test_Routes(t *testing.T) {
 			Method:        http.MethodDelete,
 			Path:          "/planes/radius/local/resourcegroups/test-rg",
 		},
+
+		// Locks
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/locks",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/locks/test-lock",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/locks/test-lock",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.LockResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/providers/System.Resources/locks/test-lock",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.ValidateMoveResourcesResourceType, Method: v1.OperationPost},
+			Method:        http.MethodPost,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/validateMoveResources",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.MoveResourcesResourceType, Method: v1.OperationPost},
+			Method:        http.MethodPost,
+			Path:          "/planes/radius/local/resourcegroups/test-rg/moveResources",
+		},
+
+		// Role-based authorization
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleDefinitions",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleDefinitions/test-role",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleDefinitions/test-role",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleDefinitionResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleDefinitions/test-role",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleAssignments",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleAssignments/test-assignment",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleAssignments/test-assignment",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.RoleAssignmentResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/radius/local/providers/System.Authorization/roleAssignments/test-assignment",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.AuditRecordResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/radius/local/providers/System.Audit/records",
+		},
+		{
+			OperationType: v1.OperationType{Type: datamodel.ResourceQueryResourceType, Method: v1.OperationPost},
+			Method:        http.MethodPost,
+			Path:          "/planes/radius/local/providers/System.Resources/query",
+		},
 		{
 			OperationType:               v1.OperationType{Type: OperationTypeUCPRadiusProxy, Method: v1.OperationProxy},
 			Method:                      http.MethodGet,
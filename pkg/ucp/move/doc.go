@@ -0,0 +1,29 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package move moves resources between the resource groups of a Radius plane.
+//
+// Resource IDs include the resource group of the resource, so moving a resource changes its ID. A move rewrites the
+// stored resources, their tracked resource entries, and every reference to the moved resources from other resources in
+// the plane, such as connections and application or environment IDs.
+//
+// Resource providers take part in a move through a validate-then-commit protocol. Before anything is changed, UCP calls
+// the validateMoveResources action of each resource provider with the resources it owns, and the move is rejected if
+// any resource provider rejects it. After the resources have been saved with their new IDs, UCP calls the moveResources
+// action so that resource providers can move any state they keep outside of their resources, and only then deletes the
+// resources from the source resource group. If the move fails before every resource provider has committed it, the
+// changes are rolled back. Resource providers that do not implement these actions cannot take part in a move.
+package move
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+var _ error = (*ErrInvalidMove)(nil)
+
+// ErrInvalidMove is returned when a move request is invalid, for example when a resource is not in the source resource
+// group.
+type ErrInvalidMove struct {
+	Message string
+}
+
+// Error returns a string representation of the error.
+func (e *ErrInvalidMove) Error() string {
+	return e.Message
+}
+
+// Is checks if the target error is of type ErrInvalidMove and if the message of the target error is equal to the
+// message of the ErrInvalidMove instance or is an empty string.
+func (e *ErrInvalidMove) Is(target error) bool {
+	t, ok := target.(*ErrInvalidMove)
+	if !ok {
+		return false
+	}
+
+	return e.Message == t.Message || t.Message == ""
+}
+
+var _ error = (*ErrMoveConflict)(nil)
+
+// ErrMoveConflict is returned when a valid move request conflicts with the current state of the resources, for example
+// when a resource is still being provisioned or a resource provider rejects the move.
+type ErrMoveConflict struct {
+	Message string
+}
+
+// Error returns a string representation of the error.
+func (e *ErrMoveConflict) Error() string {
+	return e.Message
+}
+
+// Is checks if the target error is of type ErrMoveConflict and if the message of the target error is equal to the
+// message of the ErrMoveConflict instance or is an empty string.
+func (e *ErrMoveConflict) Is(target error) bool {
+	t, ok := target.(*ErrMoveConflict)
+	if !ok {
+		return false
+	}
+
+	return e.Message == t.Message || t.Message == ""
+}
@@ -0,0 +1,523 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_radius "github.com/radius-project/radius/pkg/ucp/resources/radius"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Mover validates and performs moves of resources between resource groups.
+type Mover struct {
+	client            database.Client
+	httpClient        *http.Client
+	defaultDownstream *url.URL
+}
+
+// NewMover creates a new Mover. The HTTP client is used to call the resource providers of the moved resources, and the
+// default downstream is the address of the resource provider of resource types that do not configure one.
+func NewMover(client database.Client, httpClient *http.Client, defaultDownstream *url.URL) *Mover {
+	return &Mover{client: client, httpClient: httpClient, defaultDownstream: defaultDownstream}
+}
+
+// Plan is a validated move of resources from one resource group to another.
+type Plan struct {
+	// Source is the ID of the resource group the resources are moved from.
+	Source resources.ID
+
+	// Target is the ID of the resource group the resources are moved to.
+	Target resources.ID
+
+	// Resources is the list of resources to move, in the order of the request.
+	Resources []Resource
+
+	// References is the list of IDs of the other resources in the plane that reference the moved resources.
+	References []resources.ID
+
+	// providers is the list of resource providers of the moved resources.
+	providers []*provider
+}
+
+// Resource is a resource that is moved.
+type Resource struct {
+	// ID is the ID of the resource in the source resource group.
+	ID resources.ID
+
+	// TargetID is the ID of the resource in the target resource group.
+	TargetID resources.ID
+
+	// entry is the tracked resource entry of the resource, or nil if the resource is not tracked.
+	entry *database.Object
+
+	// stored is the stored resource, or nil if the resource is only known by its tracked resource entry.
+	stored *database.Object
+}
+
+// ids returns a map of the lowercase IDs of the moved resources to their new IDs.
+func (p *Plan) ids() map[string]string {
+	ids := map[string]string{}
+	for _, resource := range p.Resources {
+		ids[strings.ToLower(resource.ID.String())] = resource.TargetID.String()
+	}
+
+	return ids
+}
+
+// Validate validates the move of resources from the source resource group and returns the plan of the move.
+//
+// Validate returns ErrInvalidMove if the request is invalid, ErrMoveConflict if the resources cannot be moved in their
+// current state or a resource provider rejects the move, and resourcegroups.LockedError if the move is blocked by a
+// management lock. Nothing is changed by Validate.
+func (m *Mover) Validate(ctx context.Context, source resources.ID, info *v1.ResourcesMoveInfo) (*Plan, error) {
+	target, err := m.validateTarget(ctx, source, info.TargetResourceGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Source: source, Target: target}
+	if len(info.Resources) == 0 {
+		return nil, &ErrInvalidMove{Message: "at least one resource must be specified"}
+	}
+
+	seen := map[string]bool{}
+	for _, resource := range info.Resources {
+		id, err := resources.ParseResource(resource)
+		if err != nil {
+			return nil, &ErrInvalidMove{Message: fmt.Sprintf("the resource %q is not a valid resource id", resource)}
+		} else if !strings.EqualFold(id.RootScope(), source.String()) {
+			return nil, &ErrInvalidMove{Message: fmt.Sprintf("the resource %q is not in the resource group %q", resource, source.String())}
+		} else if len(id.TypeSegments()) != 1 || id.IsExtensionResource() {
+			return nil, &ErrInvalidMove{Message: fmt.Sprintf("the resource %q is not a top-level resource", resource)}
+		} else if seen[strings.ToLower(id.String())] {
+			return nil, &ErrInvalidMove{Message: fmt.Sprintf("the resource %q is specified more than once", resource)}
+		}
+		seen[strings.ToLower(id.String())] = true
+
+		moved, err := m.validateResource(ctx, id, target)
+		if err != nil {
+			return nil, err
+		}
+
+		plan.Resources = append(plan.Resources, *moved)
+	}
+
+	plan.References, err = m.findReferences(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range plan.References {
+		if err := resourcegroups.ValidateLocks(ctx, m.client, id, http.MethodPut); err != nil {
+			return nil, err
+		}
+	}
+
+	plan.providers, err = m.findProviders(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, provider := range plan.providers {
+		if err := m.callProvider(ctx, plan, provider, v1.ValidateMoveResourcesActionName); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+// Move moves the resources of a plan returned by Validate.
+//
+// The moved resources and their tracked resource entries are saved with their new IDs, the references to them are
+// rewritten, and the resource providers are asked to commit the move. If any of these steps fails, the changes made so
+// far are rolled back and the resources stay in the source resource group. Once every resource provider has committed
+// the move, the resources in the source resource group are deleted.
+func (m *Mover) Move(ctx context.Context, plan *Plan) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	j := &journal{}
+	if err := m.write(ctx, plan, j); err != nil {
+		// Roll back even if the move was canceled, otherwise the resources would be left in both resource groups.
+		logger.Info("rolling back move", "source", plan.Source.String(), "target", plan.Target.String(), "error", err.Error())
+		if rollbackErr := m.rollback(context.WithoutCancel(ctx), plan, j); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to roll back the move: %w", rollbackErr))
+		}
+
+		return err
+	}
+
+	// The move is committed, so the resources are deleted from the source resource group even if it was canceled.
+	ctx = context.WithoutCancel(ctx)
+	errs := []error{}
+	for _, resource := range plan.Resources {
+		if resource.stored != nil {
+			errs = append(errs, m.delete(ctx, resource.ID.String()))
+		}
+		if resource.entry != nil {
+			errs = append(errs, m.delete(ctx, trackedresource.IDFor(resource.ID).String()))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("the resources were moved, but could not be deleted from the source resource group: %w", err)
+	}
+
+	return nil
+}
+
+// journal records the changes made by a move, so that they can be rolled back.
+type journal struct {
+	// saved is the list of IDs of the objects saved with the new IDs of the moved resources.
+	saved []string
+
+	// references is the list of resources whose references to the moved resources were rewritten, with their data
+	// before it was rewritten.
+	references []*database.Object
+
+	// committed is the list of resource providers that committed the move.
+	committed []*provider
+}
+
+// write saves the moved resources with their new IDs, rewrites the references to them, and asks the resource providers
+// to commit the move. Every change is recorded in the journal.
+func (m *Mover) write(ctx context.Context, plan *Plan, j *journal) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	ids := plan.ids()
+
+	for _, resource := range plan.Resources {
+		logger.V(ucplog.LevelDebug).Info("moving resource", "id", resource.ID.String(), "targetID", resource.TargetID.String())
+		if err := m.moveResource(ctx, resource, ids, j); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range plan.References {
+		logger.V(ucplog.LevelDebug).Info("updating references", "id", id.String())
+		if err := m.rewriteReferences(ctx, id, ids, j); err != nil {
+			return err
+		}
+	}
+
+	for _, provider := range plan.providers {
+		if err := m.callProvider(ctx, plan, provider, v1.MoveResourcesActionName); err != nil {
+			return err
+		}
+		j.committed = append(j.committed, provider)
+	}
+
+	return nil
+}
+
+// rollback undoes the changes recorded in the journal. The resource providers that committed the move are asked to
+// move the resources back to the source resource group, the rewritten references are restored, and the objects saved
+// with the new IDs are deleted. Every change is rolled back even if some of them fail.
+func (m *Mover) rollback(ctx context.Context, plan *Plan, j *journal) error {
+	errs := []error{}
+
+	ids := plan.ids()
+	reverse := &Plan{Source: plan.Target, Target: plan.Source}
+	for _, p := range j.committed {
+		reversed := *p
+		reversed.resources = []string{}
+		for _, id := range p.resources {
+			reversed.resources = append(reversed.resources, ids[strings.ToLower(id)])
+		}
+
+		errs = append(errs, m.callProvider(ctx, reverse, &reversed, v1.MoveResourcesActionName))
+	}
+
+	for _, obj := range j.references {
+		if err := m.client.Save(ctx, obj); err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore the references of resource %q: %w", obj.ID, err))
+		}
+	}
+
+	for _, id := range j.saved {
+		errs = append(errs, m.delete(ctx, id))
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateTarget validates that the target resource group exists, and is another resource group in the same plane as
+// the source resource group.
+func (m *Mover) validateTarget(ctx context.Context, source resources.ID, targetResourceGroup string) (resources.ID, error) {
+	target, err := resources.ParseScope(targetResourceGroup)
+	if err != nil || target.FindScope(resources_radius.ScopeResourceGroups) == "" || len(target.ScopeSegments()) != len(source.ScopeSegments()) {
+		return resources.ID{}, &ErrInvalidMove{Message: fmt.Sprintf("the target resource group %q is not a valid resource group id", targetResourceGroup)}
+	}
+
+	if !strings.EqualFold(target.PlaneScope(), source.PlaneScope()) {
+		return resources.ID{}, &ErrInvalidMove{Message: fmt.Sprintf("the target resource group %q is not in the plane %q", target.String(), source.PlaneScope())}
+	} else if strings.EqualFold(target.String(), source.String()) {
+		return resources.ID{}, &ErrInvalidMove{Message: "the target resource group must be different from the source resource group"}
+	}
+
+	_, err = database.GetResource[datamodel.ResourceGroup](ctx, m.client, target.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return resources.ID{}, &ErrInvalidMove{Message: fmt.Sprintf("the target resource group %q was not found", target.String())}
+	} else if err != nil {
+		return resources.ID{}, err
+	}
+
+	return target, nil
+}
+
+// validateResource validates that a resource exists and can be moved to the target resource group.
+func (m *Mover) validateResource(ctx context.Context, id resources.ID, target resources.ID) (*Resource, error) {
+	resource := &Resource{
+		ID:       id,
+		TargetID: resources.MustParse(resources.MakeUCPID(target.ScopeSegments(), id.TypeSegments(), nil)),
+	}
+
+	var err error
+	resource.entry, err = m.get(ctx, trackedresource.IDFor(id).String())
+	if err != nil {
+		return nil, err
+	}
+
+	resource.stored, err = m.get(ctx, id.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if resource.entry == nil && resource.stored == nil {
+		return nil, &ErrInvalidMove{Message: fmt.Sprintf("the resource %q was not found", id.String())}
+	}
+
+	for _, obj := range []*database.Object{resource.entry, resource.stored} {
+		if obj == nil {
+			continue
+		}
+
+		state, err := provisioningState(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		if !state.IsTerminal() {
+			return nil, &ErrMoveConflict{Message: fmt.Sprintf("the resource %q is being provisioned and cannot be moved", id.String())}
+		}
+	}
+
+	for _, existing := range []resources.ID{resource.TargetID, trackedresource.IDFor(resource.TargetID)} {
+		obj, err := m.get(ctx, existing.String())
+		if err != nil {
+			return nil, err
+		} else if obj != nil {
+			return nil, &ErrMoveConflict{Message: fmt.Sprintf("the resource %q already exists", resource.TargetID.String())}
+		}
+	}
+
+	if err := resourcegroups.ValidateLocks(ctx, m.client, id, http.MethodDelete); err != nil {
+		return nil, err
+	}
+
+	if err := resourcegroups.ValidateLocks(ctx, m.client, resource.TargetID, http.MethodPut); err != nil {
+		return nil, err
+	}
+
+	return resource, nil
+}
+
+// findReferences returns the IDs of the resources in the plane, other than the moved resources, whose stored data
+// references a moved resource. Resources are found through their tracked resource entries.
+func (m *Mover) findReferences(ctx context.Context, plan *Plan) ([]resources.ID, error) {
+	ids := plan.ids()
+	references := []resources.ID{}
+
+	query := database.Query{
+		RootScope:      plan.Source.PlaneScope(),
+		ScopeRecursive: true,
+		ResourceType:   datamodel.GenericResourceType,
+	}
+
+	options := []database.QueryOptions{}
+	for {
+		result, err := m.client.Query(ctx, query, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range result.Items {
+			entry := datamodel.GenericResource{}
+			if err := item.As(&entry); err != nil {
+				return nil, err
+			}
+
+			id, err := resources.ParseResource(entry.Properties.ID)
+			if err != nil {
+				// Not expected to happen, skip entries that do not track a valid resource.
+				continue
+			} else if _, ok := ids[strings.ToLower(id.String())]; ok {
+				continue
+			}
+
+			obj, err := m.get(ctx, id.String())
+			if err != nil {
+				return nil, err
+			} else if obj == nil {
+				continue
+			}
+
+			data := map[string]any{}
+			if err := obj.As(&data); err != nil {
+				return nil, err
+			}
+
+			if _, ok := rewrite(data, ids); ok {
+				references = append(references, id)
+			}
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		options = []database.QueryOptions{database.WithPaginationToken(result.PaginationToken)}
+	}
+
+	slices.SortFunc(references, func(a resources.ID, b resources.ID) int {
+		return strings.Compare(strings.ToLower(a.String()), strings.ToLower(b.String()))
+	})
+
+	return references, nil
+}
+
+// moveResource saves a moved resource and its tracked resource entry with the new ID.
+func (m *Mover) moveResource(ctx context.Context, resource Resource, ids map[string]string, j *journal) error {
+	if resource.stored != nil {
+		data := map[string]any{}
+		if err := resource.stored.As(&data); err != nil {
+			return err
+		}
+
+		rewrite(data, ids)
+		err := m.client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: resource.TargetID.String()}, Data: data})
+		if err != nil {
+			return fmt.Errorf("failed to save resource %q: %w", resource.TargetID.String(), err)
+		}
+		j.saved = append(j.saved, resource.TargetID.String())
+	}
+
+	if resource.entry != nil {
+		old := datamodel.GenericResource{}
+		if err := resource.entry.As(&old); err != nil {
+			return err
+		}
+
+		trackingID := trackedresource.IDFor(resource.TargetID)
+		entry := datamodel.GenericResourceFromID(resource.TargetID, trackingID)
+		entry.Properties.APIVersion = old.Properties.APIVersion
+		entry.AsyncProvisioningState = old.AsyncProvisioningState
+
+		err := m.client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: trackingID.String()}, Data: entry})
+		if err != nil {
+			return fmt.Errorf("failed to save tracked resource entry %q: %w", trackingID.String(), err)
+		}
+		j.saved = append(j.saved, trackingID.String())
+	}
+
+	return nil
+}
+
+// rewriteReferences rewrites the references to the moved resources in the stored data of a resource.
+func (m *Mover) rewriteReferences(ctx context.Context, id resources.ID, ids map[string]string, j *journal) error {
+	obj, err := m.get(ctx, id.String())
+	if err != nil {
+		return err
+	} else if obj == nil {
+		// The resource was deleted since the move was validated.
+		return nil
+	}
+
+	// The data is copied because rewrite updates it in place, and the original data is kept to roll back the move.
+	data := map[string]any{}
+	if err := clone(obj.Data, &data); err != nil {
+		return err
+	}
+
+	if _, ok := rewrite(data, ids); !ok {
+		return nil
+	}
+
+	err = m.client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id.String()}, Data: data}, database.WithETag(obj.ETag))
+	if err != nil {
+		return fmt.Errorf("failed to update the references of resource %q: %w", id.String(), err)
+	}
+	j.references = append(j.references, &database.Object{Metadata: database.Metadata{ID: id.String()}, Data: obj.Data})
+
+	return nil
+}
+
+// get returns the stored object with the given ID, or nil if it does not exist.
+func (m *Mover) get(ctx context.Context, id string) (*database.Object, error) {
+	obj, err := m.client.Get(ctx, id)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// delete deletes the stored object with the given ID if it exists.
+func (m *Mover) delete(ctx context.Context, id string) error {
+	err := m.client.Delete(ctx, id)
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to delete %q: %w", id, err)
+	}
+
+	return nil
+}
+
+// clone copies a JSON value into out.
+func clone(in any, out any) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, out)
+}
+
+// provisioningState returns the provisioning state of a stored resource or tracked resource entry.
+func provisioningState(obj *database.Object) (v1.ProvisioningState, error) {
+	resource := v1.BaseResource{}
+	if err := obj.As(&resource); err != nil {
+		return "", err
+	}
+
+	return resource.ProvisioningState(), nil
+}
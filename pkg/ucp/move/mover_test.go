@@ -0,0 +1,518 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAPIVersion = "2023-10-01-preview"
+	testPlane      = "/planes/radius/local"
+	sourceGroup    = testPlane + "/resourceGroups/source"
+	targetGroup    = testPlane + "/resourceGroups/target"
+	otherGroup     = testPlane + "/resourceGroups/other"
+
+	applicationID = sourceGroup + "/providers/Applications.Test/applications/app"
+	frontendID    = sourceGroup + "/providers/Applications.Test/containers/frontend"
+	backendID     = otherGroup + "/providers/Applications.Test/containers/backend"
+	unrelatedID   = otherGroup + "/providers/Applications.Test/containers/unrelated"
+	untrackedID   = sourceGroup + "/providers/Applications.Test/containers/untracked"
+)
+
+// testProvider is a fake resource provider that records the move requests it receives.
+type testProvider struct {
+	server *httptest.Server
+
+	mutex    sync.Mutex
+	requests []string
+	bodies   []v1.ResourcesMoveInfo
+
+	// status is the status code returned for the validateMoveResources action.
+	status int
+
+	// failMove is the resource provider namespace whose moveResources action fails in the source resource group.
+	failMove string
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	p := &testProvider{status: http.StatusNoContent}
+	p.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+
+		body := v1.ResourcesMoveInfo{}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		require.NoError(t, err)
+		require.Equal(t, testAPIVersion, r.URL.Query().Get("api-version"))
+
+		p.requests = append(p.requests, r.Method+" "+r.URL.Path)
+		p.bodies = append(p.bodies, body)
+
+		if strings.HasSuffix(r.URL.Path, "/"+v1.ValidateMoveResourcesActionName) && p.status != http.StatusNoContent {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(p.status)
+			_ = json.NewEncoder(w).Encode(v1.ErrorResponse{Error: &v1.ErrorDetails{Code: v1.CodeConflict, Message: "the resource is in use"}})
+			return
+		}
+
+		if p.failMove != "" && r.URL.Path == sourceGroup+"/providers/"+p.failMove+"/"+v1.MoveResourcesActionName {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+func setup(t *testing.T, ctx context.Context, address string) database.Client {
+	client := inmemory.NewClient()
+
+	save(t, ctx, client, testPlane, &datamodel.RadiusPlane{BaseResource: baseResource(testPlane)})
+	for _, id := range []string{sourceGroup, targetGroup, otherGroup} {
+		save(t, ctx, client, id, &datamodel.ResourceGroup{BaseResource: baseResource(id)})
+	}
+
+	id := resources.MustParse(applicationID)
+	for _, name := range []string{"applications", "containers"} {
+		resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(resources.MustParse(sourceGroup + "/providers/Applications.Test/" + name + "/test"))
+		require.NoError(t, err)
+		save(t, ctx, client, resourceTypeID.String(), &datamodel.ResourceType{BaseResource: baseResource(resourceTypeID.String())})
+	}
+
+	locationID, err := datamodel.ResourceProviderLocationIDFromResourceID(id, v1.LocationGlobal)
+	require.NoError(t, err)
+	save(t, ctx, client, locationID.String(), &datamodel.Location{
+		BaseResource: baseResource(locationID.String()),
+		Properties: datamodel.LocationProperties{
+			Address: to.Ptr(address),
+			ResourceTypes: map[string]datamodel.LocationResourceTypeConfiguration{
+				"applications": {APIVersions: map[string]datamodel.LocationAPIVersionConfiguration{testAPIVersion: {}}},
+				"containers":   {APIVersions: map[string]datamodel.LocationAPIVersionConfiguration{testAPIVersion: {}}},
+			},
+		},
+	})
+
+	saveResource(t, ctx, client, applicationID, map[string]any{})
+	saveResource(t, ctx, client, frontendID, map[string]any{
+		"application": applicationID,
+		"connections": map[string]any{
+			"app": map[string]any{"source": applicationID},
+		},
+		"status": map[string]any{
+			"outputResources": []any{
+				map[string]any{"id": strings.ToUpper(applicationID) + "/subresources/route"},
+			},
+		},
+	})
+	saveResource(t, ctx, client, backendID, map[string]any{
+		"application": applicationID,
+		"connections": map[string]any{
+			"frontend": map[string]any{"source": frontendID},
+			"similar":  map[string]any{"source": frontendID + "-v2"},
+		},
+	})
+	saveResource(t, ctx, client, unrelatedID, map[string]any{
+		"application": otherGroup + "/providers/Applications.Test/applications/app",
+	})
+	save(t, ctx, client, untrackedID, baseResource(untrackedID))
+
+	return client
+}
+
+func baseResource(id string) v1.BaseResource {
+	return v1.BaseResource{TrackedResource: v1.TrackedResource{ID: id}}
+}
+
+func save(t *testing.T, ctx context.Context, client database.Client, id string, data any) {
+	err := client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: data})
+	require.NoError(t, err)
+}
+
+// saveResource saves a resource with the given properties and its tracked resource entry.
+func saveResource(t *testing.T, ctx context.Context, client database.Client, id string, properties map[string]any) {
+	parsed := resources.MustParse(id)
+	save(t, ctx, client, id, map[string]any{
+		"id":                id,
+		"name":              parsed.Name(),
+		"type":              parsed.Type(),
+		"provisioningState": string(v1.ProvisioningStateSucceeded),
+		"properties":        properties,
+	})
+
+	trackingID := trackedresource.IDFor(parsed)
+	entry := datamodel.GenericResourceFromID(parsed, trackingID)
+	entry.Properties.APIVersion = testAPIVersion
+	entry.AsyncProvisioningState = v1.ProvisioningStateSucceeded
+	save(t, ctx, client, trackingID.String(), entry)
+}
+
+func get(t *testing.T, ctx context.Context, client database.Client, id string) map[string]any {
+	obj, err := client.Get(ctx, id)
+	require.NoError(t, err)
+
+	data := map[string]any{}
+	err = obj.As(&data)
+	require.NoError(t, err)
+	return data
+}
+
+func newMover(t *testing.T, client database.Client, provider *testProvider) *Mover {
+	defaultDownstream, err := url.Parse(provider.server.URL)
+	require.NoError(t, err)
+	return NewMover(client, provider.server.Client(), defaultDownstream)
+}
+
+func Test_Mover_Move(t *testing.T) {
+	ctx := testcontext.New(t)
+	provider := newTestProvider(t)
+	client := setup(t, ctx, provider.server.URL)
+	mover := newMover(t, client, provider)
+
+	info := &v1.ResourcesMoveInfo{
+		Resources:           []string{applicationID, frontendID, untrackedID},
+		TargetResourceGroup: targetGroup,
+	}
+
+	plan, err := mover.Validate(ctx, resources.MustParse(sourceGroup), info)
+	require.NoError(t, err)
+	require.Len(t, plan.Resources, 3)
+	require.Equal(t, targetGroup+"/providers/Applications.Test/applications/app", plan.Resources[0].TargetID.String())
+	require.Equal(t, []resources.ID{resources.MustParse(backendID)}, plan.References)
+
+	// Validate calls the resource provider, but does not change anything.
+	require.Equal(t, []string{"POST " + sourceGroup + "/providers/Applications.Test/validateMoveResources"}, provider.requests)
+	require.Equal(t, v1.ResourcesMoveInfo{Resources: []string{applicationID, frontendID}, TargetResourceGroup: targetGroup}, provider.bodies[0])
+	_, err = client.Get(ctx, applicationID)
+	require.NoError(t, err)
+
+	err = mover.Move(ctx, plan)
+	require.NoError(t, err)
+	require.Equal(t, "POST "+sourceGroup+"/providers/Applications.Test/moveResources", provider.requests[1])
+
+	newApplicationID := targetGroup + "/providers/Applications.Test/applications/app"
+	newFrontendID := targetGroup + "/providers/Applications.Test/containers/frontend"
+
+	// The moved resources are stored with their new IDs and the references between them are rewritten.
+	frontend := get(t, ctx, client, newFrontendID)
+	require.Equal(t, newFrontendID, frontend["id"])
+	require.Equal(t, map[string]any{
+		"application": newApplicationID,
+		"connections": map[string]any{
+			"app": map[string]any{"source": newApplicationID},
+		},
+		"status": map[string]any{
+			"outputResources": []any{
+				map[string]any{"id": newApplicationID + "/subresources/route"},
+			},
+		},
+	}, frontend["properties"])
+	require.Equal(t, targetGroup+"/providers/Applications.Test/containers/untracked", get(t, ctx, client, targetGroup+"/providers/Applications.Test/containers/untracked")["id"])
+
+	// The tracked resource entries are moved.
+	entry := datamodel.GenericResource{}
+	obj, err := client.Get(ctx, trackedresource.IDFor(resources.MustParse(newFrontendID)).String())
+	require.NoError(t, err)
+	require.NoError(t, obj.As(&entry))
+	require.Equal(t, newFrontendID, entry.Properties.ID)
+	require.Equal(t, testAPIVersion, entry.Properties.APIVersion)
+	require.Equal(t, v1.ProvisioningStateSucceeded, entry.AsyncProvisioningState)
+
+	// References from other resources are rewritten, other IDs are not.
+	backend := get(t, ctx, client, backendID)
+	require.Equal(t, map[string]any{
+		"application": newApplicationID,
+		"connections": map[string]any{
+			"frontend": map[string]any{"source": newFrontendID},
+			"similar":  map[string]any{"source": frontendID + "-v2"},
+		},
+	}, backend["properties"])
+	require.Equal(t, map[string]any{"application": otherGroup + "/providers/Applications.Test/applications/app"}, get(t, ctx, client, unrelatedID)["properties"])
+
+	// The resources in the source resource group are deleted.
+	for _, id := range []string{applicationID, frontendID, untrackedID, trackedresource.IDFor(resources.MustParse(frontendID)).String()} {
+		_, err = client.Get(ctx, id)
+		require.ErrorIs(t, err, &database.ErrNotFound{ID: id})
+	}
+}
+
+func Test_Mover_Move_Rollback(t *testing.T) {
+	ctx := testcontext.New(t)
+	provider := newTestProvider(t)
+	client := setup(t, ctx, provider.server.URL)
+	mover := newMover(t, client, provider)
+
+	// The resources of a second resource provider are committed after the first one, so the first one has to be rolled
+	// back when the second one fails.
+	thingID := sourceGroup + "/providers/Applications.Other/things/thing"
+	resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(resources.MustParse(thingID))
+	require.NoError(t, err)
+	save(t, ctx, client, resourceTypeID.String(), &datamodel.ResourceType{BaseResource: baseResource(resourceTypeID.String())})
+	locationID, err := datamodel.ResourceProviderLocationIDFromResourceID(resources.MustParse(thingID), v1.LocationGlobal)
+	require.NoError(t, err)
+	save(t, ctx, client, locationID.String(), &datamodel.Location{
+		BaseResource: baseResource(locationID.String()),
+		Properties: datamodel.LocationProperties{
+			Address: to.Ptr(provider.server.URL),
+			ResourceTypes: map[string]datamodel.LocationResourceTypeConfiguration{
+				"things": {APIVersions: map[string]datamodel.LocationAPIVersionConfiguration{testAPIVersion: {}}},
+			},
+		},
+	})
+	saveResource(t, ctx, client, thingID, map[string]any{})
+
+	plan, err := mover.Validate(ctx, resources.MustParse(sourceGroup), &v1.ResourcesMoveInfo{
+		Resources:           []string{applicationID, frontendID, thingID},
+		TargetResourceGroup: targetGroup,
+	})
+	require.NoError(t, err)
+
+	provider.failMove = "Applications.Other"
+	err = mover.Move(ctx, plan)
+	require.ErrorIs(t, err, &ErrMoveConflict{Message: "the resource provider \"Applications.Other\" rejected the move: 500 Internal Server Error"})
+
+	// The resource provider that committed the move is asked to move the resources back.
+	require.Equal(t, []string{
+		"POST " + sourceGroup + "/providers/Applications.Test/validateMoveResources",
+		"POST " + sourceGroup + "/providers/Applications.Other/validateMoveResources",
+		"POST " + sourceGroup + "/providers/Applications.Test/moveResources",
+		"POST " + sourceGroup + "/providers/Applications.Other/moveResources",
+		"POST " + targetGroup + "/providers/Applications.Test/moveResources",
+	}, provider.requests)
+	require.Equal(t, v1.ResourcesMoveInfo{
+		Resources: []string{
+			targetGroup + "/providers/Applications.Test/applications/app",
+			targetGroup + "/providers/Applications.Test/containers/frontend",
+		},
+		TargetResourceGroup: sourceGroup,
+	}, provider.bodies[4])
+
+	// The resources are still in the source resource group, and nothing is left in the target resource group.
+	for _, id := range []string{applicationID, frontendID, thingID} {
+		_, err = client.Get(ctx, id)
+		require.NoError(t, err)
+		_, err = client.Get(ctx, trackedresource.IDFor(resources.MustParse(id)).String())
+		require.NoError(t, err)
+
+		targetID := resources.MustParse(resources.MakeUCPID(resources.MustParse(targetGroup).ScopeSegments(), resources.MustParse(id).TypeSegments(), nil))
+		for _, moved := range []string{targetID.String(), trackedresource.IDFor(targetID).String()} {
+			_, err = client.Get(ctx, moved)
+			require.ErrorIs(t, err, &database.ErrNotFound{ID: moved})
+		}
+	}
+
+	// The references from other resources are restored.
+	require.Equal(t, map[string]any{
+		"application": applicationID,
+		"connections": map[string]any{
+			"frontend": map[string]any{"source": frontendID},
+			"similar":  map[string]any{"source": frontendID + "-v2"},
+		},
+	}, get(t, ctx, client, backendID)["properties"])
+}
+
+func Test_Mover_Validate_ProviderNotImplemented(t *testing.T) {
+	for _, status := range []int{http.StatusNotFound, http.StatusMethodNotAllowed} {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			ctx := testcontext.New(t)
+			provider := newTestProvider(t)
+			provider.status = status
+			client := setup(t, ctx, provider.server.URL)
+			mover := newMover(t, client, provider)
+
+			_, err := mover.Validate(ctx, resources.MustParse(sourceGroup), &v1.ResourcesMoveInfo{
+				Resources:           []string{frontendID},
+				TargetResourceGroup: targetGroup,
+			})
+			require.Equal(t, &ErrMoveConflict{Message: "the resource provider \"Applications.Test\" does not support moving resources"}, err)
+		})
+	}
+}
+
+func Test_Mover_Validate_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources []string
+		target    string
+		prepare   func(t *testing.T, ctx context.Context, client database.Client, provider *testProvider)
+		expected  error
+	}{
+		{
+			name:      "no resources",
+			resources: []string{},
+			target:    targetGroup,
+			expected:  &ErrInvalidMove{Message: "at least one resource must be specified"},
+		},
+		{
+			name:      "invalid target",
+			resources: []string{frontendID},
+			target:    testPlane,
+			expected:  &ErrInvalidMove{Message: "the target resource group \"/planes/radius/local\" is not a valid resource group id"},
+		},
+		{
+			name:      "target in another plane",
+			resources: []string{frontendID},
+			target:    "/planes/radius/other/resourceGroups/target",
+			expected:  &ErrInvalidMove{Message: "the target resource group \"/planes/radius/other/resourceGroups/target\" is not in the plane \"/planes/radius/local\""},
+		},
+		{
+			name:      "target is the source",
+			resources: []string{frontendID},
+			target:    testPlane + "/resourceGroups/SOURCE",
+			expected:  &ErrInvalidMove{Message: "the target resource group must be different from the source resource group"},
+		},
+		{
+			name:      "target not found",
+			resources: []string{frontendID},
+			target:    testPlane + "/resourceGroups/missing",
+			expected:  &ErrInvalidMove{Message: "the target resource group \"/planes/radius/local/resourceGroups/missing\" was not found"},
+		},
+		{
+			name:      "resource in another resource group",
+			resources: []string{backendID},
+			target:    targetGroup,
+			expected:  &ErrInvalidMove{Message: "the resource \"" + backendID + "\" is not in the resource group \"" + sourceGroup + "\""},
+		},
+		{
+			name:      "child resource",
+			resources: []string{frontendID + "/routes/default"},
+			target:    targetGroup,
+			expected:  &ErrInvalidMove{Message: "the resource \"" + frontendID + "/routes/default\" is not a top-level resource"},
+		},
+		{
+			name:      "duplicate resource",
+			resources: []string{frontendID, sourceGroup + "/providers/applications.test/containers/FRONTEND"},
+			target:    targetGroup,
+			expected:  &ErrInvalidMove{Message: "the resource \"" + sourceGroup + "/providers/applications.test/containers/FRONTEND\" is specified more than once"},
+		},
+		{
+			name:      "resource not found",
+			resources: []string{sourceGroup + "/providers/Applications.Test/containers/missing"},
+			target:    targetGroup,
+			expected:  &ErrInvalidMove{Message: "the resource \"" + sourceGroup + "/providers/Applications.Test/containers/missing\" was not found"},
+		},
+		{
+			name:      "resource being provisioned",
+			resources: []string{frontendID},
+			target:    targetGroup,
+			prepare: func(t *testing.T, ctx context.Context, client database.Client, provider *testProvider) {
+				parsed := resources.MustParse(frontendID)
+				entry := datamodel.GenericResourceFromID(parsed, trackedresource.IDFor(parsed))
+				entry.AsyncProvisioningState = v1.ProvisioningStateUpdating
+				save(t, ctx, client, trackedresource.IDFor(parsed).String(), entry)
+			},
+			expected: &ErrMoveConflict{Message: "the resource \"" + frontendID + "\" is being provisioned and cannot be moved"},
+		},
+		{
+			name:      "resource exists in target",
+			resources: []string{frontendID},
+			target:    targetGroup,
+			prepare: func(t *testing.T, ctx context.Context, client database.Client, provider *testProvider) {
+				saveResource(t, ctx, client, targetGroup+"/providers/Applications.Test/containers/frontend", map[string]any{})
+			},
+			expected: &ErrMoveConflict{Message: "the resource \"" + targetGroup + "/providers/Applications.Test/containers/frontend\" already exists"},
+		},
+		{
+			name:      "provider rejects",
+			resources: []string{frontendID},
+			target:    targetGroup,
+			prepare: func(t *testing.T, ctx context.Context, client database.Client, provider *testProvider) {
+				provider.status = http.StatusConflict
+			},
+			expected: &ErrMoveConflict{Message: "the resource provider \"Applications.Test\" rejected the move: the resource is in use"},
+		},
+		{
+			name:      "source locked",
+			resources: []string{frontendID},
+			target:    targetGroup,
+			prepare: func(t *testing.T, ctx context.Context, client database.Client, provider *testProvider) {
+				saveLock(t, ctx, client, sourceGroup, datamodel.LockLevelCanNotDelete)
+			},
+			expected: &resourcegroups.LockedError{},
+		},
+		{
+			name:      "target locked",
+			resources: []string{frontendID},
+			target:    targetGroup,
+			prepare: func(t *testing.T, ctx context.Context, client database.Client, provider *testProvider) {
+				saveLock(t, ctx, client, targetGroup, datamodel.LockLevelReadOnly)
+			},
+			expected: &resourcegroups.LockedError{},
+		},
+		{
+			name:      "reference locked",
+			resources: []string{frontendID},
+			target:    targetGroup,
+			prepare: func(t *testing.T, ctx context.Context, client database.Client, provider *testProvider) {
+				saveLock(t, ctx, client, otherGroup, datamodel.LockLevelReadOnly)
+			},
+			expected: &resourcegroups.LockedError{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := testcontext.New(t)
+			provider := newTestProvider(t)
+			client := setup(t, ctx, provider.server.URL)
+			if tt.prepare != nil {
+				tt.prepare(t, ctx, client, provider)
+			}
+
+			_, err := newMover(t, client, provider).Validate(ctx, resources.MustParse(sourceGroup), &v1.ResourcesMoveInfo{
+				Resources:           tt.resources,
+				TargetResourceGroup: tt.target,
+			})
+			require.ErrorIs(t, err, tt.expected)
+			if _, ok := tt.expected.(*resourcegroups.LockedError); !ok {
+				require.Equal(t, tt.expected.Error(), err.Error())
+			}
+
+			// The stored resources are not changed.
+			_, err = client.Get(ctx, frontendID)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func saveLock(t *testing.T, ctx context.Context, client database.Client, resourceGroup string, level datamodel.LockLevel) {
+	id := resourceGroup + "/providers/System.Resources/locks/lock"
+	save(t, ctx, client, id, &datamodel.Lock{
+		BaseResource: baseResource(id),
+		Properties:   datamodel.LockProperties{Level: level},
+	})
+}
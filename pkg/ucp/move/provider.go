@@ -0,0 +1,161 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// requestTimeout is the timeout of the requests to resource providers.
+	requestTimeout = time.Second * 30
+)
+
+// provider is a resource provider that takes part in a move.
+type provider struct {
+	// namespace is the resource provider namespace, for example 'Applications.Core'.
+	namespace string
+
+	// downstream is the address of the resource provider.
+	downstream *url.URL
+
+	// apiVersion is the API version used to call the resource provider.
+	apiVersion string
+
+	// resources is the list of IDs of the moved resources of the resource provider.
+	resources []string
+}
+
+// findProviders groups the moved resources by resource provider namespace and finds the address of each resource
+// provider. The API version of the tracked resource entries is used to route the requests, so resources without an entry
+// are moved without involving their resource provider.
+func (m *Mover) findProviders(ctx context.Context, plan *Plan) ([]*provider, error) {
+	providers := []*provider{}
+	byNamespace := map[string]*provider{}
+	for _, resource := range plan.Resources {
+		if resource.entry == nil {
+			continue
+		}
+
+		entry := datamodel.GenericResource{}
+		if err := resource.entry.As(&entry); err != nil {
+			return nil, err
+		}
+
+		if entry.Properties.APIVersion == "" {
+			continue
+		}
+
+		namespace := strings.ToLower(resource.ID.ProviderNamespace())
+		if p, ok := byNamespace[namespace]; ok {
+			p.resources = append(p.resources, resource.ID.String())
+			continue
+		}
+
+		downstream, err := resourcegroups.ValidateDownstream(ctx, m.client, resource.ID, v1.LocationGlobal, entry.Properties.APIVersion)
+		if errors.Is(err, &resourcegroups.NotFoundError{}) || errors.Is(err, &resourcegroups.InvalidError{}) {
+			return nil, &ErrInvalidMove{Message: fmt.Sprintf("the resource %q cannot be moved: %s", resource.ID.String(), err.Error())}
+		} else if err != nil {
+			return nil, err
+		}
+
+		if downstream == nil {
+			downstream = m.defaultDownstream
+		}
+
+		if downstream == nil {
+			return nil, fmt.Errorf("no downstream address was configured for the resource provider %q", resource.ID.ProviderNamespace())
+		}
+
+		p := &provider{
+			namespace:  resource.ID.ProviderNamespace(),
+			downstream: downstream,
+			apiVersion: entry.Properties.APIVersion,
+			resources:  []string{resource.ID.String()},
+		}
+		byNamespace[namespace] = p
+		providers = append(providers, p)
+	}
+
+	return providers, nil
+}
+
+// callProvider calls the validateMoveResources or moveResources action of a resource provider, in the source resource
+// group:
+//
+//	POST {downstream}/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/validateMoveResources
+//
+// Resource providers must implement both actions, so the move fails closed: NotFound and MethodNotAllowed responses mean
+// that the resource provider does not support moving resources, and return ErrMoveConflict like other error responses.
+func (m *Mover) callProvider(ctx context.Context, plan *Plan, p *provider, action string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	destination := p.downstream.JoinPath(plan.Source.String(), resources.ProvidersSegment, p.namespace, action)
+	query := destination.Query()
+	query.Set("api-version", p.apiVersion)
+	destination.RawQuery = query.Encode()
+
+	body, err := json.Marshal(v1.ResourcesMoveInfo{Resources: p.resources, TargetResourceGroup: plan.Target.String()})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, destination.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	logger.V(ucplog.LevelDebug).Info("calling resource provider", "action", action, "destination", destination.String())
+	response, err := m.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to call the %s action of resource provider %q: %w", action, p.namespace, err)
+	}
+	defer response.Body.Close()
+	logger.V(ucplog.LevelDebug).Info("resource provider responded", "action", action, "status", response.StatusCode)
+
+	if response.StatusCode >= 200 && response.StatusCode < 300 {
+		return nil
+	} else if response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusMethodNotAllowed {
+		return &ErrMoveConflict{Message: fmt.Sprintf("the resource provider %q does not support moving resources", p.namespace)}
+	}
+
+	message := response.Status
+	errorResponse := v1.ErrorResponse{}
+	if err := json.NewDecoder(response.Body).Decode(&errorResponse); err == nil && errorResponse.Error != nil && errorResponse.Error.Message != "" {
+		message = errorResponse.Error.Message
+	}
+
+	return &ErrMoveConflict{Message: fmt.Sprintf("the resource provider %q rejected the move: %s", p.namespace, message)}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// rewrite replaces the IDs of moved resources, and the IDs of their child resources, in the strings of a JSON value.
+// The ids map the lowercase IDs of the moved resources to their new IDs. IDs are matched case-insensitively.
+//
+// rewrite returns the new value and true if anything was replaced. Maps and slices are updated in place.
+func rewrite(value any, ids map[string]string) (any, bool) {
+	switch v := value.(type) {
+	case map[string]any:
+		changed := false
+		for key, item := range v {
+			if updated, ok := rewrite(item, ids); ok {
+				v[key] = updated
+				changed = true
+			}
+		}
+		return v, changed
+
+	case []any:
+		changed := false
+		for i, item := range v {
+			if updated, ok := rewrite(item, ids); ok {
+				v[i] = updated
+				changed = true
+			}
+		}
+		return v, changed
+
+	case string:
+		return rewriteID(v, ids)
+
+	default:
+		return value, false
+	}
+}
+
+// rewriteID returns the new ID of the resource with the given ID if it is a moved resource or a child resource of a
+// moved resource.
+func rewriteID(id string, ids map[string]string) (string, bool) {
+	lower := strings.ToLower(id)
+	if updated, ok := ids[lower]; ok {
+		return updated, true
+	}
+
+	for old, updated := range ids {
+		if strings.HasPrefix(lower, old+resources.SegmentSeparator) {
+			return updated + id[len(old):], true
+		}
+	}
+
+	return id, false
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package move
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_rewrite(t *testing.T) {
+	ids := map[string]string{
+		"/planes/radius/local/resourcegroups/rg1/providers/applications.core/applications/app": "/planes/radius/local/resourceGroups/rg2/providers/Applications.Core/applications/app",
+	}
+
+	tests := []struct {
+		name     string
+		value    any
+		expected any
+		changed  bool
+	}{
+		{
+			name:     "exact match",
+			value:    "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/applications/app",
+			expected: "/planes/radius/local/resourceGroups/rg2/providers/Applications.Core/applications/app",
+			changed:  true,
+		},
+		{
+			name:     "child resource",
+			value:    "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/applications/app/routes/Default",
+			expected: "/planes/radius/local/resourceGroups/rg2/providers/Applications.Core/applications/app/routes/Default",
+			changed:  true,
+		},
+		{
+			name:     "similar name",
+			value:    "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/applications/app2",
+			expected: "/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/applications/app2",
+		},
+		{
+			name: "nested",
+			value: map[string]any{
+				"count": 1.0,
+				"items": []any{"/planes/radius/local/resourceGroups/rg1/providers/Applications.Core/applications/app", true},
+			},
+			expected: map[string]any{
+				"count": 1.0,
+				"items": []any{"/planes/radius/local/resourceGroups/rg2/providers/Applications.Core/applications/app", true},
+			},
+			changed: true,
+		},
+		{
+			name:     "no match",
+			value:    map[string]any{"name": "app"},
+			expected: map[string]any{"name": "app"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, changed := rewrite(tt.value, ids)
+			require.Equal(t, tt.expected, actual)
+			require.Equal(t, tt.changed, changed)
+		})
+	}
+}
@@ -26,10 +26,13 @@ import (
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/kubeutil"
 	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/audit"
+	"github.com/radius-project/radius/pkg/ucp/authorization"
 	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	"github.com/radius-project/radius/pkg/validator"
 	"github.com/radius-project/radius/swagger"
+	"k8s.io/client-go/kubernetes"
 	kube_rest "k8s.io/client-go/rest"
 )
 
@@ -38,6 +41,12 @@ import (
 // For testability, all fields on this struct MUST be constructed from the NewOptions function without any
 // additional initialization required.
 type Options struct {
+	// AuditSink records mutating operations in the audit log. This is only set when the audit log is enabled.
+	AuditSink audit.Sink
+
+	// Authenticator authenticates the callers of requests. This is only set when authorization is enabled.
+	Authenticator authorization.Authenticator
+
 	// Config is the configuration for the server.
 	Config *Config
 
@@ -89,6 +98,13 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 
 	options.StatusManager = statusmanager.New(databaseClient, queueClient, config.Environment.RoleLocation)
 
+	if config.Audit.Enabled {
+		options.AuditSink, err = audit.NewSink(config.Audit, databaseClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	options.SpecLoader, err = validator.LoadSpec(ctx, "ucp", swagger.SpecFilesUCP, []string{config.Server.PathBase}, "")
 	if err != nil {
 		return nil, err
@@ -112,5 +128,37 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 		return nil, err
 	}
 
+	if config.Authorization.Enabled {
+		options.Authenticator, err = newAuthenticator(config.Authorization, cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &options, nil
 }
+
+// newAuthenticator creates the authenticator for the authorization options. A Kubernetes client is created when tokens
+// are authenticated with the TokenReview API.
+func newAuthenticator(options authorization.Options, cfg *kube_rest.Config) (authorization.Authenticator, error) {
+	var client kubernetes.Interface
+	if options.Kubernetes.TokenReview {
+		var err error
+		if cfg == nil {
+			cfg, err = kubeutil.NewClientConfig(&kubeutil.ConfigOptions{
+				QPS:   kubeutil.DefaultServerQPS,
+				Burst: kubeutil.DefaultServerBurst,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get kubernetes config: %w", err)
+			}
+		}
+
+		client, err = kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+		}
+	}
+
+	return authorization.NewAuthenticator(options, client)
+}
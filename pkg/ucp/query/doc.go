@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package query implements the resource query language of UCP and executes queries against the database.
+//
+// A query is a filter expression that is evaluated against every resource in a set of scopes. The expression
+// compares property paths with literal values and combines comparisons with 'and', 'or' and 'not':
+//
+//	type == 'Applications.Datastores/redisCaches' and properties.environment endswith '/environments/prod'
+//	tags.team =~ 'payments' or not (location == 'global')
+//
+// Property paths are '.' separated JSON property names, such as 'name', 'tags.team' or 'properties.application'.
+// The supported operators are:
+//
+//   - '==' and '!=' compare strings case-sensitively.
+//   - '=~' and '!~' compare strings case-insensitively.
+//   - 'contains', 'startswith' and 'endswith' match substrings case-insensitively.
+//   - 'in' matches one of a list of values case-sensitively, for example "type in ('a', 'b')".
+//
+// Literals are strings in single or double quotes, numbers, and the booleans true and false. The equality operators
+// only match properties of the same type as the literal, so the string '1' does not equal the number 1, and the other
+// operators only accept strings. A comparison with a property that does not exist, or that has a different type,
+// only matches the '!=' and '!~' operators.
+//
+// Comparisons with the resource type, and '==' comparisons with other properties, are pushed down into the
+// database query when they are part of the top-level conjunction of the expression. The expression is always
+// evaluated in full against the results of the database query.
+package query
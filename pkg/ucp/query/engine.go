@@ -0,0 +1,384 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// DefaultTop is the number of resources returned by a query that does not specify it.
+	DefaultTop = 100
+
+	// MaxTop is the maximum number of resources returned by a query.
+	MaxTop = 1000
+)
+
+// resourceProperties are the top-level properties of stored resources that are visible to queries. Other top-level
+// properties, such as the internal metadata of resources, are removed before evaluating the filter.
+var resourceProperties = []string{"id", "name", "type", "location", "tags", "systemData", "properties"}
+
+// Engine executes resource queries against the database.
+type Engine struct {
+	client database.Client
+}
+
+// NewEngine creates a new Engine.
+func NewEngine(client database.Client) *Engine {
+	return &Engine{client: client}
+}
+
+// Execute runs the query and returns a page of the matching resources, ordered by resource ID.
+//
+// The skip token is a keyset token made of the ID of the last resource of the previous page, so pages stay consistent
+// when resources are created or deleted between requests. The database does not support ordering, so the resources are
+// read page by page and only the first top+1 matching resources after the token are kept in memory. The data of
+// tracked resources is only read when they can be on the page.
+//
+// Execute returns ErrInvalidQuery if the query is invalid.
+func (e *Engine) Execute(ctx context.Context, q *datamodel.ResourceQuery) (*datamodel.ResourceQueryResult, error) {
+	expression, err := Parse(q.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range q.Select {
+		if err := ValidatePath(path); err != nil {
+			return nil, err
+		}
+	}
+
+	top := q.Top
+	if top == 0 {
+		top = DefaultTop
+	} else if top < 0 || top > MaxTop {
+		return nil, &ErrInvalidQuery{Message: fmt.Sprintf("top must be between 1 and %d", MaxTop)}
+	}
+
+	after := ""
+	if q.SkipToken != "" {
+		after, err = parseSkipToken(q.SkipToken)
+		if err != nil {
+			return nil, &ErrInvalidQuery{Message: "the skip token is invalid"}
+		}
+	}
+
+	if len(q.Scopes) == 0 {
+		return nil, &ErrInvalidQuery{Message: "at least one scope is required"}
+	}
+
+	scopes := []string{}
+	for _, scope := range q.Scopes {
+		id, err := resources.ParseScope(scope)
+		if err != nil || !id.IsUCPQualified() {
+			return nil, &ErrInvalidQuery{Message: fmt.Sprintf("the scope %q is not a valid UCP scope", scope)}
+		}
+		scopes = append(scopes, id.String())
+	}
+
+	p := newPage(after, top)
+	err = e.match(ctx, scopes, expression, p)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := p.sorted()
+	result := &datamodel.ResourceQueryResult{Data: []map[string]any{}}
+	if len(matches) > top {
+		matches = matches[:top]
+		result.SkipToken = newSkipToken(matches[top-1]["id"].(string))
+	}
+
+	for _, resource := range matches {
+		result.Data = append(result.Data, project(resource, q.Select))
+	}
+	result.Count = len(result.Data)
+
+	return result, nil
+}
+
+// match adds the resources in the scopes that match the expression and can be on the page to the page.
+//
+// Resources are read from two sources:
+//
+//   - When the expression restricts the resource type, the resources of that type are queried directly.
+//   - The tracked resource entries of resource groups list the resources of every type that were created through
+//     UCP. The data of the resource is read from the database when it is stored there, otherwise only the id, name
+//     and type of the resource are known.
+func (e *Engine) match(ctx context.Context, scopes []string, expression Expression, p *page) error {
+	plan := newPlan(expression)
+
+	add := func(data any) error {
+		resource, err := visible(data)
+		if err != nil {
+			return err
+		}
+
+		id, _ := resource["id"].(string)
+		if id == "" || !p.includes(id) {
+			return nil
+		}
+
+		if expression == nil || expression.Evaluate(resource) {
+			p.add(id, resource)
+		}
+
+		return nil
+	}
+
+	for _, scope := range scopes {
+		for _, resourceType := range plan.resourceTypes {
+			query := database.Query{
+				RootScope:      scope,
+				ScopeRecursive: true,
+				ResourceType:   resourceType,
+				Filters:        plan.filters,
+			}
+
+			err := e.queryAll(ctx, query, func(obj *database.Object) error {
+				// Skip copying the data of resources that cannot be on the page.
+				if obj.ID != "" && !p.includes(obj.ID) {
+					return nil
+				}
+
+				return add(obj.Data)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		query := database.Query{
+			RootScope:      scope,
+			ScopeRecursive: true,
+			ResourceType:   datamodel.GenericResourceType,
+		}
+
+		err := e.queryAll(ctx, query, func(obj *database.Object) error {
+			tracked := datamodel.GenericResource{}
+			if err := obj.As(&tracked); err != nil {
+				return err
+			}
+
+			if !p.includes(tracked.Properties.ID) || !hasResourceType(plan.resourceTypes, tracked.Properties.Type) {
+				return nil
+			}
+
+			stored, err := e.client.Get(ctx, tracked.Properties.ID)
+			if errors.Is(err, &database.ErrNotFound{}) {
+				return add(map[string]any{
+					"id":   tracked.Properties.ID,
+					"name": tracked.Properties.Name,
+					"type": tracked.Properties.Type,
+				})
+			} else if err != nil {
+				return err
+			}
+
+			return add(stored.Data)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// page collects the first top+1 matching resources after the skip token, ordered by resource ID. The extra resource
+// tells whether there is a next page.
+type page struct {
+	// after is the lowercase ID of the last resource of the previous page.
+	after string
+	top   int
+
+	// bound is the lowercase ID of the last resource kept once the page is full. Resources with an ID at or after it
+	// cannot be on the page.
+	bound string
+
+	// resources are the matching resources, keyed by lowercase ID.
+	resources map[string]map[string]any
+}
+
+func newPage(after string, top int) *page {
+	return &page{after: after, top: top, resources: map[string]map[string]any{}}
+}
+
+// includes returns true if the resource with the ID can be on the page and was not added already.
+func (p *page) includes(id string) bool {
+	key := strings.ToLower(id)
+	if key <= p.after || (p.bound != "" && key >= p.bound) {
+		return false
+	}
+
+	_, ok := p.resources[key]
+	return !ok
+}
+
+// add adds a matching resource to the page.
+func (p *page) add(id string, resource map[string]any) {
+	p.resources[strings.ToLower(id)] = resource
+
+	// Trim the resources regularly so that memory is bounded by the page size rather than the number of resources.
+	if len(p.resources) > 2*(p.top+1) {
+		p.trim()
+	}
+}
+
+// trim removes the resources that cannot be on the page.
+func (p *page) trim() []string {
+	keys := make([]string, 0, len(p.resources))
+	for key := range p.resources {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if len(keys) > p.top+1 {
+		for _, key := range keys[p.top+1:] {
+			delete(p.resources, key)
+		}
+		keys = keys[:p.top+1]
+		p.bound = keys[p.top]
+	}
+
+	return keys
+}
+
+// sorted returns the resources of the page ordered by resource ID.
+func (p *page) sorted() []map[string]any {
+	result := []map[string]any{}
+	for _, key := range p.trim() {
+		result = append(result, p.resources[key])
+	}
+
+	return result
+}
+
+// newSkipToken returns the skip token of the page that follows the resource with the given ID.
+func newSkipToken(id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.ToLower(id)))
+}
+
+// parseSkipToken returns the lowercase ID of the last resource of the previous page from a token created by
+// newSkipToken.
+func parseSkipToken(token string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", err
+	}
+
+	id := string(decoded)
+	if !strings.HasPrefix(id, resources.SegmentSeparator) {
+		return "", errors.New("the skip token does not specify a resource")
+	}
+
+	return id, nil
+}
+
+// queryAll calls the function for every result of the query.
+func (e *Engine) queryAll(ctx context.Context, query database.Query, fn func(obj *database.Object) error) error {
+	token := ""
+	for {
+		result, err := e.client.Query(ctx, query, database.WithPaginationToken(token))
+		if err != nil {
+			return err
+		}
+
+		for i := range result.Items {
+			if err := fn(&result.Items[i]); err != nil {
+				return err
+			}
+		}
+
+		token = result.PaginationToken
+		if token == "" {
+			return nil
+		}
+	}
+}
+
+// hasResourceType returns true if the resource types are empty or contain the resource type.
+func hasResourceType(resourceTypes []string, resourceType string) bool {
+	if len(resourceTypes) == 0 {
+		return true
+	}
+
+	for _, t := range resourceTypes {
+		if strings.EqualFold(t, resourceType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// visible returns the properties of the stored resource data that are visible to queries. Secrets stored in the
+// 'properties.secrets' property of portable resources, and the secret recipe outputs stored in the
+// 'properties.status.secrets' property of dynamic resources by older releases, are removed.
+func visible(data any) (map[string]any, error) {
+	copied, err := (&database.Object{Data: data}).DeepCopy()
+	if err != nil {
+		return nil, err
+	}
+
+	stored, ok := copied.Data.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("the stored resource data is not an object")
+	}
+
+	resource := map[string]any{}
+	for _, key := range resourceProperties {
+		if value, ok := stored[key]; ok {
+			resource[key] = value
+		}
+	}
+
+	if properties, ok := resource["properties"].(map[string]any); ok {
+		delete(properties, "secrets")
+
+		if status, ok := properties["status"].(map[string]any); ok {
+			delete(status, "secrets")
+		}
+	}
+
+	return resource, nil
+}
+
+// project returns the selected property paths of the resource. The keys of the result are the paths. Paths that do not
+// exist in the resource are returned as nil.
+func project(resource map[string]any, paths []string) map[string]any {
+	if len(paths) == 0 {
+		return resource
+	}
+
+	result := map[string]any{}
+	for _, path := range paths {
+		value, _ := Lookup(resource, path)
+		result[path] = value
+	}
+
+	return result
+}
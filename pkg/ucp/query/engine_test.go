@@ -0,0 +1,388 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/trackedresource"
+	"github.com/radius-project/radius/test/testcontext"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	prodCacheID     = "/planes/radius/local/resourceGroups/prod/providers/Applications.Datastores/redisCaches/cache"
+	devCacheID      = "/planes/radius/local/resourceGroups/dev/providers/Applications.Datastores/redisCaches/cache"
+	otherCacheID    = "/planes/radius/other/resourceGroups/prod/providers/Applications.Datastores/redisCaches/cache"
+	containerID     = "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/containers/frontend"
+	externalID      = "/planes/radius/local/resourceGroups/prod/providers/MyCompany.Resources/databases/orders"
+	prodEnvironment = "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod"
+)
+
+func setupEngine(t *testing.T) *Engine {
+	ctx := testcontext.New(t)
+	client := inmemory.NewClient()
+
+	save := func(id string, environment string, tracked bool, stored bool) {
+		parsed := resources.MustParse(id)
+		if stored {
+			err := client.Save(ctx, &database.Object{
+				Metadata: database.Metadata{ID: id},
+				Data: map[string]any{
+					"id":       id,
+					"name":     parsed.Name(),
+					"type":     parsed.Type(),
+					"location": "global",
+					"tags":     map[string]any{"team": "payments"},
+					"tenantId": "internal",
+					"properties": map[string]any{
+						"environment": environment,
+						"secrets":     map[string]any{"password": "secret"},
+					},
+				},
+			})
+			require.NoError(t, err)
+		}
+
+		if tracked {
+			entry := datamodel.GenericResourceFromID(parsed, trackedresource.IDFor(parsed))
+			err := client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: entry.ID}, Data: entry})
+			require.NoError(t, err)
+		}
+	}
+
+	// The dev cache is not tracked, so it is only found by queries for its type.
+	save(prodCacheID, prodEnvironment, true, true)
+	save(devCacheID, "dev", false, true)
+	save(otherCacheID, prodEnvironment, true, true)
+	save(containerID, prodEnvironment, true, true)
+
+	// The external resource is tracked, but its data is stored by its resource provider.
+	save(externalID, "", true, false)
+
+	return NewEngine(client)
+}
+
+func ids(result *datamodel.ResourceQueryResult) []string {
+	values := []string{}
+	for _, resource := range result.Data {
+		values = append(values, resource["id"].(string))
+	}
+	return values
+}
+
+func Test_Engine_Execute(t *testing.T) {
+	engine := setupEngine(t)
+
+	tests := []struct {
+		name     string
+		query    datamodel.ResourceQuery
+		expected []string
+	}{
+		{
+			name:     "every tracked resource in a plane",
+			query:    datamodel.ResourceQuery{Scopes: []string{"/planes/radius/local"}},
+			expected: []string{containerID, prodCacheID, externalID},
+		},
+		{
+			name:     "every tracked resource across planes",
+			query:    datamodel.ResourceQuery{Scopes: []string{"/planes"}},
+			expected: []string{containerID, prodCacheID, externalID, otherCacheID},
+		},
+		{
+			name:     "resource type across planes",
+			query:    datamodel.ResourceQuery{Scopes: []string{"/planes/radius/local", "/planes/radius/other"}, Filter: "type == 'Applications.Datastores/redisCaches'"},
+			expected: []string{devCacheID, prodCacheID, otherCacheID},
+		},
+		{
+			name:     "resource type and property in a resource group",
+			query:    datamodel.ResourceQuery{Scopes: []string{"/planes/radius/local/resourceGroups/prod"}, Filter: "type =~ 'applications.datastores/rediscaches' and properties.environment endswith '/environments/prod'"},
+			expected: []string{prodCacheID},
+		},
+		{
+			name:     "pushed down property filter",
+			query:    datamodel.ResourceQuery{Scopes: []string{"/planes"}, Filter: "type == 'Applications.Datastores/redisCaches' and properties.environment == '" + prodEnvironment + "'"},
+			expected: []string{prodCacheID, otherCacheID},
+		},
+		{
+			name:     "tracked resource without stored data",
+			query:    datamodel.ResourceQuery{Scopes: []string{"/planes/radius/local"}, Filter: "type == 'MyCompany.Resources/databases'"},
+			expected: []string{externalID},
+		},
+		{
+			name:     "tags",
+			query:    datamodel.ResourceQuery{Scopes: []string{"/planes/radius/local"}, Filter: "tags.team == 'payments' and not (type == 'Applications.Core/containers')"},
+			expected: []string{prodCacheID},
+		},
+		{
+			name:     "secrets and internal properties are not visible",
+			query:    datamodel.ResourceQuery{Scopes: []string{"/planes"}, Filter: "properties.secrets.password == 'secret' or tenantId == 'internal'"},
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := engine.Execute(testcontext.New(t), &tt.query)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, ids(result))
+			require.Equal(t, len(tt.expected), result.Count)
+			require.Empty(t, result.SkipToken)
+		})
+	}
+}
+
+func Test_Engine_Execute_Projection(t *testing.T) {
+	engine := setupEngine(t)
+
+	t.Run("default", func(t *testing.T) {
+		result, err := engine.Execute(testcontext.New(t), &datamodel.ResourceQuery{Scopes: []string{"/planes/radius/local/resourceGroups/prod"}, Filter: "name == 'cache'"})
+		require.NoError(t, err)
+
+		expected := []map[string]any{
+			{
+				"id":         prodCacheID,
+				"name":       "cache",
+				"type":       "Applications.Datastores/redisCaches",
+				"location":   "global",
+				"tags":       map[string]any{"team": "payments"},
+				"properties": map[string]any{"environment": prodEnvironment},
+			},
+		}
+		require.Equal(t, expected, result.Data)
+	})
+
+	t.Run("select", func(t *testing.T) {
+		result, err := engine.Execute(testcontext.New(t), &datamodel.ResourceQuery{
+			Scopes: []string{"/planes/radius/local/resourceGroups/prod"},
+			Filter: "name in ('cache', 'orders')",
+			Select: []string{"name", "properties.environment"},
+		})
+		require.NoError(t, err)
+
+		expected := []map[string]any{
+			{"name": "cache", "properties.environment": prodEnvironment},
+			{"name": "orders", "properties.environment": nil},
+		}
+		require.Equal(t, expected, result.Data)
+	})
+}
+
+func Test_Engine_Execute_RecipeSecrets(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := inmemory.NewClient()
+
+	// Older releases stored the secret outputs of the recipe of a dynamic resource in its status.
+	parsed := resources.MustParse(externalID)
+	err := client.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: externalID},
+		Data: map[string]any{
+			"id":   externalID,
+			"name": parsed.Name(),
+			"type": parsed.Type(),
+			"properties": map[string]any{
+				"environment": prodEnvironment,
+				"status": map[string]any{
+					"computedValues": map[string]any{"host": "orders.example.com"},
+					"secrets":        map[string]any{"password": "secret"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	entry := datamodel.GenericResourceFromID(parsed, trackedresource.IDFor(parsed))
+	err = client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: entry.ID}, Data: entry})
+	require.NoError(t, err)
+
+	engine := NewEngine(client)
+
+	t.Run("filter", func(t *testing.T) {
+		result, err := engine.Execute(testcontext.New(t), &datamodel.ResourceQuery{Scopes: []string{"/planes"}, Filter: "properties.status.secrets.password == 'secret'"})
+		require.NoError(t, err)
+		require.Empty(t, ids(result))
+	})
+
+	t.Run("default", func(t *testing.T) {
+		result, err := engine.Execute(testcontext.New(t), &datamodel.ResourceQuery{Scopes: []string{"/planes"}})
+		require.NoError(t, err)
+
+		expected := []map[string]any{
+			{
+				"id":   externalID,
+				"name": "orders",
+				"type": "MyCompany.Resources/databases",
+				"properties": map[string]any{
+					"environment": prodEnvironment,
+					"status": map[string]any{
+						"computedValues": map[string]any{"host": "orders.example.com"},
+					},
+				},
+			},
+		}
+		require.Equal(t, expected, result.Data)
+	})
+
+	t.Run("select", func(t *testing.T) {
+		result, err := engine.Execute(testcontext.New(t), &datamodel.ResourceQuery{
+			Scopes: []string{"/planes"},
+			Select: []string{"properties.status.secrets", "properties.status.secrets.password"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []map[string]any{{"properties.status.secrets": nil, "properties.status.secrets.password": nil}}, result.Data)
+	})
+}
+
+func Test_Engine_Execute_Pagination(t *testing.T) {
+	engine := setupEngine(t)
+	query := datamodel.ResourceQuery{Scopes: []string{"/planes"}, Top: 2}
+
+	result, err := engine.Execute(testcontext.New(t), &query)
+	require.NoError(t, err)
+	require.Equal(t, []string{containerID, prodCacheID}, ids(result))
+	require.Equal(t, 2, result.Count)
+	require.NotEmpty(t, result.SkipToken)
+
+	query.SkipToken = result.SkipToken
+	result, err = engine.Execute(testcontext.New(t), &query)
+	require.NoError(t, err)
+	require.Equal(t, []string{externalID, otherCacheID}, ids(result))
+	require.Empty(t, result.SkipToken)
+}
+
+func Test_Engine_Execute_Pagination_NewResources(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := inmemory.NewClient()
+
+	save := func(id string) {
+		parsed := resources.MustParse(id)
+		err := client.Save(ctx, &database.Object{
+			Metadata: database.Metadata{ID: id},
+			Data:     map[string]any{"id": id, "name": parsed.Name(), "type": parsed.Type()},
+		})
+		require.NoError(t, err)
+
+		entry := datamodel.GenericResourceFromID(parsed, trackedresource.IDFor(parsed))
+		err = client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: entry.ID}, Data: entry})
+		require.NoError(t, err)
+	}
+
+	// More resources than fit in the memory bound of a page.
+	expected := []string{}
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("/planes/radius/local/resourceGroups/prod/providers/Applications.Core/containers/c%02d", i)
+		save(id)
+		expected = append(expected, id)
+	}
+
+	engine := NewEngine(client)
+	query := datamodel.ResourceQuery{Scopes: []string{"/planes/radius/local"}, Top: 3}
+	result, err := engine.Execute(ctx, &query)
+	require.NoError(t, err)
+	actual := ids(result)
+
+	// A resource created before the last resource of the page does not shift the following pages.
+	save("/planes/radius/local/resourceGroups/prod/providers/Applications.Core/containers/c00a")
+
+	for result.SkipToken != "" {
+		query.SkipToken = result.SkipToken
+		result, err = engine.Execute(ctx, &query)
+		require.NoError(t, err)
+		require.LessOrEqual(t, result.Count, 3)
+		actual = append(actual, ids(result)...)
+	}
+
+	require.Equal(t, expected, actual)
+}
+
+func Test_Engine_Execute_SkipToken_SkipsReads(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := database.NewMockClient(gomock.NewController(t))
+
+	entry := datamodel.GenericResourceFromID(resources.MustParse(containerID), trackedresource.IDFor(resources.MustParse(containerID)))
+	client.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&database.ObjectQueryResult{Items: []database.Object{{Metadata: database.Metadata{ID: entry.ID}, Data: entry}}}, nil)
+
+	// The tracked resource is before the skip token, so its data is not read.
+	result, err := NewEngine(client).Execute(ctx, &datamodel.ResourceQuery{
+		Scopes:    []string{"/planes/radius/local"},
+		SkipToken: newSkipToken(prodCacheID),
+	})
+	require.NoError(t, err)
+	require.Empty(t, result.Data)
+}
+
+func Test_Engine_Execute_Pushdown(t *testing.T) {
+	ctx := testcontext.New(t)
+	client := database.NewMockClient(gomock.NewController(t))
+
+	client.EXPECT().
+		Query(gomock.Any(), database.Query{
+			RootScope:      "/planes/radius/local",
+			ScopeRecursive: true,
+			ResourceType:   "Applications.Datastores/redisCaches",
+			Filters:        []database.QueryFilter{{Field: "properties.environment", Value: "prod"}},
+		}, gomock.Any()).
+		Return(&database.ObjectQueryResult{}, nil)
+	client.EXPECT().
+		Query(gomock.Any(), database.Query{
+			RootScope:      "/planes/radius/local",
+			ScopeRecursive: true,
+			ResourceType:   datamodel.GenericResourceType,
+		}, gomock.Any()).
+		Return(&database.ObjectQueryResult{}, nil)
+
+	result, err := NewEngine(client).Execute(ctx, &datamodel.ResourceQuery{
+		Scopes: []string{"/planes/radius/local"},
+		Filter: "type == 'Applications.Datastores/redisCaches' and properties.environment == 'prod'",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, result.Count)
+}
+
+func Test_Engine_Execute_Invalid(t *testing.T) {
+	engine := setupEngine(t)
+
+	tests := []struct {
+		name     string
+		query    datamodel.ResourceQuery
+		expected string
+	}{
+		{name: "no scopes", query: datamodel.ResourceQuery{}, expected: "at least one scope is required"},
+		{name: "invalid scope", query: datamodel.ResourceQuery{Scopes: []string{"/subscriptions/abc"}}, expected: `the scope "/subscriptions/abc" is not a valid UCP scope`},
+		{name: "invalid filter", query: datamodel.ResourceQuery{Scopes: []string{"/planes"}, Filter: "name =="}, expected: "unexpected end of filter"},
+		{name: "invalid select", query: datamodel.ResourceQuery{Scopes: []string{"/planes"}, Select: []string{"a..b"}}, expected: `the property path "a..b" is invalid`},
+		{name: "invalid top", query: datamodel.ResourceQuery{Scopes: []string{"/planes"}, Top: MaxTop + 1}, expected: "top must be between 1 and 1000"},
+		{name: "invalid skip token", query: datamodel.ResourceQuery{Scopes: []string{"/planes"}, SkipToken: "abc"}, expected: "the skip token is invalid"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := engine.Execute(testcontext.New(t), &tt.query)
+			require.Nil(t, result)
+			require.ErrorIs(t, err, &ErrInvalidQuery{})
+			require.EqualError(t, err, tt.expected)
+		})
+	}
+}
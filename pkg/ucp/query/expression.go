@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"slices"
+	"strings"
+)
+
+// Operator is a comparison operator of the query language.
+type Operator string
+
+const (
+	// OperatorEquals matches values that are equal, case-sensitively.
+	OperatorEquals Operator = "=="
+	// OperatorNotEquals matches values that are not equal, case-sensitively.
+	OperatorNotEquals Operator = "!="
+	// OperatorEqualsIgnoreCase matches values that are equal, case-insensitively.
+	OperatorEqualsIgnoreCase Operator = "=~"
+	// OperatorNotEqualsIgnoreCase matches values that are not equal, case-insensitively.
+	OperatorNotEqualsIgnoreCase Operator = "!~"
+	// OperatorContains matches values that contain the literal, case-insensitively.
+	OperatorContains Operator = "contains"
+	// OperatorStartsWith matches values that start with the literal, case-insensitively.
+	OperatorStartsWith Operator = "startswith"
+	// OperatorEndsWith matches values that end with the literal, case-insensitively.
+	OperatorEndsWith Operator = "endswith"
+	// OperatorIn matches values that are equal to one of the literals, case-sensitively.
+	OperatorIn Operator = "in"
+)
+
+// Expression is a parsed filter expression.
+type Expression interface {
+	// Evaluate returns true if the resource matches the expression.
+	Evaluate(resource map[string]any) bool
+}
+
+// And matches resources that match every one of its expressions.
+type And struct {
+	Expressions []Expression
+}
+
+// Evaluate implements Expression.
+func (e *And) Evaluate(resource map[string]any) bool {
+	for _, expression := range e.Expressions {
+		if !expression.Evaluate(resource) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Or matches resources that match any of its expressions.
+type Or struct {
+	Expressions []Expression
+}
+
+// Evaluate implements Expression.
+func (e *Or) Evaluate(resource map[string]any) bool {
+	for _, expression := range e.Expressions {
+		if expression.Evaluate(resource) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Not matches resources that do not match its expression.
+type Not struct {
+	Expression Expression
+}
+
+// Evaluate implements Expression.
+func (e *Not) Evaluate(resource map[string]any) bool {
+	return !e.Expression.Evaluate(resource)
+}
+
+// Comparison compares the value of a property path with one or more literal values.
+type Comparison struct {
+	// Path is the '.' separated property path.
+	Path string
+	// Operator is the comparison operator.
+	Operator Operator
+	// Values are the literal values, which are strings, numbers (float64) or booleans. Only the 'in' operator has
+	// more than one value, and the string operators only have string values.
+	Values []any
+}
+
+// Evaluate implements Expression.
+func (e *Comparison) Evaluate(resource map[string]any) bool {
+	value, found := Lookup(resource, e.Path)
+	switch e.Operator {
+	case OperatorEquals:
+		return found && equal(value, e.Values[0])
+	case OperatorNotEquals:
+		return !found || !equal(value, e.Values[0])
+	case OperatorIn:
+		return found && slices.ContainsFunc(e.Values, func(literal any) bool { return equal(value, literal) })
+	}
+
+	actual, ok := value.(string)
+	if !found || !ok {
+		return e.Operator == OperatorNotEqualsIgnoreCase
+	}
+
+	literal := e.Values[0].(string)
+	switch e.Operator {
+	case OperatorEqualsIgnoreCase:
+		return strings.EqualFold(actual, literal)
+	case OperatorNotEqualsIgnoreCase:
+		return !strings.EqualFold(actual, literal)
+	case OperatorContains:
+		return strings.Contains(strings.ToLower(actual), strings.ToLower(literal))
+	case OperatorStartsWith:
+		return strings.HasPrefix(strings.ToLower(actual), strings.ToLower(literal))
+	case OperatorEndsWith:
+		return strings.HasSuffix(strings.ToLower(actual), strings.ToLower(literal))
+	default:
+		return false
+	}
+}
+
+// Lookup returns the value of the '.' separated property path in the resource. The second return value is false
+// if the property does not exist.
+func Lookup(resource map[string]any, path string) (any, bool) {
+	var current any = resource
+	for _, segment := range strings.Split(path, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		current, ok = object[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// equal returns true if the property value has the same type and value as the literal. Values of different types,
+// such as the string '1' and the number 1, are not equal.
+func equal(value any, literal any) bool {
+	switch l := literal.(type) {
+	case string:
+		v, ok := value.(string)
+		return ok && v == l
+	case float64:
+		v, ok := value.(float64)
+		return ok && v == l
+	case bool:
+		v, ok := value.(bool)
+		return ok && v == l
+	default:
+		return false
+	}
+}
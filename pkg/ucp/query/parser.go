@@ -0,0 +1,386 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// pathRegex validates a property path. A path is one or more '.' separated segments, and the first segment must
+// start with a letter, '_' or '$'.
+var pathRegex = regexp.MustCompile(`^[a-zA-Z$_][a-zA-Z0-9$_-]*(\.[a-zA-Z0-9$_-]+)*$`)
+
+var _ error = (*ErrInvalidQuery)(nil)
+
+// ErrInvalidQuery is returned when a query cannot be parsed or is otherwise invalid.
+type ErrInvalidQuery struct {
+	Message string
+}
+
+// Error returns a string representation of the error.
+func (e *ErrInvalidQuery) Error() string {
+	return e.Message
+}
+
+// Is checks if the target error is of type ErrInvalidQuery and if the message of the target error is equal to the
+// message of the ErrInvalidQuery instance or is an empty string.
+func (e *ErrInvalidQuery) Is(target error) bool {
+	t, ok := target.(*ErrInvalidQuery)
+	if !ok {
+		return false
+	}
+
+	return e.Message == t.Message || t.Message == ""
+}
+
+// ValidatePath returns an error if the property path is not valid.
+func ValidatePath(path string) error {
+	if !pathRegex.MatchString(path) {
+		return &ErrInvalidQuery{Message: fmt.Sprintf("the property path %q is invalid", path)}
+	}
+
+	return nil
+}
+
+// Parse parses a filter expression. An empty filter returns a nil expression, which matches every resource.
+func Parse(filter string) (Expression, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tokens) == 1 {
+		return nil, nil
+	}
+
+	p := &parser{tokens: tokens}
+	expression, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if token := p.peek(); token.kind != tokenEOF {
+		return nil, p.unexpected(token)
+	}
+
+	return expression, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+)
+
+type token struct {
+	kind     tokenKind
+	value    string
+	number   float64
+	position int
+}
+
+// keywordOperators are the operators that are written as words.
+var keywordOperators = map[string]Operator{
+	string(OperatorContains):   OperatorContains,
+	string(OperatorStartsWith): OperatorStartsWith,
+	string(OperatorEndsWith):   OperatorEndsWith,
+	string(OperatorIn):         OperatorIn,
+}
+
+func tokenize(input string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, value: "(", position: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, value: ")", position: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokenComma, value: ",", position: i})
+			i++
+		case r == '=' || r == '!':
+			if i+1 >= len(runes) || (runes[i+1] != '=' && runes[i+1] != '~') {
+				return nil, &ErrInvalidQuery{Message: fmt.Sprintf("unexpected character %q at position %d", r, i)}
+			}
+			tokens = append(tokens, token{kind: tokenOperator, value: string(runes[i : i+2]), position: i})
+			i += 2
+		case r == '\'' || r == '"':
+			// Strings are quoted with single or double quotes. A quote is escaped by doubling it.
+			value := strings.Builder{}
+			start := i
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, &ErrInvalidQuery{Message: fmt.Sprintf("unterminated string at position %d", start)}
+				}
+				if runes[i] == r {
+					if i+1 < len(runes) && runes[i+1] == r {
+						value.WriteRune(r)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value.String(), position: start})
+		case r == '-' || unicode.IsDigit(r):
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			number, err := strconv.ParseFloat(string(runes[start:i]), 64)
+			if err != nil {
+				return nil, &ErrInvalidQuery{Message: fmt.Sprintf("invalid number %q at position %d", string(runes[start:i]), start)}
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: string(runes[start:i]), number: number, position: start})
+		case unicode.IsLetter(r) || r == '_' || r == '$':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || strings.ContainsRune("_$-.", runes[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, value: string(runes[start:i]), position: start})
+		default:
+			return nil, &ErrInvalidQuery{Message: fmt.Sprintf("unexpected character %q at position %d", r, i)}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, position: len(runes)}), nil
+}
+
+type parser struct {
+	tokens []token
+	index  int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.index]
+}
+
+func (p *parser) next() token {
+	token := p.tokens[p.index]
+	if token.kind != tokenEOF {
+		p.index++
+	}
+	return token
+}
+
+// acceptKeyword consumes the next token if it is the keyword.
+func (p *parser) acceptKeyword(keyword string) bool {
+	token := p.peek()
+	if token.kind == tokenIdentifier && strings.EqualFold(token.value, keyword) {
+		p.index++
+		return true
+	}
+
+	return false
+}
+
+func (p *parser) unexpected(token token) error {
+	if token.kind == tokenEOF {
+		return &ErrInvalidQuery{Message: "unexpected end of filter"}
+	}
+
+	return &ErrInvalidQuery{Message: fmt.Sprintf("unexpected %q at position %d", token.value, token.position)}
+}
+
+// parseOr parses: and-expression ('or' and-expression)*
+func (p *parser) parseOr() (Expression, error) {
+	expressions := []Expression{}
+	for {
+		expression, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		expressions = append(expressions, expression)
+
+		if !p.acceptKeyword("or") {
+			break
+		}
+	}
+
+	if len(expressions) == 1 {
+		return expressions[0], nil
+	}
+
+	return &Or{Expressions: expressions}, nil
+}
+
+// parseAnd parses: unary-expression ('and' unary-expression)*
+func (p *parser) parseAnd() (Expression, error) {
+	expressions := []Expression{}
+	for {
+		expression, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		expressions = append(expressions, expression)
+
+		if !p.acceptKeyword("and") {
+			break
+		}
+	}
+
+	if len(expressions) == 1 {
+		return expressions[0], nil
+	}
+
+	return &And{Expressions: expressions}, nil
+}
+
+// parseUnary parses: 'not' unary-expression | '(' or-expression ')' | comparison
+func (p *parser) parseUnary() (Expression, error) {
+	if p.acceptKeyword("not") {
+		expression, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &Not{Expression: expression}, nil
+	}
+
+	if p.peek().kind == tokenLeftParen {
+		p.next()
+		expression, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if token := p.next(); token.kind != tokenRightParen {
+			return nil, p.unexpected(token)
+		}
+
+		return expression, nil
+	}
+
+	return p.parseComparison()
+}
+
+// parseComparison parses: path operator literal | path 'in' '(' literal (',' literal)* ')'
+func (p *parser) parseComparison() (Expression, error) {
+	path := p.next()
+	if path.kind != tokenIdentifier || isKeyword(path.value) {
+		return nil, p.unexpected(path)
+	}
+
+	if err := ValidatePath(path.value); err != nil {
+		return nil, err
+	}
+
+	operator, err := p.parseOperator()
+	if err != nil {
+		return nil, err
+	}
+
+	if operator != OperatorIn {
+		token := p.peek()
+		value, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+
+		// Only the equality operators compare numbers and booleans.
+		if _, ok := value.(string); !ok && operator != OperatorEquals && operator != OperatorNotEquals {
+			return nil, &ErrInvalidQuery{Message: fmt.Sprintf("the operator %q requires a string at position %d", operator, token.position)}
+		}
+
+		return &Comparison{Path: path.value, Operator: operator, Values: []any{value}}, nil
+	}
+
+	if token := p.next(); token.kind != tokenLeftParen {
+		return nil, p.unexpected(token)
+	}
+
+	values := []any{}
+	for {
+		value, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		token := p.next()
+		if token.kind == tokenRightParen {
+			break
+		} else if token.kind != tokenComma {
+			return nil, p.unexpected(token)
+		}
+	}
+
+	return &Comparison{Path: path.value, Operator: OperatorIn, Values: values}, nil
+}
+
+func (p *parser) parseOperator() (Operator, error) {
+	token := p.next()
+	if token.kind == tokenOperator {
+		return Operator(token.value), nil
+	}
+
+	if token.kind == tokenIdentifier {
+		if operator, ok := keywordOperators[strings.ToLower(token.value)]; ok {
+			return operator, nil
+		}
+	}
+
+	return "", p.unexpected(token)
+}
+
+func (p *parser) parseLiteral() (any, error) {
+	token := p.next()
+	switch {
+	case token.kind == tokenString:
+		return token.value, nil
+	case token.kind == tokenNumber:
+		return token.number, nil
+	case token.kind == tokenIdentifier && strings.EqualFold(token.value, "true"):
+		return true, nil
+	case token.kind == tokenIdentifier && strings.EqualFold(token.value, "false"):
+		return false, nil
+	default:
+		return nil, p.unexpected(token)
+	}
+}
+
+func isKeyword(value string) bool {
+	switch strings.ToLower(value) {
+	case "and", "or", "not", "true", "false":
+		return true
+	default:
+		_, ok := keywordOperators[strings.ToLower(value)]
+		return ok
+	}
+}
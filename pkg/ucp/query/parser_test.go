@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		expected Expression
+	}{
+		{
+			name:     "empty",
+			filter:   "  ",
+			expected: nil,
+		},
+		{
+			name:     "comparison",
+			filter:   "type == 'Applications.Core/applications'",
+			expected: &Comparison{Path: "type", Operator: OperatorEquals, Values: []any{"Applications.Core/applications"}},
+		},
+		{
+			name:     "escaped quote",
+			filter:   `tags.owner == 'o''brien'`,
+			expected: &Comparison{Path: "tags.owner", Operator: OperatorEquals, Values: []any{"o'brien"}},
+		},
+		{
+			name:   "number and boolean",
+			filter: "properties.port == 8080 and properties.enabled != TRUE",
+			expected: &And{Expressions: []Expression{
+				&Comparison{Path: "properties.port", Operator: OperatorEquals, Values: []any{float64(8080)}},
+				&Comparison{Path: "properties.enabled", Operator: OperatorNotEquals, Values: []any{true}},
+			}},
+		},
+		{
+			name:   "precedence",
+			filter: "name =~ \"a\" or name startswith 'b' and not tags.cost-center contains 'x'",
+			expected: &Or{Expressions: []Expression{
+				&Comparison{Path: "name", Operator: OperatorEqualsIgnoreCase, Values: []any{"a"}},
+				&And{Expressions: []Expression{
+					&Comparison{Path: "name", Operator: OperatorStartsWith, Values: []any{"b"}},
+					&Not{Expression: &Comparison{Path: "tags.cost-center", Operator: OperatorContains, Values: []any{"x"}}},
+				}},
+			}},
+		},
+		{
+			name:   "parentheses and in",
+			filter: "(type IN ('a', 'b') OR location !~ 'global') AND id EndsWith '/x'",
+			expected: &And{Expressions: []Expression{
+				&Or{Expressions: []Expression{
+					&Comparison{Path: "type", Operator: OperatorIn, Values: []any{"a", "b"}},
+					&Comparison{Path: "location", Operator: OperatorNotEqualsIgnoreCase, Values: []any{"global"}},
+				}},
+				&Comparison{Path: "id", Operator: OperatorEndsWith, Values: []any{"/x"}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := Parse(tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, expression)
+		})
+	}
+}
+
+func Test_Parse_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		expected string
+	}{
+		{name: "missing value", filter: "name ==", expected: "unexpected end of filter"},
+		{name: "missing operator", filter: "name 'a'", expected: `unexpected "a" at position 5`},
+		{name: "single equals", filter: "name = 'a'", expected: `unexpected character '=' at position 5`},
+		{name: "unterminated string", filter: "name == 'a", expected: "unterminated string at position 8"},
+		{name: "unbalanced parentheses", filter: "(name == 'a'", expected: "unexpected end of filter"},
+		{name: "trailing tokens", filter: "name == 'a' 'b'", expected: `unexpected "b" at position 12`},
+		{name: "invalid path", filter: "name. == 'a'", expected: `the property path "name." is invalid`},
+		{name: "keyword as path", filter: "and == 'a'", expected: `unexpected "and" at position 0`},
+		{name: "number with string operator", filter: "name contains 1", expected: `the operator "contains" requires a string at position 14`},
+		{name: "invalid number", filter: "name == 1.2.3", expected: `invalid number "1.2.3" at position 8`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := Parse(tt.filter)
+			require.Nil(t, expression)
+			require.ErrorIs(t, err, &ErrInvalidQuery{})
+			require.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
+func Test_Evaluate(t *testing.T) {
+	resource := map[string]any{
+		"id":   "/planes/radius/local/resourceGroups/prod/providers/Applications.Datastores/redisCaches/cache",
+		"name": "cache",
+		"type": "Applications.Datastores/redisCaches",
+		"tags": map[string]any{
+			"team": "Payments",
+		},
+		"properties": map[string]any{
+			"environment": "/planes/radius/local/resourceGroups/prod/providers/Applications.Core/environments/prod",
+			"port":        float64(6379),
+			"tls":         true,
+			"hosts":       []any{"a", "b"},
+		},
+	}
+
+	tests := []struct {
+		filter   string
+		expected bool
+	}{
+		{filter: "", expected: true},
+		{filter: "type == 'Applications.Datastores/redisCaches'", expected: true},
+		{filter: "type == 'applications.datastores/rediscaches'", expected: false},
+		{filter: "type =~ 'applications.datastores/rediscaches'", expected: true},
+		{filter: "type != 'Applications.Core/containers'", expected: true},
+		{filter: "type !~ 'APPLICATIONS.DATASTORES/REDISCACHES'", expected: false},
+		{filter: "properties.environment endswith '/ENVIRONMENTS/prod'", expected: true},
+		{filter: "id startswith '/planes/radius/local/resourcegroups/prod/'", expected: true},
+		{filter: "tags.team contains 'pay'", expected: true},
+		{filter: "tags.team == 'payments'", expected: false},
+		{filter: "properties.port == 6379", expected: true},
+		{filter: "properties.port == '6379'", expected: false},
+		{filter: "properties.port != '6379'", expected: true},
+		{filter: "properties.tls == true", expected: true},
+		{filter: "properties.tls == false", expected: false},
+		{filter: "properties.hosts == 'a'", expected: false},
+		{filter: "tags.missing == 'a'", expected: false},
+		{filter: "tags.missing != 'a'", expected: true},
+		{filter: "tags.missing !~ 'a'", expected: true},
+		{filter: "tags.missing contains 'a'", expected: false},
+		{filter: "name.length == 1", expected: false},
+		{filter: "name in ('other', 'cache')", expected: true},
+		{filter: "name in ('other', 'Cache')", expected: false},
+		{filter: "not (name == 'cache')", expected: false},
+		{filter: "name == 'other' or tags.team =~ 'payments'", expected: true},
+		{filter: "name == 'cache' and tags.team == 'other'", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			expression, err := Parse(tt.filter)
+			require.NoError(t, err)
+
+			if expression == nil {
+				require.True(t, tt.expected)
+				return
+			}
+			require.Equal(t, tt.expected, expression.Evaluate(resource))
+		})
+	}
+}
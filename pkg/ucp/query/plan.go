@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"github.com/radius-project/radius/pkg/components/database"
+)
+
+const (
+	// typePath is the property path of the resource type.
+	typePath = "type"
+)
+
+// plan is the part of a filter expression that is pushed down into database queries. Resources that match the
+// expression always match the plan, but resources that match the plan do not always match the expression.
+type plan struct {
+	// resourceTypes are the resource types of the matching resources. It is empty when the expression does not
+	// restrict the resource type.
+	resourceTypes []string
+
+	// filters are the equality filters that the matching resources pass.
+	filters []database.QueryFilter
+}
+
+// newPlan creates the plan of the expression from the comparisons in its top-level conjunction.
+func newPlan(expression Expression) plan {
+	result := plan{}
+	for _, term := range conjunction(expression) {
+		comparison, ok := term.(*Comparison)
+		if !ok {
+			continue
+		}
+
+		if comparison.Path == typePath {
+			// Resource types are compared case-insensitively by the database, which matches a superset of the
+			// resources matched by every operator that is pushed down.
+			switch comparison.Operator {
+			case OperatorEquals, OperatorEqualsIgnoreCase, OperatorIn:
+				if result.resourceTypes == nil {
+					result.resourceTypes = stringValues(comparison.Values)
+				}
+			}
+			continue
+		}
+
+		// Database filters compare string properties case-sensitively, which matches the '==' operator.
+		value, ok := comparison.Values[0].(string)
+		if !ok || comparison.Operator != OperatorEquals {
+			continue
+		}
+
+		filter := database.QueryFilter{Field: comparison.Path, Value: value}
+		if filter.Validate() == nil {
+			result.filters = append(result.filters, filter)
+		}
+	}
+
+	return result
+}
+
+// conjunction returns the expressions that must all match for the expression to match.
+func conjunction(expression Expression) []Expression {
+	and, ok := expression.(*And)
+	if !ok {
+		if expression == nil {
+			return nil
+		}
+		return []Expression{expression}
+	}
+
+	terms := []Expression{}
+	for _, child := range and.Expressions {
+		terms = append(terms, conjunction(child)...)
+	}
+
+	return terms
+}
+
+// stringValues returns the string values of the literals.
+func stringValues(values []any) []string {
+	result := []string{}
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package query
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newPlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		filter   string
+		expected plan
+	}{
+		{
+			name:     "empty",
+			filter:   "",
+			expected: plan{},
+		},
+		{
+			name:   "type and equality filters",
+			filter: "type == 'Applications.Core/containers' and (properties.application == 'app' and tags.team =~ 'a')",
+			expected: plan{
+				resourceTypes: []string{"Applications.Core/containers"},
+				filters:       []database.QueryFilter{{Field: "properties.application", Value: "app"}},
+			},
+		},
+		{
+			name:   "type list",
+			filter: "type in ('a/b', 'c/d') and type =~ 'e/f'",
+			expected: plan{
+				resourceTypes: []string{"a/b", "c/d"},
+			},
+		},
+		{
+			name:     "disjunction is not pushed down",
+			filter:   "type == 'a/b' or name == 'x'",
+			expected: plan{},
+		},
+		{
+			name:     "negation is not pushed down",
+			filter:   "not type == 'a/b' and type != 'c/d'",
+			expected: plan{},
+		},
+		{
+			name:     "non-string and unsupported paths are not pushed down",
+			filter:   "properties.port == 80 and tags.cost-center == 'x'",
+			expected: plan{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, err := Parse(tt.filter)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, newPlan(expression))
+		})
+	}
+}
@@ -41,14 +41,23 @@ const (
 
 // Lookup map to get the group/Kind information from kubernetes resource kind.
 var providerLookup map[string]string = map[string]string{
-	strings.ToLower(KindDeployment):          ResourceTypeDeployment,
-	strings.ToLower(KindService):             ResourceTypeService,
-	strings.ToLower(KindSecret):              ResourceTypeSecret,
-	strings.ToLower(KindServiceAccount):      ResourceTypeServiceAccount,
-	strings.ToLower(KindRole):                ResourceTypeRole,
-	strings.ToLower(KindRoleBinding):         ResourceTypeRoleBinding,
-	strings.ToLower(KindSecretProviderClass): ResourceTypeSecretProviderClass,
-	strings.ToLower(KindContourHTTPProxy):    ResourceTypeContourHTTPProxy,
+	strings.ToLower(KindDeployment):                       ResourceTypeDeployment,
+	strings.ToLower(KindService):                          ResourceTypeService,
+	strings.ToLower(KindSecret):                           ResourceTypeSecret,
+	strings.ToLower(KindServiceAccount):                   ResourceTypeServiceAccount,
+	strings.ToLower(KindRole):                             ResourceTypeRole,
+	strings.ToLower(KindRoleBinding):                      ResourceTypeRoleBinding,
+	strings.ToLower(KindSecretProviderClass):              ResourceTypeSecretProviderClass,
+	strings.ToLower(KindContourHTTPProxy):                 ResourceTypeContourHTTPProxy,
+	strings.ToLower(KindContourExtensionService):          ResourceTypeContourExtensionService,
+	strings.ToLower(KindGatewayAPIGateway):                ResourceTypeGatewayAPIGateway,
+	strings.ToLower(KindGatewayAPIHTTPRoute):              ResourceTypeGatewayAPIHTTPRoute,
+	strings.ToLower(KindGatewayAPITLSRoute):               ResourceTypeGatewayAPITLSRoute,
+	strings.ToLower(KindGatewayAPIReferenceGrant):         ResourceTypeGatewayAPIReferenceGrant,
+	strings.ToLower(KindEnvoyGatewaySecurityPolicy):       ResourceTypeEnvoyGatewaySecurityPolicy,
+	strings.ToLower(KindEnvoyGatewayBackendTrafficPolicy): ResourceTypeEnvoyGatewayBackendTrafficPolicy,
+	strings.ToLower(KindCertManagerCertificate):           ResourceTypeCertManagerCertificate,
+	strings.ToLower(KindCertManagerIssuer):                ResourceTypeCertManagerIssuer,
 }
 
 // ToParts returns the component parts of the given UCP resource ID.
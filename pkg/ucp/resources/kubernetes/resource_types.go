@@ -52,6 +52,45 @@ const (
 	KindContourHTTPProxy = "HTTPProxy"
 	// ResourceTypeContourHTTPProxy is the resource type of a Contour HTTPProxy.
 	ResourceTypeContourHTTPProxy = "projectcontour.io/HTTPProxy"
+	// KindContourExtensionService is the kind of a Contour ExtensionService.
+	KindContourExtensionService = "ExtensionService"
+	// ResourceTypeContourExtensionService is the resource type of a Contour ExtensionService.
+	ResourceTypeContourExtensionService = "projectcontour.io/ExtensionService"
+
+	// KindGatewayAPIGateway is the kind of a Gateway API Gateway.
+	KindGatewayAPIGateway = "Gateway"
+	// ResourceTypeGatewayAPIGateway is the resource type of a Gateway API Gateway.
+	ResourceTypeGatewayAPIGateway = "gateway.networking.k8s.io/Gateway"
+	// KindGatewayAPIHTTPRoute is the kind of a Gateway API HTTPRoute.
+	KindGatewayAPIHTTPRoute = "HTTPRoute"
+	// ResourceTypeGatewayAPIHTTPRoute is the resource type of a Gateway API HTTPRoute.
+	ResourceTypeGatewayAPIHTTPRoute = "gateway.networking.k8s.io/HTTPRoute"
+	// KindGatewayAPITLSRoute is the kind of a Gateway API TLSRoute.
+	KindGatewayAPITLSRoute = "TLSRoute"
+	// ResourceTypeGatewayAPITLSRoute is the resource type of a Gateway API TLSRoute.
+	ResourceTypeGatewayAPITLSRoute = "gateway.networking.k8s.io/TLSRoute"
+	// KindGatewayAPIReferenceGrant is the kind of a Gateway API ReferenceGrant.
+	KindGatewayAPIReferenceGrant = "ReferenceGrant"
+	// ResourceTypeGatewayAPIReferenceGrant is the resource type of a Gateway API ReferenceGrant.
+	ResourceTypeGatewayAPIReferenceGrant = "gateway.networking.k8s.io/ReferenceGrant"
+
+	// KindEnvoyGatewaySecurityPolicy is the kind of an Envoy Gateway SecurityPolicy.
+	KindEnvoyGatewaySecurityPolicy = "SecurityPolicy"
+	// ResourceTypeEnvoyGatewaySecurityPolicy is the resource type of an Envoy Gateway SecurityPolicy.
+	ResourceTypeEnvoyGatewaySecurityPolicy = "gateway.envoyproxy.io/SecurityPolicy"
+	// KindEnvoyGatewayBackendTrafficPolicy is the kind of an Envoy Gateway BackendTrafficPolicy.
+	KindEnvoyGatewayBackendTrafficPolicy = "BackendTrafficPolicy"
+	// ResourceTypeEnvoyGatewayBackendTrafficPolicy is the resource type of an Envoy Gateway BackendTrafficPolicy.
+	ResourceTypeEnvoyGatewayBackendTrafficPolicy = "gateway.envoyproxy.io/BackendTrafficPolicy"
+
+	// KindCertManagerCertificate is the kind of a cert-manager Certificate.
+	KindCertManagerCertificate = "Certificate"
+	// ResourceTypeCertManagerCertificate is the resource type of a cert-manager Certificate.
+	ResourceTypeCertManagerCertificate = "cert-manager.io/Certificate"
+	// KindCertManagerIssuer is the kind of a cert-manager Issuer.
+	KindCertManagerIssuer = "Issuer"
+	// ResourceTypeCertManagerIssuer is the resource type of a cert-manager Issuer.
+	ResourceTypeCertManagerIssuer = "cert-manager.io/Issuer"
 
 	// ResourceTypeDaprComponent is the resource type of a Dapr component.
 	ResourceTypeDaprComponent = "dapr.io/Component"
@@ -23,8 +23,8 @@ import (
 	"github.com/radius-project/radius/pkg/cli/helm"
 )
 
-// Ensure RadiusInstallationCheck implements PreflightCheck interface
-var _ PreflightCheck = (*RadiusInstallationCheck)(nil)
+// Ensure RadiusInstallationCheck implements RemediableCheck interface
+var _ RemediableCheck = (*RadiusInstallationCheck)(nil)
 
 // RadiusInstallationCheck validates that Radius is currently installed
 // in the cluster and in a healthy state for upgrading.
@@ -51,6 +51,11 @@ func (r *RadiusInstallationCheck) Severity() CheckSeverity {
 	return SeverityError
 }
 
+// Remediation returns the action to take when Radius is not installed.
+func (r *RadiusInstallationCheck) Remediation() string {
+	return "Install Radius with 'rad install kubernetes'."
+}
+
 // Run executes the Radius installation check.
 func (r *RadiusInstallationCheck) Run(ctx context.Context) (bool, string, error) {
 	state, err := r.helmInterface.CheckRadiusInstall(r.kubeContext)
@@ -108,4 +108,5 @@ func TestRadiusInstallationCheck_Properties(t *testing.T) {
 
 	assert.Equal(t, "Radius Installation", check.Name())
 	assert.Equal(t, SeverityError, check.Severity())
+	assert.Contains(t, check.Remediation(), "rad install kubernetes")
 }
@@ -30,8 +30,8 @@ const (
 	RadiusSystemNamespace = "radius-system"
 )
 
-// Ensure KubernetesConnectivityCheck implements PreflightCheck interface
-var _ PreflightCheck = (*KubernetesConnectivityCheck)(nil)
+// Ensure KubernetesConnectivityCheck implements RemediableCheck interface
+var _ RemediableCheck = (*KubernetesConnectivityCheck)(nil)
 
 // KubernetesConnectivityCheck validates cluster connectivity and basic permissions.
 type KubernetesConnectivityCheck struct {
@@ -64,6 +64,11 @@ func (k *KubernetesConnectivityCheck) Severity() CheckSeverity {
 	return SeverityError
 }
 
+// Remediation returns the action to take when the cluster cannot be reached.
+func (k *KubernetesConnectivityCheck) Remediation() string {
+	return "Check that the Kubernetes context points to a running cluster with 'kubectl cluster-info', and install Radius with 'rad install kubernetes' if the namespace is missing."
+}
+
 // Run executes the connectivity check.
 func (k *KubernetesConnectivityCheck) Run(ctx context.Context) (bool, string, error) {
 	clientset, err := k.getClientset()
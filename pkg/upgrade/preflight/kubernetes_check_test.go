@@ -34,6 +34,7 @@ func TestKubernetesConnectivityCheck_Properties(t *testing.T) {
 	check := NewKubernetesConnectivityCheck("test-context")
 	assert.Equal(t, "Kubernetes Connectivity", check.Name())
 	assert.Equal(t, SeverityError, check.Severity())
+	assert.Contains(t, check.Remediation(), "kubectl cluster-info")
 }
 
 func TestKubernetesConnectivityCheck_WithClientset(t *testing.T) {
@@ -56,21 +56,13 @@ func (r *Registry) RunChecks(ctx context.Context) ([]CheckResult, error) {
 	for _, check := range r.checks {
 		r.output.LogInfo("  Running %s...", check.Name())
 
-		success, message, err := check.Run(ctx)
-		result := CheckResult{
-			Check:    check,
-			Success:  success,
-			Message:  message,
-			Error:    err,
-			Severity: check.Severity(),
-		}
-
+		result := runCheck(ctx, check)
 		results = append(results, result)
 
 		r.logCheckResult(result)
 
 		// If this is an error severity check and it failed, stop immediately
-		if result.Severity == SeverityError && (!success || err != nil) {
+		if result.Severity == SeverityError && result.Failed() {
 			return results, fmt.Errorf("pre-flight check '%s' failed: %s", check.Name(), r.getFailureReason(result))
 		}
 	}
@@ -79,10 +71,39 @@ func (r *Registry) RunChecks(ctx context.Context) ([]CheckResult, error) {
 	return results, nil
 }
 
+// RunAllChecks executes all registered preflight checks and returns their results. Unlike RunChecks, it
+// does not log the results and does not stop when a check fails, so that every check is reported.
+func (r *Registry) RunAllChecks(ctx context.Context) []CheckResult {
+	results := make([]CheckResult, 0, len(r.checks))
+	for _, check := range r.checks {
+		results = append(results, runCheck(ctx, check))
+	}
+
+	return results
+}
+
+// runCheck executes a preflight check and returns its result.
+func runCheck(ctx context.Context, check PreflightCheck) CheckResult {
+	success, message, err := check.Run(ctx)
+	result := CheckResult{
+		Check:    check,
+		Success:  success,
+		Message:  message,
+		Error:    err,
+		Severity: check.Severity(),
+	}
+
+	if remediable, ok := check.(RemediableCheck); ok && result.Failed() {
+		result.Remediation = remediable.Remediation()
+	}
+
+	return result
+}
+
 // logCheckResult logs the result of a preflight check with appropriate formatting.
 func (r *Registry) logCheckResult(result CheckResult) {
 	status := "✓"
-	if !result.Success || result.Error != nil {
+	if result.Failed() {
 		status = "✗"
 	}
 
@@ -41,6 +41,14 @@ func (m *MockPreflightCheck) Run(ctx context.Context) (bool, string, error) {
 	return m.success, m.message, m.err
 }
 
+// MockRemediableCheck implements RemediableCheck for testing
+type MockRemediableCheck struct {
+	MockPreflightCheck
+	remediation string
+}
+
+func (m *MockRemediableCheck) Remediation() string { return m.remediation }
+
 func TestRegistry_NewRegistry(t *testing.T) {
 	mockOutput := &output.MockOutput{}
 	registry := NewRegistry(mockOutput)
@@ -249,3 +257,48 @@ func TestRegistry_GetFailureReason(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistry_RunAllChecks(t *testing.T) {
+	mockOutput := &output.MockOutput{}
+	registry := NewRegistry(mockOutput)
+
+	registry.AddCheck(&MockRemediableCheck{
+		MockPreflightCheck: MockPreflightCheck{
+			name:     "Failing Check",
+			severity: SeverityError,
+			success:  false,
+			message:  "This check failed",
+		},
+		remediation: "Fix it",
+	})
+	registry.AddCheck(&MockRemediableCheck{
+		MockPreflightCheck: MockPreflightCheck{
+			name:     "Passing Check",
+			severity: SeverityWarning,
+			success:  true,
+			message:  "This check passed",
+		},
+		remediation: "Nothing to fix",
+	})
+	registry.AddCheck(&MockPreflightCheck{
+		name:     "Erroring Check",
+		severity: SeverityWarning,
+		success:  true,
+		err:      errors.New("check error"),
+	})
+
+	results := registry.RunAllChecks(context.Background())
+
+	// All checks run even though an error severity check failed, and nothing is logged.
+	require.Len(t, results, 3)
+	assert.Empty(t, mockOutput.Writes)
+
+	assert.True(t, results[0].Failed())
+	assert.Equal(t, "Fix it", results[0].Remediation)
+
+	assert.False(t, results[1].Failed())
+	assert.Empty(t, results[1].Remediation)
+
+	assert.True(t, results[2].Failed())
+	assert.Empty(t, results[2].Remediation)
+}
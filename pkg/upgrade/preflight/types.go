@@ -32,6 +32,14 @@ type PreflightCheck interface {
 	Severity() CheckSeverity
 }
 
+// RemediableCheck is implemented by preflight checks that can describe how to fix a failure.
+type RemediableCheck interface {
+	PreflightCheck
+
+	// Remediation returns the action the user can take when this check fails.
+	Remediation() string
+}
+
 // CheckSeverity represents the severity level of a preflight check.
 type CheckSeverity string
 
@@ -53,4 +61,12 @@ type CheckResult struct {
 	Message  string
 	Error    error
 	Severity CheckSeverity
+
+	// Remediation describes how to fix the failure. It is only set when the check failed and implements RemediableCheck.
+	Remediation string
+}
+
+// Failed returns true if the check did not succeed or returned an error.
+func (r CheckResult) Failed() bool {
+	return !r.Success || r.Error != nil
 }